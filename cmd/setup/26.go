@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 26.sql
+	addRecoveryCodesColumn string
+)
+
+type AddRecoveryCodesColumn struct {
+	dbClient *database.DB
+}
+
+func (mig *AddRecoveryCodesColumn) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRecoveryCodesColumn)
+	return err
+}
+
+func (mig *AddRecoveryCodesColumn) String() string {
+	return "26_auth_users_recovery_codes_column"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS auth.users2 ADD COLUMN IF NOT EXISTS recovery_codes_added BOOL DEFAULT false;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 27.sql
+	addRateLimitFieldsToLimits string
+)
+
+type AddRateLimitFieldsToLimits struct {
+	dbClient *database.DB
+}
+
+func (mig *AddRateLimitFieldsToLimits) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRateLimitFieldsToLimits)
+	return err
+}
+
+func (mig *AddRateLimitFieldsToLimits) String() string {
+	return "27_add_rate_limit_fields_to_limits"
+}
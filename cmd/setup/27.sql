@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS projections.limits ADD COLUMN IF NOT EXISTS rate_limit_instance JSONB;
+ALTER TABLE IF EXISTS projections.limits ADD COLUMN IF NOT EXISTS rate_limit_org JSONB;
+ALTER TABLE IF EXISTS projections.limits ADD COLUMN IF NOT EXISTS rate_limit_client JSONB;
+ALTER TABLE IF EXISTS projections.limits ADD COLUMN IF NOT EXISTS rate_limit_ip JSONB;
@@ -104,6 +104,8 @@ type Steps struct {
 	s23CorrectGlobalUniqueConstraints      *CorrectGlobalUniqueConstraints
 	s24AddActorToAuthTokens                *AddActorToAuthTokens
 	s25User11AddLowerFieldsToVerifiedEmail *User11AddLowerFieldsToVerifiedEmail
+	s26AddRecoveryCodesColumn              *AddRecoveryCodesColumn
+	s27AddRateLimitFieldsToLimits          *AddRateLimitFieldsToLimits
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
@@ -15,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/api/saml"
+	"github.com/zitadel/zitadel/internal/api/scim"
 	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
@@ -25,10 +26,13 @@ import (
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	execution_handler "github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	static_config "github.com/zitadel/zitadel/internal/static/config"
 	metrics "github.com/zitadel/zitadel/internal/telemetry/metrics/config"
 	tracing "github.com/zitadel/zitadel/internal/telemetry/tracing/config"
@@ -53,6 +57,7 @@ type Config struct {
 	UserAgentCookie   *middleware.UserAgentCookieConfig
 	OIDC              oidc.Config
 	SAML              saml.Config
+	SCIM              scim.Config
 	Login             login.Config
 	Console           console.Config
 	AssetStorage      static_config.AssetStorageConfig
@@ -65,9 +70,12 @@ type Config struct {
 	CustomerPortal    string
 	Machine           *id.Config
 	Actions           *actions.Config
+	Executions        *ExecutionsConfig
+	LDAPSync          *ldapsync.WorkerConfig
 	Eventstore        *eventstore.Config
 	LogStore          *logstore.Configs
 	Quotas            *QuotasConfig
+	RateLimits        *ratelimit.Config
 	Telemetry         *handlers.TelemetryPusherConfig
 }
 
@@ -79,6 +87,10 @@ type QuotasConfig struct {
 	Execution *logstore.EmitterConfig
 }
 
+type ExecutionsConfig struct {
+	Delivery *execution_handler.WorkerConfig
+}
+
 func MustNewConfig(v *viper.Viper) *Config {
 	config := new(Config)
 
@@ -52,6 +52,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/api/robots_txt"
 	"github.com/zitadel/zitadel/internal/api/saml"
+	"github.com/zitadel/zitadel/internal/api/scim"
 	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
@@ -67,8 +68,10 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
 	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
+	execution_handler "github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/access"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/execution"
@@ -76,7 +79,9 @@ import (
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/provisioning"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/webauthn"
 	"github.com/zitadel/zitadel/openapi"
@@ -215,6 +220,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		keys.DomainVerification,
 		keys.OIDC,
 		keys.SAML,
+		keys.Target,
 		&http.Client{},
 		permissionCheck,
 		sessionTokenVerifier,
@@ -246,6 +252,8 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["backchannellogout"],
+		config.Projections.Customizations["ciba"],
 		*config.Telemetry,
 		config.ExternalDomain,
 		config.ExternalPort,
@@ -258,9 +266,39 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
+		keys.OIDC,
 	)
 	notification.Start(ctx)
 
+	provisioning.Register(
+		ctx,
+		config.Projections.Customizations["scimprovisioning"],
+		commands,
+		queries,
+		keys.IDPConfig,
+	)
+	provisioning.Start(ctx)
+
+	execution_handler.Register(
+		ctx,
+		config.Projections.Customizations["executionhandler"],
+		config.Executions.Delivery,
+		commands,
+		queries,
+		eventstoreClient,
+		keys.Target,
+	)
+	execution_handler.Start(ctx)
+
+	ldapsync.Register(
+		ctx,
+		config.LDAPSync,
+		commands,
+		queries,
+		keys.User,
+	)
+	ldapsync.Start(ctx)
+
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
 	if err != nil {
@@ -334,6 +372,8 @@ func startAPIs(
 	oidcPrefixes := []string{"/.well-known/openid-configuration", "/oidc/v1", "/oauth/v2"}
 	// always set the origin in the context if available in the http headers, no matter for what protocol
 	router.Use(middleware.WithOrigin(config.ExternalSecure))
+	// pass the client certificate for the mutual TLS client authentication and certificate bound tokens
+	router.Use(middleware.WithClientCertificate(config.TLS.ClientCertificateHeader))
 	systemTokenVerifier, err := internal_authz.StartSystemTokenVerifierFromConfig(http_util.BuildHTTP(config.ExternalDomain, config.ExternalPort, config.ExternalSecure), config.SystemAPIUsers)
 	if err != nil {
 		return nil, err
@@ -360,7 +400,7 @@ func startAPIs(
 		http_util.WithNonHttpOnly(),
 		http_util.WithMaxAge(int(math.Floor(config.Quotas.Access.ExhaustedCookieMaxAge.Seconds()))),
 	)
-	limitingAccessInterceptor := middleware.NewAccessInterceptor(accessSvc, exhaustedCookieHandler, &config.Quotas.Access.AccessConfig)
+	limitingAccessInterceptor := middleware.NewAccessInterceptor(accessSvc, exhaustedCookieHandler, &config.Quotas.Access.AccessConfig, ratelimit.NewLimiter(config.RateLimits))
 	apis, err := api.New(ctx, config.Port, router, queries, verifier, config.InternalAuthZ, tlsConfig, config.HTTP2HostHeader, config.HTTP1HostHeader, config.ExternalDomain, limitingAccessInterceptor)
 	if err != nil {
 		return nil, fmt.Errorf("error creating api %w", err)
@@ -420,6 +460,8 @@ func startAPIs(
 
 	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, config.ExternalSecure, instanceInterceptor.Handler))
 
+	apis.RegisterHandlerOnPrefix(scim.HandlerPrefix, scim.NewHandler(config.SCIM, commands, queries, verifier, config.InternalAuthZ, keys.User, config.ExternalSecure, middleware.CallDurationHandler, instanceInterceptor.Handler, limitingAccessInterceptor.Handle))
+
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources, login.EndpointExternalLoginCallbackFormPost, login.EndpointSAMLACS)
 	if err != nil {
 		return nil, err
@@ -465,7 +507,7 @@ func startAPIs(
 		store,
 		consolePath,
 		oidcServer.AuthCallbackURL(),
-		provider.AuthCallbackURL(samlProvider),
+		provider.AuthCallbackURL(samlProvider.Provider),
 		config.ExternalSecure,
 		userAgentInterceptor,
 		op.NewIssuerInterceptor(oidcServer.IssuerFromRequest).Handler,
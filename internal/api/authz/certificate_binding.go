@@ -0,0 +1,30 @@
+package authz
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CertificateThumbprint returns the base64url encoded SHA-256 hash of the DER encoded certificate (RFC 8705)
+func CertificateThumbprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// CheckCertificateBinding verifies that a token bound to a client certificate
+// is only used with a connection authenticated by the same certificate
+func CheckCertificateBinding(ctx context.Context, boundThumbprint string) error {
+	if boundThumbprint == "" {
+		return nil
+	}
+	cert := http_utils.ClientCertificate(ctx)
+	if cert == nil || CertificateThumbprint(cert) != boundThumbprint {
+		return zerrors.ThrowUnauthenticated(nil, "AUTH-Thai9", "Errors.Token.CertificateBindingInvalid")
+	}
+	return nil
+}
@@ -22,6 +22,8 @@ const (
 	dataKey               key = 2
 	allPermissionsKey     key = 3
 	instanceKey           key = 4
+	dpopRequestKey        key = 5
+	dpopThumbprintKey     key = 6
 )
 
 type CtxData struct {
@@ -29,6 +31,7 @@ type CtxData struct {
 	OrgID             string
 	ProjectID         string
 	AgentID           string
+	ClientID          string
 	PreferredLanguage string
 	ResourceOwner     string
 	SystemMemberships Memberships
@@ -99,7 +102,7 @@ func (s SystemTokenVerifierFunc) VerifySystemToken(ctx context.Context, token st
 func VerifyTokenAndCreateCtxData(ctx context.Context, token, orgID, orgDomain string, t APITokenVerifier) (_ CtxData, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
-	tokenWOBearer, err := extractBearerToken(token)
+	ctx, tokenWOBearer, err := extractAccessToken(ctx, token)
 	if err != nil {
 		return CtxData{}, err
 	}
@@ -145,6 +148,7 @@ func VerifyTokenAndCreateCtxData(ctx context.Context, token, orgID, orgDomain st
 		OrgID:             orgID,
 		ProjectID:         projectID,
 		AgentID:           agentID,
+		ClientID:          clientID,
 		PreferredLanguage: prefLang,
 		ResourceOwner:     resourceOwner,
 		SystemMemberships: sysMemberships,
@@ -0,0 +1,173 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	DPoPPrefix    = "DPoP "
+	DPoPTokenType = "DPoP"
+
+	dpopProofType = "dpop+jwt"
+	// dpopProofLifetime limits the time a proof is accepted after its creation
+	dpopProofLifetime = time.Minute
+	// dpopProofClockSkew allows proofs created by clients with a clock slightly ahead
+	dpopProofClockSkew = 5 * time.Second
+)
+
+// DPoPSigningAlgorithms are the asymmetric algorithms accepted for DPoP proofs
+var DPoPSigningAlgorithms = []string{
+	string(jose.RS256), string(jose.RS384), string(jose.RS512),
+	string(jose.PS256), string(jose.PS384), string(jose.PS512),
+	string(jose.ES256), string(jose.ES384), string(jose.ES512),
+	string(jose.EdDSA),
+}
+
+// DPoPRequest describes the request a DPoP proof (RFC 9449) was sent with
+type DPoPRequest struct {
+	Proof  string
+	Method string
+	URI    string
+}
+
+type dpopProofClaims struct {
+	JWTID           string `json:"jti"`
+	Method          string `json:"htm"`
+	URI             string `json:"htu"`
+	IssuedAt        int64  `json:"iat"`
+	AccessTokenHash string `json:"ath,omitempty"`
+}
+
+// WithDPoPRequest passes the DPoP proof of the request to the access token verification
+func WithDPoPRequest(ctx context.Context, request *DPoPRequest) context.Context {
+	return context.WithValue(ctx, dpopRequestKey, request)
+}
+
+func dpopRequestFromContext(ctx context.Context) *DPoPRequest {
+	request, _ := ctx.Value(dpopRequestKey).(*DPoPRequest)
+	return request
+}
+
+// DPoPThumbprint returns the thumbprint of the key of the verified DPoP proof of the request
+func DPoPThumbprint(ctx context.Context) string {
+	thumbprint, _ := ctx.Value(dpopThumbprintKey).(string)
+	return thumbprint
+}
+
+// CheckDPoPBinding verifies that a token bound to a key is only used with a proof of the same key
+// and that a proof is only used with a bound token
+func CheckDPoPBinding(ctx context.Context, boundThumbprint string) error {
+	if DPoPThumbprint(ctx) != boundThumbprint {
+		return zerrors.ThrowUnauthenticated(nil, "AUTH-aeJ4i", "Errors.Token.DPoPBindingInvalid")
+	}
+	return nil
+}
+
+// VerifyDPoPProof verifies the proof was created for the request with the public key in its header
+// and returns the base64url encoded SHA-256 thumbprint of the key (RFC 7638).
+// If an access token is passed, the proof must contain its hash.
+func VerifyDPoPProof(proof, method, uri, accessToken string, now time.Time) (_ string, err error) {
+	signature, err := jose.ParseSigned(proof)
+	if err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-Ohgh1", "Errors.Token.DPoPProofInvalid")
+	}
+	if len(signature.Signatures) != 1 {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-eiS3o", "Errors.Token.DPoPProofInvalid")
+	}
+	header := signature.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != dpopProofType {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-Ahv7e", "Errors.Token.DPoPProofInvalid")
+	}
+	if !slices.Contains(DPoPSigningAlgorithms, header.Algorithm) {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-xu4Ee", "Errors.Token.DPoPProofInvalid")
+	}
+	key := header.JSONWebKey
+	if key == nil || !key.Valid() || !key.IsPublic() {
+		return "", zerrors.ThrowUnauthenticated(nil, "AUTH-Quo0k", "Errors.Token.DPoPProofInvalid")
+	}
+	payload, err := signature.Verify(key)
+	if err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-wah5E", "Errors.Token.DPoPProofInvalid")
+	}
+	claims := new(dpopProofClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-Gei9a", "Errors.Token.DPoPProofInvalid")
+	}
+	if err = claims.verify(method, uri, accessToken, now); err != nil {
+		return "", err
+	}
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", zerrors.ThrowUnauthenticated(err, "AUTH-iTh4a", "Errors.Token.DPoPProofInvalid")
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+func (c *dpopProofClaims) verify(method, uri, accessToken string, now time.Time) error {
+	if c.JWTID == "" || c.Method != method {
+		return zerrors.ThrowUnauthenticated(nil, "AUTH-ieX5u", "Errors.Token.DPoPProofInvalid")
+	}
+	if !equalDPoPURIs(c.URI, uri) {
+		return zerrors.ThrowUnauthenticated(nil, "AUTH-Eek0i", "Errors.Token.DPoPProofInvalid")
+	}
+	issuedAt := time.Unix(c.IssuedAt, 0)
+	if issuedAt.Before(now.Add(-dpopProofLifetime)) || issuedAt.After(now.Add(dpopProofClockSkew)) {
+		return zerrors.ThrowUnauthenticated(nil, "AUTH-Jae6u", "Errors.Token.DPoPProofInvalid")
+	}
+	if accessToken == "" {
+		return nil
+	}
+	hash := sha256.Sum256([]byte(accessToken))
+	if c.AccessTokenHash != base64.RawURLEncoding.EncodeToString(hash[:]) {
+		return zerrors.ThrowUnauthenticated(nil, "AUTH-Ooc1e", "Errors.Token.DPoPProofInvalid")
+	}
+	return nil
+}
+
+// equalDPoPURIs compares the uris without query and fragment
+// and with case-insensitive scheme and host
+func equalDPoPURIs(proofURI, requestURI string) bool {
+	proof, err := url.Parse(proofURI)
+	if err != nil {
+		return false
+	}
+	request, err := url.Parse(requestURI)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(proof.Scheme, request.Scheme) &&
+		strings.EqualFold(proof.Host, request.Host) &&
+		proof.EscapedPath() == request.EscapedPath()
+}
+
+// extractAccessToken returns the access token of the authorization header.
+// For the DPoP scheme, the proof of the request is verified and the thumbprint of its key is passed in the context,
+// so the token verification can check the binding of the token.
+func extractAccessToken(ctx context.Context, header string) (context.Context, string, error) {
+	token, ok := strings.CutPrefix(header, DPoPPrefix)
+	if !ok {
+		token, err := extractBearerToken(header)
+		return ctx, token, err
+	}
+	request := dpopRequestFromContext(ctx)
+	if request == nil || request.Proof == "" {
+		return ctx, "", zerrors.ThrowUnauthenticated(nil, "AUTH-Ir4ae", "Errors.Token.DPoPProofInvalid")
+	}
+	thumbprint, err := VerifyDPoPProof(request.Proof, request.Method, request.URI, token, time.Now())
+	if err != nil {
+		return ctx, "", err
+	}
+	return context.WithValue(ctx, dpopThumbprintKey, thumbprint), token, nil
+}
@@ -0,0 +1,157 @@
+package authz
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDPoPProof(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwk := &jose.JSONWebKey{Key: key.Public(), Algorithm: string(jose.ES256)}
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	now := time.Now()
+	accessTokenHash := sha256.Sum256([]byte("accessToken"))
+
+	createProof := func(typ string, claims *dpopProofClaims) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, &jose.SignerOptions{
+			EmbedJWK:     true,
+			ExtraHeaders: map[jose.HeaderKey]interface{}{jose.HeaderType: typ},
+		})
+		require.NoError(t, err)
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+		signed, err := signer.Sign(payload)
+		require.NoError(t, err)
+		proof, err := signed.CompactSerialize()
+		require.NoError(t, err)
+		return proof
+	}
+	validClaims := func() *dpopProofClaims {
+		return &dpopProofClaims{
+			JWTID:    "jti",
+			Method:   "POST",
+			URI:      "https://issuer.com/oauth/v2/token",
+			IssuedAt: now.Unix(),
+		}
+	}
+
+	type args struct {
+		proof       string
+		method      string
+		uri         string
+		accessToken string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "invalid proof",
+			args: args{
+				proof:  "invalid",
+				method: "POST",
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong type",
+			args: args{
+				proof:  createProof("JWT", validClaims()),
+				method: "POST",
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong method",
+			args: args{
+				proof:  createProof(dpopProofType, validClaims()),
+				method: "GET",
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong uri",
+			args: args{
+				proof:  createProof(dpopProofType, validClaims()),
+				method: "POST",
+				uri:    "https://issuer.com/oauth/v2/introspect",
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			args: args{
+				proof: createProof(dpopProofType, func() *dpopProofClaims {
+					claims := validClaims()
+					claims.IssuedAt = now.Add(-2 * dpopProofLifetime).Unix()
+					return claims
+				}()),
+				method: "POST",
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing access token hash",
+			args: args{
+				proof:       createProof(dpopProofType, validClaims()),
+				method:      "POST",
+				uri:         "https://issuer.com/oauth/v2/token",
+				accessToken: "accessToken",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok",
+			args: args{
+				proof:  createProof(dpopProofType, validClaims()),
+				method: "POST",
+				uri:    "https://ISSUER.com/oauth/v2/token?query=ignored",
+			},
+			want: base64.RawURLEncoding.EncodeToString(thumbprint),
+		},
+		{
+			name: "ok with access token hash",
+			args: args{
+				proof: createProof(dpopProofType, func() *dpopProofClaims {
+					claims := validClaims()
+					claims.AccessTokenHash = base64.RawURLEncoding.EncodeToString(accessTokenHash[:])
+					return claims
+				}()),
+				method:      "POST",
+				uri:         "https://issuer.com/oauth/v2/token",
+				accessToken: "accessToken",
+			},
+			want: base64.RawURLEncoding.EncodeToString(thumbprint),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyDPoPProof(tt.args.proof, tt.args.method, tt.args.uri, tt.args.accessToken, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 var (
@@ -26,6 +27,7 @@ type Instance interface {
 	EnableImpersonation() bool
 	Block() *bool
 	AuditLogRetention() *time.Duration
+	RateLimits() *ratelimit.Rules
 	Features() feature.Features
 }
 
@@ -52,6 +54,10 @@ func (i *instance) AuditLogRetention() *time.Duration {
 	return nil
 }
 
+func (i *instance) RateLimits() *ratelimit.Rules {
+	return nil
+}
+
 func (i *instance) InstanceID() string {
 	return i.id
 }
@@ -9,6 +9,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 func Test_Instance(t *testing.T) {
@@ -94,6 +95,10 @@ func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) RateLimits() *ratelimit.Rules {
+	panic("shouldn't be called here")
+}
+
 func (m *mockInstance) InstanceID() string {
 	return "instanceID"
 }
@@ -795,23 +795,30 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 				oidcApps = append(oidcApps, &v1_pb.DataOIDCApplication{
 					AppId: app.ID,
 					App: &management_pb.AddOIDCAppRequest{
-						ProjectId:                app.ProjectID,
-						Name:                     app.Name,
-						RedirectUris:             app.OIDCConfig.RedirectURIs,
-						ResponseTypes:            responseTypes,
-						GrantTypes:               grantTypes,
-						AppType:                  app_pb.OIDCAppType(app.OIDCConfig.AppType),
-						AuthMethodType:           app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
-						PostLogoutRedirectUris:   app.OIDCConfig.PostLogoutRedirectURIs,
-						Version:                  app_pb.OIDCVersion(app.OIDCConfig.Version),
-						DevMode:                  app.OIDCConfig.IsDevMode,
-						AccessTokenType:          app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
-						AccessTokenRoleAssertion: app.OIDCConfig.AssertAccessTokenRole,
-						IdTokenRoleAssertion:     app.OIDCConfig.AssertIDTokenRole,
-						IdTokenUserinfoAssertion: app.OIDCConfig.AssertIDTokenUserinfo,
-						ClockSkew:                durationpb.New(app.OIDCConfig.ClockSkew),
-						AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
-						SkipNativeAppSuccessPage: app.OIDCConfig.SkipNativeAppSuccessPage,
+						ProjectId:                             app.ProjectID,
+						Name:                                  app.Name,
+						RedirectUris:                          app.OIDCConfig.RedirectURIs,
+						ResponseTypes:                         responseTypes,
+						GrantTypes:                            grantTypes,
+						AppType:                               app_pb.OIDCAppType(app.OIDCConfig.AppType),
+						AuthMethodType:                        app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
+						PostLogoutRedirectUris:                app.OIDCConfig.PostLogoutRedirectURIs,
+						Version:                               app_pb.OIDCVersion(app.OIDCConfig.Version),
+						DevMode:                               app.OIDCConfig.IsDevMode,
+						AccessTokenType:                       app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
+						AccessTokenRoleAssertion:              app.OIDCConfig.AssertAccessTokenRole,
+						IdTokenRoleAssertion:                  app.OIDCConfig.AssertIDTokenRole,
+						IdTokenUserinfoAssertion:              app.OIDCConfig.AssertIDTokenUserinfo,
+						ClockSkew:                             durationpb.New(app.OIDCConfig.ClockSkew),
+						AdditionalOrigins:                     app.OIDCConfig.AdditionalOrigins,
+						SkipNativeAppSuccessPage:              app.OIDCConfig.SkipNativeAppSuccessPage,
+						BackChannelLogoutUri:                  app.OIDCConfig.BackChannelLogoutURI,
+						FrontChannelLogoutUri:                 app.OIDCConfig.FrontChannelLogoutURI,
+						RequirePushedAuthRequest:              app.OIDCConfig.RequirePushedAuthRequest,
+						RequireRequestObject:                  app.OIDCConfig.RequireRequestObject,
+						RequireDpop:                           app.OIDCConfig.RequireDPoP,
+						TlsClientAuthSubjectDn:                app.OIDCConfig.TLSClientAuthSubjectDN,
+						BackChannelClientNotificationEndpoint: app.OIDCConfig.BackChannelClientNotificationEndpoint,
 					},
 				})
 			}
@@ -819,9 +826,10 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 				apiApps = append(apiApps, &v1_pb.DataAPIApplication{
 					AppId: app.ID,
 					App: &management_pb.AddAPIAppRequest{
-						ProjectId:      app.ProjectID,
-						Name:           app.Name,
-						AuthMethodType: app_pb.APIAuthMethodType(app.APIConfig.AuthMethodType),
+						ProjectId:              app.ProjectID,
+						Name:                   app.Name,
+						AuthMethodType:         app_pb.APIAuthMethodType(app.APIConfig.AuthMethodType),
+						TlsClientAuthSubjectDn: app.APIConfig.TLSClientAuthSubjectDN,
 					},
 				})
 			}
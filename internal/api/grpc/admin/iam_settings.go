@@ -5,6 +5,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -47,17 +48,45 @@ func (s *Server) UpdateSecretGenerator(ctx context.Context, req *admin_pb.Update
 }
 
 func (s *Server) GetSMTPConfig(ctx context.Context, req *admin_pb.GetSMTPConfigRequest) (*admin_pb.GetSMTPConfigResponse, error) {
-	smtp, err := s.query.SMTPConfigByAggregateID(ctx, authz.GetInstance(ctx).InstanceID())
+	configs, err := s.query.ActiveSMTPConfigs(ctx, "")
 	if err != nil {
 		return nil, err
 	}
+	if len(configs) == 0 {
+		return nil, zerrors.ThrowNotFound(nil, "ADMIN-Sf3kx", "Errors.SMTPConfig.NotFound")
+	}
 	return &admin_pb.GetSMTPConfigResponse{
+		SmtpConfig: SMTPConfigToPb(configs[0]),
+	}, nil
+}
+
+func (s *Server) GetSMTPConfigById(ctx context.Context, req *admin_pb.GetSMTPConfigByIdRequest) (*admin_pb.GetSMTPConfigByIdResponse, error) {
+	smtp, err := s.query.SMTPConfigByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetSMTPConfigByIdResponse{
 		SmtpConfig: SMTPConfigToPb(smtp),
 	}, nil
 }
 
+func (s *Server) ListSMTPConfigs(ctx context.Context, req *admin_pb.ListSMTPConfigsRequest) (*admin_pb.ListSMTPConfigsResponse, error) {
+	queries, err := listSMTPConfigsToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMTPConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListSMTPConfigsResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  SMTPConfigsToPb(result.SMTPConfigs),
+	}, nil
+}
+
 func (s *Server) AddSMTPConfig(ctx context.Context, req *admin_pb.AddSMTPConfigRequest) (*admin_pb.AddSMTPConfigResponse, error) {
-	details, err := s.command.AddSMTPConfig(ctx, AddSMTPToConfig(req))
+	id, details, err := s.command.AddSMTPConfig(ctx, AddSMTPToConfig(req))
 	if err != nil {
 		return nil, err
 	}
@@ -66,11 +95,12 @@ func (s *Server) AddSMTPConfig(ctx context.Context, req *admin_pb.AddSMTPConfigR
 			details.Sequence,
 			details.EventDate,
 			details.ResourceOwner),
+		Id: id,
 	}, nil
 }
 
 func (s *Server) UpdateSMTPConfig(ctx context.Context, req *admin_pb.UpdateSMTPConfigRequest) (*admin_pb.UpdateSMTPConfigResponse, error) {
-	details, err := s.command.ChangeSMTPConfig(ctx, UpdateSMTPToConfig(req))
+	details, err := s.command.ChangeSMTPConfig(ctx, UpdateSMTPToConfig(ctx, req))
 	if err != nil {
 		return nil, err
 	}
@@ -82,8 +112,8 @@ func (s *Server) UpdateSMTPConfig(ctx context.Context, req *admin_pb.UpdateSMTPC
 	}, nil
 }
 
-func (s *Server) RemoveSMTPConfig(ctx context.Context, _ *admin_pb.RemoveSMTPConfigRequest) (*admin_pb.RemoveSMTPConfigResponse, error) {
-	details, err := s.command.RemoveSMTPConfig(ctx)
+func (s *Server) RemoveSMTPConfig(ctx context.Context, req *admin_pb.RemoveSMTPConfigRequest) (*admin_pb.RemoveSMTPConfigResponse, error) {
+	details, err := s.command.RemoveSMTPConfig(ctx, smtpConfigIDOrDefault(ctx, req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -95,8 +125,39 @@ func (s *Server) RemoveSMTPConfig(ctx context.Context, _ *admin_pb.RemoveSMTPCon
 	}, nil
 }
 
+func (s *Server) AddSMTPConfigHTTP(ctx context.Context, req *admin_pb.AddSMTPConfigHTTPRequest) (*admin_pb.AddSMTPConfigHTTPResponse, error) {
+	id, details, err := s.command.AddSMTPConfigHTTP(ctx, AddSMTPConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMTPConfigHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMTPConfigHTTP(ctx context.Context, req *admin_pb.UpdateSMTPConfigHTTPRequest) (*admin_pb.UpdateSMTPConfigHTTPResponse, error) {
+	details, err := s.command.ChangeSMTPConfigHTTP(ctx, UpdateSMTPConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMTPConfigHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateSMTPConfigHTTPHeaders(ctx context.Context, req *admin_pb.UpdateSMTPConfigHTTPHeadersRequest) (*admin_pb.UpdateSMTPConfigHTTPHeadersResponse, error) {
+	details, err := s.command.ChangeSMTPConfigHTTPHeaders(ctx, req.Id, SMTPConfigHTTPHeadersToModel(req.Headers))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMTPConfigHTTPHeadersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) UpdateSMTPConfigPassword(ctx context.Context, req *admin_pb.UpdateSMTPConfigPasswordRequest) (*admin_pb.UpdateSMTPConfigPasswordResponse, error) {
-	details, err := s.command.ChangeSMTPConfigPassword(ctx, req.Password)
+	details, err := s.command.ChangeSMTPConfigPassword(ctx, smtpConfigIDOrDefault(ctx, req.Id), req.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +169,26 @@ func (s *Server) UpdateSMTPConfigPassword(ctx context.Context, req *admin_pb.Upd
 	}, nil
 }
 
+func (s *Server) ActivateSMTPConfig(ctx context.Context, req *admin_pb.ActivateSMTPConfigRequest) (*admin_pb.ActivateSMTPConfigResponse, error) {
+	details, err := s.command.ActivateSMTPConfig(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ActivateSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateSMTPConfig(ctx context.Context, req *admin_pb.DeactivateSMTPConfigRequest) (*admin_pb.DeactivateSMTPConfigResponse, error) {
+	details, err := s.command.DeactivateSMTPConfig(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.DeactivateSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) GetSecurityPolicy(ctx context.Context, req *admin_pb.GetSecurityPolicyRequest) (*admin_pb.GetSecurityPolicyResponse, error) {
 	policy, err := s.query.SecurityPolicy(ctx)
 	if err != nil {
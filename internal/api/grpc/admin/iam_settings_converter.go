@@ -1,13 +1,17 @@
 package admin
 
 import (
+	"context"
+
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpemail"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -131,46 +135,165 @@ func SecretGeneratorTypeToDomain(generatorType settings_pb.SecretGeneratorType)
 	}
 }
 
-func AddSMTPToConfig(req *admin_pb.AddSMTPConfigRequest) *smtp.Config {
-	return &smtp.Config{
-		Tls:            req.Tls,
-		From:           req.SenderAddress,
-		FromName:       req.SenderName,
-		ReplyToAddress: req.ReplyToAddress,
-		SMTP: smtp.SMTP{
-			Host:     req.Host,
-			User:     req.User,
-			Password: req.Password,
+func listSMTPConfigsToModel(req *admin_pb.ListSMTPConfigsRequest) (*query.SMTPConfigsSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, 0, 1)
+	if req.OrgId != "" {
+		orgIDQuery, err := query.NewSMTPConfigOrgIDSearchQuery(req.OrgId)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, orgIDQuery)
+	}
+	return &query.SMTPConfigsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func AddSMTPToConfig(req *admin_pb.AddSMTPConfigRequest) *command.SMTPConfig {
+	return &command.SMTPConfig{
+		OrgID:       req.OrgId,
+		Description: req.Description,
+		Priority:    req.Priority,
+		Config: &smtp.Config{
+			Tls:            req.Tls,
+			From:           req.SenderAddress,
+			FromName:       req.SenderName,
+			ReplyToAddress: req.ReplyToAddress,
+			SMTP: smtp.SMTP{
+				Host:     req.Host,
+				User:     req.User,
+				Password: req.Password,
+			},
+		},
+	}
+}
+
+func UpdateSMTPToConfig(ctx context.Context, req *admin_pb.UpdateSMTPConfigRequest) *command.SMTPConfig {
+	return &command.SMTPConfig{
+		ID:          smtpConfigIDOrDefault(ctx, req.Id),
+		Description: req.Description,
+		Priority:    req.Priority,
+		Config: &smtp.Config{
+			Tls:            req.Tls,
+			From:           req.SenderAddress,
+			FromName:       req.SenderName,
+			ReplyToAddress: req.ReplyToAddress,
+			SMTP: smtp.SMTP{
+				Host: req.Host,
+				User: req.User,
+			},
+		},
+	}
+}
+
+func AddSMTPConfigHTTPToConfig(req *admin_pb.AddSMTPConfigHTTPRequest) *command.SMTPConfigHTTP {
+	return &command.SMTPConfigHTTP{
+		OrgID:       req.OrgId,
+		Description: req.Description,
+		Priority:    req.Priority,
+		Config: &httpemail.Config{
+			Endpoint:       req.Endpoint,
+			Method:         req.Method,
+			ContentType:    req.ContentType,
+			BodyTemplate:   req.BodyTemplate,
+			Headers:        SMTPConfigHTTPHeadersToModel(req.Headers),
+			SenderAddress:  req.SenderAddress,
+			SenderName:     req.SenderName,
+			ReplyToAddress: req.ReplyToAddress,
 		},
 	}
 }
 
-func UpdateSMTPToConfig(req *admin_pb.UpdateSMTPConfigRequest) *smtp.Config {
-	return &smtp.Config{
-		Tls:            req.Tls,
-		From:           req.SenderAddress,
-		FromName:       req.SenderName,
-		ReplyToAddress: req.ReplyToAddress,
-		SMTP: smtp.SMTP{
-			Host: req.Host,
-			User: req.User,
+func UpdateSMTPConfigHTTPToConfig(req *admin_pb.UpdateSMTPConfigHTTPRequest) *command.SMTPConfigHTTP {
+	return &command.SMTPConfigHTTP{
+		ID:          req.Id,
+		Description: req.Description,
+		Priority:    req.Priority,
+		Config: &httpemail.Config{
+			Endpoint:       req.Endpoint,
+			Method:         req.Method,
+			ContentType:    req.ContentType,
+			BodyTemplate:   req.BodyTemplate,
+			SenderAddress:  req.SenderAddress,
+			SenderName:     req.SenderName,
+			ReplyToAddress: req.ReplyToAddress,
 		},
 	}
 }
 
+func SMTPConfigHTTPHeadersToModel(headers []*admin_pb.SMTPConfigHTTPHeader) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+	h := make(map[string]string, len(headers))
+	for _, header := range headers {
+		h[header.Key] = header.Value
+	}
+	return h
+}
+
+// smtpConfigIDOrDefault returns the id of the config created before multiple SMTP configs were supported
+// if no id is provided, so existing clients keep working
+func smtpConfigIDOrDefault(ctx context.Context, id string) string {
+	if id != "" {
+		return id
+	}
+	return authz.GetInstance(ctx).InstanceID()
+}
+
+func SMTPConfigsToPb(configs []*query.SMTPConfig) []*settings_pb.SMTPConfig {
+	c := make([]*settings_pb.SMTPConfig, len(configs))
+	for i, config := range configs {
+		c[i] = SMTPConfigToPb(config)
+	}
+	return c
+}
+
 func SMTPConfigToPb(smtp *query.SMTPConfig) *settings_pb.SMTPConfig {
 	mapped := &settings_pb.SMTPConfig{
-		Tls:            smtp.TLS,
+		Id:             smtp.ID,
+		OrgId:          smtp.OrgID,
+		Description:    smtp.Description,
+		Priority:       smtp.Priority,
+		State:          smtpConfigStateToPb(smtp.State),
 		SenderAddress:  smtp.SenderAddress,
 		SenderName:     smtp.SenderName,
 		ReplyToAddress: smtp.ReplyToAddress,
-		Host:           smtp.Host,
-		User:           smtp.User,
 		Details:        obj_grpc.ToViewDetailsPb(smtp.Sequence, smtp.CreationDate, smtp.ChangeDate, smtp.AggregateID),
 	}
+	if smtp.SMTPConfig != nil {
+		mapped.Tls = smtp.SMTPConfig.TLS
+		mapped.Host = smtp.SMTPConfig.Host
+		mapped.User = smtp.SMTPConfig.User
+	}
+	if smtp.HTTPConfig != nil {
+		mapped.Http = &settings_pb.HTTPEmailConfig{
+			Endpoint:     smtp.HTTPConfig.Endpoint,
+			Method:       smtp.HTTPConfig.Method,
+			ContentType:  smtp.HTTPConfig.ContentType,
+			BodyTemplate: smtp.HTTPConfig.BodyTemplate,
+		}
+	}
 	return mapped
 }
 
+func smtpConfigStateToPb(state domain.SMTPConfigState) settings_pb.SMTPConfigState {
+	switch state {
+	case domain.SMTPConfigStateActive:
+		return settings_pb.SMTPConfigState_SMTP_CONFIG_ACTIVE
+	case domain.SMTPConfigStateInactive:
+		return settings_pb.SMTPConfigState_SMTP_CONFIG_INACTIVE
+	default:
+		return settings_pb.SMTPConfigState_SMTP_CONFIG_STATE_UNSPECIFIED
+	}
+}
+
 func SecurityPolicyToPb(policy *query.SecurityPolicy) *settings_pb.SecurityPolicy {
 	return &settings_pb.SecurityPolicy{
 		Details:               obj_grpc.ToViewDetailsPb(policy.Sequence, policy.CreationDate, policy.ChangeDate, policy.AggregateID),
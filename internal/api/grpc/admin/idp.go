@@ -405,6 +405,51 @@ func (s *Server) UpdateLDAPProvider(ctx context.Context, req *admin_pb.UpdateLDA
 	}, nil
 }
 
+func (s *Server) SetLDAPProviderSync(ctx context.Context, req *admin_pb.SetLDAPProviderSyncRequest) (*admin_pb.SetLDAPProviderSyncResponse, error) {
+	details, err := s.command.SetInstanceLDAPSync(ctx, req.Id, setLDAPProviderSyncToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetLDAPProviderSyncResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetLDAPProviderSync(ctx context.Context, req *admin_pb.GetLDAPProviderSyncRequest) (*admin_pb.GetLDAPProviderSyncResponse, error) {
+	sync, err := s.query.GetInstanceLDAPSync(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetLDAPProviderSyncResponse{
+		Sync: idp_grpc.LDAPSyncToPb(sync),
+	}, nil
+}
+
+func (s *Server) RemoveLDAPProviderSync(ctx context.Context, req *admin_pb.RemoveLDAPProviderSyncRequest) (*admin_pb.RemoveLDAPProviderSyncResponse, error) {
+	details, err := s.command.RemoveInstanceLDAPSync(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveLDAPProviderSyncResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListLDAPProviderSyncRuns(ctx context.Context, req *admin_pb.ListLDAPProviderSyncRunsRequest) (*admin_pb.ListLDAPProviderSyncRunsResponse, error) {
+	queries, err := listLDAPProviderSyncRunsToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchLDAPSyncRuns(ctx, req.Id, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListLDAPProviderSyncRunsResponse{
+		Result:  idp_grpc.LDAPSyncRunsToPb(resp.LDAPSyncRuns),
+		Details: object_pb.ToListDetails(resp.Count, resp.Sequence, resp.LastRun),
+	}, nil
+}
+
 func (s *Server) AddAppleProvider(ctx context.Context, req *admin_pb.AddAppleProviderRequest) (*admin_pb.AddAppleProviderResponse, error) {
 	id, details, err := s.command.AddInstanceAppleProvider(ctx, addAppleProviderToCommand(req))
 	if err != nil {
@@ -444,6 +444,37 @@ func updateLDAPProviderToCommand(req *admin_pb.UpdateLDAPProviderRequest) comman
 	}
 }
 
+func setLDAPProviderSyncToCommand(req *admin_pb.SetLDAPProviderSyncRequest) *command.LDAPSync {
+	return &command.LDAPSync{
+		OrgID:             req.OrgId,
+		Interval:          req.Interval.AsDuration(),
+		GroupsAttribute:   req.GroupsAttribute,
+		DeactivateMissing: req.DeactivateMissing,
+		GroupRoleMappings: idp_grpc.LDAPGroupRoleMappingsToCommand(req.GroupRoleMappings),
+	}
+}
+
+func listLDAPProviderSyncRunsToQuery(req *admin_pb.ListLDAPProviderSyncRunsRequest) (*query.LDAPSyncRunSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, 0, 1)
+	if req.State != idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_UNSPECIFIED {
+		stateQuery, err := query.NewLDAPSyncRunStateSearchQuery(idp_grpc.LDAPSyncRunStateToDomain(req.State))
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, stateQuery)
+	}
+	return &query.LDAPSyncRunSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.LDAPSyncRunColumnStarted,
+		},
+		Queries: queries,
+	}, nil
+}
+
 func addAppleProviderToCommand(req *admin_pb.AddAppleProviderRequest) command.AppleProvider {
 	return command.AppleProvider{
 		Name:       req.Name,
@@ -65,6 +65,99 @@ func (s *Server) UpdateSMSProviderTwilioToken(ctx context.Context, req *admin_pb
 	}, nil
 }
 
+func (s *Server) AddSMSProviderVonage(ctx context.Context, req *admin_pb.AddSMSProviderVonageRequest) (*admin_pb.AddSMSProviderVonageResponse, error) {
+	id, result, err := s.command.AddSMSConfigVonage(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigVonageToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderVonageResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonage(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageRequest) (*admin_pb.UpdateSMSProviderVonageResponse, error) {
+	result, err := s.command.ChangeSMSConfigVonage(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, UpdateSMSConfigVonageToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonageAPISecret(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageAPISecretRequest) (*admin_pb.UpdateSMSProviderVonageAPISecretResponse, error) {
+	result, err := s.command.ChangeSMSConfigVonageAPISecret(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.ApiSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageAPISecretResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderMessageBird(ctx context.Context, req *admin_pb.AddSMSProviderMessageBirdRequest) (*admin_pb.AddSMSProviderMessageBirdResponse, error) {
+	id, result, err := s.command.AddSMSConfigMessageBird(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigMessageBirdToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderMessageBirdResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderMessageBird(ctx context.Context, req *admin_pb.UpdateSMSProviderMessageBirdRequest) (*admin_pb.UpdateSMSProviderMessageBirdResponse, error) {
+	result, err := s.command.ChangeSMSConfigMessageBird(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, UpdateSMSConfigMessageBirdToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderMessageBirdResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderMessageBirdAccessKey(ctx context.Context, req *admin_pb.UpdateSMSProviderMessageBirdAccessKeyRequest) (*admin_pb.UpdateSMSProviderMessageBirdAccessKeyResponse, error) {
+	result, err := s.command.ChangeSMSConfigMessageBirdAccessKey(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.AccessKey)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderMessageBirdAccessKeyResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderHTTP(ctx context.Context, req *admin_pb.AddSMSProviderHTTPRequest) (*admin_pb.AddSMSProviderHTTPResponse, error) {
+	id, result, err := s.command.AddSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderHTTPResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPRequest) (*admin_pb.UpdateSMSProviderHTTPResponse, error) {
+	result, err := s.command.ChangeSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, UpdateSMSConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTPAuthorization(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPAuthorizationRequest) (*admin_pb.UpdateSMSProviderHTTPAuthorizationResponse, error) {
+	result, err := s.command.ChangeSMSConfigHTTPAuthorization(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPAuthorizationResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
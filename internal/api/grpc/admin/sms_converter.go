@@ -3,7 +3,10 @@ package admin
 import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
@@ -38,10 +41,18 @@ func SMSConfigToProviderPb(config *query.SMSConfig) *settings_pb.SMSProvider {
 }
 
 func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
-	if config.TwilioConfig != nil {
+	switch {
+	case config.TwilioConfig != nil:
 		return TwilioConfigToPb(config.TwilioConfig)
+	case config.VonageConfig != nil:
+		return VonageConfigToPb(config.VonageConfig)
+	case config.MessageBirdConfig != nil:
+		return MessageBirdConfigToPb(config.MessageBirdConfig)
+	case config.HTTPConfig != nil:
+		return HTTPSMSConfigToPb(config.HTTPConfig)
+	default:
+		return nil
 	}
-	return nil
 }
 
 func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
@@ -53,6 +64,35 @@ func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	}
 }
 
+func VonageConfigToPb(vonage *query.Vonage) *settings_pb.SMSProvider_Vonage {
+	return &settings_pb.SMSProvider_Vonage{
+		Vonage: &settings_pb.VonageConfig{
+			ApiKey:       vonage.APIKey,
+			SenderNumber: vonage.SenderNumber,
+		},
+	}
+}
+
+func MessageBirdConfigToPb(messageBird *query.MessageBird) *settings_pb.SMSProvider_MessageBird {
+	return &settings_pb.SMSProvider_MessageBird{
+		MessageBird: &settings_pb.MessageBirdConfig{
+			Originator: messageBird.Originator,
+		},
+	}
+}
+
+func HTTPSMSConfigToPb(http *query.SMSHTTP) *settings_pb.SMSProvider_Http {
+	return &settings_pb.SMSProvider_Http{
+		Http: &settings_pb.HTTPSMSConfig{
+			Endpoint:     http.Endpoint,
+			Method:       http.Method,
+			ContentType:  http.ContentType,
+			BodyTemplate: http.BodyTemplate,
+			SenderNumber: http.SenderNumber,
+		},
+	}
+}
+
 func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
 	switch state {
 	case domain.SMSConfigStateInactive:
@@ -78,3 +118,52 @@ func UpdateSMSConfigTwilioToConfig(req *admin_pb.UpdateSMSProviderTwilioRequest)
 		SenderNumber: req.SenderNumber,
 	}
 }
+
+func AddSMSConfigVonageToConfig(req *admin_pb.AddSMSProviderVonageRequest) *vonage.Config {
+	return &vonage.Config{
+		APIKey:       req.ApiKey,
+		APISecret:    req.ApiSecret,
+		SenderNumber: req.SenderNumber,
+	}
+}
+
+func UpdateSMSConfigVonageToConfig(req *admin_pb.UpdateSMSProviderVonageRequest) *vonage.Config {
+	return &vonage.Config{
+		APIKey:       req.ApiKey,
+		SenderNumber: req.SenderNumber,
+	}
+}
+
+func AddSMSConfigMessageBirdToConfig(req *admin_pb.AddSMSProviderMessageBirdRequest) *messagebird.Config {
+	return &messagebird.Config{
+		AccessKey:  req.AccessKey,
+		Originator: req.Originator,
+	}
+}
+
+func UpdateSMSConfigMessageBirdToConfig(req *admin_pb.UpdateSMSProviderMessageBirdRequest) *messagebird.Config {
+	return &messagebird.Config{
+		Originator: req.Originator,
+	}
+}
+
+func AddSMSConfigHTTPToConfig(req *admin_pb.AddSMSProviderHTTPRequest) *httpsms.Config {
+	return &httpsms.Config{
+		Endpoint:      req.Endpoint,
+		Method:        req.Method,
+		ContentType:   req.ContentType,
+		BodyTemplate:  req.BodyTemplate,
+		Authorization: req.Authorization,
+		SenderNumber:  req.SenderNumber,
+	}
+}
+
+func UpdateSMSConfigHTTPToConfig(req *admin_pb.UpdateSMSProviderHTTPRequest) *httpsms.Config {
+	return &httpsms.Config{
+		Endpoint:     req.Endpoint,
+		Method:       req.Method,
+		ContentType:  req.ContentType,
+		BodyTemplate: req.BodyTemplate,
+		SenderNumber: req.SenderNumber,
+	}
+}
@@ -0,0 +1,172 @@
+package execution
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	execution "github.com/zitadel/zitadel/pkg/grpc/execution/v3alpha"
+)
+
+func (s *Server) ListDeliveries(ctx context.Context, req *execution.ListDeliveriesRequest) (*execution.ListDeliveriesResponse, error) {
+	queries, err := listDeliveriesRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchExecutionDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := deliveriesToPb(resp.ExecutionDeliveries)
+	if err != nil {
+		return nil, err
+	}
+	return &execution.ListDeliveriesResponse{
+		Result:        deliveries,
+		Details:       object.ToListDetails(resp.SearchResponse),
+		SortingColumn: req.GetSortingColumn(),
+	}, nil
+}
+
+func (s *Server) ReplayDelivery(ctx context.Context, req *execution.ReplayDeliveryRequest) (*execution.ReplayDeliveryResponse, error) {
+	details, err := s.command.ReplayExecutionDelivery(ctx, req.GetDeliveryId(), authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &execution.ReplayDeliveryResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func listDeliveriesRequestToModel(req *execution.ListDeliveriesRequest) (*query.ExecutionDeliverySearchQueries, error) {
+	offset, limit, asc := object.ListQueryToQuery(req.Query)
+	queries, err := deliveryQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ExecutionDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: deliveryFieldNameToSortingColumn(req.SortingColumn),
+		},
+		Queries: queries,
+	}, nil
+}
+
+func deliveryFieldNameToSortingColumn(field execution.DeliveryFieldName) query.Column {
+	switch field {
+	case execution.DeliveryFieldName_DELIVERY_FIELD_NAME_UNSPECIFIED:
+		return query.ExecutionDeliveryColumnCreationDate
+	case execution.DeliveryFieldName_DELIVERY_FIELD_NAME_ID:
+		return query.ExecutionDeliveryColumnID
+	case execution.DeliveryFieldName_DELIVERY_FIELD_NAME_CREATION_DATE:
+		return query.ExecutionDeliveryColumnCreationDate
+	case execution.DeliveryFieldName_DELIVERY_FIELD_NAME_CHANGE_DATE:
+		return query.ExecutionDeliveryColumnChangeDate
+	case execution.DeliveryFieldName_DELIVERY_FIELD_NAME_STATE:
+		return query.ExecutionDeliveryColumnState
+	case execution.DeliveryFieldName_DELIVERY_FIELD_NAME_NEXT_ATTEMPT:
+		return query.ExecutionDeliveryColumnNextAttempt
+	default:
+		return query.ExecutionDeliveryColumnCreationDate
+	}
+}
+
+func deliveryQueriesToQuery(queries []*execution.DeliverySearchQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = deliveryQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func deliveryQueryToQuery(searchQuery *execution.DeliverySearchQuery) (query.SearchQuery, error) {
+	switch q := searchQuery.Query.(type) {
+	case *execution.DeliverySearchQuery_TargetQuery:
+		return query.NewExecutionDeliveryTargetIDSearchQuery(q.TargetQuery.GetTargetId())
+	case *execution.DeliverySearchQuery_StateQuery:
+		return query.NewExecutionDeliveryStateSearchQuery(deliveryStateToDomain(q.StateQuery.GetState()))
+	case *execution.DeliverySearchQuery_EventTypeQuery:
+		return query.NewExecutionDeliveryEventTypeSearchQuery(object.TextMethodToQuery(q.EventTypeQuery.GetMethod()), q.EventTypeQuery.GetEventType())
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GRPC-Dl2qv", "List.Query.Invalid")
+	}
+}
+
+func deliveryStateToDomain(state execution.DeliveryState) domain.ExecutionDeliveryState {
+	switch state {
+	case execution.DeliveryState_DELIVERY_STATE_PENDING:
+		return domain.ExecutionDeliveryStatePending
+	case execution.DeliveryState_DELIVERY_STATE_SUCCEEDED:
+		return domain.ExecutionDeliveryStateSucceeded
+	case execution.DeliveryState_DELIVERY_STATE_DEAD_LETTER:
+		return domain.ExecutionDeliveryStateDeadLetter
+	case execution.DeliveryState_DELIVERY_STATE_UNSPECIFIED:
+		return domain.ExecutionDeliveryStateUnspecified
+	default:
+		return domain.ExecutionDeliveryStateUnspecified
+	}
+}
+
+func deliveryStateToPb(state domain.ExecutionDeliveryState) execution.DeliveryState {
+	switch state {
+	case domain.ExecutionDeliveryStatePending:
+		return execution.DeliveryState_DELIVERY_STATE_PENDING
+	case domain.ExecutionDeliveryStateSucceeded:
+		return execution.DeliveryState_DELIVERY_STATE_SUCCEEDED
+	case domain.ExecutionDeliveryStateDeadLetter:
+		return execution.DeliveryState_DELIVERY_STATE_DEAD_LETTER
+	case domain.ExecutionDeliveryStateUnspecified:
+		return execution.DeliveryState_DELIVERY_STATE_UNSPECIFIED
+	default:
+		return execution.DeliveryState_DELIVERY_STATE_UNSPECIFIED
+	}
+}
+
+func deliveriesToPb(deliveries []*query.ExecutionDelivery) (_ []*execution.Delivery, err error) {
+	d := make([]*execution.Delivery, len(deliveries))
+	for i, delivery := range deliveries {
+		d[i], err = deliveryToPb(delivery)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func deliveryToPb(d *query.ExecutionDelivery) (*execution.Delivery, error) {
+	body := new(structpb.Struct)
+	if err := body.UnmarshalJSON(d.Body); err != nil {
+		return nil, err
+	}
+	delivery := &execution.Delivery{
+		DeliveryId:     d.ID,
+		Details:        object.DomainToDetailsPb(&d.ObjectDetails),
+		TargetId:       d.TargetID,
+		ExecutionId:    d.ExecutionID,
+		EventType:      d.EventType,
+		AggregateType:  d.AggregateType,
+		AggregateId:    d.AggregateID,
+		Body:           body,
+		State:          deliveryStateToPb(d.State),
+		Attempts:       uint32(d.Attempts),
+		LastError:      d.LastError,
+		LastStatusCode: uint32(d.LastStatusCode),
+		CreationDate:   timestamppb.New(d.CreationDate),
+	}
+	if !d.NextAttempt.IsZero() {
+		delivery.NextAttempt = timestamppb.New(d.NextAttempt)
+	}
+	return delivery, nil
+}
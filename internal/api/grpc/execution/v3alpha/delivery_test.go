@@ -0,0 +1,109 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	execution "github.com/zitadel/zitadel/pkg/grpc/execution/v3alpha"
+	object "github.com/zitadel/zitadel/pkg/grpc/object/v2beta"
+)
+
+func Test_deliveryToPb(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	body, err := structpb.NewStruct(map[string]any{"aggregateID": "user1"})
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		delivery *query.ExecutionDelivery
+		want     *execution.Delivery
+		wantErr  bool
+	}{
+		{
+			name: "invalid body",
+			delivery: &query.ExecutionDelivery{
+				Body: []byte("body"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "dead lettered",
+			delivery: &query.ExecutionDelivery{
+				ID: "delivery1",
+				ObjectDetails: domain.ObjectDetails{
+					Sequence:      2,
+					EventDate:     now,
+					ResourceOwner: "instance1",
+				},
+				CreationDate:   now,
+				InstanceID:     "instance1",
+				TargetID:       "target1",
+				ExecutionID:    "event.user",
+				EventType:      "user.human.added",
+				AggregateType:  "user",
+				AggregateID:    "user1",
+				Body:           []byte(`{"aggregateID":"user1"}`),
+				State:          domain.ExecutionDeliveryStateDeadLetter,
+				Attempts:       10,
+				LastError:      "connection refused",
+				LastStatusCode: 0,
+			},
+			want: &execution.Delivery{
+				DeliveryId: "delivery1",
+				Details: &object.Details{
+					Sequence:      2,
+					ChangeDate:    timestamppb.New(now),
+					ResourceOwner: "instance1",
+				},
+				TargetId:      "target1",
+				ExecutionId:   "event.user",
+				EventType:     "user.human.added",
+				AggregateType: "user",
+				AggregateId:   "user1",
+				Body:          body,
+				State:         execution.DeliveryState_DELIVERY_STATE_DEAD_LETTER,
+				Attempts:      10,
+				LastError:     "connection refused",
+				CreationDate:  timestamppb.New(now),
+			},
+		},
+		{
+			name: "pending",
+			delivery: &query.ExecutionDelivery{
+				ID:             "delivery1",
+				Body:           []byte(`{"aggregateID":"user1"}`),
+				State:          domain.ExecutionDeliveryStatePending,
+				Attempts:       1,
+				NextAttempt:    now,
+				LastStatusCode: 503,
+			},
+			want: &execution.Delivery{
+				DeliveryId:     "delivery1",
+				Details:        &object.Details{},
+				Body:           body,
+				State:          execution.DeliveryState_DELIVERY_STATE_PENDING,
+				Attempts:       1,
+				NextAttempt:    timestamppb.New(now),
+				LastStatusCode: 503,
+				CreationDate:   timestamppb.New(time.Time{}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deliveryToPb(tt.delivery)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -20,18 +20,25 @@ func (s *Server) CreateTarget(ctx context.Context, req *execution.CreateTargetRe
 		return nil, err
 	}
 	return &execution.CreateTargetResponse{
-		Id:      add.AggregateID,
-		Details: object.DomainToDetailsPb(details),
+		Id:         add.AggregateID,
+		Details:    object.DomainToDetailsPb(details),
+		SigningKey: add.SigningKey,
 	}, nil
 }
 
 func (s *Server) UpdateTarget(ctx context.Context, req *execution.UpdateTargetRequest) (*execution.UpdateTargetResponse, error) {
-	details, err := s.command.ChangeTarget(ctx, updateTargetToCommand(req), authz.GetInstance(ctx).InstanceID())
+	change := updateTargetToCommand(req)
+	details, err := s.command.ChangeTarget(ctx, change, authz.GetInstance(ctx).InstanceID())
 	if err != nil {
 		return nil, err
 	}
+	var signingKey *string
+	if change.SigningKey != "" {
+		signingKey = &change.SigningKey
+	}
 	return &execution.UpdateTargetResponse{
-		Details: object.DomainToDetailsPb(details),
+		Details:    object.DomainToDetailsPb(details),
+		SigningKey: signingKey,
 	}, nil
 }
 
@@ -74,7 +81,8 @@ func updateTargetToCommand(req *execution.UpdateTargetRequest) *command.ChangeTa
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.GetTargetId(),
 		},
-		Name: req.Name,
+		Name:                 req.Name,
+		RegenerateSigningKey: req.GetRegenerateSigningKey(),
 	}
 	switch t := req.GetTargetType().(type) {
 	case *execution.UpdateTargetRequest_RestWebhook:
@@ -182,6 +182,15 @@ func Test_updateTargetToCommand(t *testing.T) {
 				InterruptOnError: gu.Ptr(true),
 			},
 		},
+		{
+			name: "regenerate signing key",
+			args: args{&execution.UpdateTargetRequest{
+				RegenerateSigningKey: true,
+			}},
+			want: &command.ChangeTarget{
+				RegenerateSigningKey: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -50,6 +50,47 @@ func instanceFeaturesToPb(f *query.InstanceFeatures) *feature_pb.GetInstanceFeat
 	}
 }
 
+func orgFeaturesToCommand(req *feature_pb.SetOrganizationFeaturesRequest) *command.OrgFeatures {
+	return &command.OrgFeatures{
+		UserSchema:    req.UserSchema,
+		TokenExchange: req.OidcTokenExchange,
+	}
+}
+
+func orgFeaturesToPb(f *query.OrgFeatures) *feature_pb.GetOrganizationFeaturesResponse {
+	return &feature_pb.GetOrganizationFeaturesResponse{
+		Details:           object.DomainToDetailsPb(f.Details),
+		UserSchema:        featureSourceToFlagPb(&f.UserSchema),
+		OidcTokenExchange: featureSourceToFlagPb(&f.TokenExchange),
+	}
+}
+
+func projectFeaturesToCommand(req *feature_pb.SetProjectFeaturesRequest) *command.ProjectFeatures {
+	return &command.ProjectFeatures{
+		TokenExchange: req.OidcTokenExchange,
+	}
+}
+
+func projectFeaturesToPb(f *query.ProjectFeatures) *feature_pb.GetProjectFeaturesResponse {
+	return &feature_pb.GetProjectFeaturesResponse{
+		Details:           object.DomainToDetailsPb(f.Details),
+		OidcTokenExchange: featureSourceToFlagPb(&f.TokenExchange),
+	}
+}
+
+func appFeaturesToCommand(req *feature_pb.SetApplicationFeaturesRequest) *command.AppFeatures {
+	return &command.AppFeatures{
+		TokenExchange: req.OidcTokenExchange,
+	}
+}
+
+func appFeaturesToPb(f *query.AppFeatures) *feature_pb.GetApplicationFeaturesResponse {
+	return &feature_pb.GetApplicationFeaturesResponse{
+		Details:           object.DomainToDetailsPb(f.Details),
+		OidcTokenExchange: featureSourceToFlagPb(&f.TokenExchange),
+	}
+}
+
 func featureSourceToFlagPb(fs *query.FeatureSource[bool]) *feature_pb.FeatureFlag {
 	return &feature_pb.FeatureFlag{
 		Enabled: fs.Value,
@@ -172,6 +172,82 @@ func Test_instanceFeaturesToPb(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func Test_orgFeaturesToCommand(t *testing.T) {
+	arg := &feature_pb.SetOrganizationFeaturesRequest{
+		OrganizationId:    "org1",
+		UserSchema:        gu.Ptr(true),
+		OidcTokenExchange: nil,
+	}
+	want := &command.OrgFeatures{
+		UserSchema:    gu.Ptr(true),
+		TokenExchange: nil,
+	}
+	got := orgFeaturesToCommand(arg)
+	assert.Equal(t, want, got)
+}
+
+func Test_orgFeaturesToPb(t *testing.T) {
+	arg := &query.OrgFeatures{
+		Details: &domain.ObjectDetails{
+			Sequence:      22,
+			EventDate:     time.Unix(123, 0),
+			ResourceOwner: "org1",
+		},
+		UserSchema: query.FeatureSource[bool]{
+			Level: feature.LevelOrg,
+			Value: true,
+		},
+		TokenExchange: query.FeatureSource[bool]{
+			Level: feature.LevelInstance,
+			Value: false,
+		},
+	}
+	want := &feature_pb.GetOrganizationFeaturesResponse{
+		Details: &object.Details{
+			Sequence:      22,
+			ChangeDate:    &timestamppb.Timestamp{Seconds: 123},
+			ResourceOwner: "org1",
+		},
+		UserSchema: &feature_pb.FeatureFlag{
+			Enabled: true,
+			Source:  feature_pb.Source_SOURCE_ORGANIZATION,
+		},
+		OidcTokenExchange: &feature_pb.FeatureFlag{
+			Enabled: false,
+			Source:  feature_pb.Source_SOURCE_INSTANCE,
+		},
+	}
+	got := orgFeaturesToPb(arg)
+	assert.Equal(t, want, got)
+}
+
+func Test_appFeaturesToPb(t *testing.T) {
+	arg := &query.AppFeatures{
+		Details: &domain.ObjectDetails{
+			Sequence:      22,
+			EventDate:     time.Unix(123, 0),
+			ResourceOwner: "org1",
+		},
+		TokenExchange: query.FeatureSource[bool]{
+			Level: feature.LevelProject,
+			Value: true,
+		},
+	}
+	want := &feature_pb.GetApplicationFeaturesResponse{
+		Details: &object.Details{
+			Sequence:      22,
+			ChangeDate:    &timestamppb.Timestamp{Seconds: 123},
+			ResourceOwner: "org1",
+		},
+		OidcTokenExchange: &feature_pb.FeatureFlag{
+			Enabled: true,
+			Source:  feature_pb.Source_SOURCE_PROJECT,
+		},
+	}
+	got := appFeaturesToPb(arg)
+	assert.Equal(t, want, got)
+}
+
 func Test_featureLevelToSourcePb(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -67,14 +67,89 @@ func (s *Server) GetInstanceFeatures(ctx context.Context, req *feature.GetInstan
 }
 
 func (s *Server) SetOrganizationFeatures(ctx context.Context, req *feature.SetOrganizationFeaturesRequest) (_ *feature.SetOrganizationFeaturesResponse, err error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationFeatures not implemented")
+	details, err := s.command.SetOrgFeatures(ctx, req.GetOrganizationId(), orgFeaturesToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &feature.SetOrganizationFeaturesResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
 }
+
 func (s *Server) ResetOrganizationFeatures(ctx context.Context, req *feature.ResetOrganizationFeaturesRequest) (_ *feature.ResetOrganizationFeaturesResponse, err error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetOrganizationFeatures not implemented")
+	details, err := s.command.ResetOrgFeatures(ctx, req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	return &feature.ResetOrganizationFeaturesResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
 }
+
 func (s *Server) GetOrganizationFeatures(ctx context.Context, req *feature.GetOrganizationFeaturesRequest) (_ *feature.GetOrganizationFeaturesResponse, err error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationFeatures not implemented")
+	f, err := s.query.GetOrgFeatures(ctx, req.GetOrganizationId(), req.GetInheritance())
+	if err != nil {
+		return nil, err
+	}
+	return orgFeaturesToPb(f), nil
+}
+
+func (s *Server) SetProjectFeatures(ctx context.Context, req *feature.SetProjectFeaturesRequest) (_ *feature.SetProjectFeaturesResponse, err error) {
+	details, err := s.command.SetProjectFeatures(ctx, req.GetProjectId(), projectFeaturesToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &feature.SetProjectFeaturesResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ResetProjectFeatures(ctx context.Context, req *feature.ResetProjectFeaturesRequest) (_ *feature.ResetProjectFeaturesResponse, err error) {
+	details, err := s.command.ResetProjectFeatures(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	return &feature.ResetProjectFeaturesResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetProjectFeatures(ctx context.Context, req *feature.GetProjectFeaturesRequest) (_ *feature.GetProjectFeaturesResponse, err error) {
+	f, err := s.query.GetProjectFeatures(ctx, req.GetProjectId(), req.GetInheritance())
+	if err != nil {
+		return nil, err
+	}
+	return projectFeaturesToPb(f), nil
+}
+
+func (s *Server) SetApplicationFeatures(ctx context.Context, req *feature.SetApplicationFeaturesRequest) (_ *feature.SetApplicationFeaturesResponse, err error) {
+	details, err := s.command.SetAppFeatures(ctx, req.GetProjectId(), req.GetAppId(), appFeaturesToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &feature.SetApplicationFeaturesResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
 }
+
+func (s *Server) ResetApplicationFeatures(ctx context.Context, req *feature.ResetApplicationFeaturesRequest) (_ *feature.ResetApplicationFeaturesResponse, err error) {
+	details, err := s.command.ResetAppFeatures(ctx, req.GetProjectId(), req.GetAppId())
+	if err != nil {
+		return nil, err
+	}
+	return &feature.ResetApplicationFeaturesResponse{
+		Details: object.DomainToDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetApplicationFeatures(ctx context.Context, req *feature.GetApplicationFeaturesRequest) (_ *feature.GetApplicationFeaturesResponse, err error) {
+	f, err := s.query.GetAppFeatures(ctx, req.GetProjectId(), req.GetAppId(), req.GetInheritance())
+	if err != nil {
+		return nil, err
+	}
+	return appFeaturesToPb(f), nil
+}
+
 func (s *Server) SetUserFeatures(ctx context.Context, req *feature.SetUserFeatureRequest) (_ *feature.SetUserFeaturesResponse, err error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserFeatures not implemented")
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/zitadel/zitadel/internal/api/http"
 )
@@ -17,6 +18,17 @@ func GetGatewayHeader(ctx context.Context, headername string) string {
 	return GetHeader(ctx, runtime.MetadataPrefix+headername)
 }
 
+// GetLastHeader returns the last value of the header.
+// Values added by the gateway are appended to the ones forwarded from the http request,
+// so the last value can't be set by the client through the gateway.
+func GetLastHeader(ctx context.Context, headername string) string {
+	values := metadata.ValueFromIncomingContext(ctx, headername)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
 func GetAuthorizationHeader(ctx context.Context) string {
 	return GetHeader(ctx, http.Authorization)
 }
@@ -1,14 +1,18 @@
 package idp
 
 import (
+	"time"
+
 	"github.com/crewjam/saml"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
 	idp_pb "github.com/zitadel/zitadel/pkg/grpc/idp"
 )
 
@@ -599,6 +603,101 @@ func ldapAttributesToPb(attributes idp.LDAPAttributes) *idp_pb.LDAPAttributes {
 	}
 }
 
+func LDAPGroupRoleMappingsToCommand(mappings []*idp_pb.LDAPGroupRoleMapping) []*ldapsync.GroupRoleMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	result := make([]*ldapsync.GroupRoleMapping, len(mappings))
+	for i, mapping := range mappings {
+		result[i] = &ldapsync.GroupRoleMapping{
+			Group:     mapping.Group,
+			ProjectID: mapping.ProjectId,
+			RoleKeys:  mapping.RoleKeys,
+		}
+	}
+	return result
+}
+
+func LDAPSyncToPb(sync *query.LDAPSync) *idp_pb.LDAPSync {
+	mappings := make([]*idp_pb.LDAPGroupRoleMapping, len(sync.GroupRoleMappings))
+	for i, mapping := range sync.GroupRoleMappings {
+		mappings[i] = &idp_pb.LDAPGroupRoleMapping{
+			Group:     mapping.Group,
+			ProjectId: mapping.ProjectID,
+			RoleKeys:  mapping.RoleKeys,
+		}
+	}
+	pb := &idp_pb.LDAPSync{
+		Details:           obj_grpc.ToViewDetailsPb(sync.Sequence, sync.CreationDate, sync.EventDate, sync.ResourceOwner),
+		OrgId:             sync.OrgID,
+		Interval:          durationpb.New(sync.Interval),
+		GroupsAttribute:   sync.GroupsAttribute,
+		DeactivateMissing: sync.DeactivateMissing,
+		GroupRoleMappings: mappings,
+	}
+	if sync.LastRunID != "" {
+		pb.LastRun = &idp_pb.LDAPSyncRun{
+			Id:       sync.LastRunID,
+			State:    LDAPSyncRunStateToPb(sync.LastRunState),
+			Started:  timestamppb.New(sync.LastRunStarted),
+			Finished: optionalTimestampToPb(sync.LastRunFinished),
+		}
+	}
+	return pb
+}
+
+func LDAPSyncRunsToPb(runs []*query.LDAPSyncRun) []*idp_pb.LDAPSyncRun {
+	result := make([]*idp_pb.LDAPSyncRun, len(runs))
+	for i, run := range runs {
+		result[i] = &idp_pb.LDAPSyncRun{
+			Id:               run.ID,
+			State:            LDAPSyncRunStateToPb(run.State),
+			Started:          timestamppb.New(run.Started),
+			Finished:         optionalTimestampToPb(run.Finished),
+			UsersCreated:     run.UsersCreated,
+			UsersUpdated:     run.UsersUpdated,
+			UsersDeactivated: run.UsersDeactivated,
+			UsersReactivated: run.UsersReactivated,
+			GrantsChanged:    run.GrantsChanged,
+			Errors:           run.Errors,
+		}
+	}
+	return result
+}
+
+func LDAPSyncRunStateToPb(state domain.LDAPSyncRunState) idp_pb.LDAPSyncRunState {
+	switch state {
+	case domain.LDAPSyncRunStateRunning:
+		return idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_RUNNING
+	case domain.LDAPSyncRunStateSucceeded:
+		return idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_SUCCEEDED
+	case domain.LDAPSyncRunStateFailed:
+		return idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_FAILED
+	default:
+		return idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_UNSPECIFIED
+	}
+}
+
+func LDAPSyncRunStateToDomain(state idp_pb.LDAPSyncRunState) domain.LDAPSyncRunState {
+	switch state {
+	case idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_RUNNING:
+		return domain.LDAPSyncRunStateRunning
+	case idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_SUCCEEDED:
+		return domain.LDAPSyncRunStateSucceeded
+	case idp_pb.LDAPSyncRunState_LDAP_SYNC_RUN_STATE_FAILED:
+		return domain.LDAPSyncRunStateFailed
+	default:
+		return domain.LDAPSyncRunStateUnspecified
+	}
+}
+
+func optionalTimestampToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
 func appleConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.AppleIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Apple{
 		Apple: &idp_pb.AppleConfig{
@@ -8,6 +8,7 @@ import (
 	authn_grpc "github.com/zitadel/zitadel/internal/api/grpc/authn"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	app_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
@@ -41,22 +42,29 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) *domain.OIDCApp {
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		AppName:                  req.Name,
-		OIDCVersion:              app_grpc.OIDCVersionToDomain(req.Version),
-		RedirectUris:             req.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(req.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
-		PostLogoutRedirectUris:   req.PostLogoutRedirectUris,
-		DevMode:                  req.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
-		AccessTokenRoleAssertion: req.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     req.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: req.IdTokenUserinfoAssertion,
-		ClockSkew:                req.ClockSkew.AsDuration(),
-		AdditionalOrigins:        req.AdditionalOrigins,
-		SkipNativeAppSuccessPage: req.SkipNativeAppSuccessPage,
+		AppName:                               req.Name,
+		OIDCVersion:                           app_grpc.OIDCVersionToDomain(req.Version),
+		RedirectUris:                          req.RedirectUris,
+		ResponseTypes:                         app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
+		GrantTypes:                            app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
+		ApplicationType:                       app_grpc.OIDCApplicationTypeToDomain(req.AppType),
+		AuthMethodType:                        app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
+		PostLogoutRedirectUris:                req.PostLogoutRedirectUris,
+		DevMode:                               req.DevMode,
+		AccessTokenType:                       app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
+		AccessTokenRoleAssertion:              req.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  req.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              req.IdTokenUserinfoAssertion,
+		ClockSkew:                             req.ClockSkew.AsDuration(),
+		AdditionalOrigins:                     req.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              req.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  req.BackChannelLogoutUri,
+		FrontChannelLogoutURI:                 req.FrontChannelLogoutUri,
+		RequirePushedAuthRequest:              req.RequirePushedAuthRequest,
+		RequireRequestObject:                  req.RequireRequestObject,
+		RequireDPoP:                           req.RequireDpop,
+		TLSClientAuthSubjectDN:                req.TlsClientAuthSubjectDn,
+		BackChannelClientNotificationEndpoint: req.BackChannelClientNotificationEndpoint,
 	}
 }
 
@@ -76,8 +84,9 @@ func AddAPIAppRequestToDomain(app *mgmt_pb.AddAPIAppRequest) *domain.APIApp {
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppName:        app.Name,
-		AuthMethodType: app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		AppName:                app.Name,
+		AuthMethodType:         app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		TLSClientAuthSubjectDN: app.TlsClientAuthSubjectDn,
 	}
 }
 
@@ -93,21 +102,28 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:                    app.AppId,
-		RedirectUris:             app.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(app.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
-		PostLogoutRedirectUris:   app.PostLogoutRedirectUris,
-		DevMode:                  app.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
-		AccessTokenRoleAssertion: app.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     app.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: app.IdTokenUserinfoAssertion,
-		ClockSkew:                app.ClockSkew.AsDuration(),
-		AdditionalOrigins:        app.AdditionalOrigins,
-		SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
+		AppID:                                 app.AppId,
+		RedirectUris:                          app.RedirectUris,
+		ResponseTypes:                         app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                            app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:                       app_grpc.OIDCApplicationTypeToDomain(app.AppType),
+		AuthMethodType:                        app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
+		PostLogoutRedirectUris:                app.PostLogoutRedirectUris,
+		DevMode:                               app.DevMode,
+		AccessTokenType:                       app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
+		AccessTokenRoleAssertion:              app.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  app.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              app.IdTokenUserinfoAssertion,
+		ClockSkew:                             app.ClockSkew.AsDuration(),
+		AdditionalOrigins:                     app.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              app.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  app.BackChannelLogoutUri,
+		FrontChannelLogoutURI:                 app.FrontChannelLogoutUri,
+		RequirePushedAuthRequest:              app.RequirePushedAuthRequest,
+		RequireRequestObject:                  app.RequireRequestObject,
+		RequireDPoP:                           app.RequireDpop,
+		TLSClientAuthSubjectDN:                app.TlsClientAuthSubjectDn,
+		BackChannelClientNotificationEndpoint: app.BackChannelClientNotificationEndpoint,
 	}
 }
 
@@ -127,8 +143,9 @@ func UpdateAPIAppConfigRequestToDomain(app *mgmt_pb.UpdateAPIAppConfigRequest) *
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:          app.AppId,
-		AuthMethodType: app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		AppID:                  app.AppId,
+		AuthMethodType:         app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		TLSClientAuthSubjectDN: app.TlsClientAuthSubjectDn,
 	}
 }
 
@@ -145,6 +162,7 @@ func AddAPIClientKeyRequestToDomain(key *mgmt_pb.AddAppKeyRequest) *domain.Appli
 		ExpirationDate: expirationDate,
 		Type:           authn_grpc.KeyTypeToDomain(key.Type),
 		ApplicationID:  key.AppId,
+		PublicKey:      key.PublicKey,
 	}
 }
 
@@ -175,3 +193,11 @@ func ListAPIClientKeysRequestToQuery(ctx context.Context, req *mgmt_pb.ListAppKe
 		},
 	}, nil
 }
+
+func AddProjectRegistrationTokenRequestToCommand(req *mgmt_pb.AddProjectRegistrationTokenRequest, resourceOwner string) *command.ProjectRegistrationToken {
+	expirationDate := time.Time{}
+	if req.ExpirationDate != nil {
+		expirationDate = req.ExpirationDate.AsTime()
+	}
+	return command.NewProjectRegistrationToken(resourceOwner, req.ProjectId, expirationDate)
+}
@@ -0,0 +1,97 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetSCIMTargetByID(ctx context.Context, req *mgmt_pb.GetSCIMTargetByIDRequest) (*mgmt_pb.GetSCIMTargetByIDResponse, error) {
+	target, err := s.getSCIMTarget(ctx, req.ProjectId, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetSCIMTargetByIDResponse{
+		Target: SCIMTargetToPb(target),
+	}, nil
+}
+
+func (s *Server) ListSCIMTargets(ctx context.Context, req *mgmt_pb.ListSCIMTargetsRequest) (*mgmt_pb.ListSCIMTargetsResponse, error) {
+	queries, err := ListSCIMTargetsRequestToModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := s.query.SearchSCIMTargets(ctx, false, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListSCIMTargetsResponse{
+		Result:  SCIMTargetsToPb(targets.SCIMTargets),
+		Details: object_grpc.ToListDetails(targets.Count, targets.Sequence, targets.LastRun),
+	}, nil
+}
+
+func (s *Server) AddSCIMTarget(ctx context.Context, req *mgmt_pb.AddSCIMTargetRequest) (*mgmt_pb.AddSCIMTargetResponse, error) {
+	add := AddSCIMTargetRequestToCommand(req)
+	details, err := s.command.AddSCIMTarget(ctx, add, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddSCIMTargetResponse{
+		Id:      add.AggregateID,
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateSCIMTarget(ctx context.Context, req *mgmt_pb.UpdateSCIMTargetRequest) (*mgmt_pb.UpdateSCIMTargetResponse, error) {
+	details, err := s.command.ChangeSCIMTarget(ctx, UpdateSCIMTargetRequestToCommand(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateSCIMTargetResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveSCIMTarget(ctx context.Context, req *mgmt_pb.RemoveSCIMTargetRequest) (*mgmt_pb.RemoveSCIMTargetResponse, error) {
+	details, err := s.command.RemoveSCIMTarget(ctx, req.ProjectId, req.TargetId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveSCIMTargetResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListSCIMProvisioningStates(ctx context.Context, req *mgmt_pb.ListSCIMProvisioningStatesRequest) (*mgmt_pb.ListSCIMProvisioningStatesResponse, error) {
+	if _, err := s.getSCIMTarget(ctx, req.ProjectId, req.TargetId); err != nil {
+		return nil, err
+	}
+	queries, err := ListSCIMProvisioningStatesRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	states, err := s.query.SearchSCIMProvisioningStates(ctx, false, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListSCIMProvisioningStatesResponse{
+		Result:  SCIMProvisioningStatesToPb(states.States),
+		Details: object_grpc.ToListDetails(states.Count, states.Sequence, states.LastRun),
+	}, nil
+}
+
+func (s *Server) getSCIMTarget(ctx context.Context, projectID, targetID string) (*query.SCIMTarget, error) {
+	target, err := s.query.GetSCIMTargetByID(ctx, targetID, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	if target.ProjectID != projectID {
+		return nil, zerrors.ThrowNotFound(nil, "MANAG-Sct1n", "Errors.SCIMTarget.NotFound")
+	}
+	return target, nil
+}
@@ -0,0 +1,166 @@
+package management
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	scim_pb "github.com/zitadel/zitadel/pkg/grpc/scim_target"
+)
+
+func ListSCIMTargetsRequestToModel(ctx context.Context, req *mgmt_pb.ListSCIMTargetsRequest) (*query.SCIMTargetSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	projectQuery, err := query.NewSCIMTargetProjectIDSearchQuery(req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewSCIMTargetResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.SCIMTargetSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{projectQuery, ownerQuery},
+	}, nil
+}
+
+func AddSCIMTargetRequestToCommand(req *mgmt_pb.AddSCIMTargetRequest) *command.AddSCIMTarget {
+	return &command.AddSCIMTarget{
+		ProjectID: req.ProjectId,
+		AppID:     req.AppId,
+		Name:      req.Name,
+		Endpoint:  req.Endpoint,
+		Token:     req.Token,
+		Timeout:   req.Timeout.AsDuration(),
+	}
+}
+
+func UpdateSCIMTargetRequestToCommand(req *mgmt_pb.UpdateSCIMTargetRequest) *command.ChangeSCIMTarget {
+	timeout := req.Timeout.AsDuration()
+	return &command.ChangeSCIMTarget{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.TargetId,
+		},
+		ProjectID: req.ProjectId,
+		AppID:     &req.AppId,
+		Name:      &req.Name,
+		Endpoint:  &req.Endpoint,
+		Token:     &req.Token,
+		Timeout:   &timeout,
+	}
+}
+
+func SCIMTargetsToPb(targets []*query.SCIMTarget) []*scim_pb.SCIMTarget {
+	result := make([]*scim_pb.SCIMTarget, len(targets))
+	for i, target := range targets {
+		result[i] = SCIMTargetToPb(target)
+	}
+	return result
+}
+
+func SCIMTargetToPb(target *query.SCIMTarget) *scim_pb.SCIMTarget {
+	return &scim_pb.SCIMTarget{
+		Id:        target.ID,
+		Details:   object.ToViewDetailsPb(target.Sequence, target.CreationDate, target.EventDate, target.ResourceOwner),
+		ProjectId: target.ProjectID,
+		AppId:     target.AppID,
+		Name:      target.Name,
+		Endpoint:  target.Endpoint,
+		Timeout:   durationpb.New(target.Timeout),
+	}
+}
+
+func ListSCIMProvisioningStatesRequestToModel(req *mgmt_pb.ListSCIMProvisioningStatesRequest) (*query.SCIMProvisioningStateSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	targetQuery, err := query.NewSCIMProvisioningTargetIDSearchQuery(req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{targetQuery}
+	for _, q := range req.Queries {
+		provisioningQuery, err := SCIMProvisioningQueryToModel(q)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, provisioningQuery)
+	}
+	return &query.SCIMProvisioningStateSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func SCIMProvisioningQueryToModel(q *scim_pb.SCIMProvisioningQuery) (query.SearchQuery, error) {
+	switch q := q.Query.(type) {
+	case *scim_pb.SCIMProvisioningQuery_UserIdQuery:
+		return query.NewSCIMProvisioningUserIDSearchQuery(q.UserIdQuery.UserId)
+	case *scim_pb.SCIMProvisioningQuery_StateQuery:
+		return query.NewSCIMProvisioningStateSearchQuery(SCIMProvisioningStateToDomain(q.StateQuery.State))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "MANAG-Sct2q", "List.Query.Invalid")
+	}
+}
+
+func SCIMProvisioningStatesToPb(states []*query.SCIMProvisioningState) []*scim_pb.SCIMProvisioning {
+	result := make([]*scim_pb.SCIMProvisioning, len(states))
+	for i, state := range states {
+		result[i] = &scim_pb.SCIMProvisioning{
+			TargetId:     state.TargetID,
+			UserId:       state.UserID,
+			UserGrantId:  state.UserGrantID,
+			RemoteId:     state.RemoteID,
+			State:        SCIMProvisioningStateToPb(state.State),
+			FailureCount: state.FailureCount,
+			LastError:    state.LastError,
+			ChangeDate:   timestamppb.New(state.ChangeDate),
+		}
+	}
+	return result
+}
+
+func SCIMProvisioningStateToPb(state domain.SCIMProvisioningState) scim_pb.SCIMProvisioningState {
+	switch state {
+	case domain.SCIMProvisioningStateProvisioned:
+		return scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_PROVISIONED
+	case domain.SCIMProvisioningStateDeprovisioned:
+		return scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_DEPROVISIONED
+	case domain.SCIMProvisioningStateFailed:
+		return scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_FAILED
+	case domain.SCIMProvisioningStateUnspecified:
+		return scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_UNSPECIFIED
+	default:
+		return scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_UNSPECIFIED
+	}
+}
+
+func SCIMProvisioningStateToDomain(state scim_pb.SCIMProvisioningState) domain.SCIMProvisioningState {
+	switch state {
+	case scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_PROVISIONED:
+		return domain.SCIMProvisioningStateProvisioned
+	case scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_DEPROVISIONED:
+		return domain.SCIMProvisioningStateDeprovisioned
+	case scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_FAILED:
+		return domain.SCIMProvisioningStateFailed
+	case scim_pb.SCIMProvisioningState_SCIM_PROVISIONING_STATE_UNSPECIFIED:
+		return domain.SCIMProvisioningStateUnspecified
+	default:
+		return domain.SCIMProvisioningStateUnspecified
+	}
+}
@@ -40,6 +40,7 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 		UiLocales:    a.UiLocales,
 		LoginHint:    a.LoginHint,
 		HintUserId:   a.HintUserID,
+		AcrValues:    a.ACRValues,
 	}
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
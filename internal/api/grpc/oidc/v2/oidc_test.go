@@ -36,6 +36,7 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     gu.Ptr(time.Minute),
 		HintUserID: gu.Ptr("userID"),
+		ACRValues:  []string{"urn:zitadel:iam:acr:mfa"},
 	}
 	want := &oidc_pb.AuthRequest{
 		Id:           "authID",
@@ -56,6 +57,7 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     durationpb.New(time.Minute),
 		HintUserId: gu.Ptr("userID"),
+		AcrValues:  []string{"urn:zitadel:iam:acr:mfa"},
 	}
 	got := authRequestToPb(arg)
 	if !proto.Equal(want, got) {
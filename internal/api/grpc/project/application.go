@@ -42,25 +42,32 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
-			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
+			RedirectUris:                          app.RedirectURIs,
+			ResponseTypes:                         OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                            OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                               OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                              app.ClientID,
+			AuthMethodType:                        OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:                app.PostLogoutRedirectURIs,
+			Version:                               OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:                         len(app.ComplianceProblems) != 0,
+			ComplianceProblems:                    ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                               app.IsDevMode,
+			AccessTokenType:                       oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:              app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:                  app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:              app.AssertIDTokenUserinfo,
+			ClockSkew:                             durationpb.New(app.ClockSkew),
+			AdditionalOrigins:                     app.AdditionalOrigins,
+			AllowedOrigins:                        app.AllowedOrigins,
+			SkipNativeAppSuccessPage:              app.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:                  app.BackChannelLogoutURI,
+			FrontChannelLogoutUri:                 app.FrontChannelLogoutURI,
+			RequirePushedAuthRequest:              app.RequirePushedAuthRequest,
+			RequireRequestObject:                  app.RequireRequestObject,
+			RequireDpop:                           app.RequireDPoP,
+			TlsClientAuthSubjectDn:                app.TLSClientAuthSubjectDN,
+			BackChannelClientNotificationEndpoint: app.BackChannelClientNotificationEndpoint,
 		},
 	}
 }
@@ -76,8 +83,9 @@ func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 func AppAPIConfigToPb(app *query.APIApp) app_pb.AppConfig {
 	return &app_pb.App_ApiConfig{
 		ApiConfig: &app_pb.APIConfig{
-			ClientId:       app.ClientID,
-			AuthMethodType: APIAuthMethodeTypeToPb(app.AuthMethodType),
+			ClientId:               app.ClientID,
+			AuthMethodType:         APIAuthMethodeTypeToPb(app.AuthMethodType),
+			TlsClientAuthSubjectDn: app.TLSClientAuthSubjectDN,
 		},
 	}
 }
@@ -140,6 +148,8 @@ func OIDCGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app_pb.OIDCGra
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -162,6 +172,8 @@ func OIDCGrantTypesToDomain(grantTypes []app_pb.OIDCGrantType) []domain.OIDCGran
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -202,6 +214,10 @@ func OIDCAuthMethodTypeToPb(authType domain.OIDCAuthMethodType) app_pb.OIDCAuthM
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC
 	}
@@ -217,6 +233,10 @@ func OIDCAuthMethodTypeToDomain(authType app_pb.OIDCAuthMethodType) domain.OIDCA
 		return domain.OIDCAuthMethodTypeNone
 	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.OIDCAuthMethodTypePrivateKeyJWT
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeTLSClientAuth
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.OIDCAuthMethodTypeBasic
 	}
@@ -275,6 +295,10 @@ func APIAuthMethodeTypeToPb(methodType domain.APIAuthMethodType) app_pb.APIAuthM
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	case domain.APIAuthMethodTypePrivateKeyJWT:
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.APIAuthMethodTypeTLSClientAuth:
+		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.APIAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	}
@@ -286,6 +310,10 @@ func APIAuthMethodTypeToDomain(authType app_pb.APIAuthMethodType) domain.APIAuth
 		return domain.APIAuthMethodTypeBasic
 	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.APIAuthMethodTypePrivateKeyJWT
+	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeTLSClientAuth
+	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.APIAuthMethodTypeBasic
 	}
@@ -13,11 +13,13 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	client_middleware "github.com/zitadel/zitadel/internal/api/grpc/client/middleware"
 	"github.com/zitadel/zitadel/internal/api/grpc/server/middleware"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/query"
 )
@@ -41,8 +43,9 @@ var (
 		runtime.WithMarshalerOption(mimeWildcard, jsonMarshaler),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
 		runtime.WithIncomingHeaderMatcher(headerMatcher),
-		runtime.WithOutgoingHeaderMatcher(runtime.DefaultHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 		runtime.WithForwardResponseOption(responseForwarder),
+		runtime.WithMetadata(dpopRequestMetadata),
 	}
 
 	headerMatcher = runtime.HeaderMatcherFunc(
@@ -52,6 +55,32 @@ var (
 					return header, true
 				}
 			}
+			if strings.EqualFold(header, http_utils.DPoP) {
+				return http_utils.DPoP, true
+			}
+			return runtime.DefaultHeaderMatcher(header)
+		},
+	)
+
+	// dpopRequestMetadata passes the method and uri of the http request,
+	// which a DPoP proof sent to the REST API is created for
+	dpopRequestMetadata = func(ctx context.Context, r *http.Request) metadata.MD {
+		if r.Header.Get(http_utils.DPoP) == "" {
+			return nil
+		}
+		return metadata.Pairs(
+			middleware.DPoPMethodMetadata, r.Method,
+			middleware.DPoPURIMetadata, http_utils.ComposedOrigin(ctx)+r.URL.Path,
+		)
+	}
+
+	// outgoingHeaderMatcher returns the retry-after header of rate limited requests as is,
+	// all other metadata is prefixed with Grpc-Metadata-
+	outgoingHeaderMatcher = runtime.HeaderMatcherFunc(
+		func(header string) (string, bool) {
+			if strings.EqualFold(header, http_utils.RetryAfter) {
+				return http.CanonicalHeaderKey(header), true
+			}
 			return runtime.DefaultHeaderMatcher(header)
 		},
 	)
@@ -226,7 +255,8 @@ func (r *cookieResponseWriter) WriteHeader(status int) {
 	if status >= 200 && status < 300 {
 		r.accessInterceptor.DeleteExhaustedCookie(r.ResponseWriter)
 	}
-	if status == http.StatusTooManyRequests {
+	// rate limited requests can be retried and don't mean that the instance is blocked
+	if status == http.StatusTooManyRequests && r.Header().Get(http_utils.RetryAfter) == "" {
 		r.accessInterceptor.SetExhaustedCookie(r.ResponseWriter, r.request)
 	}
 	r.headerWritten = true
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	nethttp "net/http"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -13,6 +14,13 @@ import (
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
+const (
+	// DPoPMethodMetadata and DPoPURIMetadata pass the method and uri of the http request
+	// from the gateway, so DPoP proofs (RFC 9449) sent to the REST API can be verified
+	DPoPMethodMetadata = "x-dpop-method"
+	DPoPURIMetadata    = "x-dpop-uri"
+)
+
 func AuthorizationInterceptor(verifier authz.APITokenVerifier, authConfig authz.Config) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		return authorize(ctx, req, info, handler, verifier, authConfig)
@@ -33,6 +41,10 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		return nil, status.Error(codes.Unauthenticated, "auth header missing")
 	}
 
+	if proof := grpc_util.GetHeader(authCtx, http.DPoP); proof != "" {
+		authCtx = authz.WithDPoPRequest(authCtx, dpopRequest(authCtx, proof, info.FullMethod))
+	}
+
 	orgID, orgDomain := orgIDAndDomainFromRequest(authCtx, req)
 	ctxSetter, err := authz.CheckUserAuthorization(authCtx, req, authToken, orgID, orgDomain, verifier, authConfig, authOpt, info.FullMethod)
 	if err != nil {
@@ -42,6 +54,23 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 	return handler(ctxSetter(ctx), req)
 }
 
+// dpopRequest returns the method and uri of the http request, if it was sent through the gateway.
+// Native gRPC calls are described as POST to the full method.
+func dpopRequest(ctx context.Context, proof, fullMethod string) *authz.DPoPRequest {
+	if uri := grpc_util.GetLastHeader(ctx, DPoPURIMetadata); uri != "" {
+		return &authz.DPoPRequest{
+			Proof:  proof,
+			Method: grpc_util.GetLastHeader(ctx, DPoPMethodMetadata),
+			URI:    uri,
+		}
+	}
+	return &authz.DPoPRequest{
+		Proof:  proof,
+		Method: nethttp.MethodPost,
+		URI:    http.ComposedOrigin(ctx) + fullMethod,
+	}
+}
+
 func orgIDAndDomainFromRequest(ctx context.Context, req interface{}) (id, domain string) {
 	orgID := grpc_util.GetHeader(ctx, http.ZitadelOrgID)
 	oz, ok := req.(OrganizationFromRequest)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 func Test_hostNameFromContext(t *testing.T) {
@@ -174,6 +175,10 @@ func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) RateLimits() *ratelimit.Rules {
+	panic("shouldn't be called here")
+}
+
 func (m *mockInstance) InstanceID() string {
 	return "instanceID"
 }
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/ratelimit"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RateLimitInterceptor counts the requests per instance, organization, client and IP
+// and rejects them with a resource exhausted error and a retry-after header if a limit is reached.
+// It must run after the [AuthorizationInterceptor], so that the organization and client of the caller are known.
+func RateLimitInterceptor(limiter *ratelimit.Limiter, ignoreService ...string) grpc.UnaryServerInterceptor {
+	for idx, service := range ignoreService {
+		if !strings.HasPrefix(service, "/") {
+			ignoreService[idx] = "/" + service
+		}
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{}, err error) {
+		if !limiter.Enabled() {
+			return handler(ctx, req)
+		}
+		for _, service := range ignoreService {
+			if strings.HasPrefix(info.FullMethod, service) {
+				return handler(ctx, req)
+			}
+		}
+		ctxData := authz.GetCtxData(ctx)
+		// we don't limit calls with system user tokens
+		if ctxData.SystemMemberships != nil {
+			return handler(ctx, req)
+		}
+		interceptorCtx, span := tracing.NewServerInterceptorSpan(ctx)
+		instance := authz.GetInstance(interceptorCtx)
+		allowed, retryAfter := limiter.Allow(instance.RateLimits(), rateLimitKeys(interceptorCtx, instance.InstanceID(), ctxData))
+		span.End()
+		if !allowed {
+			err = grpc.SetHeader(ctx, metadata.Pairs(http_util.RetryAfter, RetryAfterSeconds(retryAfter)))
+			logging.OnError(err).Debug("unable to set retry-after header")
+			return nil, zerrors.ThrowResourceExhausted(nil, "LIMITS-ieT3a", "Errors.Limits.RateLimited")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func rateLimitKeys(ctx context.Context, instanceID string, ctxData authz.CtxData) ratelimit.Keys {
+	clientID := ctxData.ClientID
+	if clientID == "" {
+		clientID = ctxData.UserID
+	}
+	return ratelimit.Keys{
+		InstanceID: instanceID,
+		OrgID:      ctxData.OrgID,
+		ClientID:   clientID,
+		IP:         remoteIP(ctx),
+	}
+}
+
+// remoteIP returns the first forwarded IP set by a proxy or the grpc gateway,
+// and falls back to the address of the peer
+func remoteIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ip, ok := http_util.GetForwardedFor(http.Header(md)); ok {
+			return ip
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// RetryAfterSeconds formats the duration as delay-seconds of the Retry-After header, rounded up
+func RetryAfterSeconds(retryAfter time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+}
@@ -72,10 +72,11 @@ func (s *Server) CreateSession(ctx context.Context, req *session.CreateSessionRe
 	}
 
 	return &session.CreateSessionResponse{
-		Details:      object.DomainToDetailsPb(set.ObjectDetails),
-		SessionId:    set.ID,
-		SessionToken: set.NewToken,
-		Challenges:   challengeResponse,
+		Details:              object.DomainToDetailsPb(set.ObjectDetails),
+		SessionId:            set.ID,
+		SessionToken:         set.NewToken,
+		Challenges:           challengeResponse,
+		AuthenticationStatus: authenticationStatusToPb(set.AuthenticationStatus),
 	}, nil
 }
 
@@ -98,9 +99,10 @@ func (s *Server) SetSession(ctx context.Context, req *session.SetSessionRequest)
 		set.NewToken = req.GetSessionToken()
 	}
 	return &session.SetSessionResponse{
-		Details:      object.DomainToDetailsPb(set.ObjectDetails),
-		SessionToken: set.NewToken,
-		Challenges:   challengeResponse,
+		Details:              object.DomainToDetailsPb(set.ObjectDetails),
+		SessionToken:         set.NewToken,
+		Challenges:           challengeResponse,
+		AuthenticationStatus: authenticationStatusToPb(set.AuthenticationStatus),
 	}, nil
 }
 
@@ -172,13 +174,14 @@ func factorsToPb(s *query.Session) *session.Factors {
 		return nil
 	}
 	return &session.Factors{
-		User:     user,
-		Password: passwordFactorToPb(s.PasswordFactor),
-		WebAuthN: webAuthNFactorToPb(s.WebAuthNFactor),
-		Intent:   intentFactorToPb(s.IntentFactor),
-		Totp:     totpFactorToPb(s.TOTPFactor),
-		OtpSms:   otpFactorToPb(s.OTPSMSFactor),
-		OtpEmail: otpFactorToPb(s.OTPEmailFactor),
+		User:         user,
+		Password:     passwordFactorToPb(s.PasswordFactor),
+		WebAuthN:     webAuthNFactorToPb(s.WebAuthNFactor),
+		Intent:       intentFactorToPb(s.IntentFactor),
+		Totp:         totpFactorToPb(s.TOTPFactor),
+		OtpSms:       otpFactorToPb(s.OTPSMSFactor),
+		OtpEmail:     otpFactorToPb(s.OTPEmailFactor),
+		RecoveryCode: recoveryCodeFactorToPb(s.RecoveryCodeFactor),
 	}
 }
 
@@ -228,6 +231,15 @@ func otpFactorToPb(factor query.SessionOTPFactor) *session.OTPFactor {
 	}
 }
 
+func recoveryCodeFactorToPb(factor query.SessionRecoveryCodeFactor) *session.RecoveryCodeFactor {
+	if factor.RecoveryCodeCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.RecoveryCodeFactor{
+		VerifiedAt: timestamppb.New(factor.RecoveryCodeCheckedAt),
+	}
+}
+
 func userFactorToPb(factor query.SessionUserFactor) *session.UserFactor {
 	if factor.UserID == "" || factor.UserCheckedAt.IsZero() {
 		return nil
@@ -310,6 +322,9 @@ func (s *Server) createSessionRequestToCommand(ctx context.Context, req *session
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
+	if requirement := req.GetAuthenticationRequirement(); requirement != nil {
+		checks = append(checks, command.RequireAuthentication(authenticationRequirementToDomain(requirement)))
+	}
 	return checks, req.GetMetadata(), userAgentToCommand(req.GetUserAgent()), req.GetLifetime().AsDuration(), nil
 }
 
@@ -336,6 +351,9 @@ func (s *Server) setSessionRequestToCommand(ctx context.Context, req *session.Se
 	if err != nil {
 		return nil, err
 	}
+	if requirement := req.GetAuthenticationRequirement(); requirement != nil {
+		checks = append(checks, command.RequireAuthentication(authenticationRequirementToDomain(requirement)))
+	}
 	return checks, nil
 }
 
@@ -373,6 +391,9 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if otp := checks.GetOtpEmail(); otp != nil {
 		sessionChecks = append(sessionChecks, command.CheckOTPEmail(otp.GetCode()))
 	}
+	if recoveryCode := checks.GetRecoveryCode(); recoveryCode != nil {
+		sessionChecks = append(sessionChecks, command.CheckRecoveryCode(recoveryCode.GetCode()))
+	}
 	return sessionChecks, nil
 }
 
@@ -425,6 +446,94 @@ func userVerificationRequirementToDomain(req session.UserVerificationRequirement
 	}
 }
 
+func authenticationRequirementToDomain(req *session.AuthenticationRequirement) *domain.AuthenticationRequirement {
+	requirement := &domain.AuthenticationRequirement{
+		Level: levelOfAssuranceToDomain(req.GetLevel()),
+	}
+	if req.MaxAge != nil {
+		maxAge := req.GetMaxAge().AsDuration()
+		requirement.MaxAge = &maxAge
+	}
+	return requirement
+}
+
+func levelOfAssuranceToDomain(level session.LevelOfAssurance) domain.LevelOfAssurance {
+	switch level {
+	case session.LevelOfAssurance_LEVEL_OF_ASSURANCE_UNSPECIFIED:
+		return domain.LevelOfAssuranceNone
+	case session.LevelOfAssurance_LEVEL_OF_ASSURANCE_SINGLE_FACTOR:
+		return domain.LevelOfAssuranceSingleFactor
+	case session.LevelOfAssurance_LEVEL_OF_ASSURANCE_MULTI_FACTOR:
+		return domain.LevelOfAssuranceMultiFactor
+	case session.LevelOfAssurance_LEVEL_OF_ASSURANCE_PHISHING_RESISTANT:
+		return domain.LevelOfAssurancePhishingResistant
+	default:
+		return domain.LevelOfAssuranceNone
+	}
+}
+
+func authenticationStatusToPb(status *domain.AuthenticationStatus) *session.AuthenticationStatus {
+	if status == nil {
+		return nil
+	}
+	return &session.AuthenticationStatus{
+		Level:          levelOfAssuranceToPb(status.Level),
+		Fulfilled:      status.Fulfilled,
+		RequiredChecks: checkTypesToPb(status.RequiredChecks),
+	}
+}
+
+func levelOfAssuranceToPb(level domain.LevelOfAssurance) session.LevelOfAssurance {
+	switch level {
+	case domain.LevelOfAssuranceNone:
+		return session.LevelOfAssurance_LEVEL_OF_ASSURANCE_UNSPECIFIED
+	case domain.LevelOfAssuranceSingleFactor:
+		return session.LevelOfAssurance_LEVEL_OF_ASSURANCE_SINGLE_FACTOR
+	case domain.LevelOfAssuranceMultiFactor:
+		return session.LevelOfAssurance_LEVEL_OF_ASSURANCE_MULTI_FACTOR
+	case domain.LevelOfAssurancePhishingResistant:
+		return session.LevelOfAssurance_LEVEL_OF_ASSURANCE_PHISHING_RESISTANT
+	default:
+		return session.LevelOfAssurance_LEVEL_OF_ASSURANCE_UNSPECIFIED
+	}
+}
+
+func checkTypesToPb(checks []domain.SessionCheckType) []session.CheckType {
+	if len(checks) == 0 {
+		return nil
+	}
+	out := make([]session.CheckType, len(checks))
+	for i, check := range checks {
+		out[i] = checkTypeToPb(check)
+	}
+	return out
+}
+
+func checkTypeToPb(check domain.SessionCheckType) session.CheckType {
+	switch check {
+	case domain.SessionCheckTypeUnspecified:
+		return session.CheckType_CHECK_TYPE_UNSPECIFIED
+	case domain.SessionCheckTypeUser:
+		return session.CheckType_CHECK_TYPE_USER
+	case domain.SessionCheckTypePassword:
+		return session.CheckType_CHECK_TYPE_PASSWORD
+	case domain.SessionCheckTypeIntent:
+		return session.CheckType_CHECK_TYPE_IDP_INTENT
+	case domain.SessionCheckTypeWebAuthN:
+		return session.CheckType_CHECK_TYPE_WEB_AUTH_N
+	case domain.SessionCheckTypeTOTP:
+		return session.CheckType_CHECK_TYPE_TOTP
+	case domain.SessionCheckTypeOTPSMS:
+		return session.CheckType_CHECK_TYPE_OTP_SMS
+	case domain.SessionCheckTypeOTPEmail:
+		return session.CheckType_CHECK_TYPE_OTP_EMAIL
+	case domain.SessionCheckTypeRecoveryCode:
+		return session.CheckType_CHECK_TYPE_RECOVERY_CODE
+	default:
+		return session.CheckType_CHECK_TYPE_UNSPECIFIED
+	}
+}
+
 func (s *Server) createOTPSMSChallengeCommand(req *session.RequestChallenges_OTPSMS) (*string, command.SessionCommand) {
 	if req.GetReturnCode() {
 		challenge := new(string)
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -668,6 +669,88 @@ func Test_userVerificationRequirementToDomain(t *testing.T) {
 	}
 }
 
+func Test_authenticationRequirementToDomain(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *session.AuthenticationRequirement
+		want *domain.AuthenticationRequirement
+	}{
+		{
+			name: "level only",
+			req: &session.AuthenticationRequirement{
+				Level: session.LevelOfAssurance_LEVEL_OF_ASSURANCE_MULTI_FACTOR,
+			},
+			want: &domain.AuthenticationRequirement{
+				Level: domain.LevelOfAssuranceMultiFactor,
+			},
+		},
+		{
+			name: "level and max age",
+			req: &session.AuthenticationRequirement{
+				Level:  session.LevelOfAssurance_LEVEL_OF_ASSURANCE_PHISHING_RESISTANT,
+				MaxAge: durationpb.New(5 * time.Minute),
+			},
+			want: &domain.AuthenticationRequirement{
+				Level:  domain.LevelOfAssurancePhishingResistant,
+				MaxAge: gu.Ptr(5 * time.Minute),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := authenticationRequirementToDomain(tt.req)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_authenticationStatusToPb(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *domain.AuthenticationStatus
+		want   *session.AuthenticationStatus
+	}{
+		{
+			name:   "nil",
+			status: nil,
+			want:   nil,
+		},
+		{
+			name: "fulfilled",
+			status: &domain.AuthenticationStatus{
+				Level:     domain.LevelOfAssuranceMultiFactor,
+				Fulfilled: true,
+			},
+			want: &session.AuthenticationStatus{
+				Level:     session.LevelOfAssurance_LEVEL_OF_ASSURANCE_MULTI_FACTOR,
+				Fulfilled: true,
+			},
+		},
+		{
+			name: "checks required",
+			status: &domain.AuthenticationStatus{
+				Level:          domain.LevelOfAssuranceSingleFactor,
+				RequiredChecks: []domain.SessionCheckType{domain.SessionCheckTypeWebAuthN, domain.SessionCheckTypeTOTP, domain.SessionCheckTypeOTPSMS, domain.SessionCheckTypeOTPEmail},
+			},
+			want: &session.AuthenticationStatus{
+				Level: session.LevelOfAssurance_LEVEL_OF_ASSURANCE_SINGLE_FACTOR,
+				RequiredChecks: []session.CheckType{
+					session.CheckType_CHECK_TYPE_WEB_AUTH_N,
+					session.CheckType_CHECK_TYPE_TOTP,
+					session.CheckType_CHECK_TYPE_OTP_SMS,
+					session.CheckType_CHECK_TYPE_OTP_EMAIL,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := authenticationStatusToPb(tt.status)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_userAgentToCommand(t *testing.T) {
 	type args struct {
 		userAgent *session.UserAgent
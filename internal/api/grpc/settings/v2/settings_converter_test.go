@@ -167,6 +167,10 @@ func Test_secondFactorTypeToPb(t *testing.T) {
 			args: args{domain.SecondFactorTypeOTPEmail},
 			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL,
 		},
+		{
+			args: args{domain.SecondFactorTypeRecoveryCodes},
+			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES,
+		},
 		{
 			args: args{domain.SecondFactorTypeUnspecified},
 			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED,
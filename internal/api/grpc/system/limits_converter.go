@@ -4,6 +4,7 @@ import (
 	"github.com/muhlemmer/gu"
 
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
@@ -13,9 +14,32 @@ func setInstanceLimitsPbToCommand(req *system.SetLimitsRequest) *command.SetLimi
 		setLimits.AuditLogRetention = gu.Ptr(req.AuditLogRetention.AsDuration())
 	}
 	setLimits.Block = req.Block
+	setLimits.RateLimits = rateLimitsPbToRules(req.GetRateLimits())
 	return setLimits
 }
 
+func rateLimitsPbToRules(rateLimits *system.RateLimits) *ratelimit.Rules {
+	if rateLimits == nil {
+		return nil
+	}
+	return &ratelimit.Rules{
+		Instance: rateLimitPbToRule(rateLimits.GetInstance()),
+		Org:      rateLimitPbToRule(rateLimits.GetOrganization()),
+		Client:   rateLimitPbToRule(rateLimits.GetClient()),
+		IP:       rateLimitPbToRule(rateLimits.GetIp()),
+	}
+}
+
+func rateLimitPbToRule(rateLimit *system.RateLimit) *ratelimit.Rule {
+	if rateLimit == nil {
+		return nil
+	}
+	return &ratelimit.Rule{
+		RequestsPerSecond: rateLimit.GetRequestsPerSecond(),
+		Burst:             rateLimit.GetBurst(),
+	}
+}
+
 func bulkSetInstanceLimitsPbToCommand(req *system.BulkSetLimitsRequest) []*command.SetInstanceLimitsBulk {
 	cmds := make([]*command.SetInstanceLimitsBulk, len(req.Limits))
 	for i := range req.Limits {
@@ -15,7 +15,7 @@ import (
 )
 
 func (s *Server) CreateUserSchema(ctx context.Context, req *schema.CreateUserSchemaRequest) (*schema.CreateUserSchemaResponse, error) {
-	if err := checkUserSchemaEnabled(ctx); err != nil {
+	if err := s.checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
 	}
 	userSchema, err := createUserSchemaToCommand(req, authz.GetInstance(ctx).InstanceID())
@@ -33,7 +33,7 @@ func (s *Server) CreateUserSchema(ctx context.Context, req *schema.CreateUserSch
 }
 
 func (s *Server) UpdateUserSchema(ctx context.Context, req *schema.UpdateUserSchemaRequest) (*schema.UpdateUserSchemaResponse, error) {
-	if err := checkUserSchemaEnabled(ctx); err != nil {
+	if err := s.checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
 	}
 	userSchema, err := updateUserSchemaToCommand(req, authz.GetInstance(ctx).InstanceID())
@@ -50,7 +50,7 @@ func (s *Server) UpdateUserSchema(ctx context.Context, req *schema.UpdateUserSch
 }
 
 func (s *Server) DeactivateUserSchema(ctx context.Context, req *schema.DeactivateUserSchemaRequest) (*schema.DeactivateUserSchemaResponse, error) {
-	if err := checkUserSchemaEnabled(ctx); err != nil {
+	if err := s.checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
 	}
 	details, err := s.command.DeactivateUserSchema(ctx, req.GetId(), authz.GetInstance(ctx).InstanceID())
@@ -63,7 +63,7 @@ func (s *Server) DeactivateUserSchema(ctx context.Context, req *schema.Deactivat
 }
 
 func (s *Server) ReactivateUserSchema(ctx context.Context, req *schema.ReactivateUserSchemaRequest) (*schema.ReactivateUserSchemaResponse, error) {
-	if err := checkUserSchemaEnabled(ctx); err != nil {
+	if err := s.checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
 	}
 	details, err := s.command.ReactivateUserSchema(ctx, req.GetId(), authz.GetInstance(ctx).InstanceID())
@@ -76,7 +76,7 @@ func (s *Server) ReactivateUserSchema(ctx context.Context, req *schema.Reactivat
 }
 
 func (s *Server) DeleteUserSchema(ctx context.Context, req *schema.DeleteUserSchemaRequest) (*schema.DeleteUserSchemaResponse, error) {
-	if err := checkUserSchemaEnabled(ctx); err != nil {
+	if err := s.checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
 	}
 	details, err := s.command.DeleteUserSchema(ctx, req.GetId(), authz.GetInstance(ctx).InstanceID())
@@ -89,7 +89,7 @@ func (s *Server) DeleteUserSchema(ctx context.Context, req *schema.DeleteUserSch
 }
 
 func (s *Server) ListUserSchemas(ctx context.Context, req *schema.ListUserSchemasRequest) (*schema.ListUserSchemasResponse, error) {
-	if err := checkUserSchemaEnabled(ctx); err != nil {
+	if err := s.checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
 	}
 	queries, err := listUserSchemaToQuery(req)
@@ -111,7 +111,7 @@ func (s *Server) ListUserSchemas(ctx context.Context, req *schema.ListUserSchema
 }
 
 func (s *Server) GetUserSchemaByID(ctx context.Context, req *schema.GetUserSchemaByIDRequest) (*schema.GetUserSchemaByIDResponse, error) {
-	if err := checkUserSchemaEnabled(ctx); err != nil {
+	if err := s.checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
 	}
 	res, err := s.query.GetUserSchemaByID(ctx, req.GetId())
@@ -235,8 +235,14 @@ func userSchemaFieldNameToSortingColumn(column schema.FieldName) query.Column {
 	}
 }
 
-func checkUserSchemaEnabled(ctx context.Context) error {
-	if authz.GetInstance(ctx).Features().UserSchema {
+// checkUserSchemaEnabled checks the feature on the organization of the caller,
+// which inherits the instance and system settings if not set explicitly.
+func (s *Server) checkUserSchemaEnabled(ctx context.Context) error {
+	features, err := s.query.GetOrgFeatures(ctx, authz.GetCtxData(ctx).OrgID, true)
+	if err != nil {
+		return err
+	}
+	if features.UserSchema.Value {
 		return nil
 	}
 	return zerrors.ThrowPreconditionFailed(nil, "SCHEMA-SFjk3", "Errors.UserSchema.NotEnabled")
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"crypto/x509"
 	"net"
 	"net/http"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 const (
 	Authorization   = "authorization"
+	DPoP            = "dpop"
 	Accept          = "accept"
 	AcceptLanguage  = "accept-language"
 	CacheControl    = "cache-control"
@@ -27,6 +29,7 @@ const (
 	IfNoneMatch     = "If-None-Match"
 	LastModified    = "Last-Modified"
 	Etag            = "Etag"
+	RetryAfter      = "retry-after"
 
 	ContentSecurityPolicy   = "content-security-policy"
 	XXSSProtection          = "x-xss-protection"
@@ -46,6 +49,7 @@ const (
 	httpHeaders key = iota
 	remoteAddr
 	origin
+	clientCertificate
 )
 
 func CopyHeadersToContext(h http.Handler) http.Handler {
@@ -82,6 +86,17 @@ func WithComposedOrigin(ctx context.Context, composed string) context.Context {
 	return context.WithValue(ctx, origin, composed)
 }
 
+// ClientCertificate returns the certificate the client presented in the TLS handshake
+// or which was forwarded by a TLS terminating proxy
+func ClientCertificate(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(clientCertificate).(*x509.Certificate)
+	return cert
+}
+
+func WithClientCertificate(ctx context.Context, cert *x509.Certificate) context.Context {
+	return context.WithValue(ctx, clientCertificate, cert)
+}
+
 func RemoteIPFromCtx(ctx context.Context) string {
 	ctxHeaders, ok := HeadersFromCtx(ctx)
 	if !ok {
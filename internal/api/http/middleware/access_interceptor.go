@@ -15,6 +15,7 @@ import (
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
@@ -22,6 +23,7 @@ type AccessInterceptor struct {
 	logstoreSvc   *logstore.Service[*record.AccessLog]
 	cookieHandler *http_utils.CookieHandler
 	limitConfig   *AccessConfig
+	rateLimiter   *ratelimit.Limiter
 	storeOnly     bool
 	redirect      string
 }
@@ -33,12 +35,14 @@ type AccessConfig struct {
 
 // NewAccessInterceptor intercepts all requests and stores them to the logstore.
 // If storeOnly is false, it also checks if requests are exhausted.
-// If requests are exhausted, it also returns http.StatusTooManyRequests or a redirect to the given path and sets a cookie
-func NewAccessInterceptor(svc *logstore.Service[*record.AccessLog], cookieHandler *http_utils.CookieHandler, cookieConfig *AccessConfig) *AccessInterceptor {
+// If requests are exhausted, it also returns http.StatusTooManyRequests or a redirect to the given path and sets a cookie.
+// If the rate limits of the request are reached, it returns http.StatusTooManyRequests with a Retry-After header.
+func NewAccessInterceptor(svc *logstore.Service[*record.AccessLog], cookieHandler *http_utils.CookieHandler, cookieConfig *AccessConfig, rateLimiter *ratelimit.Limiter) *AccessInterceptor {
 	return &AccessInterceptor{
 		logstoreSvc:   svc,
 		cookieHandler: cookieHandler,
 		limitConfig:   cookieConfig,
+		rateLimiter:   rateLimiter,
 	}
 }
 
@@ -47,6 +51,7 @@ func (a *AccessInterceptor) WithoutLimiting() *AccessInterceptor {
 		logstoreSvc:   a.logstoreSvc,
 		cookieHandler: a.cookieHandler,
 		limitConfig:   a.limitConfig,
+		rateLimiter:   a.rateLimiter,
 		storeOnly:     true,
 		redirect:      a.redirect,
 	}
@@ -57,6 +62,7 @@ func (a *AccessInterceptor) WithRedirect(redirect string) *AccessInterceptor {
 		logstoreSvc:   a.logstoreSvc,
 		cookieHandler: a.cookieHandler,
 		limitConfig:   a.limitConfig,
+		rateLimiter:   a.rateLimiter,
 		storeOnly:     a.storeOnly,
 		redirect:      redirect,
 	}
@@ -66,6 +72,33 @@ func (a *AccessInterceptor) AccessService() *logstore.Service[*record.AccessLog]
 	return a.logstoreSvc
 }
 
+func (a *AccessInterceptor) RateLimiter() *ratelimit.Limiter {
+	return a.rateLimiter
+}
+
+// RateLimit returns true and responds with http.StatusTooManyRequests and a Retry-After header
+// if the rate limits of the request are reached.
+func (a *AccessInterceptor) RateLimit(w http.ResponseWriter, r *http.Request) bool {
+	if a.storeOnly || !a.rateLimiter.Enabled() {
+		return false
+	}
+	ctx := r.Context()
+	instance := authz.GetInstance(ctx)
+	ctxData := authz.GetCtxData(ctx)
+	allowed, retryAfter := a.rateLimiter.Allow(instance.RateLimits(), ratelimit.Keys{
+		InstanceID: instance.InstanceID(),
+		OrgID:      ctxData.OrgID,
+		ClientID:   ctxData.ClientID,
+		IP:         http_utils.RemoteIPStringFromRequest(r),
+	})
+	if allowed {
+		return false
+	}
+	w.Header().Set(http_utils.RetryAfter, middleware.RetryAfterSeconds(retryAfter))
+	http.Error(w, "Too many requests, please retry later.", http.StatusTooManyRequests)
+	return true
+}
+
 func (a *AccessInterceptor) Limit(w http.ResponseWriter, r *http.Request, publicAuthPathPrefixes ...string) bool {
 	if a.storeOnly {
 		return false
@@ -134,9 +167,12 @@ func (a *AccessInterceptor) handle(publicAuthPathPrefixes ...string) func(http.H
 			ctx := request.Context()
 			tracingCtx, checkSpan := tracing.NewNamedSpan(ctx, "checkAccessQuota")
 			wrappedWriter := &statusRecorder{ResponseWriter: writer, status: 0}
-			limited := a.Limit(wrappedWriter, request.WithContext(tracingCtx), publicAuthPathPrefixes...)
+			rateLimited := a.RateLimit(wrappedWriter, request.WithContext(tracingCtx))
+			limited := !rateLimited && a.Limit(wrappedWriter, request.WithContext(tracingCtx), publicAuthPathPrefixes...)
 			checkSpan.End()
-			if limited {
+			if rateLimited {
+				// the response is already written by the rate limiter
+			} else if limited {
 				if a.redirect != "" {
 					// The console guides the user when the cookie is set
 					http.Redirect(wrappedWriter, request, a.redirect, http.StatusFound)
@@ -62,6 +62,14 @@ func authorize(r *http.Request, verifier authz.APITokenVerifier, authConfig auth
 		return nil, errors.New("auth header missing")
 	}
 
+	if proof := r.Header.Get(http_util.DPoP); proof != "" {
+		authCtx = authz.WithDPoPRequest(authCtx, &authz.DPoPRequest{
+			Proof:  proof,
+			Method: r.Method,
+			URI:    http_util.ComposedOrigin(ctx) + r.URL.Path,
+		})
+	}
+
 	ctxSetter, err := authz.CheckUserAuthorization(authCtx, &httpReq{}, authToken, http_util.GetOrgID(r), "", verifier, authConfig, authOpt, r.RequestURI)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+)
+
+var errInvalidClientCertificate = errors.New("header does not contain a pem encoded certificate")
+
+// WithClientCertificate passes the certificate of the client to the context.
+// The certificate is taken from the TLS connection, or if ZITADEL runs behind a TLS terminating proxy,
+// from the configured header. The proxy must always overwrite the header, as it's trusted unconditionally.
+func WithClientCertificate(header string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cert, err := clientCertificate(r, header)
+			if err != nil {
+				logging.WithError(err).Debug("unable to parse client certificate")
+			}
+			if cert == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(http_util.WithClientCertificate(r.Context(), cert)))
+		})
+	}
+}
+
+func clientCertificate(r *http.Request, header string) (*x509.Certificate, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return r.TLS.PeerCertificates[0], nil
+	}
+	if header == "" {
+		return nil, nil
+	}
+	value := r.Header.Get(header)
+	if value == "" {
+		return nil, nil
+	}
+	der, err := clientCertificateFromHeader(value)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// clientCertificateFromHeader decodes the certificate either as byte sequence of RFC 9440 (`:base64 DER:`)
+// or as url escaped PEM, as sent by most proxies (e.g. nginx `$ssl_client_escaped_cert`)
+func clientCertificateFromHeader(value string) ([]byte, error) {
+	if encoded, ok := strings.CutPrefix(value, ":"); ok {
+		return base64.StdEncoding.DecodeString(strings.TrimSuffix(encoded, ":"))
+	}
+	unescaped, err := url.QueryUnescape(value)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(unescaped))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errInvalidClientCertificate
+	}
+	return block.Bytes, nil
+}
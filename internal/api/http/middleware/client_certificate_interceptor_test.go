@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_clientCertificateFromHeader(t *testing.T) {
+	der := []byte("certificate")
+	tests := []struct {
+		name    string
+		value   string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:  "byte sequence",
+			value: ":" + base64.StdEncoding.EncodeToString(der) + ":",
+			want:  der,
+		},
+		{
+			name:  "escaped pem",
+			value: url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))),
+			want:  der,
+		},
+		{
+			name:    "invalid byte sequence",
+			value:   ":invalid!:",
+			wantErr: true,
+		},
+		{
+			name:    "no certificate",
+			value:   url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))),
+			wantErr: true,
+		},
+		{
+			name:    "no pem",
+			value:   "certificate",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientCertificateFromHeader(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
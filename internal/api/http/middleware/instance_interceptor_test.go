@@ -15,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	zitadel_http "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 func Test_instanceInterceptor_Handler(t *testing.T) {
@@ -309,6 +310,10 @@ func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) RateLimits() *ratelimit.Rules {
+	panic("shouldn't be called here")
+}
+
 func (m *mockInstance) InstanceID() string {
 	return "instanceID"
 }
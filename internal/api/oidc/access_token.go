@@ -31,6 +31,9 @@ type accessToken struct {
 	tokenExpiration time.Time
 	isPAT           bool
 	actor           *domain.TokenActor
+	dpopThumbprint  string
+	// certificateThumbprint is set, if the token is bound to a client certificate
+	certificateThumbprint string
 }
 
 var ErrInvalidTokenFormat = errors.New("invalid token format")
@@ -86,18 +89,20 @@ func accessTokenV1(tokenID, subject string, token *model.TokenView) *accessToken
 
 func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenReadModel) *accessToken {
 	return &accessToken{
-		tokenID:         tokenID,
-		userID:          token.UserID,
-		resourceOwner:   token.ResourceOwner,
-		subject:         subject,
-		clientID:        token.ClientID,
-		audience:        token.Audience,
-		scope:           token.Scope,
-		authMethods:     token.AuthMethods,
-		authTime:        token.AuthTime,
-		tokenCreation:   token.AccessTokenCreation,
-		tokenExpiration: token.AccessTokenExpiration,
-		actor:           token.Actor,
+		tokenID:               tokenID,
+		userID:                token.UserID,
+		resourceOwner:         token.ResourceOwner,
+		subject:               subject,
+		clientID:              token.ClientID,
+		audience:              token.Audience,
+		scope:                 token.Scope,
+		authMethods:           token.AuthMethods,
+		authTime:              token.AuthTime,
+		tokenCreation:         token.AccessTokenCreation,
+		tokenExpiration:       token.AccessTokenExpiration,
+		actor:                 token.Actor,
+		dpopThumbprint:        token.DPoPThumbprint,
+		certificateThumbprint: token.CertificateThumbprint,
 	}
 }
 
@@ -44,7 +44,8 @@ func AuthMethodTypesToAMR(methodTypes []domain.UserAuthMethodType) []string {
 			// a user could use multiple (t)otp, which is a factor, but still will be returned as a single `otp` entry
 			otp++
 			factors++
-		case domain.UserAuthMethodTypeIDP:
+		case domain.UserAuthMethodTypeIDP,
+			domain.UserAuthMethodTypeRecoveryCode:
 			// no AMR value according to specification
 			factors++
 		case domain.UserAuthMethodTypeUnspecified:
@@ -60,6 +61,46 @@ func AuthMethodTypesToAMR(methodTypes []domain.UserAuthMethodType) []string {
 	return amr
 }
 
+// AuthMethodTypesToLevelOfAssurance returns the level of assurance reached by the checked auth methods.
+// The factors are counted the same way as for the `mfa` AMR value and the level is phishing resistant,
+// if a WebAuthN (passkey or u2f) is part of a multi factor authentication.
+func AuthMethodTypesToLevelOfAssurance(methodTypes []domain.UserAuthMethodType) domain.LevelOfAssurance {
+	var (
+		factors  int
+		webAuthN bool
+	)
+	for _, methodType := range methodTypes {
+		switch methodType {
+		case domain.UserAuthMethodTypePasswordless:
+			webAuthN = true
+			factors += 2
+		case domain.UserAuthMethodTypeU2F:
+			webAuthN = true
+			factors++
+		case domain.UserAuthMethodTypePassword,
+			domain.UserAuthMethodTypeOTP,
+			domain.UserAuthMethodTypeTOTP,
+			domain.UserAuthMethodTypeOTPSMS,
+			domain.UserAuthMethodTypeOTPEmail,
+			domain.UserAuthMethodTypeIDP,
+			domain.UserAuthMethodTypeRecoveryCode:
+			factors++
+		case domain.UserAuthMethodTypeUnspecified:
+			// ignore
+		}
+	}
+	switch {
+	case factors >= 2 && webAuthN:
+		return domain.LevelOfAssurancePhishingResistant
+	case factors >= 2:
+		return domain.LevelOfAssuranceMultiFactor
+	case factors == 1:
+		return domain.LevelOfAssuranceSingleFactor
+	default:
+		return domain.LevelOfAssuranceNone
+	}
+}
+
 func AMRToAuthMethodTypes(amr []string) []domain.UserAuthMethodType {
 	authMethods := make([]domain.UserAuthMethodType, 0, len(amr))
 	var (
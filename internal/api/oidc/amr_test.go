@@ -88,3 +88,48 @@ func TestAMR(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMethodTypesToLevelOfAssurance(t *testing.T) {
+	tests := []struct {
+		name        string
+		methodTypes []domain.UserAuthMethodType
+		want        domain.LevelOfAssurance
+	}{
+		{
+			"no checks, none",
+			nil,
+			domain.LevelOfAssuranceNone,
+		},
+		{
+			"pw checked, single factor",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+			domain.LevelOfAssuranceSingleFactor,
+		},
+		{
+			"pw and otp checked, multi factor",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeOTPSMS},
+			domain.LevelOfAssuranceMultiFactor,
+		},
+		{
+			"u2f checked, single factor",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypeU2F},
+			domain.LevelOfAssuranceSingleFactor,
+		},
+		{
+			"pw and u2f checked, phishing resistant",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeU2F},
+			domain.LevelOfAssurancePhishingResistant,
+		},
+		{
+			"passkey checked, phishing resistant",
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePasswordless},
+			domain.LevelOfAssurancePhishingResistant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AuthMethodTypesToLevelOfAssurance(tt.methodTypes)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
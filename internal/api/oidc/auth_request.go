@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/saml"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -76,6 +77,7 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		Prompt:        PromptToBusiness(req.Prompt),
 		UILocales:     UILocalesToBusiness(req.UILocales),
 		MaxAge:        MaxAgeToBusiness(req.MaxAge),
+		ACRValues:     req.ACRValues,
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -218,7 +220,10 @@ func (o *OPStorage) CreateAccessToken(ctx context.Context, req op.TokenRequest)
 	}()
 	if authReq, ok := req.(*AuthRequestV2); ok {
 		activity.Trigger(ctx, "", authReq.CurrentAuthRequest.UserID, activity.OIDCAccessToken, o.eventstore.FilterToQueryReducer)
-		return o.command.AddOIDCSessionAccessToken(setContextUserSystem(ctx), authReq.GetID())
+		return o.command.AddOIDCSessionAccessToken(setContextUserSystem(ctx), authReq.GetID(), dpopBindingFromContext(ctx).bind(), certificateBindingFromContext(ctx).bind())
+	}
+	if err = dpopBindingFromContext(ctx).checkUnbound(); err != nil {
+		return "", time.Time{}, err
 	}
 
 	userAgentID, applicationID, userOrgID, authTime, amr, reason, actor := getInfoFromRequest(req)
@@ -249,11 +254,14 @@ func (o *OPStorage) CreateAccessAndRefreshTokens(ctx context.Context, req op.Tok
 	case *AuthRequestV2:
 		// trigger activity log for authentication for user
 		activity.Trigger(ctx, "", tokenReq.GetSubject(), activity.OIDCRefreshToken, o.eventstore.FilterToQueryReducer)
-		return o.command.AddOIDCSessionRefreshAndAccessToken(setContextUserSystem(ctx), tokenReq.GetID())
+		return o.command.AddOIDCSessionRefreshAndAccessToken(setContextUserSystem(ctx), tokenReq.GetID(), dpopBindingFromContext(ctx).bind(), certificateBindingFromContext(ctx).bind())
 	case *RefreshTokenRequestV2:
 		// trigger activity log for authentication for user
 		activity.Trigger(ctx, "", tokenReq.GetSubject(), activity.OIDCRefreshToken, o.eventstore.FilterToQueryReducer)
-		return o.command.ExchangeOIDCSessionRefreshAndAccessToken(setContextUserSystem(ctx), tokenReq.OIDCSessionWriteModel.AggregateID, refreshToken, tokenReq.RequestedScopes)
+		return o.command.ExchangeOIDCSessionRefreshAndAccessToken(setContextUserSystem(ctx), tokenReq.OIDCSessionWriteModel.AggregateID, refreshToken, tokenReq.RequestedScopes, dpopBindingFromContext(ctx).bind(), certificateBindingFromContext(ctx).bind())
+	}
+	if err = dpopBindingFromContext(ctx).checkUnbound(); err != nil {
+		return "", "", time.Time{}, err
 	}
 
 	userAgentID, applicationID, userOrgID, authTime, authMethodsReferences, reason, actor := getInfoFromRequest(req)
@@ -371,7 +379,15 @@ func (o *OPStorage) TerminateSessionFromRequest(ctx context.Context, endSessionR
 	// and if not provided, terminate the session using the V1 method
 	headers, _ := http_utils.HeadersFromCtx(ctx)
 	if loginClient := headers.Get(LoginClientHeader); loginClient == "" {
-		return endSessionRequest.RedirectURI, o.TerminateSession(ctx, endSessionRequest.UserID, endSessionRequest.ClientID)
+		if err = o.TerminateSession(ctx, endSessionRequest.UserID, endSessionRequest.ClientID); err != nil {
+			return endSessionRequest.RedirectURI, err
+		}
+		redirectURI, err = o.samlLogoutRedirect(ctx, endSessionRequest.RedirectURI)
+		if err != nil {
+			return redirectURI, err
+		}
+		userAgentID, _ := middleware.UserAgentIDFromCtx(ctx)
+		return o.frontChannelLogoutRedirect(ctx, userAgentID, redirectURI)
 	}
 
 	// in case there are not id_token_hint, redirect to the UI and let it decide which session to terminate
@@ -384,7 +400,24 @@ func (o *OPStorage) TerminateSessionFromRequest(ctx context.Context, endSessionR
 	if err != nil {
 		return "", err
 	}
-	return endSessionRequest.RedirectURI, nil
+	return o.frontChannelLogoutRedirect(ctx, endSessionRequest.IDTokenHintClaims.SessionID, endSessionRequest.RedirectURI)
+}
+
+// samlLogoutRedirect routes the user agent through the SAML logout,
+// if it is still logged into any SAML application
+func (o *OPStorage) samlLogoutRedirect(ctx context.Context, redirectURI string) (string, error) {
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		return redirectURI, nil
+	}
+	sessions, err := o.query.ActiveSAMLSessionsByUserAgent(ctx, userAgentID)
+	if err != nil {
+		return redirectURI, err
+	}
+	if len(sessions.SAMLSessions) == 0 {
+		return redirectURI, nil
+	}
+	return saml.LogoutURL(o.encAlg, redirectURI)
 }
 
 func (o *OPStorage) RevokeToken(ctx context.Context, token, userID, clientID string) (err *oidc.Error) {
@@ -175,7 +175,16 @@ func PromptToBusiness(oidcPrompt []string) []domain.Prompt {
 }
 
 func ACRValuesToBusiness(values []string) []domain.LevelOfAssurance {
-	return nil
+	if len(values) == 0 {
+		return nil
+	}
+	levels := make([]domain.LevelOfAssurance, 0, len(values))
+	for _, value := range values {
+		if level, ok := domain.LevelOfAssuranceFromACR(value); ok {
+			levels = append(levels, level)
+		}
+	}
+	return levels
 }
 
 func UILocalesToBusiness(tags []language.Tag) []string {
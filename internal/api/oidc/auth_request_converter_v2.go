@@ -6,6 +6,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 type AuthRequestV2 struct {
@@ -17,7 +18,7 @@ func (a *AuthRequestV2) GetID() string {
 }
 
 func (a *AuthRequestV2) GetACR() string {
-	return "" //PLANNED: impl
+	return domain.ACRForLevelOfAssurance(AuthMethodTypesToLevelOfAssurance(a.AuthMethods), a.ACRValues)
 }
 
 func (a *AuthRequestV2) GetAMR() []string {
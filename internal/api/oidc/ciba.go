@@ -0,0 +1,305 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// CIBAEndpoint is the backchannel authentication endpoint
+	// of the Client Initiated Backchannel Authentication (CIBA)
+	CIBAEndpoint = "/oauth/v2/bc-authorize"
+	// GrantTypeCIBA is used by the client to poll for the tokens of a backchannel authentication request
+	GrantTypeCIBA oidc.GrantType = "urn:openid:params:grant-type:ciba"
+
+	cibaDeliveryModePoll = "poll"
+	cibaDeliveryModePing = "ping"
+
+	cibaAuthReqIDBytes          = 32
+	cibaBindingMessageMaxLength = 64
+
+	errorUnknownUserID           = "unknown_user_id"
+	errorInvalidBindingMessage   = "invalid_binding_message"
+	paramAuthReqID               = "auth_req_id"
+	paramBindingMessage          = "binding_message"
+	paramClientNotificationToken = "client_notification_token"
+	paramRequestedExpiry         = "requested_expiry"
+	paramLoginHintToken          = "login_hint_token"
+)
+
+// cibaDeliveryMode returns the token delivery mode of a client allowed to use the CIBA grant.
+// Clients with a notification endpoint use the ping mode, all others the poll mode.
+func cibaDeliveryMode(app *domain.OIDCApp) string {
+	if !slices.Contains(app.GrantTypes, domain.OIDCGrantTypeCIBA) {
+		return ""
+	}
+	if app.BackChannelClientNotificationEndpoint != "" {
+		return cibaDeliveryModePing
+	}
+	return cibaDeliveryModePoll
+}
+
+type cibaResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn int64  `json:"expires_in"`
+	Interval  int64  `json:"interval,omitempty"`
+}
+
+func (s *Server) cibaHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.backchannelAuthentication(r.Context(), r)
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+// backchannelAuthentication authenticates the client and starts the authentication of the hinted user.
+// The user is asked to approve the request in the device authorization flow of the login UI,
+// which is why the request is stored as a device authorization with the auth_req_id as device code.
+func (s *Server) backchannelAuthentication(ctx context.Context, r *http.Request) (_ *cibaResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	if r.Method != http.MethodPost {
+		return nil, op.NewStatusError(oidc.ErrInvalidRequest().WithDescription("backchannel authentication requests must use POST"), http.StatusMethodNotAllowed)
+	}
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("cannot parse form").WithParent(err)
+	}
+	client, err := s.authenticateClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(client.GrantTypes(), GrantTypeCIBA) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("client is not allowed to use the CIBA grant")
+	}
+	scopes := strings.Fields(r.PostForm.Get("scope"))
+	if !slices.Contains(scopes, oidc.ScopeOpenID) {
+		return nil, oidc.ErrInvalidScope().WithDescription("the openid scope is required")
+	}
+	scopes, err = op.ValidateAuthReqScopes(client, scopes)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.cibaUser(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	bindingMessage := r.PostForm.Get(paramBindingMessage)
+	if len(bindingMessage) > cibaBindingMessageMaxLength {
+		return nil, &oidc.Error{ErrorType: errorInvalidBindingMessage, Description: "binding_message is too long"}
+	}
+	notificationToken := r.PostForm.Get(paramClientNotificationToken)
+	if notificationToken == "" && clientCIBADeliveryMode(client) == cibaDeliveryModePing {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_notification_token is required for the ping mode")
+	}
+	config := s.Provider().DeviceAuthorization()
+	lifetime, err := cibaRequestLifetime(r.PostForm.Get(paramRequestedExpiry), config.Lifetime)
+	if err != nil {
+		return nil, err
+	}
+	authReqID, err := op.NewDeviceCode(cibaAuthReqIDBytes)
+	if err != nil {
+		return nil, err
+	}
+	userCode, err := op.NewUserCode([]rune(config.UserCode.CharSet), config.UserCode.CharAmount, config.UserCode.DashInterval)
+	if err != nil {
+		return nil, err
+	}
+	storage, ok := s.Provider().Storage().(*OPStorage)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Eeng3", "Errors.Internal")
+	}
+	err = storage.StoreCIBARequest(ctx, &command.CIBARequest{
+		ClientID:          client.GetID(),
+		AuthReqID:         authReqID,
+		UserCode:          userCode,
+		Expires:           time.Now().Add(lifetime),
+		Scopes:            scopes,
+		UserID:            user.ID,
+		LoginHint:         user.PreferredLoginName,
+		BindingMessage:    bindingMessage,
+		NotificationToken: notificationToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cibaResponse{
+		AuthReqID: authReqID,
+		ExpiresIn: int64(lifetime / time.Second),
+		Interval:  int64(config.PollInterval / time.Second),
+	}, nil
+}
+
+// cibaUser returns the active user identified by either the login_hint or the id_token_hint.
+// The login_hint_token is not supported.
+func (s *Server) cibaUser(ctx context.Context, r *http.Request) (*query.User, error) {
+	loginHint := r.PostForm.Get("login_hint")
+	idTokenHint := r.PostForm.Get("id_token_hint")
+	if r.PostForm.Has(paramLoginHintToken) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("login_hint_token is not supported")
+	}
+	if (loginHint == "") == (idTokenHint == "") {
+		return nil, oidc.ErrInvalidRequest().WithDescription("exactly one of login_hint or id_token_hint is required")
+	}
+	var (
+		user *query.User
+		err  error
+	)
+	if loginHint != "" {
+		user, err = s.query.GetUserByLoginName(ctx, true, loginHint)
+	} else {
+		var userID string
+		userID, err = op.ValidateAuthReqIDTokenHint(ctx, idTokenHint, s.Provider().IDTokenHintVerifier(ctx))
+		if err != nil {
+			return nil, err
+		}
+		user, err = s.query.GetUserByID(ctx, true, userID)
+	}
+	if zerrors.IsNotFound(err) {
+		return nil, (&oidc.Error{ErrorType: errorUnknownUserID, Description: "user not found"}).WithParent(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Human == nil || !user.State.IsEnabled() {
+		return nil, &oidc.Error{ErrorType: errorUnknownUserID, Description: "user cannot be authenticated"}
+	}
+	return user, nil
+}
+
+// cibaRequestLifetime returns the requested_expiry in seconds, limited to the configured lifetime
+func cibaRequestLifetime(requestedExpiry string, maxLifetime time.Duration) (time.Duration, error) {
+	if requestedExpiry == "" {
+		return maxLifetime, nil
+	}
+	seconds, err := strconv.ParseInt(requestedExpiry, 10, 64)
+	if err != nil || seconds <= 0 {
+		return 0, oidc.ErrInvalidRequest().WithDescription("requested_expiry must be a positive number of seconds")
+	}
+	return min(time.Duration(seconds)*time.Second, maxLifetime), nil
+}
+
+func clientCIBADeliveryMode(client op.Client) string {
+	c, ok := client.(*Client)
+	if !ok || c.client.BackChannelClientNotificationEndpoint == "" {
+		return cibaDeliveryModePoll
+	}
+	return cibaDeliveryModePing
+}
+
+// withCIBAToken serves token requests of the CIBA grant.
+// The token handler of the oidc library rejects unknown grant types
+// before the [Server] is called.
+func (s *Server) withCIBAToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != s.Endpoints().Token.Relative() || r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err), s.getLogger(r.Context()))
+			return
+		}
+		if oidc.GrantType(r.PostForm.Get("grant_type")) != GrantTypeCIBA {
+			next.ServeHTTP(w, r)
+			return
+		}
+		resp, err := s.cibaToken(r.Context(), r)
+		if err != nil {
+			op.WriteError(w, r, err, s.getLogger(r.Context()))
+			return
+		}
+		for key, values := range resp.Header {
+			w.Header()[key] = values
+		}
+		httphelper.MarshalJSON(w, resp.Data)
+	})
+}
+
+// cibaToken returns the tokens of an approved backchannel authentication request.
+// Like for the device authorization grant, the client receives an authorization_pending error
+// until the user approved or denied the request.
+func (s *Server) cibaToken(ctx context.Context, r *http.Request) (_ *op.Response, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	client, err := s.authenticateClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(client.GrantTypes(), GrantTypeCIBA) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("client is not allowed to use the CIBA grant")
+	}
+	authReqID := r.PostForm.Get(paramAuthReqID)
+	if authReqID == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("auth_req_id missing")
+	}
+	deviceAuth, err := s.query.DeviceAuthByDeviceCode(ctx, authReqID)
+	if zerrors.IsNotFound(err) {
+		return nil, oidc.ErrInvalidGrant().WithDescription("invalid auth_req_id").WithParent(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// device codes must not be exchanged with the CIBA grant
+	if deviceAuth.UserID == "" || deviceAuth.ClientID != client.GetID() {
+		return nil, oidc.ErrInvalidGrant().WithDescription("invalid auth_req_id")
+	}
+	if err = s.checkCIBAState(ctx, deviceAuth); err != nil {
+		return nil, err
+	}
+	ctx, err = withDPoPBinding(ctx, client, r.Method, r.URL, r.Header)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withCertificateBinding(ctx, client)
+	tokens, err := op.CreateDeviceTokenResponse(ctx, newDeviceAuthorizationState(deviceAuth), s.Provider(), client)
+	if err != nil {
+		return nil, err
+	}
+	resp := op.NewResponse(tokens)
+	setDPoPTokenType(ctx, resp)
+	return resp, nil
+}
+
+// checkCIBAState maps the state of the request to the errors of the CIBA token response (CIBA, section 11).
+// Expired requests are canceled the same way as in [OPStorage.GetDeviceAuthorizatonState].
+func (s *Server) checkCIBAState(ctx context.Context, deviceAuth *query.DeviceAuth) error {
+	switch {
+	case deviceAuth.State.Done():
+		return nil
+	case deviceAuth.State == domain.DeviceAuthStateExpired:
+		return oidc.ErrExpiredDeviceCode()
+	case deviceAuth.State.Denied():
+		return oidc.ErrAccessDenied()
+	case deviceAuth.Expires.Before(time.Now()):
+		if _, err := s.command.CancelDeviceAuth(ctx, deviceAuth.DeviceCode, domain.DeviceAuthCanceledExpired); err != nil {
+			return err
+		}
+		return oidc.ErrExpiredDeviceCode()
+	default:
+		return oidc.ErrAuthorizationPending()
+	}
+}
@@ -0,0 +1,60 @@
+package oidc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_cibaRequestLifetime(t *testing.T) {
+	tests := []struct {
+		name            string
+		requestedExpiry string
+		want            time.Duration
+		wantErr         bool
+	}{
+		{
+			name: "not requested",
+			want: 5 * time.Minute,
+		},
+		{
+			name:            "shorter",
+			requestedExpiry: "60",
+			want:            time.Minute,
+		},
+		{
+			name:            "longer, limited",
+			requestedExpiry: "3600",
+			want:            5 * time.Minute,
+		},
+		{
+			name:            "zero",
+			requestedExpiry: "0",
+			wantErr:         true,
+		},
+		{
+			name:            "invalid",
+			requestedExpiry: "one minute",
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cibaRequestLifetime(tt.requestedExpiry, 5*time.Minute)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_clientCIBADeliveryMode(t *testing.T) {
+	assert.Equal(t, cibaDeliveryModePoll, clientCIBADeliveryMode(&Client{client: &query.OIDCClient{}}))
+	assert.Equal(t, cibaDeliveryModePing, clientCIBADeliveryMode(&Client{client: &query.OIDCClient{BackChannelClientNotificationEndpoint: "https://example.com/notify"}}))
+}
@@ -132,6 +132,14 @@ func (o *OPStorage) SetUserinfoFromToken(ctx context.Context, userInfo *oidc.Use
 		if err = o.isOriginAllowed(ctx, token.ClientID, origin); err != nil {
 			return err
 		}
+		// the userinfo endpoint only accepts the bearer scheme,
+		// so tokens bound to a DPoP key must not be used
+		if err = authz.CheckDPoPBinding(ctx, token.DPoPThumbprint); err != nil {
+			return err
+		}
+		if err = authz.CheckCertificateBinding(ctx, token.CertificateThumbprint); err != nil {
+			return err
+		}
 		return o.setUserinfo(ctx, userInfo, token.UserID, token.ClientID, token.Scope, nil)
 	}
 
@@ -169,15 +177,39 @@ func (o *OPStorage) SetUserinfoFromScopes(ctx context.Context, userInfo *oidc.Us
 }
 
 // SetUserinfoFromRequest extends the SetUserinfoFromScopes during the id_token generation.
-// This is required for V2 tokens to be able to set the sessionID (`sid`) claim.
-func (o *OPStorage) SetUserinfoFromRequest(ctx context.Context, userinfo *oidc.UserInfo, request op.IDTokenRequest, _ []string) error {
+// This is required to be able to set the sessionID (`sid`) claim.
+// For V1 tokens the user agent is the session.
+// Clients with a back- or front-channel logout uri are registered for the logout of the session.
+func (o *OPStorage) SetUserinfoFromRequest(ctx context.Context, userinfo *oidc.UserInfo, request op.IDTokenRequest, _ []string) (err error) {
+	var sessionID string
 	switch t := request.(type) {
 	case *AuthRequestV2:
-		userinfo.AppendClaims("sid", t.SessionID)
+		sessionID = t.SessionID
 	case *RefreshTokenRequestV2:
-		userinfo.AppendClaims("sid", t.SessionID)
+		sessionID = t.SessionID
+	case *AuthRequest:
+		sessionID = t.AgentID
+	case *RefreshTokenRequest:
+		sessionID = t.UserAgentID
 	}
-	return nil
+	if sessionID == "" {
+		return nil
+	}
+	userinfo.AppendClaims("sid", sessionID)
+
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+	app, err := o.query.AppByOIDCClientID(ctx, request.GetClientID())
+	if err != nil {
+		return err
+	}
+	if app.OIDCConfig.BackChannelLogoutURI == "" && app.OIDCConfig.FrontChannelLogoutURI == "" {
+		return nil
+	}
+	return o.command.RegisterSessionLogout(ctx, sessionID, request.GetSubject(), request.GetClientID(), op.IssuerFromContext(ctx))
 }
 
 func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection *oidc.IntrospectionResponse, tokenID, subject, clientID string) (err error) {
@@ -606,6 +638,13 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 		span.EndWithError(err)
 	}()
 
+	var dpopThumbprint string
+	if binding := dpopBindingFromContext(ctx); binding != nil && binding.bound {
+		dpopThumbprint = binding.thumbprint
+	}
+	if cnf := confirmation(dpopThumbprint, certificateBindingFromContext(ctx).boundThumbprint()); cnf != nil {
+		claims = appendClaim(claims, ClaimConfirmation, cnf)
+	}
 	roles := make([]string, 0)
 	var allRoles bool
 	for _, scope := range scopes {
@@ -998,7 +1037,8 @@ func (s *Server) VerifyClient(ctx context.Context, r *op.Request[op.ClientCreden
 	if err != nil {
 		return nil, err
 	}
-	client, err := s.query.GetOIDCClientByID(ctx, clientID, assertion)
+	// the keys are also needed to authenticate a self-signed client certificate
+	client, err := s.query.GetOIDCClientByID(ctx, clientID, assertion || api_http.ClientCertificate(ctx) != nil)
 	if zerrors.IsNotFound(err) {
 		return nil, oidc.ErrInvalidClient().WithParent(err).WithDescription("client not found")
 	}
@@ -1020,6 +1060,10 @@ func (s *Server) VerifyClient(ctx context.Context, r *op.Request[op.ClientCreden
 		err = s.verifyClientSecret(ctx, client, r.Data.ClientSecret)
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		err = s.verifyClientAssertion(ctx, client, r.Data.ClientAssertion)
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		err = s.verifyClientCertificate(ctx, false, client.TLSClientAuthSubjectDN, nil)
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		err = s.verifyClientCertificate(ctx, true, "", client.PublicKeys)
 	case domain.OIDCAuthMethodTypeNone:
 	}
 	if err != nil {
@@ -171,6 +171,10 @@ func authMethodToOIDC(authType domain.OIDCAuthMethodType) oidc.AuthMethod {
 		return oidc.AuthMethodNone
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return oidc.AuthMethodPrivateKeyJWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return AuthMethodTLSClientAuth
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return AuthMethodSelfSignedTLSClientAuth
 	default:
 		return oidc.AuthMethodBasic
 	}
@@ -217,6 +221,8 @@ func grantTypeToOIDC(grantType domain.OIDCGrantType) oidc.GrantType {
 		return oidc.GrantTypeDeviceCode
 	case domain.OIDCGrantTypeTokenExchange:
 		return oidc.GrantTypeTokenExchange
+	case domain.OIDCGrantTypeCIBA:
+		return GrantTypeCIBA
 	default:
 		return oidc.GrantTypeCode
 	}
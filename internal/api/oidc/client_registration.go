@@ -0,0 +1,408 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// ClientRegistrationEndpoint registers clients dynamically (RFC 7591).
+	// The registration of a client is managed on the endpoint suffixed with its client_id (RFC 7592).
+	ClientRegistrationEndpoint = "/oauth/v2/register"
+
+	errorInvalidRedirectURI    = "invalid_redirect_uri"
+	errorInvalidClientMetadata = "invalid_client_metadata"
+	errorInvalidToken          = "invalid_token"
+
+	applicationTypeWeb    = "web"
+	applicationTypeNative = "native"
+)
+
+// clientMetadata are the registered client metadata (RFC 7591, section 2) supported by ZITADEL
+type clientMetadata struct {
+	ClientName                         string              `json:"client_name,omitempty"`
+	RedirectURIs                       []string            `json:"redirect_uris,omitempty"`
+	PostLogoutRedirectURIs             []string            `json:"post_logout_redirect_uris,omitempty"`
+	TokenEndpointAuthMethod            oidc.AuthMethod     `json:"token_endpoint_auth_method,omitempty"`
+	GrantTypes                         []oidc.GrantType    `json:"grant_types,omitempty"`
+	ResponseTypes                      []oidc.ResponseType `json:"response_types,omitempty"`
+	ApplicationType                    string              `json:"application_type,omitempty"`
+	BackChannelLogoutURI               string              `json:"backchannel_logout_uri,omitempty"`
+	FrontChannelLogoutURI              string              `json:"frontchannel_logout_uri,omitempty"`
+	RequirePushedAuthorizationRequests bool                `json:"require_pushed_authorization_requests,omitempty"`
+	DPoPBoundAccessTokens              bool                `json:"dpop_bound_access_tokens,omitempty"`
+	TLSClientAuthSubjectDN             string              `json:"tls_client_auth_subject_dn,omitempty"`
+	BackChannelTokenDeliveryMode       string              `json:"backchannel_token_delivery_mode,omitempty"`
+	BackChannelClientNotificationURI   string              `json:"backchannel_client_notification_endpoint,omitempty"`
+}
+
+type clientRegistrationResponse struct {
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64  `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   *int64 `json:"client_secret_expires_at,omitempty"`
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string `json:"registration_client_uri"`
+	clientMetadata
+}
+
+func (s *Server) clientRegistrationHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.registerClient(r.Context(), r)
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+func (s *Server) clientConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		resp *clientRegistrationResponse
+		err  error
+	)
+	clientID := chi.URLParam(r, "client_id")
+	switch r.Method {
+	case http.MethodGet:
+		resp, err = s.readClientRegistration(r.Context(), r, clientID)
+	case http.MethodPut:
+		resp, err = s.updateClientRegistration(r.Context(), r, clientID)
+	case http.MethodDelete:
+		err = s.deleteClientRegistration(r.Context(), r, clientID)
+	default:
+		err = op.NewStatusError(oidc.ErrInvalidRequest().WithDescription("method not allowed"), http.StatusMethodNotAllowed)
+	}
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+// registerClient adds an OIDC application to the project of the initial access token
+func (s *Server) registerClient(ctx context.Context, r *http.Request) (_ *clientRegistrationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+	app, err := decodeClientMetadata(r)
+	if err != nil {
+		return nil, err
+	}
+	app, registrationAccessToken, err := s.command.RegisterOIDCApplication(ctx, token, app)
+	if err != nil {
+		return nil, err
+	}
+	resp := newClientRegistrationResponse(ctx, app)
+	resp.ClientIDIssuedAt = app.ChangeDate.Unix()
+	resp.RegistrationAccessToken = registrationAccessToken
+	return resp, nil
+}
+
+func (s *Server) readClientRegistration(ctx context.Context, r *http.Request, clientID string) (_ *clientRegistrationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+	app, err := s.command.GetRegisteredOIDCApplication(ctx, token, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return newClientRegistrationResponse(ctx, app), nil
+}
+
+func (s *Server) updateClientRegistration(ctx context.Context, r *http.Request, clientID string) (_ *clientRegistrationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+	app, err := decodeClientMetadata(r)
+	if err != nil {
+		return nil, err
+	}
+	app.ClientID = clientID
+	app, err = s.command.ChangeRegisteredOIDCApplication(ctx, token, app)
+	if err != nil {
+		return nil, err
+	}
+	return newClientRegistrationResponse(ctx, app), nil
+}
+
+func (s *Server) deleteClientRegistration(ctx context.Context, r *http.Request, clientID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = clientRegistrationError(err)
+		span.EndWithError(err)
+	}()
+
+	token, err := bearerToken(r)
+	if err != nil {
+		return err
+	}
+	_, err = s.command.RemoveRegisteredOIDCApplication(ctx, token, clientID)
+	return err
+}
+
+func bearerToken(r *http.Request) (string, error) {
+	token, ok := strings.CutPrefix(r.Header.Get(http_utils.Authorization), oidc.PrefixBearer)
+	if !ok || token == "" {
+		return "", op.NewStatusError(&oidc.Error{ErrorType: errorInvalidToken, Description: "bearer token missing"}, http.StatusUnauthorized)
+	}
+	return token, nil
+}
+
+func decodeClientMetadata(r *http.Request) (*domain.OIDCApp, error) {
+	metadata := new(clientMetadata)
+	if err := json.NewDecoder(r.Body).Decode(metadata); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("cannot parse client metadata").WithParent(err)
+	}
+	return metadata.toOIDCApp()
+}
+
+// clientRegistrationError returns the error codes of RFC 7591 and RFC 6750
+// for invalid metadata and tokens
+func clientRegistrationError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var zError *zerrors.ZitadelError
+	if !errors.As(err, &zError) {
+		return oidcError(err)
+	}
+	switch {
+	case zerrors.IsUnauthenticated(err):
+		return op.NewStatusError(
+			(&oidc.Error{ErrorType: errorInvalidToken, Description: zError.GetMessage()}).WithParent(err),
+			http.StatusUnauthorized,
+		)
+	case zerrors.IsErrorInvalidArgument(err):
+		return (&oidc.Error{ErrorType: errorInvalidClientMetadata, Description: zError.GetMessage()}).WithParent(err)
+	}
+	return oidcError(err)
+}
+
+// toOIDCApp applies the defaults of RFC 7591 to the metadata
+// and maps them to the OIDC configuration of an application
+func (m *clientMetadata) toOIDCApp() (*domain.OIDCApp, error) {
+	if m.ClientName == "" {
+		return nil, &oidc.Error{ErrorType: errorInvalidClientMetadata, Description: "client_name is required"}
+	}
+	if m.TokenEndpointAuthMethod == "" {
+		m.TokenEndpointAuthMethod = oidc.AuthMethodBasic
+	}
+	if len(m.GrantTypes) == 0 {
+		m.GrantTypes = []oidc.GrantType{oidc.GrantTypeCode}
+	}
+	if len(m.ResponseTypes) == 0 {
+		m.ResponseTypes = []oidc.ResponseType{oidc.ResponseTypeCode}
+	}
+	authMethod, err := authMethodToDomain(m.TokenEndpointAuthMethod)
+	if err != nil {
+		return nil, err
+	}
+	grantTypes, err := grantTypesToDomain(m.GrantTypes)
+	if err != nil {
+		return nil, err
+	}
+	responseTypes, err := responseTypesToDomain(m.ResponseTypes)
+	if err != nil {
+		return nil, err
+	}
+	applicationType, err := applicationTypeToDomain(m.ApplicationType, authMethod)
+	if err != nil {
+		return nil, err
+	}
+	if len(m.RedirectURIs) == 0 && (slices.Contains(grantTypes, domain.OIDCGrantTypeAuthorizationCode) || slices.Contains(grantTypes, domain.OIDCGrantTypeImplicit)) {
+		return nil, &oidc.Error{ErrorType: errorInvalidRedirectURI, Description: "redirect_uris are required for the grant types"}
+	}
+	for _, uri := range m.RedirectURIs {
+		if _, err := url.ParseRequestURI(uri); err != nil {
+			return nil, (&oidc.Error{ErrorType: errorInvalidRedirectURI, Description: "invalid redirect_uri"}).WithParent(err)
+		}
+	}
+	notificationEndpoint, err := m.backChannelClientNotificationEndpoint(grantTypes)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.OIDCApp{
+		AppName:                               m.ClientName,
+		OIDCVersion:                           domain.OIDCVersionV1,
+		RedirectUris:                          m.RedirectURIs,
+		ResponseTypes:                         responseTypes,
+		GrantTypes:                            grantTypes,
+		ApplicationType:                       applicationType,
+		AuthMethodType:                        authMethod,
+		PostLogoutRedirectUris:                m.PostLogoutRedirectURIs,
+		AccessTokenType:                       domain.OIDCTokenTypeBearer,
+		BackChannelLogoutURI:                  m.BackChannelLogoutURI,
+		FrontChannelLogoutURI:                 m.FrontChannelLogoutURI,
+		RequirePushedAuthRequest:              m.RequirePushedAuthorizationRequests,
+		RequireDPoP:                           m.DPoPBoundAccessTokens,
+		TLSClientAuthSubjectDN:                m.TLSClientAuthSubjectDN,
+		BackChannelClientNotificationEndpoint: notificationEndpoint,
+	}, nil
+}
+
+// backChannelClientNotificationEndpoint validates the CIBA registration metadata (CIBA, section 4).
+// Only the poll and ping modes are supported, the latter requires the notification endpoint.
+func (m *clientMetadata) backChannelClientNotificationEndpoint(grantTypes []domain.OIDCGrantType) (string, error) {
+	if !slices.Contains(grantTypes, domain.OIDCGrantTypeCIBA) {
+		return "", nil
+	}
+	switch m.BackChannelTokenDeliveryMode {
+	case cibaDeliveryModePoll:
+		return "", nil
+	case cibaDeliveryModePing:
+		if m.BackChannelClientNotificationURI == "" {
+			return "", &oidc.Error{ErrorType: errorInvalidClientMetadata, Description: "backchannel_client_notification_endpoint is required for the ping mode"}
+		}
+		return m.BackChannelClientNotificationURI, nil
+	default:
+		return "", &oidc.Error{ErrorType: errorInvalidClientMetadata, Description: "backchannel_token_delivery_mode is not supported"}
+	}
+}
+
+func newClientRegistrationResponse(ctx context.Context, app *domain.OIDCApp) *clientRegistrationResponse {
+	resp := &clientRegistrationResponse{
+		ClientID:              app.ClientID,
+		ClientSecret:          app.ClientSecretString,
+		RegistrationClientURI: op.IssuerFromContext(ctx) + ClientRegistrationEndpoint + "/" + url.PathEscape(app.ClientID),
+		clientMetadata: clientMetadata{
+			ClientName:                         app.AppName,
+			RedirectURIs:                       app.RedirectUris,
+			PostLogoutRedirectURIs:             app.PostLogoutRedirectUris,
+			TokenEndpointAuthMethod:            authMethodToOIDC(app.AuthMethodType),
+			GrantTypes:                         grantTypesToOIDC(app.GrantTypes),
+			ResponseTypes:                      responseTypesToOIDC(app.ResponseTypes),
+			ApplicationType:                    applicationTypeToOIDC(app.ApplicationType),
+			BackChannelLogoutURI:               app.BackChannelLogoutURI,
+			FrontChannelLogoutURI:              app.FrontChannelLogoutURI,
+			RequirePushedAuthorizationRequests: app.RequirePushedAuthRequest,
+			DPoPBoundAccessTokens:              app.RequireDPoP,
+			TLSClientAuthSubjectDN:             app.TLSClientAuthSubjectDN,
+			BackChannelTokenDeliveryMode:       cibaDeliveryMode(app),
+			BackChannelClientNotificationURI:   app.BackChannelClientNotificationEndpoint,
+		},
+	}
+	if app.ClientSecretString != "" {
+		// the secret does not expire
+		var expiresAt int64
+		resp.ClientSecretExpiresAt = &expiresAt
+	}
+	return resp
+}
+
+// authMethodToDomain rejects the methods requiring keys,
+// as they can't be registered dynamically
+func authMethodToDomain(authMethod oidc.AuthMethod) (domain.OIDCAuthMethodType, error) {
+	switch authMethod {
+	case oidc.AuthMethodBasic:
+		return domain.OIDCAuthMethodTypeBasic, nil
+	case oidc.AuthMethodPost:
+		return domain.OIDCAuthMethodTypePost, nil
+	case oidc.AuthMethodNone:
+		return domain.OIDCAuthMethodTypeNone, nil
+	case AuthMethodTLSClientAuth:
+		return domain.OIDCAuthMethodTypeTLSClientAuth, nil
+	default:
+		return 0, &oidc.Error{ErrorType: errorInvalidClientMetadata, Description: "token_endpoint_auth_method is not supported"}
+	}
+}
+
+func grantTypesToDomain(grantTypes []oidc.GrantType) ([]domain.OIDCGrantType, error) {
+	domainTypes := make([]domain.OIDCGrantType, len(grantTypes))
+	for i, grantType := range grantTypes {
+		switch grantType {
+		case oidc.GrantTypeCode:
+			domainTypes[i] = domain.OIDCGrantTypeAuthorizationCode
+		case oidc.GrantTypeImplicit:
+			domainTypes[i] = domain.OIDCGrantTypeImplicit
+		case oidc.GrantTypeRefreshToken:
+			domainTypes[i] = domain.OIDCGrantTypeRefreshToken
+		case oidc.GrantTypeDeviceCode:
+			domainTypes[i] = domain.OIDCGrantTypeDeviceCode
+		case oidc.GrantTypeTokenExchange:
+			domainTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case GrantTypeCIBA:
+			domainTypes[i] = domain.OIDCGrantTypeCIBA
+		default:
+			return nil, &oidc.Error{ErrorType: errorInvalidClientMetadata, Description: "grant_type " + string(grantType) + " is not supported"}
+		}
+	}
+	return domainTypes, nil
+}
+
+func responseTypesToDomain(responseTypes []oidc.ResponseType) ([]domain.OIDCResponseType, error) {
+	domainTypes := make([]domain.OIDCResponseType, len(responseTypes))
+	for i, responseType := range responseTypes {
+		switch responseType {
+		case oidc.ResponseTypeCode:
+			domainTypes[i] = domain.OIDCResponseTypeCode
+		case oidc.ResponseTypeIDToken:
+			domainTypes[i] = domain.OIDCResponseTypeIDTokenToken
+		case oidc.ResponseTypeIDTokenOnly:
+			domainTypes[i] = domain.OIDCResponseTypeIDToken
+		default:
+			return nil, &oidc.Error{ErrorType: errorInvalidClientMetadata, Description: "response_type " + string(responseType) + " is not supported"}
+		}
+	}
+	return domainTypes, nil
+}
+
+// applicationTypeToDomain maps web clients without authentication to user agent applications
+func applicationTypeToDomain(applicationType string, authMethod domain.OIDCAuthMethodType) (domain.OIDCApplicationType, error) {
+	switch applicationType {
+	case "", applicationTypeWeb:
+		if authMethod == domain.OIDCAuthMethodTypeNone {
+			return domain.OIDCApplicationTypeUserAgent, nil
+		}
+		return domain.OIDCApplicationTypeWeb, nil
+	case applicationTypeNative:
+		return domain.OIDCApplicationTypeNative, nil
+	default:
+		return 0, &oidc.Error{ErrorType: errorInvalidClientMetadata, Description: "application_type is not supported"}
+	}
+}
+
+func applicationTypeToOIDC(applicationType domain.OIDCApplicationType) string {
+	if applicationType == domain.OIDCApplicationTypeNative {
+		return applicationTypeNative
+	}
+	return applicationTypeWeb
+}
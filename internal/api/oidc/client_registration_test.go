@@ -0,0 +1,208 @@
+package oidc
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_clientMetadata_toOIDCApp(t *testing.T) {
+	tests := []struct {
+		name      string
+		metadata  clientMetadata
+		want      *domain.OIDCApp
+		wantError string
+	}{
+		{
+			name: "defaults",
+			metadata: clientMetadata{
+				ClientName:   "app",
+				RedirectURIs: []string{"https://example.com/callback"},
+			},
+			want: &domain.OIDCApp{
+				AppName:         "app",
+				OIDCVersion:     domain.OIDCVersionV1,
+				RedirectUris:    []string{"https://example.com/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeWeb,
+				AuthMethodType:  domain.OIDCAuthMethodTypeBasic,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+			},
+		},
+		{
+			name: "public native client",
+			metadata: clientMetadata{
+				ClientName:              "app",
+				RedirectURIs:            []string{"com.example.app:/callback"},
+				TokenEndpointAuthMethod: oidc.AuthMethodNone,
+				GrantTypes:              []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeRefreshToken},
+				ApplicationType:         applicationTypeNative,
+			},
+			want: &domain.OIDCApp{
+				AppName:         "app",
+				OIDCVersion:     domain.OIDCVersionV1,
+				RedirectUris:    []string{"com.example.app:/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeRefreshToken},
+				ApplicationType: domain.OIDCApplicationTypeNative,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+			},
+		},
+		{
+			name: "public web client",
+			metadata: clientMetadata{
+				ClientName:              "app",
+				RedirectURIs:            []string{"https://example.com/callback"},
+				TokenEndpointAuthMethod: oidc.AuthMethodNone,
+			},
+			want: &domain.OIDCApp{
+				AppName:         "app",
+				OIDCVersion:     domain.OIDCVersionV1,
+				RedirectUris:    []string{"https://example.com/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeUserAgent,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+			},
+		},
+		{
+			name: "device code without redirect uris",
+			metadata: clientMetadata{
+				ClientName:              "app",
+				TokenEndpointAuthMethod: oidc.AuthMethodNone,
+				GrantTypes:              []oidc.GrantType{oidc.GrantTypeDeviceCode},
+				ApplicationType:         applicationTypeNative,
+			},
+			want: &domain.OIDCApp{
+				AppName:         "app",
+				OIDCVersion:     domain.OIDCVersionV1,
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeDeviceCode},
+				ApplicationType: domain.OIDCApplicationTypeNative,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+			},
+		},
+		{
+			name: "missing client name",
+			metadata: clientMetadata{
+				RedirectURIs: []string{"https://example.com/callback"},
+			},
+			wantError: errorInvalidClientMetadata,
+		},
+		{
+			name: "missing redirect uris",
+			metadata: clientMetadata{
+				ClientName: "app",
+			},
+			wantError: errorInvalidRedirectURI,
+		},
+		{
+			name: "invalid redirect uri",
+			metadata: clientMetadata{
+				ClientName:   "app",
+				RedirectURIs: []string{"callback"},
+			},
+			wantError: errorInvalidRedirectURI,
+		},
+		{
+			name: "private key jwt not supported",
+			metadata: clientMetadata{
+				ClientName:              "app",
+				RedirectURIs:            []string{"https://example.com/callback"},
+				TokenEndpointAuthMethod: oidc.AuthMethodPrivateKeyJWT,
+			},
+			wantError: errorInvalidClientMetadata,
+		},
+		{
+			name: "grant type not supported",
+			metadata: clientMetadata{
+				ClientName:   "app",
+				RedirectURIs: []string{"https://example.com/callback"},
+				GrantTypes:   []oidc.GrantType{oidc.GrantTypeClientCredentials},
+			},
+			wantError: errorInvalidClientMetadata,
+		},
+		{
+			name: "application type not supported",
+			metadata: clientMetadata{
+				ClientName:      "app",
+				RedirectURIs:    []string{"https://example.com/callback"},
+				ApplicationType: "service",
+			},
+			wantError: errorInvalidClientMetadata,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.metadata.toOIDCApp()
+			if tt.wantError != "" {
+				var oidcErr *oidc.Error
+				require.ErrorAs(t, err, &oidcErr)
+				assert.EqualValues(t, tt.wantError, oidcErr.ErrorType)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_clientRegistrationError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantType   string
+		wantStatus int
+	}{
+		{
+			name:       "unauthenticated",
+			err:        zerrors.ThrowUnauthenticated(nil, "id", "Errors.Project.RegistrationToken.Invalid"),
+			wantType:   errorInvalidToken,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid argument",
+			err:        zerrors.ThrowInvalidArgument(nil, "id", "Errors.Project.App.Invalid"),
+			wantType:   errorInvalidClientMetadata,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not found",
+			err:        zerrors.ThrowNotFound(nil, "id", "Errors.Project.NotFound"),
+			wantType:   "invalid_request",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unknown",
+			err:        errors.New("unknown"),
+			wantType:   "server_error",
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := clientRegistrationError(tt.err)
+			var oidcErr *oidc.Error
+			require.ErrorAs(t, err, &oidcErr)
+			assert.EqualValues(t, tt.wantType, oidcErr.ErrorType)
+
+			w := httptest.NewRecorder()
+			op.WriteError(w, httptest.NewRequest(http.MethodPost, ClientRegistrationEndpoint, nil), err, slog.Default())
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
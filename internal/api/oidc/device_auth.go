@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -90,6 +91,20 @@ func (o *OPStorage) StoreDeviceAuthorization(ctx context.Context, clientID, devi
 	return err
 }
 
+// StoreCIBARequest creates a new backchannel authentication request.
+// The project role scopes are asserted the same way as for a device authorization.
+func (o *OPStorage) StoreCIBARequest(ctx context.Context, request *command.CIBARequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	request.Scopes, err = o.assertProjectRoleScopes(ctx, request.ClientID, request.Scopes)
+	if err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "OIDC-Ja3ee", "Errors.Internal")
+	}
+	_, err = o.command.AddCIBARequest(ctx, request)
+	return err
+}
+
 func newDeviceAuthorizationState(d *query.DeviceAuth) *op.DeviceAuthorizationState {
 	return &op.DeviceAuthorizationState{
 		ClientID: d.ClientID,
@@ -0,0 +1,110 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+)
+
+const (
+	// ClaimConfirmation binds a token to the key of the client (RFC 7800)
+	ClaimConfirmation = "cnf"
+	// ClaimConfirmationJWKThumbprint is the thumbprint of the DPoP key the token is bound to (RFC 9449)
+	ClaimConfirmationJWKThumbprint = "jkt"
+
+	errorInvalidDPoPProof = "invalid_dpop_proof"
+)
+
+// dpopBinding holds the thumbprint of the verified DPoP proof of a token request.
+// It is passed through the context to the [OPStorage], which binds the created tokens.
+type dpopBinding struct {
+	thumbprint string
+	required   bool
+	bound      bool
+}
+
+type dpopBindingKey struct{}
+
+func dpopBindingFromContext(ctx context.Context) *dpopBinding {
+	binding, _ := ctx.Value(dpopBindingKey{}).(*dpopBinding)
+	return binding
+}
+
+// bind returns the thumbprint the tokens of the request must be bound to
+func (b *dpopBinding) bind() string {
+	if b == nil {
+		return ""
+	}
+	b.bound = b.thumbprint != ""
+	return b.thumbprint
+}
+
+// checkUnbound is called for tokens which cannot be bound.
+// They are issued as bearer tokens, unless the client requires DPoP.
+func (b *dpopBinding) checkUnbound() error {
+	if b == nil || !b.required {
+		return nil
+	}
+	return dpopError("the token type does not support DPoP")
+}
+
+func dpopError(description string) *oidc.Error {
+	return &oidc.Error{
+		ErrorType:   errorInvalidDPoPProof,
+		Description: description,
+	}
+}
+
+// withDPoPBinding verifies the DPoP proof of a token request, if one is sent or required by the client,
+// and passes the thumbprint of its key to the token creation.
+func withDPoPBinding(ctx context.Context, client op.Client, method string, uri *url.URL, header http.Header) (context.Context, error) {
+	var required bool
+	if c, ok := client.(*Client); ok {
+		required = c.client.RequireDPoP
+	}
+	proofs := header.Values(http_utils.DPoP)
+	if len(proofs) == 0 {
+		if required {
+			return ctx, dpopError("the client requires a DPoP proof")
+		}
+		return ctx, nil
+	}
+	if len(proofs) > 1 {
+		return ctx, dpopError("only one DPoP proof must be sent")
+	}
+	thumbprint, err := authz.VerifyDPoPProof(proofs[0], method, http_utils.ComposedOrigin(ctx)+uri.Path, "", time.Now())
+	if err != nil {
+		return ctx, dpopError("DPoP proof is invalid").WithParent(err)
+	}
+	return context.WithValue(ctx, dpopBindingKey{}, &dpopBinding{thumbprint: thumbprint, required: required}), nil
+}
+
+// checkDPoPResponseType prevents the implicit flow for clients requiring DPoP,
+// as access tokens issued by the authorization endpoint cannot be bound to a key
+func checkDPoPResponseType(client op.Client, responseType oidc.ResponseType) error {
+	c, ok := client.(*Client)
+	if !ok || !c.client.RequireDPoP {
+		return nil
+	}
+	if responseType == oidc.ResponseTypeIDToken {
+		return oidc.ErrInvalidRequest().WithDescription("the client requires DPoP bound access tokens, which cannot be issued by the implicit flow")
+	}
+	return nil
+}
+
+// setDPoPTokenType sets the token type of the response, if the issued tokens were bound
+func setDPoPTokenType(ctx context.Context, resp *op.Response) {
+	if binding := dpopBindingFromContext(ctx); binding == nil || !binding.bound {
+		return
+	}
+	if tokens, ok := resp.Data.(*oidc.AccessTokenResponse); ok {
+		tokens.TokenType = authz.DPoPTokenType
+	}
+}
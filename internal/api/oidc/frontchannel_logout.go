@@ -0,0 +1,177 @@
+package oidc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// FrontChannelLogoutEndpoint renders the front-channel logout uris of the clients of a terminated session
+	// in iframes and redirects to the post logout redirect uri afterward
+	FrontChannelLogoutEndpoint = "/oidc/v1/frontchannel_logout"
+
+	queryFrontChannelLogout = "logout"
+)
+
+type frontChannelLogout struct {
+	Frames      []string `json:"frames"`
+	RedirectURI string   `json:"redirectURI"`
+}
+
+// frontChannelLogoutRedirect routes the user agent through the front-channel logout,
+// if any client which received an id_token of the session has a front-channel logout uri.
+// The page data is passed encrypted, so the logout can't be abused as an open redirect.
+func (o *OPStorage) frontChannelLogoutRedirect(ctx context.Context, sessionID, redirectURI string) (string, error) {
+	if sessionID == "" {
+		return redirectURI, nil
+	}
+	clients, err := o.query.SessionLogoutClients(ctx, sessionID)
+	if err != nil {
+		return redirectURI, err
+	}
+	logout := &frontChannelLogout{RedirectURI: redirectURI}
+	for _, client := range clients {
+		app, err := o.query.AppByOIDCClientID(ctx, client.ClientID)
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return redirectURI, err
+		}
+		if app.OIDCConfig == nil || app.OIDCConfig.FrontChannelLogoutURI == "" {
+			continue
+		}
+		frame, err := frontChannelLogoutFrame(app.OIDCConfig.FrontChannelLogoutURI, client.Issuer, sessionID)
+		if err != nil {
+			return redirectURI, err
+		}
+		logout.addFrame(frame)
+	}
+	if len(logout.Frames) == 0 {
+		return redirectURI, nil
+	}
+	data, err := json.Marshal(logout)
+	if err != nil {
+		return redirectURI, err
+	}
+	encrypted, err := o.encAlg.Encrypt(data)
+	if err != nil {
+		return redirectURI, err
+	}
+	return FrontChannelLogoutEndpoint + "?" + queryFrontChannelLogout + "=" + base64.RawURLEncoding.EncodeToString(encrypted), nil
+}
+
+// addFrame adds the frame once, as a client might be registered for multiple users of a user agent
+func (l *frontChannelLogout) addFrame(frame string) {
+	for _, existing := range l.Frames {
+		if existing == frame {
+			return
+		}
+	}
+	l.Frames = append(l.Frames, frame)
+}
+
+// frontChannelLogoutFrame adds the issuer and the session id to the front-channel logout uri,
+// so the client can verify the logout belongs to the id_token it received
+func frontChannelLogoutFrame(logoutURI, issuer, sessionID string) (string, error) {
+	frame, err := url.Parse(logoutURI)
+	if err != nil {
+		return "", err
+	}
+	query := frame.Query()
+	query.Set("iss", issuer)
+	query.Set("sid", sessionID)
+	frame.RawQuery = query.Encode()
+	return frame.String(), nil
+}
+
+type frontChannelLogoutHandler struct {
+	encAlg              crypto.EncryptionAlgorithm
+	defaultLoggedOutURL string
+	template            *template.Template
+}
+
+func newFrontChannelLogoutHandler(encAlg crypto.EncryptionAlgorithm, defaultLoggedOutURL string) *frontChannelLogoutHandler {
+	return &frontChannelLogoutHandler{
+		encAlg:              encAlg,
+		defaultLoggedOutURL: defaultLoggedOutURL,
+		template:            template.Must(template.New("frontchannel_logout").Parse(frontChannelLogoutTemplate)),
+	}
+}
+
+func (h *frontChannelLogoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logout := h.logout(r.URL.Query().Get(queryFrontChannelLogout))
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := h.template.Execute(w, logout); err != nil {
+		logging.WithError(err).Error("unable to render front-channel logout")
+	}
+}
+
+func (h *frontChannelLogoutHandler) logout(encrypted string) *frontChannelLogout {
+	if encrypted == "" {
+		return &frontChannelLogout{RedirectURI: h.defaultLoggedOutURL}
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(encrypted)
+	if err != nil {
+		logging.WithError(err).Info("invalid front-channel logout")
+		return &frontChannelLogout{RedirectURI: h.defaultLoggedOutURL}
+	}
+	data, err := h.encAlg.Decrypt(decoded, h.encAlg.EncryptionKeyID())
+	if err != nil {
+		logging.WithError(err).Info("invalid front-channel logout")
+		return &frontChannelLogout{RedirectURI: h.defaultLoggedOutURL}
+	}
+	logout := new(frontChannelLogout)
+	if err = json.Unmarshal(data, logout); err != nil {
+		logging.WithError(err).Info("invalid front-channel logout")
+		return &frontChannelLogout{RedirectURI: h.defaultLoggedOutURL}
+	}
+	if logout.RedirectURI == "" {
+		logout.RedirectURI = h.defaultLoggedOutURL
+	}
+	return logout
+}
+
+const frontChannelLogoutTemplate = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"/><title>Logout</title></head>
+<body>
+{{range .Frames}}<iframe src="{{.}}" style="display:none"></iframe>
+{{end}}<noscript><a href="{{.RedirectURI}}">Continue</a></noscript><a id="redirect" href="{{.RedirectURI}}" hidden></a>
+<script>
+(function () {
+	var done = false;
+	function proceed() {
+		if (done) {
+			return;
+		}
+		done = true;
+		window.location.replace(document.getElementById('redirect').href);
+	}
+	var frames = document.getElementsByTagName('iframe');
+	var pending = frames.length;
+	if (pending === 0) {
+		proceed();
+		return;
+	}
+	for (var i = 0; i < frames.length; i++) {
+		frames[i].addEventListener('load', function () {
+			if (--pending === 0) {
+				proceed();
+			}
+		});
+	}
+	setTimeout(proceed, 5000);
+})();
+</script>
+</body>
+</html>`
@@ -0,0 +1,107 @@
+package oidc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
+
+func Test_frontChannelLogoutFrame(t *testing.T) {
+	tests := []struct {
+		name      string
+		logoutURI string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "without query",
+			logoutURI: "https://rp.example.com/logout",
+			want:      "https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.com&sid=session1",
+		},
+		{
+			name:      "with query",
+			logoutURI: "https://rp.example.com/logout?tenant=1",
+			want:      "https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.com&sid=session1&tenant=1",
+		},
+		{
+			name:      "invalid uri",
+			logoutURI: "https://rp.example.com/%zz",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := frontChannelLogoutFrame(tt.logoutURI, "https://issuer.com", "session1")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_frontChannelLogoutHandler_logout(t *testing.T) {
+	encAlg := crypto.CreateMockEncryptionAlg(gomock.NewController(t))
+	handler := newFrontChannelLogoutHandler(encAlg, "/logged-out")
+	encode := func(logout *frontChannelLogout) string {
+		data, err := json.Marshal(logout)
+		require.NoError(t, err)
+		encrypted, err := encAlg.Encrypt(data)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(encrypted)
+	}
+	tests := []struct {
+		name      string
+		encrypted string
+		want      *frontChannelLogout
+	}{
+		{
+			name: "missing",
+			want: &frontChannelLogout{RedirectURI: "/logged-out"},
+		},
+		{
+			name:      "invalid encoding",
+			encrypted: "%%%",
+			want:      &frontChannelLogout{RedirectURI: "/logged-out"},
+		},
+		{
+			name:      "invalid data",
+			encrypted: base64.RawURLEncoding.EncodeToString([]byte("data")),
+			want:      &frontChannelLogout{RedirectURI: "/logged-out"},
+		},
+		{
+			name: "without redirect",
+			encrypted: encode(&frontChannelLogout{
+				Frames: []string{"https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.com&sid=session1"},
+			}),
+			want: &frontChannelLogout{
+				Frames:      []string{"https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.com&sid=session1"},
+				RedirectURI: "/logged-out",
+			},
+		},
+		{
+			name: "with redirect",
+			encrypted: encode(&frontChannelLogout{
+				Frames:      []string{"https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.com&sid=session1"},
+				RedirectURI: "https://rp.example.com/logged-out",
+			}),
+			want: &frontChannelLogout{
+				Frames:      []string{"https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.com&sid=session1"},
+				RedirectURI: "https://rp.example.com/logged-out",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, handler.logout(tt.encrypted))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -121,6 +122,15 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Actor:                           actorDomainToClaims(token.actor),
 	}
 	introspectionResp.SetUserInfo(userInfo)
+	if token.dpopThumbprint != "" {
+		introspectionResp.TokenType = authz.DPoPTokenType
+	}
+	if cnf := confirmation(token.dpopThumbprint, token.certificateThumbprint); cnf != nil {
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 1)
+		}
+		introspectionResp.Claims[ClaimConfirmation] = cnf
+	}
 	return op.NewResponse(introspectionResp), nil
 }
 
@@ -141,6 +151,12 @@ func (s *Server) introspectionClientAuth(ctx context.Context, cc *op.ClientCrede
 			return "", "", err
 		}
 
+		if tlsClientAuth, selfSigned := client.TLSClientAuth(); tlsClientAuth {
+			if err := s.verifyClientCertificate(ctx, selfSigned, client.TLSClientAuthSubjectDN, client.PublicKeys); err != nil {
+				return "", "", oidc.ErrUnauthorizedClient().WithParent(err)
+			}
+			return client.ClientID, client.ProjectID, nil
+		}
 		if cc.ClientAssertion != "" {
 			verifier := op.NewJWTProfileVerifierKeySet(keySetMap(client.PublicKeys), op.IssuerFromContext(ctx), time.Hour, time.Second)
 			if _, err := op.VerifyJWTAssertion(ctx, cc.ClientAssertion, verifier); err != nil {
@@ -174,7 +190,7 @@ func (s *Server) clientFromCredentials(ctx context.Context, cc *op.ClientCredent
 	if err != nil {
 		return nil, err
 	}
-	client, err = s.query.GetIntrospectionClientByID(ctx, clientID, assertion)
+	client, err = s.query.GetIntrospectionClientByID(ctx, clientID, assertion || http_utils.ClientCertificate(ctx) != nil)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, oidc.ErrUnauthorizedClient().WithParent(err)
 	}
@@ -0,0 +1,247 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+)
+
+const (
+	// ClaimConfirmationCertificateThumbprint is the thumbprint of the client certificate the token is bound to (RFC 8705)
+	ClaimConfirmationCertificateThumbprint = "x5t#S256"
+
+	AuthMethodTLSClientAuth           oidc.AuthMethod = "tls_client_auth"
+	AuthMethodSelfSignedTLSClientAuth oidc.AuthMethod = "self_signed_tls_client_auth"
+)
+
+var errNoClientCAs = errors.New("no certificates found")
+
+// loadClientCAs returns the certificate authorities trusted to issue client certificates for the tls_client_auth method.
+// If no path is configured, the system pool is used.
+func loadClientCAs(path string) (*x509.CertPool, error) {
+	if path == "" {
+		return x509.SystemCertPool()
+	}
+	certs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certs) {
+		return nil, errNoClientCAs
+	}
+	return pool, nil
+}
+
+// verifyTLSClientAuth verifies the client certificate was issued by a trusted certificate authority
+// for the subject registered on the client (RFC 8705, section 2.1)
+func verifyTLSClientAuth(cert *x509.Certificate, roots *x509.CertPool, subjectDN string, now time.Time) error {
+	if cert == nil {
+		return oidc.ErrInvalidClient().WithDescription("client certificate missing")
+	}
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return oidc.ErrInvalidClient().WithParent(err).WithDescription("invalid client certificate")
+	}
+	if subjectDN == "" || !equalDistinguishedNames(cert.Subject.String(), subjectDN) {
+		return oidc.ErrInvalidClient().WithDescription("client certificate subject does not match")
+	}
+	return nil
+}
+
+// verifySelfSignedTLSClientAuth verifies the public key of the client certificate
+// is one of the keys registered on the client (RFC 8705, section 2.2).
+// The certificate chain is not verified.
+func verifySelfSignedTLSClientAuth(cert *x509.Certificate, publicKeys map[string][]byte, now time.Time) error {
+	if cert == nil {
+		return oidc.ErrInvalidClient().WithDescription("client certificate missing")
+	}
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return oidc.ErrInvalidClient().WithDescription("client certificate expired or not yet valid")
+	}
+	for _, publicKey := range publicKeys {
+		block, _ := pem.Decode(publicKey)
+		if block != nil && bytes.Equal(block.Bytes, cert.RawSubjectPublicKeyInfo) {
+			return nil
+		}
+	}
+	return oidc.ErrInvalidClient().WithDescription("client certificate does not match a registered key")
+}
+
+// equalDistinguishedNames compares the names case-insensitive and ignores spaces around the separators
+func equalDistinguishedNames(a, b string) bool {
+	return strings.EqualFold(normalizeDistinguishedName(a), normalizeDistinguishedName(b))
+}
+
+func normalizeDistinguishedName(dn string) string {
+	var (
+		normalized strings.Builder
+		attribute  strings.Builder
+		escaped    bool
+	)
+	writeAttribute := func() {
+		typ, value, _ := strings.Cut(attribute.String(), "=")
+		normalized.WriteString(strings.TrimSpace(typ))
+		normalized.WriteByte('=')
+		normalized.WriteString(strings.TrimSpace(value))
+		attribute.Reset()
+	}
+	for _, r := range dn {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',' || r == '+':
+			writeAttribute()
+			normalized.WriteRune(r)
+			continue
+		}
+		attribute.WriteRune(r)
+	}
+	writeAttribute()
+	return normalized.String()
+}
+
+// withCertificateBinding passes the thumbprint of the client certificate to the token creation,
+// if the client authenticated with it
+func withCertificateBinding(ctx context.Context, client op.Client) context.Context {
+	c, ok := client.(*Client)
+	if !ok || !c.client.AuthMethodType.IsTLSClientAuth() {
+		return ctx
+	}
+	cert := http_utils.ClientCertificate(ctx)
+	if cert == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, certificateBindingKey{}, &certificateBinding{thumbprint: authz.CertificateThumbprint(cert)})
+}
+
+// certificateBinding holds the thumbprint of the client certificate of a token request.
+// Like the [dpopBinding], it is passed through the context to the [OPStorage].
+type certificateBinding struct {
+	thumbprint string
+	bound      bool
+}
+
+type certificateBindingKey struct{}
+
+func certificateBindingFromContext(ctx context.Context) *certificateBinding {
+	binding, _ := ctx.Value(certificateBindingKey{}).(*certificateBinding)
+	return binding
+}
+
+// bind returns the thumbprint the access token of the request must be bound to
+func (b *certificateBinding) bind() string {
+	if b == nil {
+		return ""
+	}
+	b.bound = true
+	return b.thumbprint
+}
+
+func (b *certificateBinding) boundThumbprint() string {
+	if b == nil || !b.bound {
+		return ""
+	}
+	return b.thumbprint
+}
+
+// confirmation returns the confirmation claim of a token bound to a DPoP key and / or a client certificate
+func confirmation(dpopThumbprint, certificateThumbprint string) map[string]string {
+	if dpopThumbprint == "" && certificateThumbprint == "" {
+		return nil
+	}
+	cnf := make(map[string]string, 2)
+	if dpopThumbprint != "" {
+		cnf[ClaimConfirmationJWKThumbprint] = dpopThumbprint
+	}
+	if certificateThumbprint != "" {
+		cnf[ClaimConfirmationCertificateThumbprint] = certificateThumbprint
+	}
+	return cnf
+}
+
+// verifyClientCertificate authenticates the client by the certificate of the request
+// either with the tls_client_auth or the self_signed_tls_client_auth method
+func (s *Server) verifyClientCertificate(ctx context.Context, selfSigned bool, subjectDN string, publicKeys map[string][]byte) error {
+	cert := http_utils.ClientCertificate(ctx)
+	if selfSigned {
+		return verifySelfSignedTLSClientAuth(cert, publicKeys, time.Now())
+	}
+	return verifyTLSClientAuth(cert, s.clientCAs, subjectDN, time.Now())
+}
+
+// withTLSClientAuthIntrospection serves introspection requests of clients authenticating with a certificate.
+// The introspection handler of the oidc library requires a client_secret or client_assertion
+// and would reject them before the [Server.Introspect] is called.
+func (s *Server) withTLSClientAuthIntrospection(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != s.Endpoints().Introspection.Relative() || http_utils.ClientCertificate(r.Context()) == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if _, _, ok := r.BasicAuth(); ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err), s.getLogger(r.Context()))
+			return
+		}
+		if r.Form.Get("client_secret") != "" || r.Form.Get("client_assertion") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		resp, err := s.introspectTLSClientAuth(r)
+		if err != nil {
+			op.WriteError(w, r, err, s.getLogger(r.Context()))
+			return
+		}
+		for key, values := range resp.Header {
+			w.Header()[key] = values
+		}
+		httphelper.MarshalJSON(w, resp.Data)
+	})
+}
+
+func (s *Server) introspectTLSClientAuth(r *http.Request) (*op.Response, error) {
+	cc := new(op.ClientCredentials)
+	if err := s.Provider().Decoder().Decode(cc, r.Form); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if cc.ClientID == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id must be provided")
+	}
+	request := new(oidc.IntrospectionRequest)
+	if err := s.Provider().Decoder().Decode(request, r.Form); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if request.Token == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("token missing")
+	}
+	return s.Introspect(r.Context(), &op.Request[op.IntrospectionRequest]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.Form,
+		Data:   &op.IntrospectionRequest{ClientCredentials: cc, IntrospectionRequest: request},
+	})
+}
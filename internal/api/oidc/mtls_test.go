@@ -0,0 +1,256 @@
+package oidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCertificate(t *testing.T, subject string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: subject, Organization: []string{"ZITADEL"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func Test_verifyTLSClientAuth(t *testing.T) {
+	ca, caKey := createTestCertificate(t, "ca", nil, nil, true)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	cert, _ := createTestCertificate(t, "client", ca, caKey, false)
+	selfSigned, _ := createTestCertificate(t, "client", nil, nil, false)
+
+	type args struct {
+		cert      *x509.Certificate
+		subjectDN string
+		now       time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "missing certificate",
+			args: args{
+				subjectDN: "CN=client,O=ZITADEL",
+				now:       time.Now(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "untrusted issuer",
+			args: args{
+				cert:      selfSigned,
+				subjectDN: "CN=client,O=ZITADEL",
+				now:       time.Now(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			args: args{
+				cert:      cert,
+				subjectDN: "CN=client,O=ZITADEL",
+				now:       time.Now().Add(2 * time.Hour),
+			},
+			wantErr: true,
+		},
+		{
+			name: "subject mismatch",
+			args: args{
+				cert:      cert,
+				subjectDN: "CN=other,O=ZITADEL",
+				now:       time.Now(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "no subject registered",
+			args: args{
+				cert: cert,
+				now:  time.Now(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok",
+			args: args{
+				cert:      cert,
+				subjectDN: "cn=client, o=ZITADEL",
+				now:       time.Now(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyTLSClientAuth(tt.args.cert, roots, tt.args.subjectDN, tt.args.now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_verifySelfSignedTLSClientAuth(t *testing.T) {
+	cert, _ := createTestCertificate(t, "client", nil, nil, false)
+	other, _ := createTestCertificate(t, "client", nil, nil, false)
+	publicKey := func(cert *x509.Certificate) []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: cert.RawSubjectPublicKeyInfo})
+	}
+
+	type args struct {
+		cert       *x509.Certificate
+		publicKeys map[string][]byte
+		now        time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "missing certificate",
+			args: args{
+				publicKeys: map[string][]byte{"key1": publicKey(cert)},
+				now:        time.Now(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			args: args{
+				cert:       cert,
+				publicKeys: map[string][]byte{"key1": publicKey(cert)},
+				now:        time.Now().Add(2 * time.Hour),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown key",
+			args: args{
+				cert:       cert,
+				publicKeys: map[string][]byte{"key1": publicKey(other)},
+				now:        time.Now(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok",
+			args: args{
+				cert:       cert,
+				publicKeys: map[string][]byte{"key1": publicKey(other), "key2": publicKey(cert)},
+				now:        time.Now(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifySelfSignedTLSClientAuth(tt.args.cert, tt.args.publicKeys, tt.args.now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_equalDistinguishedNames(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{
+			name: "equal",
+			a:    "CN=client,O=ZITADEL",
+			b:    "CN=client,O=ZITADEL",
+			want: true,
+		},
+		{
+			name: "case and spaces",
+			a:    "CN=client,O=ZITADEL",
+			b:    "cn = Client , o=zitadel",
+			want: true,
+		},
+		{
+			name: "escaped separator",
+			a:    `CN=client\,one,O=ZITADEL`,
+			b:    `CN=client\, one,O=ZITADEL`,
+			want: false,
+		},
+		{
+			name: "different",
+			a:    "CN=client,O=ZITADEL",
+			b:    "CN=client,O=other",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, equalDistinguishedNames(tt.a, tt.b))
+		})
+	}
+}
+
+func Test_confirmation(t *testing.T) {
+	tests := []struct {
+		name                  string
+		dpopThumbprint        string
+		certificateThumbprint string
+		want                  map[string]string
+	}{
+		{
+			name: "unbound",
+		},
+		{
+			name:           "dpop",
+			dpopThumbprint: "jkt",
+			want:           map[string]string{ClaimConfirmationJWKThumbprint: "jkt"},
+		},
+		{
+			name:                  "certificate",
+			certificateThumbprint: "x5t",
+			want:                  map[string]string{ClaimConfirmationCertificateThumbprint: "x5t"},
+		},
+		{
+			name:                  "both",
+			dpopThumbprint:        "jkt",
+			certificateThumbprint: "x5t",
+			want:                  map[string]string{ClaimConfirmationJWKThumbprint: "jkt", ClaimConfirmationCertificateThumbprint: "x5t"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, confirmation(tt.dpopThumbprint, tt.certificateThumbprint))
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
@@ -43,6 +45,7 @@ type Config struct {
 	DefaultLoginURLV2                 string
 	DefaultLogoutURLV2                string
 	PublicKeyCacheMaxAge              time.Duration
+	TLSClientAuthCAPath               string
 }
 
 type EndpointConfig struct {
@@ -98,6 +101,10 @@ func NewServer(
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "OIDC-EGrqd", "cannot create op config: %w")
 	}
+	clientCAs, err := loadClientCAs(config.TLSClientAuthCAPath)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "OIDC-ooP4u", "cannot load tls client auth certificate authorities")
+	}
 	storage := newStorage(config, command, query, repo, encryptionAlg, es, projections, externalSecure)
 	keyCache := newPublicKeyCache(context.TODO(), config.PublicKeyCacheMaxAge, query.GetPublicKeyByID)
 	accessTokenKeySet := newOidcKeySet(keyCache, withKeyExpiryCheck(true))
@@ -136,6 +143,7 @@ func NewServer(
 		hashAlg:                    crypto.NewBCrypt(10), // as we are only verifying in oidc, the cost is already part of the hash string and the config here is irrelevant.
 		signingKeyAlgorithm:        config.SigningKeyAlgorithm,
 		assetAPIPrefix:             assets.AssetAPI(externalSecure),
+		clientCAs:                  clientCAs,
 	}
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
 	server.Handler = op.RegisterLegacyServer(server,
@@ -149,11 +157,29 @@ func NewServer(
 			http_utils.CopyHeadersToContext,
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
 			middleware.ActivityHandler,
-		))
+			server.withTLSClientAuthIntrospection,
+			server.withCIBAToken,
+		),
+		op.WithSetRouter(func(router chi.Router) {
+			router.HandleFunc(PushedAuthRequestEndpoint, server.pushedAuthRequestHandler)
+			router.HandleFunc(CIBAEndpoint, server.cibaHandler)
+			router.Post(ClientRegistrationEndpoint, server.clientRegistrationHandler)
+			router.HandleFunc(ClientRegistrationEndpoint+"/{client_id}", server.clientConfigurationHandler)
+		}),
+	)
+	server.Handler = withFrontChannelLogout(server.Handler, newFrontChannelLogoutHandler(encryptionAlg, defaultLogoutRedirectURI), instanceHandler)
 
 	return server, nil
 }
 
+// withFrontChannelLogout serves the front-channel logout page next to the endpoints of the oidc library
+func withFrontChannelLogout(handler http.Handler, logout *frontChannelLogoutHandler, instanceHandler func(http.Handler) http.Handler) http.Handler {
+	router := mux.NewRouter()
+	router.Handle(FrontChannelLogoutEndpoint, middleware.NoCacheInterceptor().Handler(instanceHandler(logout)))
+	router.PathPrefix("/").Handler(handler)
+	return router
+}
+
 func publicAuthPathPrefixes(endpoints *EndpointConfig) []string {
 	authURL := op.DefaultEndpoints.Authorization.Relative()
 	keysURL := op.DefaultEndpoints.JwksURI.Relative()
@@ -0,0 +1,209 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	// PushedAuthRequestEndpoint accepts authorization requests pushed by the client (RFC 9126)
+	PushedAuthRequestEndpoint = "/oauth/v2/par"
+	// RequestURIPrefix prefixes the id of a pushed authorization request in the returned request_uri
+	RequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+
+	pushedAuthRequestLifetime = time.Minute
+	paramRequestURI           = "request_uri"
+)
+
+// clientCredentialParams are not stored as part of a pushed authorization request
+var clientCredentialParams = []string{
+	"client_secret",
+	"client_assertion",
+	"client_assertion_type",
+}
+
+type pushedAuthRequestResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int64  `json:"expires_in"`
+}
+
+func (s *Server) pushedAuthRequestHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.pushAuthRequest(r.Context(), r)
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+// pushAuthRequest authenticates the client and validates the pushed authorization request
+// the same way the authorization endpoint does. The parameters are stored, so the client
+// can start the authorization with only the client_id and the returned request_uri.
+func (s *Server) pushAuthRequest(ctx context.Context, r *http.Request) (_ *pushedAuthRequestResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	if r.Method != http.MethodPost {
+		return nil, op.NewStatusError(oidc.ErrInvalidRequest().WithDescription("pushed authorization requests must use POST"), http.StatusMethodNotAllowed)
+	}
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("cannot parse form").WithParent(err)
+	}
+	client, err := s.authenticateClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if r.PostForm.Has(paramRequestURI) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri must not be pushed")
+	}
+	parameters := pushedAuthRequestParameters(r.PostForm)
+	authReq := new(oidc.AuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, parameters); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("cannot parse auth request").WithParent(err)
+	}
+	if authReq.ClientID != "" && authReq.ClientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+	authReq.ClientID = client.GetID()
+	parameters.Set("client_id", client.GetID())
+	if err = s.validatePushedAuthRequest(ctx, authReq, client); err != nil {
+		return nil, err
+	}
+	id, err := s.command.AddPushedAuthRequest(ctx, client.GetID(), parameters, time.Now().Add(pushedAuthRequestLifetime))
+	if err != nil {
+		return nil, err
+	}
+	return &pushedAuthRequestResponse{
+		RequestURI: RequestURIPrefix + id,
+		ExpiresIn:  int64(pushedAuthRequestLifetime / time.Second),
+	}, nil
+}
+
+// authenticateClient authenticates the client of a request to one of the custom endpoints
+// the same way as on the token endpoint
+func (s *Server) authenticateClient(ctx context.Context, r *http.Request) (op.Client, error) {
+	credentials := new(op.ClientCredentials)
+	if err := s.Provider().Decoder().Decode(credentials, r.PostForm); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("cannot parse client credentials").WithParent(err)
+	}
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		var err error
+		if credentials.ClientID, err = url.QueryUnescape(clientID); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+		if credentials.ClientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+	}
+	if credentials.ClientID == "" && credentials.ClientAssertion == "" {
+		return nil, oidc.ErrInvalidClient().WithDescription("client_id or client_assertion must be provided")
+	}
+	return s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method:   r.Method,
+		URL:      r.URL,
+		Header:   r.Header,
+		Form:     r.Form,
+		PostForm: r.PostForm,
+		Data:     credentials,
+	})
+}
+
+// validatePushedAuthRequest runs the validations of the authorization endpoint,
+// so the client receives errors directly instead of on the redirect.
+// The request object is verified on the authorization again, as the raw parameters are stored.
+func (s *Server) validatePushedAuthRequest(ctx context.Context, authReq *oidc.AuthRequest, client op.Client) (err error) {
+	requestObject := authReq.RequestParam != ""
+	if requestObject {
+		if !s.Provider().RequestObjectSupported() {
+			return oidc.ErrRequestNotSupported()
+		}
+		if err = op.ParseRequestObject(ctx, authReq, s.Provider().Storage(), op.IssuerFromContext(ctx)); err != nil {
+			return err
+		}
+	}
+	if err = checkAuthRequestRequirements(client, true, requestObject); err != nil {
+		return err
+	}
+	if err = checkDPoPResponseType(client, authReq.ResponseType); err != nil {
+		return err
+	}
+	if authReq.RedirectURI == "" {
+		return oidc.ErrInvalidRequest().WithDescription("auth request is missing redirect_uri")
+	}
+	if _, err = op.ValidateAuthReqPrompt(authReq.Prompt, authReq.MaxAge); err != nil {
+		return err
+	}
+	if _, err = op.ValidateAuthReqScopes(client, authReq.Scopes); err != nil {
+		return err
+	}
+	if err = op.ValidateAuthReqRedirectURI(client, authReq.RedirectURI, authReq.ResponseType); err != nil {
+		return err
+	}
+	return op.ValidateAuthReqResponseType(client, authReq.ResponseType)
+}
+
+// resolvePushedAuthRequest replaces the parameters of the authorization request with the pushed ones,
+// if the request references a pushed authorization request by its request_uri.
+// The pushed request is invalidated, as it must only be used once.
+func (s *Server) resolvePushedAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (pushed bool, err error) {
+	requestURI := r.Form.Get(paramRequestURI)
+	if requestURI == "" {
+		return false, nil
+	}
+	id, ok := strings.CutPrefix(requestURI, RequestURIPrefix)
+	if !ok || id == "" {
+		return false, oidc.ErrInvalidRequest().WithDescription("request_uri is not supported, use the pushed authorization request endpoint")
+	}
+	if r.Data.ClientID == "" {
+		return false, oidc.ErrInvalidRequest().WithDescription("auth request is missing client_id")
+	}
+	parameters, err := s.command.UsePushedAuthRequest(ctx, id, r.Data.ClientID)
+	if err != nil {
+		return false, err
+	}
+	authReq := new(oidc.AuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, parameters); err != nil {
+		return false, oidc.ErrServerError().WithDescription("cannot parse pushed auth request").WithParent(err)
+	}
+	r.Data = authReq
+	return true, nil
+}
+
+// checkAuthRequestRequirements enforces the application settings
+// to only accept pushed authorization requests and / or signed request objects
+func checkAuthRequestRequirements(client op.Client, pushed, requestObject bool) error {
+	c, ok := client.(*Client)
+	if !ok {
+		return nil
+	}
+	if c.client.RequirePushedAuthRequest && !pushed {
+		return oidc.ErrInvalidRequest().WithDescription("the client requires pushed authorization requests")
+	}
+	if c.client.RequireRequestObject && !requestObject {
+		return oidc.ErrInvalidRequest().WithDescription("the client requires a signed request object")
+	}
+	return nil
+}
+
+func pushedAuthRequestParameters(form url.Values) url.Values {
+	parameters := make(url.Values, len(form))
+	for key, values := range form {
+		parameters[key] = values
+	}
+	for _, param := range clientCredentialParams {
+		parameters.Del(param)
+	}
+	return parameters
+}
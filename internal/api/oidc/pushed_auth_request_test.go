@@ -0,0 +1,74 @@
+package oidc
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_checkAuthRequestRequirements(t *testing.T) {
+	tests := []struct {
+		name          string
+		client        *query.OIDCClient
+		pushed        bool
+		requestObject bool
+		wantErr       bool
+	}{
+		{
+			name:   "no requirements",
+			client: &query.OIDCClient{},
+		},
+		{
+			name:    "pushed required, missing",
+			client:  &query.OIDCClient{RequirePushedAuthRequest: true},
+			wantErr: true,
+		},
+		{
+			name:   "pushed required, ok",
+			client: &query.OIDCClient{RequirePushedAuthRequest: true},
+			pushed: true,
+		},
+		{
+			name:    "request object required, missing",
+			client:  &query.OIDCClient{RequireRequestObject: true},
+			pushed:  true,
+			wantErr: true,
+		},
+		{
+			name:          "request object required, ok",
+			client:        &query.OIDCClient{RequireRequestObject: true},
+			requestObject: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAuthRequestRequirements(&Client{client: tt.client}, tt.pushed, tt.requestObject)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_pushedAuthRequestParameters(t *testing.T) {
+	form := url.Values{
+		"client_id":             {"client1"},
+		"client_secret":         {"secret"},
+		"client_assertion":      {"assertion"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"redirect_uri":          {"https://rp.example.com/callback"},
+		"scope":                 {"openid"},
+	}
+	got := pushedAuthRequestParameters(form)
+	assert.Equal(t, url.Values{
+		"client_id":    {"client1"},
+		"redirect_uri": {"https://rp.example.com/callback"},
+		"scope":        {"openid"},
+	}, got)
+	assert.Len(t, form, 6, "form must not be modified")
+}
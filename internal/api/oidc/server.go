@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"crypto/x509"
 	"log/slog"
 	"net/http"
 	"time"
@@ -10,9 +11,11 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -38,6 +41,7 @@ type Server struct {
 	hashAlg             crypto.HashAlgorithm
 	signingKeyAlgorithm string
 	assetAPIPrefix      func(ctx context.Context) string
+	clientCAs           *x509.CertPool
 }
 
 func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
@@ -122,7 +126,37 @@ func (s *Server) Discovery(ctx context.Context, r *op.Request[struct{}]) (_ *op.
 	if len(allowedLanguages) == 0 {
 		allowedLanguages = i18n.SupportedLanguages()
 	}
-	return op.NewResponse(s.createDiscoveryConfig(ctx, allowedLanguages)), nil
+	return op.NewResponse(&discoveryConfiguration{
+		DiscoveryConfiguration:                s.createDiscoveryConfig(ctx, allowedLanguages),
+		PushedAuthRequestEndpoint:             op.IssuerFromContext(ctx) + PushedAuthRequestEndpoint,
+		BackChannelLogoutSupported:            true,
+		BackChannelLogoutSessionSupported:     true,
+		FrontChannelLogoutSupported:           true,
+		FrontChannelLogoutSessionSupported:    true,
+		DPoPSigningAlgValuesSupported:         authz.DPoPSigningAlgorithms,
+		TLSClientCertificateBoundAccessTokens: true,
+		BackChannelAuthenticationEndpoint:     op.IssuerFromContext(ctx) + CIBAEndpoint,
+		BackChannelTokenDeliveryModes:         []string{cibaDeliveryModePoll, cibaDeliveryModePing},
+		BackChannelUserCodeParameterSupported: false,
+	}), nil
+}
+
+// discoveryConfiguration extends the discovery of the oidc library
+// with the back- and front-channel logout support, the pushed authorization request endpoint,
+// the algorithms supported for DPoP proofs, the certificate bound access tokens (RFC 8705)
+// and the backchannel authentication (CIBA)
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	PushedAuthRequestEndpoint             string   `json:"pushed_authorization_request_endpoint"`
+	BackChannelLogoutSupported            bool     `json:"backchannel_logout_supported"`
+	BackChannelLogoutSessionSupported     bool     `json:"backchannel_logout_session_supported"`
+	FrontChannelLogoutSupported           bool     `json:"frontchannel_logout_supported"`
+	FrontChannelLogoutSessionSupported    bool     `json:"frontchannel_logout_session_supported"`
+	DPoPSigningAlgValuesSupported         []string `json:"dpop_signing_alg_values_supported"`
+	TLSClientCertificateBoundAccessTokens bool     `json:"tls_client_certificate_bound_access_tokens"`
+	BackChannelAuthenticationEndpoint     string   `json:"backchannel_authentication_endpoint"`
+	BackChannelTokenDeliveryModes         []string `json:"backchannel_token_delivery_modes_supported"`
+	BackChannelUserCodeParameterSupported bool     `json:"backchannel_user_code_parameter_supported"`
 }
 
 func (s *Server) Keys(ctx context.Context, r *op.Request[struct{}]) (_ *op.Response, err error) {
@@ -134,9 +168,28 @@ func (s *Server) Keys(ctx context.Context, r *op.Request[struct{}]) (_ *op.Respo
 
 func (s *Server) VerifyAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (_ *op.ClientRequest[oidc.AuthRequest], err error) {
 	ctx, span := tracing.NewSpan(ctx)
-	defer func() { span.EndWithError(err) }()
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
 
-	return s.LegacyServer.VerifyAuthRequest(ctx, r)
+	pushed, err := s.resolvePushedAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	// the request object is copied into the auth request and removed by the verification
+	requestObject := r.Data.RequestParam != ""
+	clientRequest, err := s.LegacyServer.VerifyAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkAuthRequestRequirements(clientRequest.Client, pushed, requestObject); err != nil {
+		return nil, err
+	}
+	if err = checkDPoPResponseType(clientRequest.Client, clientRequest.Data.ResponseType); err != nil {
+		return nil, err
+	}
+	return clientRequest, nil
 }
 
 func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthRequest]) (_ *op.Redirect, err error) {
@@ -157,14 +210,34 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.CodeExchange(ctx, r)
+	ctx, err = withDPoPBinding(ctx, r.Client, r.Method, r.URL, r.Header)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withCertificateBinding(ctx, r.Client)
+	resp, err := s.LegacyServer.CodeExchange(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	setDPoPTokenType(ctx, resp)
+	return resp, nil
 }
 
 func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.RefreshTokenRequest]) (_ *op.Response, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.RefreshToken(ctx, r)
+	ctx, err = withDPoPBinding(ctx, r.Client, r.Method, r.URL, r.Header)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withCertificateBinding(ctx, r.Client)
+	resp, err := s.LegacyServer.RefreshToken(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	setDPoPTokenType(ctx, resp)
+	return resp, nil
 }
 
 func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGrantRequest]) (_ *op.Response, err error) {
@@ -185,7 +258,17 @@ func (s *Server) DeviceToken(ctx context.Context, r *op.ClientRequest[oidc.Devic
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.DeviceToken(ctx, r)
+	ctx, err = withDPoPBinding(ctx, r.Client, r.Method, r.URL, r.Header)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withCertificateBinding(ctx, r.Client)
+	resp, err := s.LegacyServer.DeviceToken(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	setDPoPTokenType(ctx, resp)
+	return resp, nil
 }
 
 func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoRequest]) (_ *op.Response, err error) {
@@ -221,16 +304,18 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 		EndSessionEndpoint:                         s.Endpoints().EndSession.Absolute(issuer),
 		JwksURI:                                    s.Endpoints().JwksURI.Absolute(issuer),
 		DeviceAuthorizationEndpoint:                s.Endpoints().DeviceAuthorization.Absolute(issuer),
+		RegistrationEndpoint:                       issuer + ClientRegistrationEndpoint,
 		ScopesSupported:                            op.Scopes(s.Provider()),
 		ResponseTypesSupported:                     op.ResponseTypes(s.Provider()),
-		GrantTypesSupported:                        op.GrantTypes(s.Provider()),
+		GrantTypesSupported:                        append(op.GrantTypes(s.Provider()), GrantTypeCIBA),
+		ACRValuesSupported:                         domain.SupportedACRValues,
 		SubjectTypesSupported:                      op.SubjectTypes(s.Provider()),
 		IDTokenSigningAlgValuesSupported:           []string{s.signingKeyAlgorithm},
 		RequestObjectSigningAlgValuesSupported:     op.RequestObjectSigAlgorithms(s.Provider()),
-		TokenEndpointAuthMethodsSupported:          op.AuthMethodsTokenEndpoint(s.Provider()),
+		TokenEndpointAuthMethodsSupported:          append(op.AuthMethodsTokenEndpoint(s.Provider()), AuthMethodTLSClientAuth, AuthMethodSelfSignedTLSClientAuth),
 		TokenEndpointAuthSigningAlgValuesSupported: op.TokenSigAlgorithms(s.Provider()),
 		IntrospectionEndpointAuthSigningAlgValuesSupported: op.IntrospectionSigAlgorithms(s.Provider()),
-		IntrospectionEndpointAuthMethodsSupported:          op.AuthMethodsIntrospectionEndpoint(s.Provider()),
+		IntrospectionEndpointAuthMethodsSupported:          append(op.AuthMethodsIntrospectionEndpoint(s.Provider()), AuthMethodTLSClientAuth, AuthMethodSelfSignedTLSClientAuth),
 		RevocationEndpointAuthSigningAlgValuesSupported:    op.RevocationSigAlgorithms(s.Provider()),
 		RevocationEndpointAuthMethodsSupported:             op.AuthMethodsRevocationEndpoint(s.Provider()),
 		ClaimsSupported:                                    op.SupportedClaims(s.Provider()),
@@ -70,12 +70,12 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 				DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
 				CheckSessionIframe:                                 "",
 				JwksURI:                                            "https://issuer.com/keys",
-				RegistrationEndpoint:                               "",
+				RegistrationEndpoint:                               "https://issuer.com/oauth/v2/register",
 				ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
 				ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
 				ResponseModesSupported:                             nil,
-				GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer},
-				ACRValuesSupported:                                 nil,
+				GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer, GrantTypeCIBA},
+				ACRValuesSupported:                                 []string{"urn:zitadel:iam:acr:sf", "urn:zitadel:iam:acr:mfa", "urn:zitadel:iam:acr:phr", "http://schemas.openid.net/pape/policies/2007/06/multi-factor", "http://schemas.openid.net/pape/policies/2007/06/phishing-resistant"},
 				SubjectTypesSupported:                              []string{"public"},
 				IDTokenSigningAlgValuesSupported:                   []string{"RS256"},
 				IDTokenEncryptionAlgValuesSupported:                nil,
@@ -86,11 +86,11 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 				RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
 				RequestObjectEncryptionAlgValuesSupported:          nil,
 				RequestObjectEncryptionEncValuesSupported:          nil,
-				TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+				TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT, AuthMethodTLSClientAuth, AuthMethodSelfSignedTLSClientAuth},
 				TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
 				RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
 				RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
-				IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
+				IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT, AuthMethodTLSClientAuth, AuthMethodSelfSignedTLSClientAuth},
 				IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
 				DisplayValuesSupported:                             nil,
 				ClaimTypesSupported:                                nil,
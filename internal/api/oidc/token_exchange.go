@@ -40,18 +40,22 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	if !authz.GetFeatures(ctx).TokenExchange {
-		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-oan4I", "Errors.TokenExchange.FeatureDisabled")
-	}
-	if len(r.Data.Resource) > 0 {
-		return nil, oidc.ErrInvalidTarget().WithDescription("resource parameter not supported")
-	}
-
 	client, ok := r.Client.(*Client)
 	if !ok {
 		// not supposed to happen, but just preventing a panic if it does.
 		return nil, zerrors.ThrowInternal(nil, "OIDC-eShi5", "Error.Internal")
 	}
+	// the feature can be enabled or disabled on each level down to the client's application
+	features, err := s.query.GetAppFeatures(ctx, client.client.ProjectID, client.client.AppID, true)
+	if err != nil {
+		return nil, err
+	}
+	if !features.TokenExchange.Value {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-oan4I", "Errors.TokenExchange.FeatureDisabled")
+	}
+	if len(r.Data.Resource) > 0 {
+		return nil, oidc.ErrInvalidTarget().WithDescription("resource parameter not supported")
+	}
 
 	subjectToken, err := s.verifyExchangeToken(ctx, client, r.Data.SubjectToken, r.Data.SubjectTokenType, oidc.AllTokenTypes...)
 	if err != nil {
@@ -0,0 +1,605 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/serviceprovider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	// EndpointLogout is the IdP-initiated single logout,
+	// which logs out the user agent at all service providers it received a SAML response for
+	EndpointLogout = "/logout"
+
+	queryLogoutRedirect = "redirect"
+
+	samlVersion                 = "2.0"
+	timeFormat                  = "2006-01-02T15:04:05.999Z"
+	logoutRequestLifetime       = 5 * time.Minute
+	defaultSignatureAlgorithm   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	nameIDFormatEmailAddress    = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	nameIDFormatEntity          = "urn:oasis:names:tc:SAML:2.0:nameid-format:entity"
+	parameterSAMLRequest        = "SAMLRequest"
+	parameterSAMLResponse       = "SAMLResponse"
+	parameterRelayState         = "RelayState"
+	parameterSignatureAlgorithm = "SigAlg"
+	parameterSignature          = "Signature"
+)
+
+// LogoutURL returns the path of the IdP-initiated single logout.
+// The redirectURI is passed encrypted, so the logout can't be abused as an open redirect.
+func LogoutURL(encAlg crypto.EncryptionAlgorithm, redirectURI string) (string, error) {
+	encrypted, err := encAlg.Encrypt([]byte(redirectURI))
+	if err != nil {
+		return "", err
+	}
+	return HandlerPrefix + EndpointLogout + "?" + queryLogoutRedirect + "=" + base64.RawURLEncoding.EncodeToString(encrypted), nil
+}
+
+type logoutHandler struct {
+	storage              *Storage
+	metadataEndpoint     provider.Endpoint
+	singleLogoutEndpoint provider.Endpoint
+	signatureAlgorithm   string
+	defaultLoggedOutURL  string
+	template             *template.Template
+}
+
+func newLogoutHandler(storage *Storage, config *provider.Config) *logoutHandler {
+	h := &logoutHandler{
+		storage:              storage,
+		metadataEndpoint:     provider.NewEndpoint(provider.DefaultMetadataEndpoint),
+		singleLogoutEndpoint: provider.NewEndpoint(provider.DefaultSingleLogOutEndpoint),
+		signatureAlgorithm:   defaultSignatureAlgorithm,
+		defaultLoggedOutURL:  login.DefaultLoggedOutPath,
+		template:             template.Must(template.New("logout").Parse(logoutTemplate)),
+	}
+	if config.MetadataConfig != nil && config.MetadataConfig.Path != "" {
+		h.metadataEndpoint = provider.NewEndpoint(config.MetadataConfig.Path)
+	}
+	if config.IDPConfig == nil {
+		return h
+	}
+	if config.IDPConfig.SignatureAlgorithm != "" {
+		h.signatureAlgorithm = config.IDPConfig.SignatureAlgorithm
+	}
+	if config.IDPConfig.Endpoints != nil && config.IDPConfig.Endpoints.SingleLogOut != nil && config.IDPConfig.Endpoints.SingleLogOut.Relative() != "" {
+		h.singleLogoutEndpoint = *config.IDPConfig.Endpoints.SingleLogOut
+	}
+	return h
+}
+
+// handleSingleLogout handles the LogoutRequests of service providers (SP-initiated logout)
+// and the LogoutResponses to the LogoutRequests sent on propagation of a logout
+func (h *logoutHandler) handleSingleLogout(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Errorf("failed to parse form: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	if r.Form.Get(parameterSAMLResponse) != "" {
+		// the service provider confirmed the propagated logout,
+		// the session was already terminated when the LogoutRequest was issued
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	ctx := r.Context()
+	request, err := parseLogoutRequest(r)
+	if err != nil {
+		logging.WithError(err).Info("invalid saml logout request")
+		http.Error(w, fmt.Errorf("failed to parse logout request: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	sp, err := h.storage.GetEntityByID(ctx, request.request.Issuer.Text)
+	if err != nil {
+		logging.WithError(err).Info("unknown service provider in saml logout request")
+		http.Error(w, fmt.Errorf("failed to find registered serviceprovider: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	service := singleLogoutService(sp, request.binding)
+	if err = h.validateLogoutRequest(ctx, request, sp); err != nil {
+		logging.WithError(err).Info("invalid saml logout request")
+		h.sendLogoutResponse(ctx, w, request, service, nil, provider.StatusCodeRequestDenied, err.Error())
+		return
+	}
+	sessions, err := h.storage.query.ActiveSAMLSessionsByNameID(ctx, sp.GetEntityID(), request.request.NameID.Text)
+	if err != nil {
+		logging.WithError(err).Error("unable to get saml sessions")
+		h.sendLogoutResponse(ctx, w, request, service, nil, provider.StatusCodeResponder, "failed to get sessions")
+		return
+	}
+	frames, err := h.terminate(ctx, userAgentIDs(sessions.SAMLSessions), sp.GetEntityID())
+	if err != nil {
+		logging.WithError(err).Error("unable to terminate saml sessions")
+		h.sendLogoutResponse(ctx, w, request, service, frames, provider.StatusCodePartialLogout, "failed to terminate sessions")
+		return
+	}
+	h.sendLogoutResponse(ctx, w, request, service, frames, provider.StatusCodeSuccess, "")
+}
+
+// handleLogout logs out the user agent of the request at ZITADEL and all service providers (IdP-initiated logout)
+// and redirects to the passed (encrypted) redirect afterward
+func (h *logoutHandler) handleLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	redirectURI := h.logoutRedirectURI(r.URL.Query().Get(queryLogoutRedirect))
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		http.Redirect(w, r, redirectURI, http.StatusFound)
+		return
+	}
+	frames, err := h.terminate(ctx, []string{userAgentID}, "")
+	if err != nil {
+		logging.WithError(err).Error("unable to terminate saml sessions")
+	}
+	h.render(w, &logoutPage{Frames: frames, RedirectURI: redirectURI})
+}
+
+func (h *logoutHandler) logoutRedirectURI(encrypted string) string {
+	if encrypted == "" {
+		return h.defaultLoggedOutURL
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(encrypted)
+	if err != nil {
+		logging.WithError(err).Info("invalid saml logout redirect")
+		return h.defaultLoggedOutURL
+	}
+	redirectURI, err := h.storage.encAlg.DecryptString(decoded, h.storage.encAlg.EncryptionKeyID())
+	if err != nil {
+		logging.WithError(err).Info("invalid saml logout redirect")
+		return h.defaultLoggedOutURL
+	}
+	return redirectURI
+}
+
+// terminate signs out the users of the user agents and terminates their SAML sessions.
+// It returns the frames sending the LogoutRequests to all participating service providers except the initiating one.
+func (h *logoutHandler) terminate(ctx context.Context, userAgentIDs []string, initiatingEntityID string) ([]*logoutFrame, error) {
+	if len(userAgentIDs) == 0 {
+		return nil, nil
+	}
+	sessions, err := h.storage.query.ActiveSAMLSessionsByUserAgent(ctx, userAgentIDs...)
+	if err != nil {
+		return nil, err
+	}
+	frames := make([]*logoutFrame, 0, len(sessions.SAMLSessions))
+	propagated := make(map[string]bool, len(sessions.SAMLSessions))
+	for _, session := range sessions.SAMLSessions {
+		key := session.EntityID + "\n" + session.NameID
+		if session.EntityID != initiatingEntityID && !propagated[key] {
+			propagated[key] = true
+			frame, err := h.logoutFrame(ctx, session)
+			logging.OnError(err).WithField("entityID", session.EntityID).Warn("unable to propagate saml logout")
+			if frame != nil {
+				frames = append(frames, frame)
+			}
+		}
+		if _, err = h.storage.command.TerminateSAMLSession(ctx, session.ID); err != nil {
+			return frames, err
+		}
+	}
+	for _, userAgentID := range userAgentIDs {
+		if err = h.signOut(ctx, userAgentID); err != nil {
+			return frames, err
+		}
+	}
+	return frames, nil
+}
+
+func (h *logoutHandler) signOut(ctx context.Context, userAgentID string) error {
+	userIDs, err := h.storage.repo.UserSessionUserIDsByAgentID(ctx, userAgentID)
+	if err != nil {
+		return err
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return h.storage.command.HumansSignOut(authz.SetCtxData(ctx, authz.CtxData{UserID: userIDs[0]}), userAgentID, userIDs)
+}
+
+// logoutFrame creates the LogoutRequest for the service provider of the session.
+// It returns nil if the service provider does not support single logout.
+func (h *logoutHandler) logoutFrame(ctx context.Context, session *query.SAMLSession) (*logoutFrame, error) {
+	sp, err := h.storage.GetEntityByID(ctx, session.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	service := singleLogoutService(sp, provider.RedirectBinding)
+	if service == nil {
+		return nil, nil
+	}
+	now := time.Now().UTC()
+	request := &samlp.LogoutRequestType{
+		Id:           provider.NewID(),
+		Version:      samlVersion,
+		IssueInstant: now.Format(timeFormat),
+		NotOnOrAfter: now.Add(logoutRequestLifetime).Format(timeFormat),
+		Destination:  service.Location,
+		Issuer:       h.issuer(ctx),
+		NameID: &saml.NameIDType{
+			Format: nameIDFormatEmailAddress,
+			Text:   session.NameID,
+		},
+	}
+	if service.Binding == provider.RedirectBinding {
+		data, err := marshal(request)
+		if err != nil {
+			return nil, err
+		}
+		redirectURL, err := h.redirectURL(ctx, service.Location, parameterSAMLRequest, data, "")
+		if err != nil {
+			return nil, err
+		}
+		return &logoutFrame{URL: redirectURL}, nil
+	}
+	if request.Signature, err = h.postSignature(ctx, request); err != nil {
+		return nil, err
+	}
+	data, err := marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	form := &logoutForm{
+		Action:    service.Location,
+		Parameter: parameterSAMLRequest,
+		Message:   base64.StdEncoding.EncodeToString(data),
+	}
+	var document bytes.Buffer
+	if err = h.template.ExecuteTemplate(&document, "frame", form); err != nil {
+		return nil, err
+	}
+	return &logoutFrame{Document: document.String()}, nil
+}
+
+// sendLogoutResponse renders the page propagating the logout to the other service providers
+// and sends the LogoutResponse to the initiating service provider afterward.
+// If the service provider does not support single logout, the user is redirected to the logged out page of the login.
+func (h *logoutHandler) sendLogoutResponse(ctx context.Context, w http.ResponseWriter, request *logoutRequest, service *md.EndpointType, frames []*logoutFrame, status, message string) {
+	page := &logoutPage{
+		Frames:      frames,
+		RedirectURI: h.defaultLoggedOutURL,
+	}
+	if service == nil {
+		h.render(w, page)
+		return
+	}
+	location := service.Location
+	if service.ResponseLocation != "" {
+		location = service.ResponseLocation
+	}
+	now := time.Now().UTC()
+	response := &samlp.LogoutResponseType{
+		Id:           provider.NewID(),
+		InResponseTo: request.request.Id,
+		Version:      samlVersion,
+		IssueInstant: now.Format(timeFormat),
+		Destination:  location,
+		Issuer:       h.issuer(ctx),
+		Status: samlp.StatusType{
+			StatusCode: samlp.StatusCodeType{
+				Value: status,
+			},
+			StatusMessage: message,
+		},
+	}
+	err := h.setLogoutResponse(ctx, page, service.Binding, location, response, request.relayState)
+	if err != nil {
+		logging.WithError(err).Error("unable to create saml logout response")
+		http.Error(w, fmt.Errorf("failed to create logout response: %w", err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.render(w, page)
+}
+
+func (h *logoutHandler) setLogoutResponse(ctx context.Context, page *logoutPage, binding, location string, response *samlp.LogoutResponseType, relayState string) (err error) {
+	if binding == provider.RedirectBinding {
+		data, err := marshal(response)
+		if err != nil {
+			return err
+		}
+		page.RedirectURI, err = h.redirectURL(ctx, location, parameterSAMLResponse, data, relayState)
+		return err
+	}
+	if response.Signature, err = h.postSignature(ctx, response); err != nil {
+		return err
+	}
+	data, err := marshal(response)
+	if err != nil {
+		return err
+	}
+	page.Form = &logoutForm{
+		Action:     location,
+		Parameter:  parameterSAMLResponse,
+		Message:    base64.StdEncoding.EncodeToString(data),
+		RelayState: relayState,
+	}
+	return nil
+}
+
+func (h *logoutHandler) validateLogoutRequest(ctx context.Context, request *logoutRequest, sp *serviceprovider.ServiceProvider) error {
+	if request.request.Id == "" {
+		return errors.New("ID is missing in request")
+	}
+	if request.request.Version != samlVersion {
+		return errors.New("version of request is not supported")
+	}
+	if request.request.NameID == nil || request.request.NameID.Text == "" {
+		return errors.New("NameID is missing in request")
+	}
+	if request.request.NotOnOrAfter != "" {
+		notOnOrAfter, err := time.Parse(time.RFC3339, request.request.NotOnOrAfter)
+		if err != nil {
+			return fmt.Errorf("failed to parse NotOnOrAfter: %w", err)
+		}
+		if !time.Now().Before(notOnOrAfter) {
+			return errors.New("request is expired")
+		}
+	}
+	if request.request.Destination != "" && request.request.Destination != h.singleLogoutEndpoint.Absolute(provider.IssuerFromContext(ctx)) {
+		return errors.New("destination of request is unknown")
+	}
+	if request.binding == provider.RedirectBinding && request.signature != "" {
+		return sp.ValidateRedirectSignature(request.message, request.relayState, request.signatureAlgorithm, request.signature)
+	}
+	if request.binding == provider.PostBinding && request.request.Signature != nil {
+		return sp.ValidatePostSignature(string(request.data))
+	}
+	return nil
+}
+
+func (h *logoutHandler) issuer(ctx context.Context) *saml.NameIDType {
+	return &saml.NameIDType{
+		Format: nameIDFormatEntity,
+		Text:   h.metadataEndpoint.Absolute(provider.IssuerFromContext(ctx)),
+	}
+}
+
+// redirectURL creates the signed URL of the message for the HTTP-Redirect binding
+func (h *logoutHandler) redirectURL(ctx context.Context, location, parameter string, data []byte, relayState string) (string, error) {
+	message, err := deflateAndEncode(data)
+	if err != nil {
+		return "", err
+	}
+	query := parameter + "=" + url.QueryEscape(message)
+	if relayState != "" {
+		query += "&" + parameterRelayState + "=" + url.QueryEscape(relayState)
+	}
+	query += "&" + parameterSignatureAlgorithm + "=" + url.QueryEscape(h.signatureAlgorithm)
+
+	certAndKey, err := h.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	tlsCert, err := signature.ParseTlsKeyPair(certAndKey.Certificate, certAndKey.Key)
+	if err != nil {
+		return "", err
+	}
+	signingContext, err := signature.GetSigningContext(tlsCert, h.signatureAlgorithm)
+	if err != nil {
+		return "", err
+	}
+	sig, err := signature.CreateRedirect(signingContext, query)
+	if err != nil {
+		return "", err
+	}
+	query += "&" + parameterSignature + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(sig))
+	if strings.Contains(location, "?") {
+		return location + "&" + query, nil
+	}
+	return location + "?" + query, nil
+}
+
+// postSignature creates the enveloped signature of the element for the HTTP-POST binding
+func (h *logoutHandler) postSignature(ctx context.Context, element any) (*xml_dsig.SignatureType, error) {
+	certAndKey, err := h.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := signature.GetSigner(certAndKey.Certificate, certAndKey.Key, h.signatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	return signature.Create(signer, element)
+}
+
+func (h *logoutHandler) render(w http.ResponseWriter, page *logoutPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := h.template.ExecuteTemplate(w, "page", page); err != nil {
+		logging.WithError(err).Error("unable to render saml logout")
+	}
+}
+
+type logoutRequest struct {
+	request *samlp.LogoutRequestType
+	binding string
+	// message is the SAMLRequest parameter as received
+	message string
+	// data is the decoded xml of the message
+	data               []byte
+	relayState         string
+	signatureAlgorithm string
+	signature          string
+}
+
+func parseLogoutRequest(r *http.Request) (_ *logoutRequest, err error) {
+	request := &logoutRequest{
+		binding:            provider.PostBinding,
+		message:            r.Form.Get(parameterSAMLRequest),
+		relayState:         r.Form.Get(parameterRelayState),
+		signatureAlgorithm: r.Form.Get(parameterSignatureAlgorithm),
+		signature:          r.Form.Get(parameterSignature),
+	}
+	if request.message == "" {
+		return nil, errors.New("no SAMLRequest provided")
+	}
+	if r.URL.Query().Has(parameterSAMLRequest) {
+		request.binding = provider.RedirectBinding
+	}
+	request.data, err = base64.StdEncoding.DecodeString(request.message)
+	if err != nil {
+		return nil, err
+	}
+	if request.binding == provider.RedirectBinding {
+		request.data, err = io.ReadAll(flate.NewReader(bytes.NewReader(request.data)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	request.request = new(samlp.LogoutRequestType)
+	if err = xml.Unmarshal(request.data, request.request); err != nil {
+		return nil, err
+	}
+	if request.request.Issuer == nil || request.request.Issuer.Text == "" {
+		return nil, errors.New("issuer is missing in request")
+	}
+	return request, nil
+}
+
+// singleLogoutService returns the endpoint of the service provider for the preferred binding.
+// If the binding is not supported, the HTTP-Redirect or HTTP-POST binding is used.
+func singleLogoutService(sp *serviceprovider.ServiceProvider, binding string) *md.EndpointType {
+	if sp.Metadata == nil || sp.Metadata.SPSSODescriptor == nil {
+		return nil
+	}
+	var fallback *md.EndpointType
+	for i, service := range sp.Metadata.SPSSODescriptor.SingleLogoutService {
+		if service.Binding == binding {
+			return &sp.Metadata.SPSSODescriptor.SingleLogoutService[i]
+		}
+		if fallback == nil && (service.Binding == provider.RedirectBinding || service.Binding == provider.PostBinding) {
+			fallback = &sp.Metadata.SPSSODescriptor.SingleLogoutService[i]
+		}
+	}
+	return fallback
+}
+
+func userAgentIDs(sessions []*query.SAMLSession) []string {
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		if !slices.Contains(ids, session.UserAgentID) {
+			ids = append(ids, session.UserAgentID)
+		}
+	}
+	return ids
+}
+
+func marshal(element any) ([]byte, error) {
+	data, err := xml.Marshal(element)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func deflateAndEncode(data []byte) (string, error) {
+	var buf bytes.Buffer
+	writer, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err = writer.Write(data); err != nil {
+		return "", err
+	}
+	if err = writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+type logoutPage struct {
+	// Frames send the LogoutRequests to the other participating service providers
+	Frames []*logoutFrame
+	// Form posts the LogoutResponse to the initiating service provider,
+	// if not set the user agent is redirected to the RedirectURI
+	Form        *logoutForm
+	RedirectURI string
+}
+
+// logoutFrame either loads the URL (HTTP-Redirect binding)
+// or the Document posting the LogoutRequest (HTTP-POST binding)
+type logoutFrame struct {
+	URL      string
+	Document string
+}
+
+type logoutForm struct {
+	Action     string
+	Parameter  string
+	Message    string
+	RelayState string
+}
+
+const logoutTemplate = `{{define "form"}}<form id="continue" method="post" action="{{.Action}}">
+<input type="hidden" name="{{.Parameter}}" value="{{.Message}}"/>
+{{if .RelayState}}<input type="hidden" name="RelayState" value="{{.RelayState}}"/>{{end}}
+<noscript><input type="submit" value="Continue"/></noscript>
+</form>{{end}}
+{{define "frame"}}<!DOCTYPE html>
+<html><body onload="document.getElementById('continue').submit()">{{template "form" .}}</body></html>{{end}}
+{{define "page"}}<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"/><title>Logout</title></head>
+<body>
+{{range .Frames}}{{if .URL}}<iframe src="{{.URL}}" data-loads="1" style="display:none"></iframe>{{else}}<iframe srcdoc="{{.Document}}" data-loads="2" style="display:none"></iframe>{{end}}
+{{end}}
+{{if .Form}}{{template "form" .Form}}{{else}}<noscript><a id="continue" href="{{.RedirectURI}}">Continue</a></noscript><a id="redirect" href="{{.RedirectURI}}" hidden></a>{{end}}
+<script>
+(function () {
+	var done = false;
+	function proceed() {
+		if (done) {
+			return;
+		}
+		done = true;
+		var form = document.getElementById('continue');
+		if (form && form.tagName === 'FORM') {
+			form.submit();
+			return;
+		}
+		window.location.replace(document.getElementById('redirect').href);
+	}
+	var frames = document.getElementsByTagName('iframe');
+	var pending = frames.length;
+	if (pending === 0) {
+		proceed();
+		return;
+	}
+	for (var i = 0; i < frames.length; i++) {
+		(function (frame, expected) {
+			frame.addEventListener('load', function () {
+				expected--;
+				if (expected === 0 && --pending === 0) {
+					proceed();
+				}
+			});
+		})(frames[i], parseInt(frames[i].getAttribute('data-loads'), 10));
+	}
+	setTimeout(proceed, 5000);
+})();
+</script>
+</body>
+</html>{{end}}`
@@ -0,0 +1,152 @@
+package saml
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/serviceprovider"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+)
+
+func Test_parseLogoutRequest(t *testing.T) {
+	data, err := marshal(&samlp.LogoutRequestType{
+		Id:      "id",
+		Version: samlVersion,
+		Issuer:  &saml.NameIDType{Text: "https://sp.example.com"},
+		NameID:  &saml.NameIDType{Format: nameIDFormatEmailAddress, Text: "user@example.com"},
+	})
+	require.NoError(t, err)
+	deflated, err := deflateAndEncode(data)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		request     func() *http.Request
+		wantBinding string
+		wantErr     bool
+	}{
+		{
+			name: "no request",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/SLO", nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "redirect binding",
+			request: func() *http.Request {
+				query := url.Values{
+					parameterSAMLRequest: {deflated},
+					parameterRelayState:  {"state"},
+				}
+				return httptest.NewRequest(http.MethodGet, "/SLO?"+query.Encode(), nil)
+			},
+			wantBinding: provider.RedirectBinding,
+		},
+		{
+			name: "post binding",
+			request: func() *http.Request {
+				form := url.Values{
+					parameterSAMLRequest: {base64.StdEncoding.EncodeToString(data)},
+					parameterRelayState:  {"state"},
+				}
+				r := httptest.NewRequest(http.MethodPost, "/SLO", strings.NewReader(form.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return r
+			},
+			wantBinding: provider.PostBinding,
+		},
+		{
+			name: "post binding, deflated",
+			request: func() *http.Request {
+				form := url.Values{
+					parameterSAMLRequest: {deflated},
+				}
+				r := httptest.NewRequest(http.MethodPost, "/SLO", strings.NewReader(form.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return r
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.request()
+			require.NoError(t, r.ParseForm())
+			got, err := parseLogoutRequest(r)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBinding, got.binding)
+			assert.Equal(t, "state", got.relayState)
+			assert.Equal(t, "https://sp.example.com", got.request.Issuer.Text)
+			assert.Equal(t, "user@example.com", got.request.NameID.Text)
+		})
+	}
+}
+
+func Test_singleLogoutService(t *testing.T) {
+	const soapBinding = "urn:oasis:names:tc:SAML:2.0:bindings:SOAP"
+	serviceProvider := func(services ...md.EndpointType) *serviceprovider.ServiceProvider {
+		return &serviceprovider.ServiceProvider{
+			Metadata: &md.EntityDescriptorType{
+				SPSSODescriptor: &md.SPSSODescriptorType{
+					SingleLogoutService: services,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		sp      *serviceprovider.ServiceProvider
+		binding string
+		want    *md.EndpointType
+	}{
+		{
+			name:    "no descriptor",
+			sp:      &serviceprovider.ServiceProvider{Metadata: &md.EntityDescriptorType{}},
+			binding: provider.RedirectBinding,
+		},
+		{
+			name: "preferred binding",
+			sp: serviceProvider(
+				md.EndpointType{Binding: provider.PostBinding, Location: "https://sp.example.com/post"},
+				md.EndpointType{Binding: provider.RedirectBinding, Location: "https://sp.example.com/redirect"},
+			),
+			binding: provider.RedirectBinding,
+			want:    &md.EndpointType{Binding: provider.RedirectBinding, Location: "https://sp.example.com/redirect"},
+		},
+		{
+			name: "fallback binding",
+			sp: serviceProvider(
+				md.EndpointType{Binding: soapBinding, Location: "https://sp.example.com/soap"},
+				md.EndpointType{Binding: provider.PostBinding, Location: "https://sp.example.com/post"},
+			),
+			binding: provider.RedirectBinding,
+			want:    &md.EndpointType{Binding: provider.PostBinding, Location: "https://sp.example.com/post"},
+		},
+		{
+			name: "unsupported binding",
+			sp: serviceProvider(
+				md.EndpointType{Binding: soapBinding, Location: "https://sp.example.com/soap"},
+			),
+			binding: provider.RedirectBinding,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, singleLogoutService(tt.sp, tt.binding))
+		})
+	}
+}
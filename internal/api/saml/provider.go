@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/gorilla/mux"
 	"github.com/zitadel/saml/pkg/provider"
 
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
@@ -27,6 +28,16 @@ type Config struct {
 	ProviderConfig *provider.Config
 }
 
+// Provider extends the SAML provider with the handling of the single logout
+type Provider struct {
+	*provider.Provider
+	handler http.Handler
+}
+
+func (p *Provider) HttpHandler() http.Handler {
+	return p.handler
+}
+
 func NewProvider(
 	conf Config,
 	externalSecure bool,
@@ -40,7 +51,7 @@ func NewProvider(
 	instanceHandler,
 	userAgentCookie func(http.Handler) http.Handler,
 	accessHandler *middleware.AccessInterceptor,
-) (*provider.Provider, error) {
+) (*Provider, error) {
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
 
 	provStorage, err := newStorage(
@@ -56,29 +67,55 @@ func NewProvider(
 		return nil, err
 	}
 
+	interceptors := []provider.HttpInterceptor{
+		middleware.MetricsHandler(metricTypes),
+		middleware.TelemetryHandler(),
+		middleware.NoCacheInterceptor().Handler,
+		instanceHandler,
+		userAgentCookie,
+		accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
+		http_utils.CopyHeadersToContext,
+		middleware.ActivityHandler,
+	}
 	options := []provider.Option{
-		provider.WithHttpInterceptors(
-			middleware.MetricsHandler(metricTypes),
-			middleware.TelemetryHandler(),
-			middleware.NoCacheInterceptor().Handler,
-			instanceHandler,
-			userAgentCookie,
-			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
-			http_utils.CopyHeadersToContext,
-			middleware.ActivityHandler,
-		),
-		provider.WithCustomTimeFormat("2006-01-02T15:04:05.999Z"),
+		provider.WithHttpInterceptors(interceptors...),
+		provider.WithCustomTimeFormat(timeFormat),
 	}
 	if !externalSecure {
 		options = append(options, provider.WithAllowInsecure())
 	}
 
-	return provider.NewProvider(
+	samlProvider, err := provider.NewProvider(
 		provStorage,
 		HandlerPrefix,
 		conf.ProviderConfig,
 		options...,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: samlProvider,
+		handler:  newHandler(samlProvider, newLogoutHandler(provStorage, conf.ProviderConfig), interceptors),
+	}, nil
+}
+
+// newHandler serves the single logout endpoints, as the provider only confirms LogoutRequests
+// without terminating any session, all other endpoints are served by the provider
+func newHandler(samlProvider *provider.Provider, logout *logoutHandler, interceptors []provider.HttpInterceptor) http.Handler {
+	issuerInterceptor := provider.NewIssuerInterceptor(samlProvider.IssuerFromRequest)
+	intercept := func(handlerFunc http.HandlerFunc) http.Handler {
+		var handler http.Handler = handlerFunc
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			handler = interceptors[i](handler)
+		}
+		return issuerInterceptor.Handler(handler)
+	}
+	router := mux.NewRouter()
+	router.Handle(logout.singleLogoutEndpoint.Relative(), intercept(logout.handleSingleLogout))
+	router.Handle(EndpointLogout, intercept(logout.handleLogout))
+	router.PathPrefix("/").Handler(samlProvider.HttpHandler())
+	return router
 }
 
 func newStorage(
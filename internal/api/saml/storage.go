@@ -152,7 +152,26 @@ func (p *Storage) SetUserinfoWithUserID(ctx context.Context, applicationID strin
 
 	// trigger activity log for authentication for user
 	activity.Trigger(ctx, user.ResourceOwner, user.ID, activity.SAMLResponse, p.eventstore.FilterToQueryReducer)
-	return nil
+	return p.addSession(ctx, applicationID, user)
+}
+
+// addSession keeps track of the application the user is logged into,
+// so the logout can be propagated to it
+func (p *Storage) addSession(ctx context.Context, applicationID string, user *query.User) error {
+	userAgentID, _ := middleware.UserAgentIDFromCtx(ctx)
+	entityID, err := p.GetEntityIDByAppID(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+	_, _, err = p.command.AddSAMLSession(ctx, &command.SAMLSession{
+		UserID:            user.ID,
+		UserResourceOwner: user.ResourceOwner,
+		UserAgentID:       userAgentID,
+		AppID:             applicationID,
+		EntityID:          entityID,
+		NameID:            user.PreferredLoginName,
+	})
+	return err
 }
 
 func (p *Storage) SetUserinfoWithLoginName(ctx context.Context, userinfo models.AttributeSetter, loginName string, attributes []int) (err error) {
@@ -0,0 +1,174 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const bulkIDPrefix = "bulkId:"
+
+// bulkIDReference matches references like `"bulkId:qwerty"` in the path and data of a bulk operation
+var bulkIDReference = regexp.MustCompile(`bulkId:([^"/\s]+)`)
+
+var bulkMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// bulk executes the operations of the request one after the other (RFC 7644 section 3.7).
+// Every operation is dispatched to the corresponding endpoint and therefore authorized on its own.
+// References to resources created in the same request (bulkId:<id>) are resolved,
+// as long as they were created in a preceding operation.
+func (s *Server) bulk(w http.ResponseWriter, r *http.Request) {
+	request := new(BulkRequest)
+	if err := readJSON(r, request); err != nil {
+		writeError(w, err)
+		return
+	}
+	if !slices.Contains(request.Schemas, schemaBulkRequest) {
+		writeError(w, invalidValueError(nil, "SCIM-Bk2sa"))
+		return
+	}
+	if s.config.MaxBulkOperations > 0 && len(request.Operations) > s.config.MaxBulkOperations {
+		writeJSON(w, http.StatusRequestEntityTooLarge, &Error{
+			Schemas:  []string{schemaError},
+			ScimType: scimTypeTooMany,
+			Detail:   "Errors.SCIM.Bulk.TooManyOperations",
+			Status:   strconv.Itoa(http.StatusRequestEntityTooLarge),
+		})
+		return
+	}
+	orgID := orgIDFromRequest(r)
+	createdIDs := make(map[string]string, len(request.Operations))
+	response := &BulkResponse{
+		Schemas:    []string{schemaBulkResponse},
+		Operations: make([]*BulkOperationResponse, 0, len(request.Operations)),
+	}
+	errorCount := 0
+	for _, operation := range request.Operations {
+		if request.FailOnErrors > 0 && errorCount >= request.FailOnErrors {
+			break
+		}
+		result := s.bulkOperation(r, orgID, operation, createdIDs)
+		if status, _ := strconv.Atoi(result.Status); status >= http.StatusBadRequest {
+			errorCount++
+		}
+		response.Operations = append(response.Operations, result)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) bulkOperation(r *http.Request, orgID string, operation *BulkOperation, createdIDs map[string]string) *BulkOperationResponse {
+	result := &BulkOperationResponse{
+		Method:  operation.Method,
+		BulkID:  operation.BulkID,
+		Version: operation.Version,
+	}
+	request, err := s.bulkOperationRequest(r, orgID, operation, createdIDs)
+	if err != nil {
+		return bulkErrorResponse(result, err)
+	}
+	recorder := newBulkResponseWriter()
+	s.router.ServeHTTP(recorder, request)
+
+	result.Status = strconv.Itoa(recorder.status)
+	result.Location = recorder.Header().Get("Location")
+	if recorder.status >= http.StatusBadRequest {
+		result.Response = recorder.body.Bytes()
+		return result
+	}
+	result.Version = recorder.Header().Get("ETag")
+	if request.Method == http.MethodPost && operation.BulkID != "" {
+		created := new(struct {
+			ID string `json:"id"`
+		})
+		if err = json.Unmarshal(recorder.body.Bytes(), created); err == nil {
+			createdIDs[operation.BulkID] = created.ID
+		}
+	}
+	return result
+}
+
+func (s *Server) bulkOperationRequest(r *http.Request, orgID string, operation *BulkOperation, createdIDs map[string]string) (*http.Request, error) {
+	method := strings.ToUpper(operation.Method)
+	if !slices.Contains(bulkMethods, method) {
+		return nil, invalidValueError(nil, "SCIM-Bm2sa")
+	}
+	if method == http.MethodPost && operation.BulkID == "" {
+		return nil, invalidValueError(nil, "SCIM-Bi2sa")
+	}
+	if !strings.HasPrefix(operation.Path, "/") {
+		return nil, invalidPathError("SCIM-Bp2sa", operation.Path)
+	}
+	path, err := resolveBulkIDs(operation.Path, createdIDs)
+	if err != nil {
+		return nil, err
+	}
+	data, err := resolveBulkIDs(string(operation.Data), createdIDs)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(r.Context(), method, "/"+orgID+path, strings.NewReader(data))
+	if err != nil {
+		return nil, invalidPathError("SCIM-Bp3sd", operation.Path)
+	}
+	request.Host = r.Host
+	request.RemoteAddr = r.RemoteAddr
+	request.Header = r.Header.Clone()
+	request.Header.Del("If-Match")
+	if operation.Version != "" {
+		request.Header.Set("If-Match", operation.Version)
+	}
+	return request, nil
+}
+
+func resolveBulkIDs(value string, createdIDs map[string]string) (resolved string, err error) {
+	resolved = bulkIDReference.ReplaceAllStringFunc(value, func(reference string) string {
+		id, ok := createdIDs[strings.TrimPrefix(reference, bulkIDPrefix)]
+		if !ok {
+			err = withScimType(scimTypeInvalidValue, zerrors.ThrowInvalidArgument(fmt.Errorf("unresolved reference %q", reference), "SCIM-Br2sa", "Errors.SCIM.Bulk.UnresolvedReference"))
+			return reference
+		}
+		return id
+	})
+	return resolved, err
+}
+
+func bulkErrorResponse(result *BulkOperationResponse, err error) *BulkOperationResponse {
+	status, response := errorToResponse(err)
+	result.Status = strconv.Itoa(status)
+	result.Response, _ = json.Marshal(response)
+	return result
+}
+
+// bulkResponseWriter captures the response of a single operation of a bulk request
+type bulkResponseWriter struct {
+	header http.Header
+	body   *bytes.Buffer
+	status int
+}
+
+func newBulkResponseWriter() *bulkResponseWriter {
+	return &bulkResponseWriter{
+		header: make(http.Header),
+		body:   new(bytes.Buffer),
+		status: http.StatusOK,
+	}
+}
+
+func (w *bulkResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bulkResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bulkResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
@@ -0,0 +1,286 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ServiceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	DocumentationURI      string                  `json:"documentationUri,omitempty"`
+	Patch                 *Supported              `json:"patch"`
+	Bulk                  *BulkSupported          `json:"bulk"`
+	Filter                *FilterSupported        `json:"filter"`
+	ChangePassword        *Supported              `json:"changePassword"`
+	Sort                  *Supported              `json:"sort"`
+	ETag                  *Supported              `json:"etag"`
+	AuthenticationSchemes []*AuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  *Meta                   `json:"meta,omitempty"`
+}
+
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+type BulkSupported struct {
+	Supported      bool  `json:"supported"`
+	MaxOperations  int   `json:"maxOperations"`
+	MaxPayloadSize int64 `json:"maxPayloadSize"`
+}
+
+type FilterSupported struct {
+	Supported  bool   `json:"supported"`
+	MaxResults uint64 `json:"maxResults"`
+}
+
+type AuthenticationScheme struct {
+	Type             string `json:"type"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	SpecURI          string `json:"specUri,omitempty"`
+	DocumentationURI string `json:"documentationUri,omitempty"`
+	Primary          bool   `json:"primary,omitempty"`
+}
+
+type ResourceType struct {
+	Schemas          []string           `json:"schemas"`
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Endpoint         string             `json:"endpoint"`
+	Description      string             `json:"description"`
+	Schema           string             `json:"schema"`
+	SchemaExtensions []*SchemaExtension `json:"schemaExtensions,omitempty"`
+	Meta             *Meta              `json:"meta,omitempty"`
+}
+
+type SchemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+type Schema struct {
+	Schemas     []string           `json:"schemas"`
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Attributes  []*SchemaAttribute `json:"attributes"`
+	Meta        *Meta              `json:"meta,omitempty"`
+}
+
+type SchemaAttribute struct {
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	MultiValued   bool               `json:"multiValued"`
+	Required      bool               `json:"required"`
+	CaseExact     bool               `json:"caseExact"`
+	Mutability    string             `json:"mutability"`
+	Returned      string             `json:"returned"`
+	Uniqueness    string             `json:"uniqueness"`
+	SubAttributes []*SchemaAttribute `json:"subAttributes,omitempty"`
+}
+
+func (s *Server) getServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &ServiceProviderConfig{
+		Schemas:          []string{schemaServiceProviderConfig},
+		DocumentationURI: "https://zitadel.com/docs/apis/scim",
+		Patch:            &Supported{Supported: true},
+		Bulk: &BulkSupported{
+			Supported:      true,
+			MaxOperations:  s.config.MaxBulkOperations,
+			MaxPayloadSize: s.config.MaxPayloadSize,
+		},
+		Filter: &FilterSupported{
+			Supported:  true,
+			MaxResults: s.config.MaxResults,
+		},
+		ChangePassword: &Supported{Supported: true},
+		Sort:           &Supported{Supported: true},
+		ETag:           &Supported{Supported: true},
+		AuthenticationSchemes: []*AuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication using a personal access token or an access token of a machine user",
+				SpecURI:     "https://www.rfc-editor.org/rfc/rfc6750",
+				Primary:     true,
+			},
+		},
+		Meta: &Meta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     s.baseURL(r.Context(), orgIDFromRequest(r)) + "/ServiceProviderConfig",
+		},
+	})
+}
+
+func (s *Server) listResourceTypes(w http.ResponseWriter, r *http.Request) {
+	resourceTypes := s.resourceTypes(r.Context(), orgIDFromRequest(r))
+	resources := make([]any, len(resourceTypes))
+	for i, resourceType := range resourceTypes {
+		resources[i] = resourceType
+	}
+	writeJSON(w, http.StatusOK, newListResponse(uint64(len(resources)), 1, resources))
+}
+
+func (s *Server) resourceTypes(ctx context.Context, orgID string) []*ResourceType {
+	baseURL := s.baseURL(ctx, orgID)
+	return []*ResourceType{
+		{
+			Schemas:          []string{schemaResourceType},
+			ID:               resourceTypeUser,
+			Name:             resourceTypeUser,
+			Endpoint:         "/Users",
+			Description:      "Human and machine users of the organization",
+			Schema:           schemaUser,
+			SchemaExtensions: []*SchemaExtension{{Schema: schemaZitadelUser}},
+			Meta: &Meta{
+				ResourceType: "ResourceType",
+				Location:     baseURL + "/ResourceTypes/" + resourceTypeUser,
+			},
+		},
+		{
+			Schemas:          []string{schemaResourceType},
+			ID:               resourceTypeGroup,
+			Name:             resourceTypeGroup,
+			Endpoint:         "/Groups",
+			Description:      "Roles of the projects of the organization",
+			Schema:           schemaGroup,
+			SchemaExtensions: []*SchemaExtension{{Schema: schemaZitadelGroup}},
+			Meta: &Meta{
+				ResourceType: "ResourceType",
+				Location:     baseURL + "/ResourceTypes/" + resourceTypeGroup,
+			},
+		},
+	}
+}
+
+func (s *Server) listSchemas(w http.ResponseWriter, r *http.Request) {
+	schemas := s.schemas(r.Context(), orgIDFromRequest(r))
+	resources := make([]any, len(schemas))
+	for i, schema := range schemas {
+		resources[i] = schema
+	}
+	writeJSON(w, http.StatusOK, newListResponse(uint64(len(resources)), 1, resources))
+}
+
+func (s *Server) getSchema(w http.ResponseWriter, r *http.Request) {
+	id := resourceIDFromRequest(r)
+	for _, schema := range s.schemas(r.Context(), orgIDFromRequest(r)) {
+		if schema.ID == id {
+			writeJSON(w, http.StatusOK, schema)
+			return
+		}
+	}
+	writeError(w, zerrors.ThrowNotFound(nil, "SCIM-Sc2sf", "Errors.SCIM.Schema.NotFound"))
+}
+
+func (s *Server) schemas(ctx context.Context, orgID string) []*Schema {
+	baseURL := s.baseURL(ctx, orgID)
+	schemas := []*Schema{
+		{
+			ID:          schemaUser,
+			Name:        resourceTypeUser,
+			Description: "User Account",
+			Attributes: []*SchemaAttribute{
+				stringAttribute("userName", true, "server"),
+				{
+					Name:       "name",
+					Type:       "complex",
+					Mutability: "readWrite",
+					Returned:   "default",
+					Uniqueness: "none",
+					SubAttributes: []*SchemaAttribute{
+						stringAttribute("formatted", false, "none"),
+						stringAttribute("familyName", false, "none"),
+						stringAttribute("givenName", false, "none"),
+					},
+				},
+				stringAttribute("displayName", false, "none"),
+				stringAttribute("nickName", false, "none"),
+				stringAttribute("preferredLanguage", false, "none"),
+				{Name: "active", Type: "boolean", Mutability: "readWrite", Returned: "default", Uniqueness: "none"},
+				{Name: "password", Type: "string", Mutability: "writeOnly", Returned: "never", Uniqueness: "none"},
+				multiValuedAttribute("emails"),
+				multiValuedAttribute("phoneNumbers"),
+			},
+		},
+		{
+			ID:          schemaGroup,
+			Name:        resourceTypeGroup,
+			Description: "Group",
+			Attributes: []*SchemaAttribute{
+				stringAttribute("displayName", true, "none"),
+				{
+					Name:        "members",
+					Type:        "complex",
+					MultiValued: true,
+					Mutability:  "readWrite",
+					Returned:    "default",
+					Uniqueness:  "none",
+					SubAttributes: []*SchemaAttribute{
+						{Name: "value", Type: "string", Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+						{Name: "$ref", Type: "reference", Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+						{Name: "display", Type: "string", Mutability: "readOnly", Returned: "default", Uniqueness: "none"},
+					},
+				},
+			},
+		},
+		{
+			ID:          schemaZitadelUser,
+			Name:        "ZitadelUser",
+			Description: "ZITADEL specific attributes of a user",
+			Attributes: []*SchemaAttribute{
+				{Name: "machine", Type: "boolean", Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+				stringAttribute("description", false, "none"),
+				{Name: "emailVerified", Type: "boolean", Mutability: "readWrite", Returned: "default", Uniqueness: "none"},
+				{Name: "phoneVerified", Type: "boolean", Mutability: "readWrite", Returned: "default", Uniqueness: "none"},
+			},
+		},
+		{
+			ID:          schemaZitadelGroup,
+			Name:        "ZitadelGroup",
+			Description: "ZITADEL specific attributes of a group representing a project role",
+			Attributes: []*SchemaAttribute{
+				{Name: "projectId", Type: "string", Required: true, CaseExact: true, Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+				{Name: "roleKey", Type: "string", Required: true, CaseExact: true, Mutability: "immutable", Returned: "default", Uniqueness: "none"},
+				stringAttribute("group", false, "none"),
+			},
+		},
+	}
+	for _, schema := range schemas {
+		schema.Schemas = []string{schemaSchema}
+		schema.Meta = &Meta{
+			ResourceType: "Schema",
+			Location:     baseURL + "/Schemas/" + schema.ID,
+		}
+	}
+	return schemas
+}
+
+func stringAttribute(name string, required bool, uniqueness string) *SchemaAttribute {
+	return &SchemaAttribute{
+		Name:       name,
+		Type:       "string",
+		Required:   required,
+		Mutability: "readWrite",
+		Returned:   "default",
+		Uniqueness: uniqueness,
+	}
+}
+
+func multiValuedAttribute(name string) *SchemaAttribute {
+	return &SchemaAttribute{
+		Name:        name,
+		Type:        "complex",
+		MultiValued: true,
+		Mutability:  "readWrite",
+		Returned:    "default",
+		Uniqueness:  "none",
+		SubAttributes: []*SchemaAttribute{
+			stringAttribute("value", false, "none"),
+			stringAttribute("type", false, "none"),
+			{Name: "primary", Type: "boolean", Mutability: "readWrite", Returned: "default", Uniqueness: "none"},
+		},
+	}
+}
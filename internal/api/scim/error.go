@@ -0,0 +1,113 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/zitadel/logging"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// scimType values defined in RFC 7644 section 3.12
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeTooMany       = "tooMany"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeMutability    = "mutability"
+)
+
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	Status   string   `json:"status"`
+}
+
+// scimError wraps an error with the scimType returned to the client
+type scimError struct {
+	scimType string
+	parent   error
+}
+
+func (e *scimError) Error() string {
+	return e.parent.Error()
+}
+
+func (e *scimError) Unwrap() error {
+	return e.parent
+}
+
+func withScimType(scimType string, err error) error {
+	return &scimError{scimType: scimType, parent: err}
+}
+
+func invalidFilterError(id, message string) error {
+	return withScimType(scimTypeInvalidFilter, zerrors.ThrowInvalidArgument(nil, id, message))
+}
+
+func invalidSyntaxError(parent error, id string) error {
+	return withScimType(scimTypeInvalidSyntax, zerrors.ThrowInvalidArgument(parent, id, "Errors.SCIM.InvalidSyntax"))
+}
+
+func invalidPathError(id, path string) error {
+	return withScimType(scimTypeInvalidPath, zerrors.ThrowInvalidArgument(fmt.Errorf("invalid path %q", path), id, "Errors.SCIM.InvalidPath"))
+}
+
+func invalidValueError(parent error, id string) error {
+	return withScimType(scimTypeInvalidValue, zerrors.ThrowInvalidArgument(parent, id, "Errors.SCIM.InvalidValue"))
+}
+
+func errorToResponse(err error) (int, *Error) {
+	status, ok := http_util.ZitadelErrorToHTTPStatusCode(err)
+	if !ok {
+		logging.WithError(err).Warn("unexpected error on scim api")
+		status = http.StatusInternalServerError
+	}
+	response := &Error{
+		Schemas: []string{schemaError},
+		Status:  strconv.Itoa(status),
+		Detail:  err.Error(),
+	}
+	zitadelErr := new(zerrors.ZitadelError)
+	if errors.As(err, &zitadelErr) {
+		response.Detail = zitadelErr.GetMessage()
+	}
+	target := new(scimError)
+	if errors.As(err, &target) {
+		response.ScimType = target.scimType
+	} else if zerrors.IsErrorAlreadyExists(err) {
+		response.ScimType = scimTypeUniqueness
+	}
+	return status, response
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status, response := errorToResponse(err)
+	writeJSON(w, status, response)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", contentTypeSCIM)
+	w.WriteHeader(status)
+	if body == nil {
+		return
+	}
+	err := json.NewEncoder(w).Encode(body)
+	logging.OnError(err).Warn("unable to write scim response")
+}
+
+func readJSON(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return invalidSyntaxError(err, "SCIM-Jfq2s")
+	}
+	return nil
+}
@@ -0,0 +1,500 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// filter operators defined in RFC 7644 section 3.4.2.2
+const (
+	filterOpEqual         = "eq"
+	filterOpNotEqual      = "ne"
+	filterOpContains      = "co"
+	filterOpStartsWith    = "sw"
+	filterOpEndsWith      = "ew"
+	filterOpPresent       = "pr"
+	filterOpGreater       = "gt"
+	filterOpGreaterEquals = "ge"
+	filterOpLess          = "lt"
+	filterOpLessEquals    = "le"
+
+	filterOpAnd = "and"
+	filterOpOr  = "or"
+	filterOpNot = "not"
+)
+
+type filterExpression interface {
+	isFilterExpression()
+}
+
+// attributeExpression compares an attribute (e.g. `userName eq "bjensen"`)
+type attributeExpression struct {
+	// Path is the lowercased attribute path without schema prefix (e.g. name.givenname)
+	Path     string
+	Operator string
+	// Value is either a string, a bool, a float64 or nil
+	Value any
+}
+
+// logicalExpression combines two expressions with `and` or `or`
+type logicalExpression struct {
+	Operator string
+	Left     filterExpression
+	Right    filterExpression
+}
+
+// notExpression negates an expression (e.g. `not (userName eq "bjensen")`)
+type notExpression struct {
+	Expression filterExpression
+}
+
+func (*attributeExpression) isFilterExpression() {}
+func (*logicalExpression) isFilterExpression()   {}
+func (*notExpression) isFilterExpression()       {}
+
+type filterTokenType int
+
+const (
+	filterTokenWord filterTokenType = iota
+	filterTokenString
+	filterTokenOpenParenthesis
+	filterTokenCloseParenthesis
+	filterTokenOpenBracket
+	filterTokenCloseBracket
+)
+
+type filterToken struct {
+	typ   filterTokenType
+	value string
+}
+
+// parseFilter parses a SCIM filter (RFC 7644 section 3.4.2.2) into an expression tree.
+// Value paths (e.g. `emails[type eq "work"]`) are flattened by prefixing the attributes
+// of the sub filter with the parent attribute.
+func parseFilter(filter string) (filterExpression, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, invalidFilterError("SCIM-Ef2tq", "Errors.SCIM.Filter.Empty")
+	}
+	p := &filterParser{tokens: tokens}
+	expression, err := p.parseOr("")
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, invalidFilterError("SCIM-Ut4bm", "Errors.SCIM.Filter.UnexpectedToken")
+	}
+	return expression, nil
+}
+
+func tokenizeFilter(filter string) ([]*filterToken, error) {
+	tokens := make([]*filterToken, 0)
+	runes := []rune(filter)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case r == '(':
+			tokens = append(tokens, &filterToken{typ: filterTokenOpenParenthesis})
+		case r == ')':
+			tokens = append(tokens, &filterToken{typ: filterTokenCloseParenthesis})
+		case r == '[':
+			tokens = append(tokens, &filterToken{typ: filterTokenOpenBracket})
+		case r == ']':
+			tokens = append(tokens, &filterToken{typ: filterTokenCloseBracket})
+		case r == '"':
+			end := i + 1
+			for ; end < len(runes); end++ {
+				if runes[end] == '\\' {
+					end++
+					continue
+				}
+				if runes[end] == '"' {
+					break
+				}
+			}
+			if end >= len(runes) {
+				return nil, invalidFilterError("SCIM-Qo2nd", "Errors.SCIM.Filter.UnterminatedString")
+			}
+			var value string
+			if err := json.Unmarshal([]byte(string(runes[i:end+1])), &value); err != nil {
+				return nil, invalidFilterError("SCIM-Kw9fe", "Errors.SCIM.Filter.InvalidString")
+			}
+			tokens = append(tokens, &filterToken{typ: filterTokenString, value: value})
+			i = end
+		default:
+			end := i
+			for ; end < len(runes); end++ {
+				if unicode.IsSpace(runes[end]) || strings.ContainsRune("()[]\"", runes[end]) {
+					break
+				}
+			}
+			tokens = append(tokens, &filterToken{typ: filterTokenWord, value: string(runes[i:end])})
+			i = end - 1
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []*filterToken
+	pos    int
+}
+
+func (p *filterParser) peek() *filterToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() *filterToken {
+	token := p.peek()
+	if token != nil {
+		p.pos++
+	}
+	return token
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	token := p.peek()
+	return token != nil && token.typ == filterTokenWord && strings.EqualFold(token.value, keyword)
+}
+
+func (p *filterParser) expect(typ filterTokenType) error {
+	token := p.next()
+	if token == nil || token.typ != typ {
+		return invalidFilterError("SCIM-Pa8xs", "Errors.SCIM.Filter.UnexpectedToken")
+	}
+	return nil
+}
+
+func (p *filterParser) parseOr(parent string) (filterExpression, error) {
+	left, err := p.parseAnd(parent)
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword(filterOpOr) {
+		p.next()
+		right, err := p.parseAnd(parent)
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpression{Operator: filterOpOr, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd(parent string) (filterExpression, error) {
+	left, err := p.parseUnary(parent)
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword(filterOpAnd) {
+		p.next()
+		right, err := p.parseUnary(parent)
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpression{Operator: filterOpAnd, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary(parent string) (filterExpression, error) {
+	if p.peekKeyword(filterOpNot) {
+		p.next()
+		if err := p.expect(filterTokenOpenParenthesis); err != nil {
+			return nil, err
+		}
+		expression, err := p.parseOr(parent)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(filterTokenCloseParenthesis); err != nil {
+			return nil, err
+		}
+		return &notExpression{Expression: expression}, nil
+	}
+	token := p.peek()
+	if token == nil {
+		return nil, invalidFilterError("SCIM-Lq3sa", "Errors.SCIM.Filter.UnexpectedEnd")
+	}
+	if token.typ == filterTokenOpenParenthesis {
+		p.next()
+		expression, err := p.parseOr(parent)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(filterTokenCloseParenthesis); err != nil {
+			return nil, err
+		}
+		return expression, nil
+	}
+	return p.parseAttribute(parent)
+}
+
+func (p *filterParser) parseAttribute(parent string) (filterExpression, error) {
+	token := p.next()
+	if token == nil || token.typ != filterTokenWord {
+		return nil, invalidFilterError("SCIM-Aw2qe", "Errors.SCIM.Filter.AttributeExpected")
+	}
+	path := normalizeAttributePath(token.value)
+	if parent != "" {
+		path = parent + "." + path
+	}
+	if next := p.peek(); next != nil && next.typ == filterTokenOpenBracket {
+		p.next()
+		expression, err := p.parseOr(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(filterTokenCloseBracket); err != nil {
+			return nil, err
+		}
+		return expression, nil
+	}
+
+	operator := p.next()
+	if operator == nil || operator.typ != filterTokenWord {
+		return nil, invalidFilterError("SCIM-Op3ds", "Errors.SCIM.Filter.OperatorExpected")
+	}
+	op := strings.ToLower(operator.value)
+	switch op {
+	case filterOpPresent:
+		return &attributeExpression{Path: path, Operator: op}, nil
+	case filterOpEqual, filterOpNotEqual, filterOpContains, filterOpStartsWith, filterOpEndsWith,
+		filterOpGreater, filterOpGreaterEquals, filterOpLess, filterOpLessEquals:
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return &attributeExpression{Path: path, Operator: op, Value: value}, nil
+	default:
+		return nil, invalidFilterError("SCIM-Uo4sf", "Errors.SCIM.Filter.UnknownOperator")
+	}
+}
+
+func (p *filterParser) parseValue() (any, error) {
+	token := p.next()
+	if token == nil {
+		return nil, invalidFilterError("SCIM-Vq2mf", "Errors.SCIM.Filter.ValueExpected")
+	}
+	if token.typ == filterTokenString {
+		return token.value, nil
+	}
+	if token.typ != filterTokenWord {
+		return nil, invalidFilterError("SCIM-Vn3dk", "Errors.SCIM.Filter.ValueExpected")
+	}
+	switch strings.ToLower(token.value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	var number float64
+	if err := json.Unmarshal([]byte(token.value), &number); err != nil {
+		return nil, invalidFilterError("SCIM-Nm4sq", "Errors.SCIM.Filter.InvalidValue")
+	}
+	return number, nil
+}
+
+// normalizeAttributePath removes the core schema prefix and lowercases the path,
+// because attribute names are case-insensitive (RFC 7643 section 2.1)
+func normalizeAttributePath(path string) string {
+	for _, schema := range []string{schemaUser, schemaGroup} {
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			path = path[len(schema)+1:]
+			break
+		}
+	}
+	return strings.ToLower(path)
+}
+
+// userFilterToQuery maps a filter on user resources to the search queries of the user projection
+func userFilterToQuery(expression filterExpression) (query.SearchQuery, error) {
+	switch e := expression.(type) {
+	case *logicalExpression:
+		left, err := userFilterToQuery(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := userFilterToQuery(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		if e.Operator == filterOpOr {
+			return query.NewUserOrSearchQuery([]query.SearchQuery{left, right})
+		}
+		return query.NewUserAndSearchQuery([]query.SearchQuery{left, right})
+	case *notExpression:
+		q, err := userFilterToQuery(e.Expression)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewUserNotSearchQuery(q)
+	case *attributeExpression:
+		return userAttributeToQuery(e)
+	}
+	return nil, invalidFilterError("SCIM-Xk2ds", "Errors.SCIM.Filter.Unsupported")
+}
+
+func userAttributeToQuery(e *attributeExpression) (query.SearchQuery, error) {
+	if e.Path == "active" {
+		active, ok := e.Value.(bool)
+		if !ok || (e.Operator != filterOpEqual && e.Operator != filterOpNotEqual) {
+			return nil, invalidFilterError("SCIM-Ac3ds", "Errors.SCIM.Filter.Unsupported")
+		}
+		inactive, err := query.NewUserStateSearchQuery(int32(domain.UserStateInactive))
+		if err != nil {
+			return nil, err
+		}
+		if active == (e.Operator == filterOpEqual) {
+			return query.NewUserNotSearchQuery(inactive)
+		}
+		return inactive, nil
+	}
+	if e.Path == "id" {
+		id, ok := e.Value.(string)
+		if !ok || e.Operator != filterOpEqual {
+			return nil, invalidFilterError("SCIM-Id3fs", "Errors.SCIM.Filter.Unsupported")
+		}
+		return query.NewUserInUserIdsSearchQuery([]string{id})
+	}
+
+	var newQuery func(string, query.TextComparison) (query.SearchQuery, error)
+	switch e.Path {
+	case "username":
+		newQuery = query.NewUserUsernameSearchQuery
+	case "name.givenname":
+		newQuery = query.NewUserFirstNameSearchQuery
+	case "name.familyname":
+		newQuery = query.NewUserLastNameSearchQuery
+	case "displayname":
+		newQuery = query.NewUserDisplayNameSearchQuery
+	case "nickname":
+		newQuery = query.NewUserNickNameSearchQuery
+	case "emails", "emails.value":
+		newQuery = query.NewUserEmailSearchQuery
+	case "phonenumbers", "phonenumbers.value":
+		newQuery = query.NewUserPhoneSearchQuery
+	default:
+		return nil, invalidFilterError("SCIM-Ua2lo", "Errors.SCIM.Filter.UnsupportedAttribute")
+	}
+	return textFilterToQuery(e, newQuery)
+}
+
+func textFilterToQuery(e *attributeExpression, newQuery func(string, query.TextComparison) (query.SearchQuery, error)) (query.SearchQuery, error) {
+	if e.Operator == filterOpPresent {
+		q, err := newQuery("", query.TextEquals)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewUserNotSearchQuery(q)
+	}
+	value, ok := e.Value.(string)
+	if !ok {
+		return nil, invalidFilterError("SCIM-Tv3sa", "Errors.SCIM.Filter.InvalidValue")
+	}
+	switch e.Operator {
+	case filterOpEqual:
+		return newQuery(value, query.TextEqualsIgnoreCase)
+	case filterOpNotEqual:
+		q, err := newQuery(value, query.TextEqualsIgnoreCase)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewUserNotSearchQuery(q)
+	case filterOpContains:
+		return newQuery(value, query.TextContainsIgnoreCase)
+	case filterOpStartsWith:
+		return newQuery(value, query.TextStartsWithIgnoreCase)
+	case filterOpEndsWith:
+		return newQuery(value, query.TextEndsWithIgnoreCase)
+	}
+	return nil, invalidFilterError("SCIM-Tq2ds", "Errors.SCIM.Filter.UnsupportedOperator")
+}
+
+// groupFilterMatches evaluates a filter on a group in memory,
+// because groups are assembled from project roles and user grants
+func groupFilterMatches(expression filterExpression, group *Group) (bool, error) {
+	switch e := expression.(type) {
+	case *logicalExpression:
+		left, err := groupFilterMatches(e.Left, group)
+		if err != nil {
+			return false, err
+		}
+		right, err := groupFilterMatches(e.Right, group)
+		if err != nil {
+			return false, err
+		}
+		if e.Operator == filterOpOr {
+			return left || right, nil
+		}
+		return left && right, nil
+	case *notExpression:
+		matches, err := groupFilterMatches(e.Expression, group)
+		return !matches, err
+	case *attributeExpression:
+		switch e.Path {
+		case "id":
+			return compareText(e, group.ID)
+		case "displayname":
+			return compareText(e, group.DisplayName)
+		case "members", "members.value":
+			if e.Operator == filterOpNotEqual {
+				matches, err := groupFilterMatches(&attributeExpression{Path: e.Path, Operator: filterOpEqual, Value: e.Value}, group)
+				return !matches, err
+			}
+			for _, member := range group.Members {
+				if matches, err := compareText(e, member.Value); matches || err != nil {
+					return matches, err
+				}
+			}
+			return false, nil
+		}
+	}
+	return false, invalidFilterError("SCIM-Gf3as", "Errors.SCIM.Filter.UnsupportedAttribute")
+}
+
+func compareText(e *attributeExpression, actual string) (bool, error) {
+	if e.Operator == filterOpPresent {
+		return actual != "", nil
+	}
+	value, ok := e.Value.(string)
+	if !ok {
+		return false, invalidFilterError("SCIM-Cv2ls", "Errors.SCIM.Filter.InvalidValue")
+	}
+	actual, value = strings.ToLower(actual), strings.ToLower(value)
+	switch e.Operator {
+	case filterOpEqual:
+		return actual == value, nil
+	case filterOpNotEqual:
+		return actual != value, nil
+	case filterOpContains:
+		return strings.Contains(actual, value), nil
+	case filterOpStartsWith:
+		return strings.HasPrefix(actual, value), nil
+	case filterOpEndsWith:
+		return strings.HasSuffix(actual, value), nil
+	case filterOpGreater:
+		return actual > value, nil
+	case filterOpGreaterEquals:
+		return actual >= value, nil
+	case filterOpLess:
+		return actual < value, nil
+	case filterOpLessEquals:
+		return actual <= value, nil
+	}
+	return false, invalidFilterError("SCIM-Co3pe", "Errors.SCIM.Filter.UnsupportedOperator")
+}
@@ -0,0 +1,178 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		want    filterExpression
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			filter:  " ",
+			wantErr: true,
+		},
+		{
+			name:   "equal",
+			filter: `userName eq "bjensen"`,
+			want:   &attributeExpression{Path: "username", Operator: filterOpEqual, Value: "bjensen"},
+		},
+		{
+			name:   "schema prefix",
+			filter: `urn:ietf:params:scim:schemas:core:2.0:User:name.familyName co "O'Malley"`,
+			want:   &attributeExpression{Path: "name.familyname", Operator: filterOpContains, Value: "O'Malley"},
+		},
+		{
+			name:   "present",
+			filter: `title pr`,
+			want:   &attributeExpression{Path: "title", Operator: filterOpPresent},
+		},
+		{
+			name:   "boolean",
+			filter: `active eq True`,
+			want:   &attributeExpression{Path: "active", Operator: filterOpEqual, Value: true},
+		},
+		{
+			name:   "escaped string",
+			filter: `displayName eq "Babs \"B\" Jensen"`,
+			want:   &attributeExpression{Path: "displayname", Operator: filterOpEqual, Value: `Babs "B" Jensen`},
+		},
+		{
+			name:   "and binds stronger than or",
+			filter: `userName sw "J" or title pr and userType eq "Employee"`,
+			want: &logicalExpression{
+				Operator: filterOpOr,
+				Left:     &attributeExpression{Path: "username", Operator: filterOpStartsWith, Value: "J"},
+				Right: &logicalExpression{
+					Operator: filterOpAnd,
+					Left:     &attributeExpression{Path: "title", Operator: filterOpPresent},
+					Right:    &attributeExpression{Path: "usertype", Operator: filterOpEqual, Value: "Employee"},
+				},
+			},
+		},
+		{
+			name:   "grouping and not",
+			filter: `not (userName eq "a" or userName eq "b")`,
+			want: &notExpression{
+				Expression: &logicalExpression{
+					Operator: filterOpOr,
+					Left:     &attributeExpression{Path: "username", Operator: filterOpEqual, Value: "a"},
+					Right:    &attributeExpression{Path: "username", Operator: filterOpEqual, Value: "b"},
+				},
+			},
+		},
+		{
+			name:   "value path",
+			filter: `emails[type eq "work" and value co "@example.com"]`,
+			want: &logicalExpression{
+				Operator: filterOpAnd,
+				Left:     &attributeExpression{Path: "emails.type", Operator: filterOpEqual, Value: "work"},
+				Right:    &attributeExpression{Path: "emails.value", Operator: filterOpContains, Value: "@example.com"},
+			},
+		},
+		{
+			name:    "unknown operator",
+			filter:  `userName is "bjensen"`,
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			filter:  `userName eq`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated string",
+			filter:  `userName eq "bjensen`,
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced parenthesis",
+			filter:  `(userName eq "bjensen"`,
+			wantErr: true,
+		},
+		{
+			name:    "trailing token",
+			filter:  `userName eq "bjensen" "foo"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFilter(tt.filter)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_groupFilterMatches(t *testing.T) {
+	group := &Group{
+		ID:          "123:admin",
+		DisplayName: "Administrators",
+		Members: []*MemberRef{
+			{Value: "user1"},
+			{Value: "user2"},
+		},
+	}
+	tests := []struct {
+		name    string
+		filter  string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "display name equals ignoring case",
+			filter: `displayName eq "administrators"`,
+			want:   true,
+		},
+		{
+			name:   "display name starts with",
+			filter: `displayName sw "User"`,
+			want:   false,
+		},
+		{
+			name:   "member",
+			filter: `members[value eq "user2"]`,
+			want:   true,
+		},
+		{
+			name:   "member not equal",
+			filter: `members.value ne "user1"`,
+			want:   false,
+		},
+		{
+			name:   "not member",
+			filter: `not (members eq "user3") and id eq "123:admin"`,
+			want:   true,
+		},
+		{
+			name:    "unsupported attribute",
+			filter:  `externalId eq "foo"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := parseFilter(tt.filter)
+			require.NoError(t, err)
+			got, err := groupFilterMatches(filter, group)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
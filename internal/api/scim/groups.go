@@ -0,0 +1,502 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// groupIDSeparator separates the project id and the role key in the id of a group
+	groupIDSeparator = ":"
+
+	queryExcludedAttributes = "excludedAttributes"
+)
+
+func groupID(projectID, roleKey string) string {
+	return projectID + groupIDSeparator + roleKey
+}
+
+func parseGroupID(id string) (projectID, roleKey string, err error) {
+	projectID, roleKey, ok := strings.Cut(id, groupIDSeparator)
+	if !ok || projectID == "" || roleKey == "" {
+		return "", "", zerrors.ThrowNotFound(nil, "SCIM-Gi2sa", "Errors.SCIM.Group.NotFound")
+	}
+	return projectID, roleKey, nil
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	request, err := s.listRequestFromQuery(r, nil)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if request.sortBy != "" && request.sortBy != "displayname" {
+		writeError(w, invalidValueError(nil, "SCIM-Gs2sf"))
+		return
+	}
+	roles, err := s.searchRoles(ctx, orgID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// members are always loaded if filtered by, as the filter is evaluated on the resource
+	withMembers := request.filter != nil || !excludesMembers(r)
+	members := make(map[string][]*MemberRef)
+	if withMembers {
+		if members, err = s.projectRoleMembers(ctx, orgID); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	groups := make([]*Group, 0, len(roles))
+	for _, role := range roles {
+		group := s.roleToResource(ctx, role, members[groupID(role.ProjectID, role.Key)])
+		if request.filter != nil {
+			matches, err := groupFilterMatches(request.filter, group)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			if !matches {
+				continue
+			}
+		}
+		if excludesMembers(r) {
+			group.Members = nil
+		}
+		groups = append(groups, group)
+	}
+	slices.SortStableFunc(groups, func(a, b *Group) int {
+		if request.sortBy == "" {
+			return 0
+		}
+		if request.Asc {
+			return strings.Compare(strings.ToLower(a.DisplayName), strings.ToLower(b.DisplayName))
+		}
+		return strings.Compare(strings.ToLower(b.DisplayName), strings.ToLower(a.DisplayName))
+	})
+	resources := make([]any, len(groups))
+	for i, group := range groups {
+		resources[i] = group
+	}
+	writeJSON(w, http.StatusOK, newListResponse(uint64(len(resources)), request.startIndex, request.paginate(resources)))
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	s.writeGroup(r.Context(), w, http.StatusOK, orgIDFromRequest(r), resourceIDFromRequest(r), false)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	resource := new(Group)
+	if err := readJSON(r, resource); err != nil {
+		writeError(w, err)
+		return
+	}
+	if resource.ZitadelGroup == nil || resource.ZitadelGroup.ProjectID == "" || resource.ZitadelGroup.RoleKey == "" {
+		writeError(w, withScimType(scimTypeInvalidValue, zerrors.ThrowInvalidArgument(nil, "SCIM-Gc2sa", "Errors.SCIM.Group.ProjectRoleMissing")))
+		return
+	}
+	_, err := s.commands.AddProjectRole(ctx, &domain.ProjectRole{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: resource.ZitadelGroup.ProjectID,
+		},
+		Key:         resource.ZitadelGroup.RoleKey,
+		DisplayName: resource.DisplayName,
+		Group:       resource.ZitadelGroup.Group,
+	}, orgID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = s.addMembers(ctx, orgID, resource.ZitadelGroup.ProjectID, resource.ZitadelGroup.RoleKey, memberIDs(resource.Members)); err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeGroup(ctx, w, http.StatusCreated, orgID, groupID(resource.ZitadelGroup.ProjectID, resource.ZitadelGroup.RoleKey), true)
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	role, err := s.getOrgRole(ctx, orgID, resourceIDFromRequest(r), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = checkVersion(r, role.Sequence); err != nil {
+		writeError(w, err)
+		return
+	}
+	resource := new(Group)
+	if err = readJSON(r, resource); err != nil {
+		writeError(w, err)
+		return
+	}
+	group := role.Group
+	if resource.ZitadelGroup != nil {
+		group = resource.ZitadelGroup.Group
+	}
+	if err = s.changeRole(ctx, role, resource.DisplayName, group); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = s.replaceMembers(ctx, orgID, role.ProjectID, role.Key, memberIDs(resource.Members)); err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeGroup(ctx, w, http.StatusOK, orgID, groupID(role.ProjectID, role.Key), true)
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	role, err := s.getOrgRole(ctx, orgID, resourceIDFromRequest(r), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = checkVersion(r, role.Sequence); err != nil {
+		writeError(w, err)
+		return
+	}
+	request := new(PatchRequest)
+	if err = readJSON(r, request); err != nil {
+		writeError(w, err)
+		return
+	}
+	patch, err := parseGroupPatch(request)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if patch.DisplayName != nil {
+		if err = s.changeRole(ctx, role, *patch.DisplayName, role.Group); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	if patch.RemoveAll || patch.ReplaceMembers != nil {
+		err = s.replaceMembers(ctx, orgID, role.ProjectID, role.Key, patch.ReplaceMembers)
+	}
+	if err == nil {
+		err = s.removeMembers(ctx, orgID, role.ProjectID, role.Key, patch.RemoveMembers)
+	}
+	if err == nil {
+		err = s.addMembers(ctx, orgID, role.ProjectID, role.Key, patch.AddMembers)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeGroup(ctx, w, http.StatusOK, orgID, groupID(role.ProjectID, role.Key), true)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	role, err := s.getOrgRole(ctx, orgID, resourceIDFromRequest(r), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	grants, err := s.roleGrants(ctx, role.ProjectID, role.Key)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	grantIDs := make([]string, len(grants))
+	for i, grant := range grants {
+		grantIDs[i] = grant.ID
+	}
+	projectGrants, err := s.queries.SearchProjectGrantsByProjectIDAndRoleKey(ctx, role.ProjectID, role.Key)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	projectGrantIDs := make([]string, len(projectGrants.ProjectGrants))
+	for i, grant := range projectGrants.ProjectGrants {
+		projectGrantIDs[i] = grant.GrantID
+	}
+	if _, err = s.commands.RemoveProjectRole(ctx, role.ProjectID, role.Key, orgID, projectGrantIDs, grantIDs...); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) writeGroup(ctx context.Context, w http.ResponseWriter, status int, orgID, id string, triggerBulk bool) {
+	role, err := s.getOrgRole(ctx, orgID, id, triggerBulk)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	grants, err := s.roleGrants(ctx, role.ProjectID, role.Key)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	members := make([]*MemberRef, len(grants))
+	for i, grant := range grants {
+		members[i] = s.grantToMember(ctx, grant)
+	}
+	resource := s.roleToResource(ctx, role, members)
+	writeResource(w, status, resource, resource.Meta)
+}
+
+func (s *Server) searchRoles(ctx context.Context, orgID string, queries ...query.SearchQuery) ([]*query.ProjectRole, error) {
+	resourceOwnerQuery, err := query.NewProjectRoleResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.queries.SearchProjectRoles(ctx, false, &query.ProjectRoleSearchQueries{
+		Queries: append(queries, resourceOwnerQuery),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roles.ProjectRoles, nil
+}
+
+// getOrgRole returns the project role represented by the group id, if the project belongs to the organization of the request
+func (s *Server) getOrgRole(ctx context.Context, orgID, id string, triggerBulk bool) (*query.ProjectRole, error) {
+	projectID, roleKey, err := parseGroupID(id)
+	if err != nil {
+		return nil, err
+	}
+	projectQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	keyQuery, err := query.NewProjectRoleKeySearchQuery(query.TextEquals, roleKey)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewProjectRoleResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.queries.SearchProjectRoles(ctx, triggerBulk, &query.ProjectRoleSearchQueries{
+		Queries: []query.SearchQuery{projectQuery, keyQuery, resourceOwnerQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(roles.ProjectRoles) != 1 {
+		return nil, zerrors.ThrowNotFound(nil, "SCIM-Gr2sf", "Errors.SCIM.Group.NotFound")
+	}
+	return roles.ProjectRoles[0], nil
+}
+
+func (s *Server) changeRole(ctx context.Context, role *query.ProjectRole, displayName, group string) error {
+	if displayName == "" {
+		return invalidValueError(nil, "SCIM-Gd2sa")
+	}
+	if displayName == role.DisplayName && group == role.Group {
+		return nil
+	}
+	_, err := s.commands.ChangeProjectRole(ctx, &domain.ProjectRole{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: role.ProjectID,
+		},
+		Key:         role.Key,
+		DisplayName: displayName,
+		Group:       group,
+	}, role.ResourceOwner)
+	return err
+}
+
+// projectRoleMembers returns the members of all roles of the projects of the organization mapped by the group id
+func (s *Server) projectRoleMembers(ctx context.Context, orgID string) (map[string][]*MemberRef, error) {
+	projectOwnerQuery, err := query.NewUserGrantProjectOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectOwnerQuery},
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[string][]*MemberRef)
+	for _, grant := range grants.UserGrants {
+		for _, role := range grant.Roles {
+			id := groupID(grant.ProjectID, role)
+			members[id] = append(members[id], s.grantToMember(ctx, grant))
+		}
+	}
+	return members, nil
+}
+
+func (s *Server) roleGrants(ctx context.Context, projectID, roleKey string) ([]*query.UserGrant, error) {
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	roleQuery, err := query.NewUserGrantRoleQuery(roleKey)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectQuery, roleQuery},
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	return grants.UserGrants, nil
+}
+
+// userProjectGrant returns the grant of the user on the project of the organization or nil if there is none
+func (s *Server) userProjectGrant(ctx context.Context, orgID, projectID, userID string) (*query.UserGrant, error) {
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	grant, err := s.queries.UserGrant(ctx, true, projectQuery, userQuery, resourceOwnerQuery)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return grant, err
+}
+
+func (s *Server) addMembers(ctx context.Context, orgID, projectID, roleKey string, userIDs []string) error {
+	for _, userID := range userIDs {
+		if err := s.addMember(ctx, orgID, projectID, roleKey, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) addMember(ctx context.Context, orgID, projectID, roleKey, userID string) error {
+	grant, err := s.userProjectGrant(ctx, orgID, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if grant == nil {
+		_, err = s.commands.AddUserGrant(ctx, &domain.UserGrant{
+			UserID:    userID,
+			ProjectID: projectID,
+			RoleKeys:  []string{roleKey},
+		}, orgID)
+		return err
+	}
+	if slices.Contains(grant.Roles, roleKey) {
+		return nil
+	}
+	_, err = s.commands.ChangeUserGrant(ctx, &domain.UserGrant{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: grant.ID,
+		},
+		UserID:   userID,
+		RoleKeys: append(slices.Clone(grant.Roles), roleKey),
+	}, orgID)
+	return err
+}
+
+func (s *Server) removeMembers(ctx context.Context, orgID, projectID, roleKey string, userIDs []string) error {
+	for _, userID := range userIDs {
+		grant, err := s.userProjectGrant(ctx, orgID, projectID, userID)
+		if err != nil {
+			return err
+		}
+		if err = s.removeMember(ctx, orgID, roleKey, grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) removeMember(ctx context.Context, orgID, roleKey string, grant *query.UserGrant) (err error) {
+	if grant == nil || !slices.Contains(grant.Roles, roleKey) {
+		return nil
+	}
+	roles := slices.DeleteFunc(slices.Clone(grant.Roles), func(role string) bool { return role == roleKey })
+	// the grant is removed completely if the user has no other role on the project
+	if len(roles) == 0 {
+		_, err = s.commands.RemoveUserGrant(ctx, grant.ID, orgID)
+		return err
+	}
+	_, err = s.commands.ChangeUserGrant(ctx, &domain.UserGrant{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: grant.ID,
+		},
+		UserID:   grant.UserID,
+		RoleKeys: roles,
+	}, orgID)
+	return err
+}
+
+// replaceMembers sets the members of the group to the users passed
+func (s *Server) replaceMembers(ctx context.Context, orgID, projectID, roleKey string, userIDs []string) error {
+	grants, err := s.roleGrants(ctx, projectID, roleKey)
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants {
+		if slices.Contains(userIDs, grant.UserID) {
+			continue
+		}
+		if err = s.removeMember(ctx, orgID, roleKey, grant); err != nil {
+			return err
+		}
+	}
+	return s.addMembers(ctx, orgID, projectID, roleKey, userIDs)
+}
+
+func (s *Server) roleToResource(ctx context.Context, role *query.ProjectRole, members []*MemberRef) *Group {
+	id := groupID(role.ProjectID, role.Key)
+	return &Group{
+		Schemas:     []string{schemaGroup, schemaZitadelGroup},
+		ID:          id,
+		DisplayName: role.DisplayName,
+		Members:     members,
+		ZitadelGroup: &ZitadelGroup{
+			ProjectID: role.ProjectID,
+			RoleKey:   role.Key,
+			Group:     role.Group,
+		},
+		Meta: &Meta{
+			ResourceType: resourceTypeGroup,
+			Created:      &role.CreationDate,
+			LastModified: &role.ChangeDate,
+			Location:     s.baseURL(ctx, role.ResourceOwner) + "/Groups/" + id,
+			Version:      version(role.Sequence),
+		},
+	}
+}
+
+func (s *Server) grantToMember(ctx context.Context, grant *query.UserGrant) *MemberRef {
+	return &MemberRef{
+		Value:   grant.UserID,
+		Ref:     s.baseURL(ctx, grant.UserResourceOwner) + "/Users/" + grant.UserID,
+		Display: grant.DisplayName,
+	}
+}
+
+func excludesMembers(r *http.Request) bool {
+	for _, attribute := range strings.Split(r.URL.Query().Get(queryExcludedAttributes), ",") {
+		if normalizeAttributePath(strings.TrimSpace(attribute)) == "members" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package scim
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	queryFilter     = "filter"
+	queryStartIndex = "startIndex"
+	queryCount      = "count"
+	querySortBy     = "sortBy"
+	querySortOrder  = "sortOrder"
+
+	sortOrderDescending = "descending"
+)
+
+type listRequest struct {
+	query.SearchRequest
+	filter     filterExpression
+	startIndex uint64
+	sortBy     string
+}
+
+// listRequestFromQuery parses the query parameters of a list request defined in RFC 7644 section 3.4.2.
+// The sortBy parameter is mapped to the columns passed, unknown attributes are rejected.
+func (s *Server) listRequestFromQuery(r *http.Request, sortColumns map[string]query.Column) (_ *listRequest, err error) {
+	values := r.URL.Query()
+	request := &listRequest{
+		startIndex: 1,
+		SearchRequest: query.SearchRequest{
+			Limit: s.config.MaxResults,
+			Asc:   true,
+		},
+	}
+	if filter := values.Get(queryFilter); filter != "" {
+		if request.filter, err = parseFilter(filter); err != nil {
+			return nil, err
+		}
+	}
+	if startIndex := values.Get(queryStartIndex); startIndex != "" {
+		index, err := strconv.ParseInt(startIndex, 10, 64)
+		if err != nil {
+			return nil, invalidValueError(err, "SCIM-Si2fa")
+		}
+		// a value less than 1 is interpreted as 1
+		if index > 1 {
+			request.startIndex = uint64(index)
+		}
+	}
+	request.Offset = request.startIndex - 1
+	if count := values.Get(queryCount); count != "" {
+		limit, err := strconv.ParseInt(count, 10, 64)
+		if err != nil {
+			return nil, invalidValueError(err, "SCIM-Cn3sa")
+		}
+		// a negative value is interpreted as 0
+		if limit < 0 {
+			limit = 0
+		}
+		if s.config.MaxResults == 0 || uint64(limit) < s.config.MaxResults {
+			request.Limit = uint64(limit)
+		}
+	}
+	if sortBy := values.Get(querySortBy); sortBy != "" {
+		request.sortBy = normalizeAttributePath(sortBy)
+		if sortColumns != nil {
+			column, ok := sortColumns[request.sortBy]
+			if !ok {
+				return nil, invalidValueError(nil, "SCIM-Sb2sf")
+			}
+			request.SortingColumn = column
+		}
+	}
+	request.Asc = !strings.EqualFold(values.Get(querySortOrder), sortOrderDescending)
+	return request, nil
+}
+
+// paginate applies the offset and limit of the request on an already filtered list of resources
+func (r *listRequest) paginate(resources []any) []any {
+	if r.Offset >= uint64(len(resources)) {
+		return []any{}
+	}
+	resources = resources[r.Offset:]
+	if r.Limit > 0 && r.Limit < uint64(len(resources)) {
+		resources = resources[:r.Limit]
+	}
+	return resources
+}
+
+// writeResource writes the resource and sets the location and version headers defined in RFC 7644 section 3.1 and 3.14
+func writeResource(w http.ResponseWriter, status int, resource any, meta *Meta) {
+	if meta != nil {
+		if meta.Location != "" {
+			w.Header().Set("Location", meta.Location)
+		}
+		if meta.Version != "" {
+			w.Header().Set("ETag", meta.Version)
+		}
+	}
+	writeJSON(w, status, resource)
+}
+
+// checkVersion compares the If-Match header (if provided) with the current version of the resource
+func checkVersion(r *http.Request, sequence uint64) error {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" {
+		return nil
+	}
+	current := version(sequence)
+	for _, tag := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(tag) == current {
+			return nil
+		}
+	}
+	return zerrors.ThrowPreconditionFailed(nil, "SCIM-Vs2ka", "Errors.SCIM.VersionMismatch")
+}
@@ -0,0 +1,444 @@
+package scim
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// patch operations defined in RFC 7644 section 3.5.2
+const (
+	patchOpAdd     = "add"
+	patchOpReplace = "replace"
+	patchOpRemove  = "remove"
+)
+
+// patchPath is a parsed patch path, e.g. `emails[type eq "work"].value`
+// results in Attribute `emails`, Filter `type eq "work"` and SubAttribute `value`
+type patchPath struct {
+	Attribute    string
+	Filter       filterExpression
+	SubAttribute string
+}
+
+func parsePatchPath(path string) (*patchPath, error) {
+	if path == "" {
+		return &patchPath{}, nil
+	}
+	p := new(patchPath)
+	if extension := strings.ToLower(schemaZitadelUser); strings.HasPrefix(strings.ToLower(path), extension) {
+		p.Attribute = extension
+		p.SubAttribute = strings.ToLower(strings.TrimPrefix(path[len(extension):], ":"))
+		return p, nil
+	}
+	attribute, rest, hasFilter := strings.Cut(path, "[")
+	if hasFilter {
+		filter, subAttribute, ok := strings.Cut(rest, "]")
+		if !ok {
+			return nil, invalidPathError("SCIM-Pf2sa", path)
+		}
+		var err error
+		if p.Filter, err = parseFilter(filter); err != nil {
+			return nil, invalidPathError("SCIM-Pf3sd", path)
+		}
+		p.SubAttribute = strings.ToLower(strings.TrimPrefix(subAttribute, "."))
+	}
+	p.Attribute = normalizeAttributePath(attribute)
+	if !hasFilter {
+		p.Attribute, p.SubAttribute, _ = strings.Cut(p.Attribute, ".")
+	}
+	return p, nil
+}
+
+func validatePatchRequest(request *PatchRequest) error {
+	if !slices.Contains(request.Schemas, schemaPatchOp) {
+		return invalidValueError(nil, "SCIM-Ps2ds")
+	}
+	if len(request.Operations) == 0 {
+		return invalidValueError(nil, "SCIM-Ps3fa")
+	}
+	for _, operation := range request.Operations {
+		operation.Op = strings.ToLower(operation.Op)
+		switch operation.Op {
+		case patchOpAdd, patchOpReplace:
+			if len(operation.Value) == 0 {
+				return invalidValueError(nil, "SCIM-Ps4sl")
+			}
+		case patchOpRemove:
+			if operation.Path == "" {
+				return withScimType(scimTypeNoTarget, zerrors.ThrowInvalidArgument(nil, "SCIM-Ps5ek", "Errors.SCIM.Patch.NoTarget"))
+			}
+		default:
+			return invalidValueError(nil, "SCIM-Ps6wq")
+		}
+	}
+	return nil
+}
+
+// applyUserPatch applies the operations of the request to the user resource.
+// ZITADEL users only have a single email and phone number,
+// therefore any filter on those multi-valued attributes addresses the primary value.
+func applyUserPatch(user *User, request *PatchRequest) error {
+	if err := validatePatchRequest(request); err != nil {
+		return err
+	}
+	for _, operation := range request.Operations {
+		path, err := parsePatchPath(operation.Path)
+		if err != nil {
+			return err
+		}
+		if path.Attribute == "" {
+			if err = applyUserPatchWithoutPath(user, operation); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = applyUserPatchAttribute(user, operation.Op, path, operation.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyUserPatchWithoutPath handles operations where the value contains the attributes to be changed,
+// e.g. `{"op":"replace","value":{"active":false,"name.givenName":"Barbara"}}`
+func applyUserPatchWithoutPath(user *User, operation *PatchOperation) error {
+	values := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(operation.Value, &values); err != nil {
+		return invalidValueError(err, "SCIM-Pw2as")
+	}
+	for attribute, value := range values {
+		path, err := parsePatchPath(attribute)
+		if err != nil {
+			return err
+		}
+		if err = applyUserPatchAttribute(user, operation.Op, path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyUserPatchAttribute(user *User, op string, path *patchPath, value json.RawMessage) error {
+	if op == patchOpRemove {
+		return removeUserAttribute(user, path)
+	}
+	switch path.Attribute {
+	case "username":
+		return unmarshalPatchValue(value, &user.UserName)
+	case "externalid":
+		return unmarshalPatchValue(value, &user.ExternalID)
+	case "displayname":
+		return unmarshalPatchValue(value, &user.DisplayName)
+	case "nickname":
+		return unmarshalPatchValue(value, &user.NickName)
+	case "preferredlanguage":
+		return unmarshalPatchValue(value, &user.PreferredLanguage)
+	case "password":
+		return unmarshalPatchValue(value, &user.Password)
+	case "active":
+		active, err := unmarshalBool(value)
+		if err != nil {
+			return err
+		}
+		user.Active = &active
+		return nil
+	case "name":
+		if user.Name == nil {
+			user.Name = new(Name)
+		}
+		switch path.SubAttribute {
+		case "givenname":
+			return unmarshalPatchValue(value, &user.Name.GivenName)
+		case "familyname":
+			return unmarshalPatchValue(value, &user.Name.FamilyName)
+		case "formatted":
+			return unmarshalPatchValue(value, &user.Name.Formatted)
+		case "":
+			name := new(Name)
+			if err := unmarshalPatchValue(value, name); err != nil {
+				return err
+			}
+			user.Name = mergeName(user.Name, name)
+			return nil
+		}
+	case "emails":
+		return patchMultiValued(&user.Emails, path, value)
+	case "phonenumbers":
+		return patchMultiValued(&user.PhoneNumbers, path, value)
+	case strings.ToLower(schemaZitadelUser):
+		return applyUserPatchExtension(user, path.SubAttribute, value)
+	}
+	return invalidPathError("SCIM-Pa3sf", path.Attribute)
+}
+
+func applyUserPatchExtension(user *User, subAttribute string, value json.RawMessage) error {
+	if user.ZitadelUser == nil {
+		user.ZitadelUser = new(ZitadelUser)
+	}
+	switch subAttribute {
+	case "description":
+		return unmarshalPatchValue(value, &user.ZitadelUser.Description)
+	case "emailverified":
+		verified, err := unmarshalBool(value)
+		user.ZitadelUser.EmailVerified = &verified
+		return err
+	case "phoneverified":
+		verified, err := unmarshalBool(value)
+		user.ZitadelUser.PhoneVerified = &verified
+		return err
+	case "":
+	default:
+		return invalidPathError("SCIM-Pe2sa", subAttribute)
+	}
+	extension := new(ZitadelUser)
+	if err := unmarshalPatchValue(value, extension); err != nil {
+		return err
+	}
+	if extension.Description != "" {
+		user.ZitadelUser.Description = extension.Description
+	}
+	if extension.EmailVerified != nil {
+		user.ZitadelUser.EmailVerified = extension.EmailVerified
+	}
+	if extension.PhoneVerified != nil {
+		user.ZitadelUser.PhoneVerified = extension.PhoneVerified
+	}
+	return nil
+}
+
+func removeUserAttribute(user *User, path *patchPath) error {
+	switch path.Attribute {
+	case "externalid":
+		user.ExternalID = ""
+	case "displayname":
+		user.DisplayName = ""
+	case "nickname":
+		user.NickName = ""
+	case "preferredlanguage":
+		user.PreferredLanguage = ""
+	case "phonenumbers":
+		user.PhoneNumbers = nil
+	case "name":
+		if user.Name == nil {
+			return nil
+		}
+		switch path.SubAttribute {
+		case "formatted":
+			user.Name.Formatted = ""
+		default:
+			// given and family name are required by ZITADEL
+			return withScimType(scimTypeMutability, zerrors.ThrowInvalidArgument(nil, "SCIM-Rm2as", "Errors.SCIM.Patch.AttributeRequired"))
+		}
+	case "username", "emails", "active", "password":
+		return withScimType(scimTypeMutability, zerrors.ThrowInvalidArgument(nil, "SCIM-Rm3sd", "Errors.SCIM.Patch.AttributeRequired"))
+	default:
+		return invalidPathError("SCIM-Rm4fs", path.Attribute)
+	}
+	return nil
+}
+
+func patchMultiValued(values *[]*MultiValued, path *patchPath, value json.RawMessage) error {
+	switch path.SubAttribute {
+	case "value":
+		var v string
+		if err := unmarshalPatchValue(value, &v); err != nil {
+			return err
+		}
+		if len(*values) == 0 {
+			*values = []*MultiValued{{Primary: true}}
+		}
+		(*values)[0].Value = v
+		return nil
+	case "":
+	default:
+		return invalidPathError("SCIM-Mv2sa", path.Attribute+"."+path.SubAttribute)
+	}
+	patched := make([]*MultiValued, 0, 1)
+	if err := unmarshalPatchValue(value, &patched); err != nil {
+		// some clients send a single object instead of a list
+		single := new(MultiValued)
+		if err := unmarshalPatchValue(value, single); err != nil {
+			return err
+		}
+		patched = append(patched, single)
+	}
+	*values = patched
+	return nil
+}
+
+func mergeName(current, patched *Name) *Name {
+	if current == nil {
+		return patched
+	}
+	if patched.GivenName != "" {
+		current.GivenName = patched.GivenName
+	}
+	if patched.FamilyName != "" {
+		current.FamilyName = patched.FamilyName
+	}
+	if patched.Formatted != "" {
+		current.Formatted = patched.Formatted
+	}
+	return current
+}
+
+func unmarshalPatchValue(value json.RawMessage, v any) error {
+	if err := json.Unmarshal(value, v); err != nil {
+		return invalidValueError(err, "SCIM-Uv2ds")
+	}
+	return nil
+}
+
+// unmarshalBool also accepts boolean strings, because some clients (e.g. Azure AD) send "True" and "False"
+func unmarshalBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, invalidValueError(err, "SCIM-Ub2sa")
+	}
+	switch strings.ToLower(s) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, invalidValueError(nil, "SCIM-Ub3sd")
+}
+
+// groupPatch is the result of the patch operations on a group
+// as the members are represented by user grants and are therefore changed one by one
+type groupPatch struct {
+	DisplayName    *string
+	AddMembers     []string
+	RemoveMembers  []string
+	ReplaceMembers []string
+	RemoveAll      bool
+}
+
+func parseGroupPatch(request *PatchRequest) (*groupPatch, error) {
+	if err := validatePatchRequest(request); err != nil {
+		return nil, err
+	}
+	patch := new(groupPatch)
+	for _, operation := range request.Operations {
+		path, err := parsePatchPath(operation.Path)
+		if err != nil {
+			return nil, err
+		}
+		switch path.Attribute {
+		case "":
+			if err = parseGroupPatchWithoutPath(patch, operation); err != nil {
+				return nil, err
+			}
+		case "displayname":
+			if operation.Op == patchOpRemove {
+				return nil, withScimType(scimTypeMutability, zerrors.ThrowInvalidArgument(nil, "SCIM-Gp2sa", "Errors.SCIM.Patch.AttributeRequired"))
+			}
+			displayName := ""
+			if err = unmarshalPatchValue(operation.Value, &displayName); err != nil {
+				return nil, err
+			}
+			patch.DisplayName = &displayName
+		case "members":
+			if err = parseGroupPatchMembers(patch, operation, path); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, invalidPathError("SCIM-Gp3sd", operation.Path)
+		}
+	}
+	return patch, nil
+}
+
+func parseGroupPatchWithoutPath(patch *groupPatch, operation *PatchOperation) error {
+	group := new(Group)
+	if err := unmarshalPatchValue(operation.Value, group); err != nil {
+		return err
+	}
+	if group.DisplayName != "" {
+		patch.DisplayName = &group.DisplayName
+	}
+	if group.Members == nil {
+		return nil
+	}
+	members := memberIDs(group.Members)
+	if operation.Op == patchOpReplace {
+		patch.ReplaceMembers = members
+		patch.RemoveAll = len(members) == 0
+		return nil
+	}
+	patch.AddMembers = append(patch.AddMembers, members...)
+	return nil
+}
+
+func parseGroupPatchMembers(patch *groupPatch, operation *PatchOperation, path *patchPath) error {
+	if operation.Op == patchOpRemove && path.Filter != nil {
+		members, err := memberIDsFromFilter(path.Filter)
+		if err != nil {
+			return err
+		}
+		patch.RemoveMembers = append(patch.RemoveMembers, members...)
+		return nil
+	}
+	members := make([]*MemberRef, 0)
+	if len(operation.Value) > 0 {
+		if err := unmarshalPatchValue(operation.Value, &members); err != nil {
+			return err
+		}
+	}
+	switch operation.Op {
+	case patchOpAdd:
+		patch.AddMembers = append(patch.AddMembers, memberIDs(members)...)
+	case patchOpReplace:
+		patch.ReplaceMembers = memberIDs(members)
+		patch.RemoveAll = len(members) == 0
+	case patchOpRemove:
+		// without a value all members are removed (RFC 7644 section 3.5.2.2)
+		if len(members) == 0 {
+			patch.RemoveAll = true
+			return nil
+		}
+		patch.RemoveMembers = append(patch.RemoveMembers, memberIDs(members)...)
+	}
+	return nil
+}
+
+// memberIDsFromFilter returns the ids of a filter like `value eq "1" or value eq "2"`
+func memberIDsFromFilter(filter filterExpression) ([]string, error) {
+	switch e := filter.(type) {
+	case *attributeExpression:
+		id, ok := e.Value.(string)
+		if e.Operator != filterOpEqual || (e.Path != "members.value" && e.Path != "value") || !ok {
+			return nil, invalidFilterError("SCIM-Mf2sa", "Errors.SCIM.Filter.Unsupported")
+		}
+		return []string{id}, nil
+	case *logicalExpression:
+		if e.Operator != filterOpOr {
+			return nil, invalidFilterError("SCIM-Mf3sd", "Errors.SCIM.Filter.Unsupported")
+		}
+		left, err := memberIDsFromFilter(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := memberIDsFromFilter(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return append(left, right...), nil
+	}
+	return nil, invalidFilterError("SCIM-Mf4fq", "Errors.SCIM.Filter.Unsupported")
+}
+
+func memberIDs(members []*MemberRef) []string {
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = member.Value
+	}
+	return ids
+}
@@ -0,0 +1,208 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parsePatchPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    *patchPath
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			path: "",
+			want: &patchPath{},
+		},
+		{
+			name: "attribute",
+			path: "userName",
+			want: &patchPath{Attribute: "username"},
+		},
+		{
+			name: "sub attribute",
+			path: "name.givenName",
+			want: &patchPath{Attribute: "name", SubAttribute: "givenname"},
+		},
+		{
+			name: "schema prefix",
+			path: "urn:ietf:params:scim:schemas:core:2.0:User:name.familyName",
+			want: &patchPath{Attribute: "name", SubAttribute: "familyname"},
+		},
+		{
+			name: "extension",
+			path: "urn:ietf:params:scim:schemas:extension:zitadel:2.0:User:emailVerified",
+			want: &patchPath{Attribute: "urn:ietf:params:scim:schemas:extension:zitadel:2.0:user", SubAttribute: "emailverified"},
+		},
+		{
+			name: "filter",
+			path: `emails[type eq "work"].value`,
+			want: &patchPath{
+				Attribute:    "emails",
+				Filter:       &attributeExpression{Path: "type", Operator: filterOpEqual, Value: "work"},
+				SubAttribute: "value",
+			},
+		},
+		{
+			name:    "unterminated filter",
+			path:    `emails[type eq "work"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePatchPath(tt.path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_applyUserPatch(t *testing.T) {
+	active, inactive, verified := true, false, true
+	tests := []struct {
+		name    string
+		user    *User
+		request string
+		want    *User
+		wantErr bool
+	}{
+		{
+			name:    "missing schema",
+			user:    &User{},
+			request: `{"Operations":[{"op":"replace","path":"userName","value":"bjensen"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown operation",
+			user:    &User{},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"move","path":"userName","value":"bjensen"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "replace attributes",
+			user:    &User{UserName: "bjensen", Active: &active, Name: &Name{GivenName: "Barbara", FamilyName: "Jensen"}},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","path":"userName","value":"babs"},{"op":"replace","path":"name.givenName","value":"Babs"},{"op":"replace","path":"active","value":"False"}]}`,
+			want:    &User{UserName: "babs", Active: &inactive, Name: &Name{GivenName: "Babs", FamilyName: "Jensen"}},
+		},
+		{
+			name:    "replace without path",
+			user:    &User{UserName: "bjensen", Active: &active, Name: &Name{GivenName: "Barbara", FamilyName: "Jensen"}},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","value":{"active":false,"name":{"familyName":"Doe"},"urn:ietf:params:scim:schemas:extension:zitadel:2.0:User":{"emailVerified":true}}}]}`,
+			want:    &User{UserName: "bjensen", Active: &inactive, Name: &Name{GivenName: "Barbara", FamilyName: "Doe"}, ZitadelUser: &ZitadelUser{EmailVerified: &verified}},
+		},
+		{
+			name:    "replace email by filter",
+			user:    &User{UserName: "bjensen", Emails: []*MultiValued{{Value: "bjensen@example.com", Primary: true}}},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"emails[type eq \"work\"].value","value":"babs@example.com"}]}`,
+			want:    &User{UserName: "bjensen", Emails: []*MultiValued{{Value: "babs@example.com", Primary: true}}},
+		},
+		{
+			name:    "add phone",
+			user:    &User{UserName: "bjensen"},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"phoneNumbers","value":[{"value":"+41791234567","type":"mobile"}]}]}`,
+			want:    &User{UserName: "bjensen", PhoneNumbers: []*MultiValued{{Value: "+41791234567", Type: "mobile"}}},
+		},
+		{
+			name:    "remove optional attributes",
+			user:    &User{UserName: "bjensen", ExternalID: "ext", NickName: "babs", PhoneNumbers: []*MultiValued{{Value: "+41791234567"}}},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove","path":"externalId"},{"op":"remove","path":"nickName"},{"op":"remove","path":"phoneNumbers"}]}`,
+			want:    &User{UserName: "bjensen"},
+		},
+		{
+			name:    "remove required attribute",
+			user:    &User{UserName: "bjensen"},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove","path":"userName"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "remove without path",
+			user:    &User{UserName: "bjensen"},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown attribute",
+			user:    &User{UserName: "bjensen"},
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"title","value":"Tour Guide"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := new(PatchRequest)
+			require.NoError(t, json.Unmarshal([]byte(tt.request), request))
+			err := applyUserPatch(tt.user, request)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tt.user)
+		})
+	}
+}
+
+func Test_parseGroupPatch(t *testing.T) {
+	displayName := "Admins"
+	tests := []struct {
+		name    string
+		request string
+		want    *groupPatch
+		wantErr bool
+	}{
+		{
+			name:    "add members",
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"members","value":[{"value":"1"},{"value":"2"}]}]}`,
+			want:    &groupPatch{AddMembers: []string{"1", "2"}},
+		},
+		{
+			name:    "remove members by filter",
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove","path":"members[value eq \"1\" or value eq \"2\"]"}]}`,
+			want:    &groupPatch{RemoveMembers: []string{"1", "2"}},
+		},
+		{
+			name:    "remove all members",
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove","path":"members"}]}`,
+			want:    &groupPatch{RemoveAll: true},
+		},
+		{
+			name:    "replace without path",
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","value":{"displayName":"Admins","members":[{"value":"3"}]}}]}`,
+			want:    &groupPatch{DisplayName: &displayName, ReplaceMembers: []string{"3"}},
+		},
+		{
+			name:    "unsupported member filter",
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove","path":"members[display sw \"A\"]"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "remove display name",
+			request: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove","path":"displayName"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := new(PatchRequest)
+			require.NoError(t, json.Unmarshal([]byte(tt.request), request))
+			got, err := parseGroupPatch(request)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package scim
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaZitadelUser           = "urn:ietf:params:scim:schemas:extension:zitadel:2.0:User"
+	schemaZitadelGroup          = "urn:ietf:params:scim:schemas:extension:zitadel:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaBulkRequest           = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	schemaBulkResponse          = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	schemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+
+	contentTypeSCIM = "application/scim+json"
+
+	// externalIDMetadataKey is the user metadata key the SCIM externalId is stored in
+	externalIDMetadataKey = "urn:zitadel:scim:externalId"
+)
+
+type Meta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+	Version      string     `json:"version,omitempty"`
+}
+
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	NickName    string   `json:"nickName,omitempty"`
+	// PreferredLanguage is represented as a language tag (e.g. en-US)
+	PreferredLanguage string            `json:"preferredLanguage,omitempty"`
+	Locale            string            `json:"locale,omitempty"`
+	Active            *bool             `json:"active,omitempty"`
+	Password          string            `json:"password,omitempty"`
+	Emails            []*MultiValued    `json:"emails,omitempty"`
+	PhoneNumbers      []*MultiValued    `json:"phoneNumbers,omitempty"`
+	Groups            []*GroupReference `json:"groups,omitempty"`
+	ZitadelUser       *ZitadelUser      `json:"urn:ietf:params:scim:schemas:extension:zitadel:2.0:User,omitempty"`
+	Meta              *Meta             `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type MultiValued struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// ZitadelUser is the ZITADEL specific extension of the user resource.
+// If Machine is set, the resource is provisioned as machine user.
+type ZitadelUser struct {
+	Machine       bool   `json:"machine,omitempty"`
+	Description   string `json:"description,omitempty"`
+	EmailVerified *bool  `json:"emailVerified,omitempty"`
+	PhoneVerified *bool  `json:"phoneVerified,omitempty"`
+}
+
+type GroupReference struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type Group struct {
+	Schemas      []string      `json:"schemas"`
+	ID           string        `json:"id,omitempty"`
+	DisplayName  string        `json:"displayName"`
+	Members      []*MemberRef  `json:"members,omitempty"`
+	ZitadelGroup *ZitadelGroup `json:"urn:ietf:params:scim:schemas:extension:zitadel:2.0:Group,omitempty"`
+	Meta         *Meta         `json:"meta,omitempty"`
+}
+
+type MemberRef struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// ZitadelGroup is the ZITADEL specific extension of the group resource.
+// A group is represented by a role of a project of the organization.
+type ZitadelGroup struct {
+	ProjectID string `json:"projectId"`
+	RoleKey   string `json:"roleKey"`
+	Group     string `json:"group,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults uint64   `json:"totalResults"`
+	StartIndex   uint64   `json:"startIndex"`
+	ItemsPerPage uint64   `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+func newListResponse(total, startIndex uint64, resources []any) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: uint64(len(resources)),
+		Resources:    resources,
+	}
+}
+
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type BulkRequest struct {
+	Schemas      []string         `json:"schemas"`
+	FailOnErrors int              `json:"failOnErrors,omitempty"`
+	Operations   []*BulkOperation `json:"Operations"`
+}
+
+type BulkOperation struct {
+	Method  string          `json:"method"`
+	BulkID  string          `json:"bulkId,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Path    string          `json:"path"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+type BulkResponse struct {
+	Schemas    []string                 `json:"schemas"`
+	Operations []*BulkOperationResponse `json:"Operations"`
+}
+
+type BulkOperationResponse struct {
+	Method   string          `json:"method"`
+	BulkID   string          `json:"bulkId,omitempty"`
+	Version  string          `json:"version,omitempty"`
+	Location string          `json:"location,omitempty"`
+	Status   string          `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+}
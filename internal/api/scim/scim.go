@@ -0,0 +1,177 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerPrefix = "/scim/v2"
+
+	varOrgID      = "orgID"
+	varResourceID = "id"
+
+	orgPrefix      = "/{" + varOrgID + ":[0-9]+}"
+	resourceSuffix = "/{" + varResourceID + "}"
+
+	serviceProviderConfigPath = orgPrefix + "/ServiceProviderConfig"
+	schemasPath               = orgPrefix + "/Schemas"
+	resourceTypesPath         = orgPrefix + "/ResourceTypes"
+	usersPath                 = orgPrefix + "/Users"
+	groupsPath                = orgPrefix + "/Groups"
+	bulkPath                  = orgPrefix + "/Bulk"
+
+	permissionUserGrantRead  = "user.grant.read"
+	permissionUserGrantWrite = "user.grant.write"
+	permissionRoleWrite      = "project.role.write"
+)
+
+type Config struct {
+	// MaxResults is the maximum amount of resources returned in a list response
+	MaxResults uint64
+	// MaxBulkOperations is the maximum amount of operations allowed in a single bulk request
+	MaxBulkOperations int
+	// MaxPayloadSize is the maximum size of a request body in bytes
+	MaxPayloadSize int64
+}
+
+type Server struct {
+	config      Config
+	commands    *command.Commands
+	queries     *query.Queries
+	verifier    authz.APITokenVerifier
+	authConfig  authz.Config
+	userCodeAlg crypto.EncryptionAlgorithm
+	baseURL     func(ctx context.Context, orgID string) string
+	router      *mux.Router
+}
+
+// BaseURL returns the instance and organization specific root of the SCIM endpoints
+func BaseURL(externalSecure bool) func(ctx context.Context, orgID string) string {
+	return func(ctx context.Context, orgID string) string {
+		return http_util.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), externalSecure) + HandlerPrefix + "/" + orgID
+	}
+}
+
+func NewHandler(
+	config Config,
+	commands *command.Commands,
+	queries *query.Queries,
+	verifier authz.APITokenVerifier,
+	authConfig authz.Config,
+	userCodeAlg crypto.EncryptionAlgorithm,
+	externalSecure bool,
+	middlewares ...mux.MiddlewareFunc,
+) http.Handler {
+	s := &Server{
+		config:      config,
+		commands:    commands,
+		queries:     queries,
+		verifier:    verifier,
+		authConfig:  authConfig,
+		userCodeAlg: userCodeAlg,
+		baseURL:     BaseURL(externalSecure),
+	}
+
+	router := mux.NewRouter()
+	router.Use(middlewares...)
+	router.Use(s.limitPayload)
+
+	router.HandleFunc(serviceProviderConfigPath, s.authorize(domain.PermissionUserRead, s.getServiceProviderConfig)).Methods(http.MethodGet)
+	router.HandleFunc(schemasPath, s.authorize(domain.PermissionUserRead, s.listSchemas)).Methods(http.MethodGet)
+	router.HandleFunc(schemasPath+resourceSuffix, s.authorize(domain.PermissionUserRead, s.getSchema)).Methods(http.MethodGet)
+	router.HandleFunc(resourceTypesPath, s.authorize(domain.PermissionUserRead, s.listResourceTypes)).Methods(http.MethodGet)
+
+	router.HandleFunc(usersPath, s.authorize(domain.PermissionUserRead, s.listUsers)).Methods(http.MethodGet)
+	router.HandleFunc(usersPath, s.authorize(domain.PermissionUserWrite, s.createUser)).Methods(http.MethodPost)
+	router.HandleFunc(usersPath+resourceSuffix, s.authorize(domain.PermissionUserRead, s.getUser)).Methods(http.MethodGet)
+	router.HandleFunc(usersPath+resourceSuffix, s.authorize(domain.PermissionUserWrite, s.replaceUser)).Methods(http.MethodPut)
+	router.HandleFunc(usersPath+resourceSuffix, s.authorize(domain.PermissionUserWrite, s.patchUser)).Methods(http.MethodPatch)
+	router.HandleFunc(usersPath+resourceSuffix, s.authorize(domain.PermissionUserDelete, s.deleteUser)).Methods(http.MethodDelete)
+
+	router.HandleFunc(groupsPath, s.authorize(permissionUserGrantRead, s.listGroups)).Methods(http.MethodGet)
+	router.HandleFunc(groupsPath, s.authorize(permissionRoleWrite, s.createGroup)).Methods(http.MethodPost)
+	router.HandleFunc(groupsPath+resourceSuffix, s.authorize(permissionUserGrantRead, s.getGroup)).Methods(http.MethodGet)
+	router.HandleFunc(groupsPath+resourceSuffix, s.authorize(permissionUserGrantWrite, s.replaceGroup)).Methods(http.MethodPut)
+	router.HandleFunc(groupsPath+resourceSuffix, s.authorize(permissionUserGrantWrite, s.patchGroup)).Methods(http.MethodPatch)
+	router.HandleFunc(groupsPath+resourceSuffix, s.authorize(permissionRoleWrite, s.deleteGroup)).Methods(http.MethodDelete)
+
+	// the operations of a bulk request are authorized one by one
+	router.HandleFunc(bulkPath, s.authorize(authenticated, s.bulk)).Methods(http.MethodPost)
+
+	router.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, zerrors.ThrowNotFound(nil, "SCIM-Nf3ks", "Errors.SCIM.EndpointNotFound"))
+	})
+	s.router = router
+	return router
+}
+
+// authenticated is the permission which only requires a valid token without any further permission check
+const authenticated = "authenticated"
+
+// authorize verifies the (personal access) token of the request for the organization in the path.
+// Only machine users are allowed to use the SCIM endpoints.
+func (s *Server) authorize(permission string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := s.checkAuthorization(r.Context(), http_util.GetAuthorization(r), mux.Vars(r)[varOrgID], permission, r.Method+":"+r.URL.Path)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		next(w, r.WithContext(ctx))
+	}
+}
+
+func (s *Server) checkAuthorization(ctx context.Context, token, orgID, permission, method string) (_ context.Context, err error) {
+	authCtx, span := tracing.NewServerInterceptorSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if token == "" {
+		return nil, zerrors.ThrowUnauthenticated(nil, "SCIM-Sd3fa", "auth header missing")
+	}
+	ctxSetter, err := authz.CheckUserAuthorization(authCtx, nil, token, orgID, "", s.verifier, s.authConfig, authz.Option{Permission: permission}, method)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ctxSetter(ctx)
+	ctxData := authz.GetCtxData(ctx)
+	if ctxData.SystemMemberships != nil {
+		return ctx, nil
+	}
+	caller, err := s.queries.GetUserByID(ctx, false, ctxData.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Type != domain.UserTypeMachine {
+		return nil, zerrors.ThrowPermissionDenied(nil, "SCIM-Mh2ak", "Errors.SCIM.MachineUserRequired")
+	}
+	return ctx, nil
+}
+
+func (s *Server) limitPayload(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.config.MaxPayloadSize > 0 && r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, s.config.MaxPayloadSize)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func orgIDFromRequest(r *http.Request) string {
+	return mux.Vars(r)[varOrgID]
+}
+
+func resourceIDFromRequest(r *http.Request) string {
+	return mux.Vars(r)[varResourceID]
+}
@@ -0,0 +1,608 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	request, err := s.listRequestFromQuery(r, map[string]query.Column{
+		"username":          query.UserUsernameCol,
+		"meta.created":      query.UserCreationDateCol,
+		"meta.lastmodified": query.UserChangeDateCol,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	orgQuery, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	queries := []query.SearchQuery{orgQuery}
+	if request.filter != nil {
+		filterQuery, err := userFilterToQuery(request.filter)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		queries = append(queries, filterQuery)
+	}
+	users, err := s.queries.SearchUsers(ctx, &query.UserSearchQueries{
+		SearchRequest: request.SearchRequest,
+		Queries:       queries,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	resources := make([]any, len(users.Users))
+	for i, user := range users.Users {
+		resources[i], err = s.userToResource(ctx, user)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, newListResponse(users.Count, request.startIndex, resources))
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, err := s.getOrgUser(ctx, orgIDFromRequest(r), resourceIDFromRequest(r), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	resource, err := s.userToResource(ctx, user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, resource, resource.Meta)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	resource := new(User)
+	if err := readJSON(r, resource); err != nil {
+		writeError(w, err)
+		return
+	}
+	userID, err := s.addUser(ctx, orgID, resource)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeUser(ctx, w, http.StatusCreated, orgID, userID)
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	user, err := s.getOrgUser(ctx, orgID, resourceIDFromRequest(r), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = checkVersion(r, user.Sequence); err != nil {
+		writeError(w, err)
+		return
+	}
+	resource := new(User)
+	if err = readJSON(r, resource); err != nil {
+		writeError(w, err)
+		return
+	}
+	current, err := s.userToResource(ctx, user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = s.changeUser(ctx, user, current, resource); err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeUser(ctx, w, http.StatusOK, orgID, user.ID)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := orgIDFromRequest(r)
+	user, err := s.getOrgUser(ctx, orgID, resourceIDFromRequest(r), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = checkVersion(r, user.Sequence); err != nil {
+		writeError(w, err)
+		return
+	}
+	request := new(PatchRequest)
+	if err = readJSON(r, request); err != nil {
+		writeError(w, err)
+		return
+	}
+	current, err := s.userToResource(ctx, user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// the patch is applied on a copy, so the changes can be determined by comparing it to the current state
+	patched, err := s.userToResource(ctx, user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = applyUserPatch(patched, request); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = s.changeUser(ctx, user, current, patched); err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeUser(ctx, w, http.StatusOK, orgID, user.ID)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, err := s.getOrgUser(ctx, orgIDFromRequest(r), resourceIDFromRequest(r), false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	memberships, grants, err := s.removeUserDependencies(ctx, user.ID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if _, err = s.commands.RemoveUserV2(ctx, user.ID, memberships, grants...); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) writeUser(ctx context.Context, w http.ResponseWriter, status int, orgID, userID string) {
+	user, err := s.getOrgUser(ctx, orgID, userID, true)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	resource, err := s.userToResource(ctx, user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, status, resource, resource.Meta)
+}
+
+// getOrgUser returns the user only if it belongs to the organization of the request
+func (s *Server) getOrgUser(ctx context.Context, orgID, userID string, triggerBulk bool) (*query.User, error) {
+	user, err := s.queries.GetUserByID(ctx, triggerBulk, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.ResourceOwner != orgID {
+		return nil, zerrors.ThrowNotFound(nil, "SCIM-Ur2sf", "Errors.User.NotFound")
+	}
+	return user, nil
+}
+
+func (s *Server) addUser(ctx context.Context, orgID string, resource *User) (string, error) {
+	if resource.UserName == "" {
+		return "", invalidValueError(nil, "SCIM-Au2sa")
+	}
+	if resource.ZitadelUser != nil && resource.ZitadelUser.Machine {
+		return s.addMachineUser(ctx, orgID, resource)
+	}
+	human, err := resourceToAddHuman(resource)
+	if err != nil {
+		return "", err
+	}
+	if err = s.commands.AddUserHuman(ctx, orgID, human, false, s.userCodeAlg); err != nil {
+		return "", err
+	}
+	if resource.Active != nil && !*resource.Active {
+		if _, err = s.commands.DeactivateUserV2(ctx, human.ID); err != nil {
+			return "", err
+		}
+	}
+	return human.ID, nil
+}
+
+func (s *Server) addMachineUser(ctx context.Context, orgID string, resource *User) (string, error) {
+	machine := &command.Machine{
+		ObjectRoot: models.ObjectRoot{
+			ResourceOwner: orgID,
+		},
+		Username:        resource.UserName,
+		Name:            machineName(resource),
+		Description:     resource.ZitadelUser.Description,
+		AccessTokenType: domain.OIDCTokenTypeBearer,
+	}
+	if _, err := s.commands.AddMachine(ctx, machine); err != nil {
+		return "", err
+	}
+	if resource.ExternalID != "" {
+		if _, err := s.commands.SetUserMetadata(ctx, &domain.Metadata{Key: externalIDMetadataKey, Value: []byte(resource.ExternalID)}, machine.AggregateID, orgID); err != nil {
+			return "", err
+		}
+	}
+	if resource.Active != nil && !*resource.Active {
+		if _, err := s.commands.DeactivateUserV2(ctx, machine.AggregateID); err != nil {
+			return "", err
+		}
+	}
+	return machine.AggregateID, nil
+}
+
+func machineName(resource *User) string {
+	if resource.DisplayName != "" {
+		return resource.DisplayName
+	}
+	return resource.UserName
+}
+
+func resourceToAddHuman(resource *User) (*command.AddHuman, error) {
+	preferredLanguage, err := parseLanguage(resource.PreferredLanguage)
+	if err != nil {
+		return nil, err
+	}
+	human := &command.AddHuman{
+		Username:          resource.UserName,
+		DisplayName:       resource.DisplayName,
+		NickName:          resource.NickName,
+		PreferredLanguage: preferredLanguage,
+		Password:          resource.Password,
+		Email: command.Email{
+			Address: domain.EmailAddress(primaryValue(resource.Emails)),
+		},
+		Phone: command.Phone{
+			Number: domain.PhoneNumber(primaryValue(resource.PhoneNumbers)),
+		},
+	}
+	if resource.Name != nil {
+		human.FirstName = resource.Name.GivenName
+		human.LastName = resource.Name.FamilyName
+	}
+	if resource.ZitadelUser != nil {
+		human.Email.Verified = resource.ZitadelUser.EmailVerified != nil && *resource.ZitadelUser.EmailVerified
+		human.Phone.Verified = resource.ZitadelUser.PhoneVerified != nil && *resource.ZitadelUser.PhoneVerified
+	}
+	if resource.ExternalID != "" {
+		human.Metadata = append(human.Metadata, &command.AddMetadataEntry{
+			Key:   externalIDMetadataKey,
+			Value: []byte(resource.ExternalID),
+		})
+	}
+	return human, nil
+}
+
+// changeUser executes the commands necessary to transition the user from the current to the desired resource
+func (s *Server) changeUser(ctx context.Context, user *query.User, current, desired *User) (err error) {
+	if user.Type == domain.UserTypeMachine {
+		err = s.changeMachineUser(ctx, user, current, desired)
+	} else {
+		err = s.changeHumanUser(ctx, user, current, desired)
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.changeExternalID(ctx, user, current.ExternalID, desired.ExternalID); err != nil {
+		return err
+	}
+	return s.changeUserState(ctx, user, desired.Active)
+}
+
+func (s *Server) changeHumanUser(ctx context.Context, user *query.User, current, desired *User) error {
+	change, err := resourceToChangeHuman(user.ID, current, desired)
+	if err != nil {
+		return err
+	}
+	if change.Changed() {
+		if err = s.commands.ChangeUserHuman(ctx, change, s.userCodeAlg); err != nil {
+			return err
+		}
+	}
+	if primaryValue(current.PhoneNumbers) != "" && primaryValue(desired.PhoneNumbers) == "" {
+		if _, err = s.commands.RemoveHumanPhone(ctx, user.ID, user.ResourceOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceToChangeHuman(userID string, current, desired *User) (*command.ChangeHuman, error) {
+	change := &command.ChangeHuman{
+		ID: userID,
+	}
+	if desired.UserName != "" && desired.UserName != current.UserName {
+		change.Username = &desired.UserName
+	}
+	profile, err := resourceToChangeProfile(current, desired)
+	if err != nil {
+		return nil, err
+	}
+	change.Profile = profile
+	email := primaryValue(desired.Emails)
+	if email == "" {
+		email = primaryValue(current.Emails)
+	}
+	if email != primaryValue(current.Emails) || emailVerifiedChanged(current, desired) {
+		change.Email = &command.Email{
+			Address:  domain.EmailAddress(email),
+			Verified: desired.ZitadelUser != nil && desired.ZitadelUser.EmailVerified != nil && *desired.ZitadelUser.EmailVerified,
+		}
+	}
+	if phone := primaryValue(desired.PhoneNumbers); phone != "" && phone != primaryValue(current.PhoneNumbers) {
+		change.Phone = &command.Phone{
+			Number:   domain.PhoneNumber(phone),
+			Verified: desired.ZitadelUser != nil && desired.ZitadelUser.PhoneVerified != nil && *desired.ZitadelUser.PhoneVerified,
+		}
+	}
+	if desired.Password != "" {
+		change.Password = &command.Password{
+			Password: &desired.Password,
+		}
+	}
+	return change, nil
+}
+
+func emailVerifiedChanged(current, desired *User) bool {
+	if desired.ZitadelUser == nil || desired.ZitadelUser.EmailVerified == nil || !*desired.ZitadelUser.EmailVerified {
+		return false
+	}
+	return current.ZitadelUser == nil || current.ZitadelUser.EmailVerified == nil || !*current.ZitadelUser.EmailVerified
+}
+
+func resourceToChangeProfile(current, desired *User) (*command.Profile, error) {
+	profile := new(command.Profile)
+	changed := false
+	currentName, desiredName := current.Name, desired.Name
+	if currentName == nil {
+		currentName = new(Name)
+	}
+	if desiredName == nil {
+		desiredName = new(Name)
+	}
+	if desiredName.GivenName != "" && desiredName.GivenName != currentName.GivenName {
+		profile.FirstName, changed = &desiredName.GivenName, true
+	}
+	if desiredName.FamilyName != "" && desiredName.FamilyName != currentName.FamilyName {
+		profile.LastName, changed = &desiredName.FamilyName, true
+	}
+	if desired.NickName != current.NickName {
+		profile.NickName, changed = &desired.NickName, true
+	}
+	if desired.DisplayName != current.DisplayName {
+		profile.DisplayName, changed = &desired.DisplayName, true
+	}
+	if desired.PreferredLanguage != current.PreferredLanguage {
+		preferredLanguage, err := parseLanguage(desired.PreferredLanguage)
+		if err != nil {
+			return nil, err
+		}
+		profile.PreferredLanguage, changed = &preferredLanguage, true
+	}
+	if !changed {
+		return nil, nil
+	}
+	return profile, nil
+}
+
+func (s *Server) changeMachineUser(ctx context.Context, user *query.User, current, desired *User) error {
+	if desired.UserName != "" && desired.UserName != current.UserName {
+		if _, err := s.commands.ChangeUsername(ctx, user.ResourceOwner, user.ID, desired.UserName); err != nil {
+			return err
+		}
+	}
+	var description string
+	if desired.ZitadelUser != nil {
+		description = desired.ZitadelUser.Description
+	}
+	name := machineName(desired)
+	if name == user.Machine.Name && description == user.Machine.Description {
+		return nil
+	}
+	_, err := s.commands.ChangeMachine(ctx, &command.Machine{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   user.ID,
+			ResourceOwner: user.ResourceOwner,
+		},
+		Name:            name,
+		Description:     description,
+		AccessTokenType: user.Machine.AccessTokenType,
+	})
+	return err
+}
+
+func (s *Server) changeExternalID(ctx context.Context, user *query.User, current, desired string) (err error) {
+	if current == desired {
+		return nil
+	}
+	if desired == "" {
+		_, err = s.commands.RemoveUserMetadata(ctx, externalIDMetadataKey, user.ID, user.ResourceOwner)
+		return err
+	}
+	_, err = s.commands.SetUserMetadata(ctx, &domain.Metadata{Key: externalIDMetadataKey, Value: []byte(desired)}, user.ID, user.ResourceOwner)
+	return err
+}
+
+func (s *Server) changeUserState(ctx context.Context, user *query.User, active *bool) (err error) {
+	if active == nil {
+		return nil
+	}
+	isActive := user.State != domain.UserStateInactive
+	switch {
+	case *active && !isActive:
+		_, err = s.commands.ReactivateUserV2(ctx, user.ID)
+	case !*active && isActive:
+		_, err = s.commands.DeactivateUserV2(ctx, user.ID)
+	}
+	return err
+}
+
+func (s *Server) userToResource(ctx context.Context, user *query.User) (*User, error) {
+	externalID, err := s.externalID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	resource := userToResource(user, s.baseURL(ctx, user.ResourceOwner))
+	resource.ExternalID = externalID
+	return resource, nil
+}
+
+func (s *Server) externalID(ctx context.Context, userID string) (string, error) {
+	metadata, err := s.queries.GetUserMetadataByKey(ctx, false, userID, externalIDMetadataKey, false)
+	if zerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(metadata.Value), nil
+}
+
+func userToResource(user *query.User, baseURL string) *User {
+	active := user.State != domain.UserStateInactive
+	resource := &User{
+		Schemas:  []string{schemaUser, schemaZitadelUser},
+		ID:       user.ID,
+		UserName: user.Username,
+		Active:   &active,
+		Meta: &Meta{
+			ResourceType: resourceTypeUser,
+			Created:      &user.CreationDate,
+			LastModified: &user.ChangeDate,
+			Location:     baseURL + "/Users/" + user.ID,
+			Version:      version(user.Sequence),
+		},
+	}
+	if user.Machine != nil {
+		resource.DisplayName = user.Machine.Name
+		resource.ZitadelUser = &ZitadelUser{
+			Machine:     true,
+			Description: user.Machine.Description,
+		}
+		return resource
+	}
+	if user.Human == nil {
+		return resource
+	}
+	resource.DisplayName = user.Human.DisplayName
+	resource.NickName = user.Human.NickName
+	resource.Name = &Name{
+		Formatted:  user.Human.DisplayName,
+		GivenName:  user.Human.FirstName,
+		FamilyName: user.Human.LastName,
+	}
+	if !user.Human.PreferredLanguage.IsRoot() {
+		resource.PreferredLanguage = user.Human.PreferredLanguage.String()
+	}
+	if user.Human.Email != "" {
+		resource.Emails = []*MultiValued{{Value: string(user.Human.Email), Primary: true}}
+	}
+	if user.Human.Phone != "" {
+		resource.PhoneNumbers = []*MultiValued{{Value: string(user.Human.Phone), Primary: true}}
+	}
+	resource.ZitadelUser = &ZitadelUser{
+		EmailVerified: &user.Human.IsEmailVerified,
+		PhoneVerified: &user.Human.IsPhoneVerified,
+	}
+	return resource
+}
+
+func (s *Server) removeUserDependencies(ctx context.Context, userID string) ([]*command.CascadingMembership, []string, error) {
+	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	grants, err := s.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userGrantUserQuery},
+	}, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	membershipsUserQuery, err := query.NewMembershipUserIDQuery(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberships, err := s.queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{membershipsUserQuery},
+	}, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	grantIDs := make([]string, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		grantIDs[i] = grant.ID
+	}
+	return cascadingMemberships(memberships.Memberships), grantIDs, nil
+}
+
+func cascadingMemberships(memberships []*query.Membership) []*command.CascadingMembership {
+	cascades := make([]*command.CascadingMembership, len(memberships))
+	for i, membership := range memberships {
+		cascades[i] = &command.CascadingMembership{
+			UserID:        membership.UserID,
+			ResourceOwner: membership.ResourceOwner,
+		}
+		if membership.IAM != nil {
+			cascades[i].IAM = &command.CascadingIAMMembership{IAMID: membership.IAM.IAMID}
+		}
+		if membership.Org != nil {
+			cascades[i].Org = &command.CascadingOrgMembership{OrgID: membership.Org.OrgID}
+		}
+		if membership.Project != nil {
+			cascades[i].Project = &command.CascadingProjectMembership{ProjectID: membership.Project.ProjectID}
+		}
+		if membership.ProjectGrant != nil {
+			cascades[i].ProjectGrant = &command.CascadingProjectGrantMembership{
+				ProjectID: membership.ProjectGrant.ProjectID,
+				GrantID:   membership.ProjectGrant.GrantID,
+			}
+		}
+	}
+	return cascades
+}
+
+func primaryValue(values []*MultiValued) string {
+	if len(values) == 0 {
+		return ""
+	}
+	if i := slices.IndexFunc(values, func(value *MultiValued) bool { return value.Primary }); i >= 0 {
+		return values[i].Value
+	}
+	return values[0].Value
+}
+
+func parseLanguage(tag string) (language.Tag, error) {
+	if tag == "" {
+		return language.Und, nil
+	}
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return language.Und, invalidValueError(err, "SCIM-Lp2sa")
+	}
+	return parsed, nil
+}
+
+func version(sequence uint64) string {
+	return `W/"` + strconv.FormatUint(sequence, 10) + `"`
+}
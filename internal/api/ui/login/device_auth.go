@@ -33,20 +33,22 @@ func (l *Login) renderDeviceAuthUserCode(w http.ResponseWriter, r *http.Request,
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplDeviceAuthUserCode], data, nil)
 }
 
-func (l *Login) renderDeviceAuthAction(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, scopes []string) {
+func (l *Login) renderDeviceAuthAction(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, authDev *domain.AuthRequestDevice) {
 	translator := l.getTranslator(r.Context(), authReq)
 	data := &struct {
 		baseData
-		AuthRequestID string
-		Username      string
-		ClientID      string
-		Scopes        []string
+		AuthRequestID  string
+		Username       string
+		ClientID       string
+		Scopes         []string
+		BindingMessage string
 	}{
-		baseData:      l.getBaseData(r, authReq, translator, "DeviceAuth.Title", "DeviceAuth.Action.Description", "", ""),
-		AuthRequestID: authReq.ID,
-		Username:      authReq.UserName,
-		ClientID:      authReq.ApplicationID,
-		Scopes:        scopes,
+		baseData:       l.getBaseData(r, authReq, translator, "DeviceAuth.Title", "DeviceAuth.Action.Description", "", ""),
+		AuthRequestID:  authReq.ID,
+		Username:       authReq.UserName,
+		ClientID:       authReq.ApplicationID,
+		Scopes:         authDev.Scopes,
+		BindingMessage: authDev.BindingMessage,
 	}
 
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplDeviceAuthAction], data, nil)
@@ -105,6 +107,13 @@ func (l *Login) handleDeviceAuthUserCode(w http.ResponseWriter, r *http.Request)
 		l.renderDeviceAuthUserCode(w, r, err)
 		return
 	}
+	// backchannel authentication requests (CIBA) are bound to the requested user
+	deviceAuth, err := l.query.DeviceAuthByDeviceCode(ctx, deviceAuthReq.DeviceCode)
+	if err != nil {
+		l.renderDeviceAuthUserCode(w, r, err)
+		return
+	}
+	deviceAuthReq.BindingMessage = deviceAuth.BindingMessage
 	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
 	if !ok {
 		l.renderDeviceAuthUserCode(w, r, errors.New("internal error: agent ID missing"))
@@ -115,6 +124,7 @@ func (l *Login) handleDeviceAuthUserCode(w http.ResponseWriter, r *http.Request)
 		AgentID:       userAgentID,
 		ApplicationID: deviceAuthReq.ClientID,
 		InstanceID:    authz.GetInstance(ctx).InstanceID(),
+		LoginHint:     deviceAuth.LoginHint,
 		Request:       deviceAuthReq,
 	})
 	if err != nil {
@@ -167,7 +177,7 @@ func (l *Login) handleDeviceAuthAction(w http.ResponseWriter, r *http.Request) {
 	case deviceAuthDenied:
 		_, err = l.command.CancelDeviceAuth(r.Context(), authDev.DeviceCode, domain.DeviceAuthCanceledDenied)
 	default:
-		l.renderDeviceAuthAction(w, r, authReq, authDev.Scopes)
+		l.renderDeviceAuthAction(w, r, authReq, authDev)
 		return
 	}
 	if err != nil {
@@ -178,6 +188,12 @@ func (l *Login) handleDeviceAuthAction(w http.ResponseWriter, r *http.Request) {
 	l.renderDeviceAuthDone(w, r, authReq, action)
 }
 
+// DeviceAuthLink returns the link to the device authorization flow with a prefilled user code.
+// It is sent to users to approve a backchannel authentication request (CIBA).
+func DeviceAuthLink(origin, userCode string) string {
+	return externalLink(origin) + EndpointDeviceAuth + "?user_code=" + url.QueryEscape(userCode)
+}
+
 // deviceAuthCallbackURL creates the callback URL with which the user
 // is redirected back to the device authorization flow.
 func (l *Login) deviceAuthCallbackURL(authRequestID string) string {
@@ -55,6 +55,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplPasswordlessRegistrationDone: "passwordless_registration_done.html",
 		tmplPasswordlessPrompt:           "passwordless_prompt.html",
 		tmplMFAVerify:                    "mfa_verify_totp.html",
+		tmplMFAVerifyRecoveryCode:        "mfa_verify_recovery_code.html",
 		tmplMFAPrompt:                    "mfa_prompt.html",
 		tmplMFAInitVerify:                "mfa_init_otp.html",
 		tmplMFASMSInit:                   "mfa_init_otp_sms.html",
@@ -74,6 +74,14 @@ func (u *User) Reducers() []handler.AggregateReducer {
 					Event:  user_repo.HumanOTPEmailRemovedType,
 					Reduce: u.ProcessUser,
 				},
+				{
+					Event:  user_repo.HumanRecoveryCodesAddedType,
+					Reduce: u.ProcessUser,
+				},
+				{
+					Event:  user_repo.HumanRecoveryCodesRemovedType,
+					Reduce: u.ProcessUser,
+				},
 				{
 					Event:  user_repo.MachineAddedEventType,
 					Reduce: u.ProcessUser,
@@ -372,6 +380,8 @@ func (u *User) ProcessUser(event eventstore.Event) (_ *handler.Statement, err er
 			user_repo.HumanOTPSMSRemovedType,
 			user_repo.HumanOTPEmailAddedType,
 			user_repo.HumanOTPEmailRemovedType,
+			user_repo.HumanRecoveryCodesAddedType,
+			user_repo.HumanRecoveryCodesRemovedType,
 			user_repo.HumanU2FTokenAddedType,
 			user_repo.HumanU2FTokenVerifiedType,
 			user_repo.HumanU2FTokenRemovedType,
@@ -99,6 +99,10 @@ func (repo *TokenVerifierRepo) VerifyAccessToken(ctx context.Context, tokenStrin
 		userID, clientID, resourceOwner, err = repo.verifyAccessTokenV2(ctx, tokenID, verifierClientID, projectID)
 		return
 	}
+	// only tokens of OIDC sessions can be bound to a DPoP key
+	if err = authz.CheckDPoPBinding(ctx, ""); err != nil {
+		return "", "", "", "", "", err
+	}
 	if sessionID, ok := strings.CutPrefix(tokenID, authz.SessionTokenPrefix); ok {
 		userID, clientID, resourceOwner, err = repo.verifySessionToken(ctx, sessionID, tokenString)
 		return
@@ -142,6 +146,12 @@ func (repo *TokenVerifierRepo) verifyAccessTokenV2(ctx context.Context, token, v
 	if activeToken.Actor != nil {
 		return "", "", "", zerrors.ThrowPermissionDenied(nil, "APP-Shi0J", "Errors.TokenExchange.Token.NotForAPI")
 	}
+	if err = authz.CheckDPoPBinding(ctx, activeToken.DPoPThumbprint); err != nil {
+		return "", "", "", err
+	}
+	if err = authz.CheckCertificateBinding(ctx, activeToken.CertificateThumbprint); err != nil {
+		return "", "", "", err
+	}
 	if err = verifyAudience(activeToken.Audience, verifierClientID, projectID); err != nil {
 		return "", "", "", err
 	}
@@ -220,6 +230,9 @@ func authMethodsFromSession(session *query.Session) []domain.UserAuthMethodType
 	if !session.OTPEmailFactor.OTPCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeOTPEmail)
 	}
+	if !session.RecoveryCodeFactor.RecoveryCodeCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
+	}
 	return types
 }
 
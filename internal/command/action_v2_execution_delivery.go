@@ -0,0 +1,173 @@
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/executiondelivery"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ExecutionDeliveryTarget is a target which has to be called for an event,
+// because it is part of the execution with ExecutionID.
+type ExecutionDeliveryTarget struct {
+	TargetID    string
+	ExecutionID string
+}
+
+// QueueExecutionDeliveries queues a delivery of the body for every target.
+// The id of a delivery is derived from the event and the target,
+// so queueing the same event twice (e.g. on a retry of the handler) does not result in duplicate deliveries.
+func (c *Commands) QueueExecutionDeliveries(ctx context.Context, event eventstore.Event, body json.RawMessage, targets []*ExecutionDeliveryTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	instanceID := event.Aggregate().InstanceID
+	ids := make([]string, len(targets))
+	for i, target := range targets {
+		ids[i] = executionDeliveryID(event, target.TargetID)
+	}
+	wm := NewExecutionDeliveriesExistWriteModel(ids, instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return err
+	}
+	cmds := make([]eventstore.Command, 0, len(targets))
+	for i, target := range targets {
+		if wm.Exists(ids[i]) {
+			continue
+		}
+		cmds = append(cmds, executiondelivery.NewQueuedEvent(
+			ctx,
+			executiondelivery.NewAggregate(ids[i], instanceID),
+			target.TargetID,
+			target.ExecutionID,
+			string(event.Type()),
+			string(event.Aggregate().Type),
+			event.Aggregate().ID,
+			body,
+		))
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	_, err := c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+func executionDeliveryID(event eventstore.Event, targetID string) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		event.Aggregate().InstanceID,
+		string(event.Aggregate().Type),
+		event.Aggregate().ID,
+		strconv.FormatUint(event.Sequence(), 10),
+		targetID,
+	}, ":")))
+	return hex.EncodeToString(hash[:16])
+}
+
+// ExecutionDeliverySucceeded records the successful attempt of the delivery.
+func (c *Commands) ExecutionDeliverySucceeded(ctx context.Context, id, instanceID string, attempt uint16, statusCode int) error {
+	wm, err := c.pendingExecutionDeliveryWriteModel(ctx, id, instanceID, attempt)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, wm, executiondelivery.NewSucceededEvent(
+		ctx,
+		ExecutionDeliveryAggregateFromWriteModel(&wm.WriteModel),
+		attempt,
+		statusCode,
+	))
+}
+
+// ExecutionDeliveryFailed records the failed attempt of the delivery, which will be retried at nextAttempt.
+func (c *Commands) ExecutionDeliveryFailed(ctx context.Context, id, instanceID string, attempt uint16, statusCode int, deliveryErr error, nextAttempt time.Time) error {
+	wm, err := c.pendingExecutionDeliveryWriteModel(ctx, id, instanceID, attempt)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, wm, executiondelivery.NewFailedEvent(
+		ctx,
+		ExecutionDeliveryAggregateFromWriteModel(&wm.WriteModel),
+		attempt,
+		statusCode,
+		deliveryErr,
+		nextAttempt,
+	))
+}
+
+// ExecutionDeliveryDeadLettered records the last failed attempt of the delivery, which will not be retried anymore.
+func (c *Commands) ExecutionDeliveryDeadLettered(ctx context.Context, id, instanceID string, attempt uint16, statusCode int, deliveryErr error) error {
+	wm, err := c.pendingExecutionDeliveryWriteModel(ctx, id, instanceID, attempt)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, wm, executiondelivery.NewDeadLetteredEvent(
+		ctx,
+		ExecutionDeliveryAggregateFromWriteModel(&wm.WriteModel),
+		attempt,
+		statusCode,
+		deliveryErr,
+	))
+}
+
+// pendingExecutionDeliveryWriteModel ensures the delivery is still pending
+// and the attempt was not already recorded by another worker.
+func (c *Commands) pendingExecutionDeliveryWriteModel(ctx context.Context, id, instanceID string, attempt uint16) (*ExecutionDeliveryWriteModel, error) {
+	if id == "" || instanceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Edl1m", "Errors.IDMissing")
+	}
+	wm, err := c.getExecutionDeliveryWriteModelByID(ctx, id, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Edl2n", "Errors.Execution.Delivery.NotFound")
+	}
+	if wm.State != domain.ExecutionDeliveryStatePending || wm.Attempts+1 != attempt {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Edl3p", "Errors.Execution.Delivery.AlreadyAttempted")
+	}
+	return wm, nil
+}
+
+// ReplayExecutionDelivery queues a dead lettered delivery again.
+func (c *Commands) ReplayExecutionDelivery(ctx context.Context, id, resourceOwner string) (*domain.ObjectDetails, error) {
+	if id == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Edl4r", "Errors.IDMissing")
+	}
+	wm, err := c.getExecutionDeliveryWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Edl5n", "Errors.Execution.Delivery.NotFound")
+	}
+	if wm.State != domain.ExecutionDeliveryStateDeadLetter {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Edl6d", "Errors.Execution.Delivery.NotDeadLetter")
+	}
+	if !c.existsTargetsByIDs(ctx, []string{wm.TargetID}, resourceOwner) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Edl7t", "Errors.Target.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, executiondelivery.NewReplayedEvent(
+		ctx,
+		ExecutionDeliveryAggregateFromWriteModel(&wm.WriteModel),
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) getExecutionDeliveryWriteModelByID(ctx context.Context, id, resourceOwner string) (*ExecutionDeliveryWriteModel, error) {
+	wm := NewExecutionDeliveryWriteModel(id, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/executiondelivery"
+)
+
+type ExecutionDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	TargetID string
+	Attempts uint16
+	State    domain.ExecutionDeliveryState
+}
+
+func NewExecutionDeliveryWriteModel(id string, resourceOwner string) *ExecutionDeliveryWriteModel {
+	return &ExecutionDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+			InstanceID:    resourceOwner,
+		},
+	}
+}
+
+func (wm *ExecutionDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *executiondelivery.QueuedEvent:
+			wm.TargetID = e.TargetID
+			wm.State = domain.ExecutionDeliveryStatePending
+		case *executiondelivery.SucceededEvent:
+			wm.Attempts = e.Attempt
+			wm.State = domain.ExecutionDeliveryStateSucceeded
+		case *executiondelivery.FailedEvent:
+			wm.Attempts = e.Attempt
+		case *executiondelivery.DeadLetteredEvent:
+			wm.Attempts = e.Attempt
+			wm.State = domain.ExecutionDeliveryStateDeadLetter
+		case *executiondelivery.ReplayedEvent:
+			wm.Attempts = 0
+			wm.State = domain.ExecutionDeliveryStatePending
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ExecutionDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(executiondelivery.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(executiondelivery.QueuedEventType,
+			executiondelivery.SucceededEventType,
+			executiondelivery.FailedEventType,
+			executiondelivery.DeadLetteredEventType,
+			executiondelivery.ReplayedEventType).
+		Builder()
+}
+
+func ExecutionDeliveryAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            wm.AggregateID,
+		Type:          executiondelivery.AggregateType,
+		ResourceOwner: wm.ResourceOwner,
+		InstanceID:    wm.InstanceID,
+		Version:       executiondelivery.AggregateVersion,
+	}
+}
+
+type ExecutionDeliveriesExistWriteModel struct {
+	eventstore.WriteModel
+
+	ids         []string
+	existingIDs []string
+}
+
+func (wm *ExecutionDeliveriesExistWriteModel) Exists(id string) bool {
+	return slices.Contains(wm.existingIDs, id)
+}
+
+func NewExecutionDeliveriesExistWriteModel(ids []string, resourceOwner string) *ExecutionDeliveriesExistWriteModel {
+	return &ExecutionDeliveriesExistWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+			InstanceID:    resourceOwner,
+		},
+		ids: ids,
+	}
+}
+
+func (wm *ExecutionDeliveriesExistWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*executiondelivery.QueuedEvent); ok && !slices.Contains(wm.existingIDs, e.Aggregate().ID) {
+			wm.existingIDs = append(wm.existingIDs, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ExecutionDeliveriesExistWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(executiondelivery.AggregateType).
+		AggregateIDs(wm.ids...).
+		EventTypes(executiondelivery.QueuedEventType).
+		Builder()
+}
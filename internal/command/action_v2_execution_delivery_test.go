@@ -0,0 +1,490 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/executiondelivery"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_QueueExecutionDeliveries(t *testing.T) {
+	event := eventFromEventPusher(
+		user.NewHumanInitialCodeSentEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate),
+	)
+	body := json.RawMessage(`{"aggregateID":"user1"}`)
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		targets []*ExecutionDeliveryTarget
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no targets, ok",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			res{},
+		},
+		{
+			"already queued, ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate(executionDeliveryID(event, "target1"), event.Aggregate().InstanceID),
+								"target1",
+								"event",
+								string(event.Type()),
+								string(event.Aggregate().Type),
+								event.Aggregate().ID,
+								body,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				targets: []*ExecutionDeliveryTarget{
+					{TargetID: "target1", ExecutionID: "event"},
+				},
+			},
+			res{},
+		},
+		{
+			"push failed, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+					expectPushFailed(
+						zerrors.ThrowInternal(nil, "id", "push failed"),
+						executiondelivery.NewQueuedEvent(context.Background(),
+							executiondelivery.NewAggregate(executionDeliveryID(event, "target1"), event.Aggregate().InstanceID),
+							"target1",
+							"event",
+							string(event.Type()),
+							string(event.Aggregate().Type),
+							event.Aggregate().ID,
+							body,
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				targets: []*ExecutionDeliveryTarget{
+					{TargetID: "target1", ExecutionID: "event"},
+				},
+			},
+			res{
+				err: zerrors.IsInternal,
+			},
+		},
+		{
+			"queue, ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate(executionDeliveryID(event, "target1"), event.Aggregate().InstanceID),
+								"target1",
+								"event",
+								string(event.Type()),
+								string(event.Aggregate().Type),
+								event.Aggregate().ID,
+								body,
+							),
+						),
+					),
+					expectPush(
+						executiondelivery.NewQueuedEvent(context.Background(),
+							executiondelivery.NewAggregate(executionDeliveryID(event, "target2"), event.Aggregate().InstanceID),
+							"target2",
+							"event.user",
+							string(event.Type()),
+							string(event.Aggregate().Type),
+							event.Aggregate().ID,
+							body,
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				targets: []*ExecutionDeliveryTarget{
+					{TargetID: "target1", ExecutionID: "event"},
+					{TargetID: "target2", ExecutionID: "event.user"},
+				},
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			err := c.QueueExecutionDeliveries(tt.args.ctx, event, body, tt.args.targets)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_ExecutionDeliveryFailed(t *testing.T) {
+	nextAttempt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deliveryErr := errors.New("connection refused")
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		id      string
+		attempt uint16
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no id, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx:     context.Background(),
+				attempt: 1,
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:     context.Background(),
+				id:      "delivery1",
+				attempt: 1,
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"already attempted, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								"target1", "event", "user.human.added", "user", "user1", nil,
+							),
+						),
+						eventFromEventPusher(
+							executiondelivery.NewFailedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								1, 500, deliveryErr, nextAttempt,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:     context.Background(),
+				id:      "delivery1",
+				attempt: 1,
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"not pending, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								"target1", "event", "user.human.added", "user", "user1", nil,
+							),
+						),
+						eventFromEventPusher(
+							executiondelivery.NewSucceededEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								1, 200,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:     context.Background(),
+				id:      "delivery1",
+				attempt: 2,
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"failed, ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								"target1", "event", "user.human.added", "user", "user1", nil,
+							),
+						),
+						eventFromEventPusher(
+							executiondelivery.NewFailedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								1, 500, deliveryErr, nextAttempt,
+							),
+						),
+					),
+					expectPush(
+						executiondelivery.NewFailedEvent(context.Background(),
+							executiondelivery.NewAggregate("delivery1", "instance1"),
+							2, 500, deliveryErr, nextAttempt,
+						),
+					),
+				),
+			},
+			args{
+				ctx:     context.Background(),
+				id:      "delivery1",
+				attempt: 2,
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			err := c.ExecutionDeliveryFailed(tt.args.ctx, tt.args.id, "instance1", tt.args.attempt, 500, deliveryErr, nextAttempt)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_ReplayExecutionDelivery(t *testing.T) {
+	deliveryErr := errors.New("connection refused")
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no id, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "instance1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "delivery1",
+				resourceOwner: "instance1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"not dead lettered, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								"target1", "event", "user.human.added", "user", "user1", nil,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "delivery1",
+				resourceOwner: "instance1",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"target removed, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								"target1", "event", "user.human.added", "user", "user1", nil,
+							),
+						),
+						eventFromEventPusher(
+							executiondelivery.NewDeadLetteredEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								5, 0, deliveryErr,
+							),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "delivery1",
+				resourceOwner: "instance1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"replay, ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							executiondelivery.NewQueuedEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								"target1", "event", "user.human.added", "user", "user1", nil,
+							),
+						),
+						eventFromEventPusher(
+							executiondelivery.NewDeadLetteredEvent(context.Background(),
+								executiondelivery.NewAggregate("delivery1", "instance1"),
+								5, 0, deliveryErr,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							target.NewAddedEvent(context.Background(),
+								target.NewAggregate("target1", "instance1"),
+								"name",
+								domain.TargetTypeWebhook,
+								"https://example.com",
+								time.Second,
+								true,
+								true,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						executiondelivery.NewReplayedEvent(context.Background(),
+							executiondelivery.NewAggregate("delivery1", "instance1"),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "delivery1",
+				resourceOwner: "instance1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.ReplayExecutionDelivery(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -168,6 +168,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -256,6 +257,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -301,6 +303,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -346,6 +349,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -738,6 +742,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							time.Second,
 							true,
 							true,
+							nil,
 						),
 					),
 					expectPushFailed(
@@ -825,6 +830,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -870,6 +876,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -915,6 +922,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -1115,6 +1123,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -1203,6 +1212,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -1248,6 +1258,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -1293,6 +1304,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -1462,6 +1474,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
@@ -1539,6 +1552,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								time.Second,
 								true,
 								true,
+								nil,
 							),
 						),
 					),
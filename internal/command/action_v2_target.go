@@ -5,12 +5,22 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/repository/target"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
+// targetSigningKeyConfig is used to generate the signing keys of the targets,
+// as long as no secret generator is configured on the instance
+var targetSigningKeyConfig = &crypto.GeneratorConfig{
+	Length:              32,
+	IncludeLowerLetters: true,
+	IncludeUpperLetters: true,
+	IncludeDigits:       true,
+}
+
 type AddTarget struct {
 	models.ObjectRoot
 
@@ -20,6 +30,9 @@ type AddTarget struct {
 	Timeout          time.Duration
 	Async            bool
 	InterruptOnError bool
+
+	// SigningKey is set by the command and only returned once after the creation
+	SigningKey string
 }
 
 func (a *AddTarget) IsValid() error {
@@ -59,6 +72,10 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 	if wm.State.Exists() {
 		return nil, zerrors.ThrowAlreadyExists(nil, "INSTANCE-9axkz0jvzm", "Errors.Target.AlreadyExists")
 	}
+	signingKey, err := c.newTargetSigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	pushedEvents, err := c.eventstore.Push(ctx, target.NewAddedEvent(
 		ctx,
@@ -69,10 +86,12 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		add.Timeout,
 		add.Async,
 		add.InterruptOnError,
+		signingKey.Crypted,
 	))
 	if err != nil {
 		return nil, err
 	}
+	add.SigningKey = signingKey.Plain
 	if err := AppendAndReduce(wm, pushedEvents...); err != nil {
 		return nil, err
 	}
@@ -88,6 +107,11 @@ type ChangeTarget struct {
 	Timeout          *time.Duration
 	Async            *bool
 	InterruptOnError *bool
+	// RegenerateSigningKey replaces the key used to sign the payloads sent to the target
+	RegenerateSigningKey bool
+
+	// SigningKey is set by the command if a new key was generated
+	SigningKey string
 }
 
 func (a *ChangeTarget) IsValid() error {
@@ -124,6 +148,14 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 	if !existing.State.Exists() {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-xj14f2cccn", "Errors.Target.NotFound")
 	}
+	var signingKey *CryptoCode
+	if change.RegenerateSigningKey {
+		signingKey, err = c.newTargetSigningKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		change.SigningKey = signingKey.Plain
+	}
 
 	changedEvent := existing.NewChangedEvent(
 		ctx,
@@ -133,7 +165,9 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		change.URL,
 		change.Timeout,
 		change.Async,
-		change.InterruptOnError)
+		change.InterruptOnError,
+		signingKey,
+	)
 	if changedEvent == nil {
 		return writeModelToObjectDetails(&existing.WriteModel), nil
 	}
@@ -173,6 +207,10 @@ func (c *Commands) DeleteTarget(ctx context.Context, id, resourceOwner string) (
 	return writeModelToObjectDetails(&existing.WriteModel), nil
 }
 
+func (c *Commands) newTargetSigningKey(ctx context.Context) (*CryptoCode, error) {
+	return c.newCodeWithDefault(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeSigningKey, c.targetEncryption, targetSigningKeyConfig) //nolint:staticcheck
+}
+
 func (c *Commands) existsTargetsByIDs(ctx context.Context, ids []string, resourceOwner string) bool {
 	wm := NewTargetsExistsWriteModel(ids, resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, wm)
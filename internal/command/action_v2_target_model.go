@@ -5,6 +5,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/target"
@@ -19,6 +20,7 @@ type TargetWriteModel struct {
 	Timeout          time.Duration
 	Async            bool
 	InterruptOnError bool
+	SigningKey       *crypto.CryptoValue
 
 	State domain.TargetState
 }
@@ -42,6 +44,8 @@ func (wm *TargetWriteModel) Reduce() error {
 			wm.URL = e.URL
 			wm.Timeout = e.Timeout
 			wm.Async = e.Async
+			wm.InterruptOnError = e.InterruptOnError
+			wm.SigningKey = e.SigningKey
 			wm.State = domain.TargetActive
 		case *target.ChangedEvent:
 			if e.Name != nil {
@@ -62,6 +66,9 @@ func (wm *TargetWriteModel) Reduce() error {
 			if e.InterruptOnError != nil {
 				wm.InterruptOnError = *e.InterruptOnError
 			}
+			if e.SigningKey != nil {
+				wm.SigningKey = e.SigningKey
+			}
 		case *target.RemovedEvent:
 			wm.State = domain.TargetRemoved
 		}
@@ -90,6 +97,7 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	timeout *time.Duration,
 	async *bool,
 	interruptOnError *bool,
+	signingKey *CryptoCode,
 ) *target.ChangedEvent {
 	changes := make([]target.Changes, 0)
 	if name != nil && wm.Name != *name {
@@ -110,6 +118,9 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	if interruptOnError != nil && wm.InterruptOnError != *interruptOnError {
 		changes = append(changes, target.ChangeInterruptOnError(*interruptOnError))
 	}
+	if signingKey != nil {
+		changes = append(changes, target.ChangeSigningKey(signingKey.Crypted))
+	}
 	if len(changes) == 0 {
 		return nil
 	}
@@ -21,6 +21,7 @@ func targetAddEvent(aggID, resourceOwner string) *target.AddedEvent {
 		time.Second,
 		false,
 		false,
+		nil,
 	)
 }
 
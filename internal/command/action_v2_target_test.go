@@ -8,6 +8,7 @@ import (
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -28,9 +29,10 @@ func TestCommands_AddTarget(t *testing.T) {
 		resourceOwner string
 	}
 	type res struct {
-		id      string
-		details *domain.ObjectDetails
-		err     func(error) bool
+		id         string
+		details    *domain.ObjectDetails
+		signingKey string
+		err        func(error) bool
 	}
 	tests := []struct {
 		name   string
@@ -133,6 +135,7 @@ func TestCommands_AddTarget(t *testing.T) {
 							time.Second,
 							false,
 							false,
+							&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("12345678")},
 						),
 					),
 				),
@@ -165,6 +168,7 @@ func TestCommands_AddTarget(t *testing.T) {
 							time.Second,
 							false,
 							false,
+							nil,
 						),
 					),
 				),
@@ -198,6 +202,7 @@ func TestCommands_AddTarget(t *testing.T) {
 							time.Second,
 							false,
 							false,
+							&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("12345678")},
 						),
 					),
 				),
@@ -218,6 +223,7 @@ func TestCommands_AddTarget(t *testing.T) {
 				details: &domain.ObjectDetails{
 					ResourceOwner: "org1",
 				},
+				signingKey: "12345678",
 			},
 		},
 		{
@@ -234,6 +240,7 @@ func TestCommands_AddTarget(t *testing.T) {
 							time.Second,
 							true,
 							true,
+							&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("12345678")},
 						),
 					),
 				),
@@ -256,14 +263,16 @@ func TestCommands_AddTarget(t *testing.T) {
 				details: &domain.ObjectDetails{
 					ResourceOwner: "org1",
 				},
+				signingKey: "12345678",
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &Commands{
-				eventstore:  tt.fields.eventstore,
-				idGenerator: tt.fields.idGenerator,
+				eventstore:         tt.fields.eventstore,
+				idGenerator:        tt.fields.idGenerator,
+				newCodeWithDefault: mockCodeWithDefault("12345678", time.Hour),
 			}
 			details, err := c.AddTarget(tt.args.ctx, tt.args.add, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -275,6 +284,7 @@ func TestCommands_AddTarget(t *testing.T) {
 			if tt.res.err == nil {
 				assert.Equal(t, tt.res.id, tt.args.add.AggregateID)
 				assert.Equal(t, tt.res.details, details)
+				assert.Equal(t, tt.res.signingKey, tt.args.add.SigningKey)
 			}
 		})
 	}
@@ -426,6 +436,7 @@ func TestCommands_ChangeTarget(t *testing.T) {
 								0,
 								false,
 								false,
+								nil,
 							),
 						),
 					),
@@ -461,6 +472,7 @@ func TestCommands_ChangeTarget(t *testing.T) {
 								0,
 								false,
 								false,
+								nil,
 							),
 						),
 					),
@@ -503,6 +515,7 @@ func TestCommands_ChangeTarget(t *testing.T) {
 								0,
 								false,
 								false,
+								nil,
 							),
 						),
 					),
@@ -546,6 +559,7 @@ func TestCommands_ChangeTarget(t *testing.T) {
 								0,
 								false,
 								false,
+								nil,
 							),
 						),
 					),
@@ -585,11 +599,56 @@ func TestCommands_ChangeTarget(t *testing.T) {
 				},
 			},
 		},
+		{
+			"regenerate signing key, ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							target.NewAddedEvent(context.Background(),
+								target.NewAggregate("id1", "org1"),
+								"name",
+								domain.TargetTypeWebhook,
+								"https://example.com",
+								0,
+								false,
+								false,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "org1"),
+							[]target.Changes{
+								target.ChangeSigningKey(&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("12345678")}),
+							},
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					RegenerateSigningKey: true,
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &Commands{
-				eventstore: tt.fields.eventstore,
+				eventstore:         tt.fields.eventstore,
+				newCodeWithDefault: mockCodeWithDefault("12345678", time.Hour),
 			}
 			details, err := c.ChangeTarget(tt.args.ctx, tt.args.change, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -668,6 +727,7 @@ func TestCommands_DeleteTarget(t *testing.T) {
 								0,
 								false,
 								false,
+								nil,
 							),
 						),
 					),
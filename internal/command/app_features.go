@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AppFeatures struct {
+	TokenExchange *bool
+}
+
+func (m *AppFeatures) isEmpty() bool {
+	return m.TokenExchange == nil
+}
+
+func (c *Commands) SetAppFeatures(ctx context.Context, projectID, appID string, f *AppFeatures) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieG4o", "Errors.IDMissing")
+	}
+	if f.isEmpty() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ba9ie", "Errors.NoChangesFound")
+	}
+	resourceOwner, err := c.checkAppFeaturesPermission(ctx, projectID, appID)
+	if err != nil {
+		return nil, err
+	}
+	wm := NewAppFeaturesWriteModel(appID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	cmds := wm.setCommands(ctx, f)
+	if len(cmds) == 0 {
+		return writeModelToObjectDetails(wm.WriteModel), nil
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
+
+func (c *Commands) ResetAppFeatures(ctx context.Context, projectID, appID string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xu2ai", "Errors.IDMissing")
+	}
+	resourceOwner, err := c.checkAppFeaturesPermission(ctx, projectID, appID)
+	if err != nil {
+		return nil, err
+	}
+	wm := NewAppFeaturesWriteModel(appID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if wm.isEmpty() {
+		return writeModelToObjectDetails(wm.WriteModel), nil
+	}
+	aggregate := feature_v2.NewAggregate(appID, resourceOwner)
+	events, err := c.eventstore.Push(ctx, feature_v2.NewResetEvent(ctx, aggregate, feature_v2.AppResetEventType))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
+
+// checkAppFeaturesPermission ensures the application exists and the caller is allowed to manage the features of its project.
+// It returns the resource owner (organization) of the application.
+func (c *Commands) checkAppFeaturesPermission(ctx context.Context, projectID, appID string) (string, error) {
+	app, err := c.getApplicationWriteModel(ctx, projectID, appID, "")
+	if err != nil {
+		return "", err
+	}
+	if app.State == domain.AppStateUnspecified || app.State == domain.AppStateRemoved {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-Phae6", "Errors.Project.App.NotExisting")
+	}
+	if err = c.checkPermission(ctx, domain.PermissionProjectFeatureWrite, app.ResourceOwner, projectID); err != nil {
+		return "", err
+	}
+	return app.ResourceOwner, nil
+}
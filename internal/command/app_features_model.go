@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+)
+
+type AppFeaturesWriteModel struct {
+	*eventstore.WriteModel
+	AppFeatures
+}
+
+func NewAppFeaturesWriteModel(appID, resourceOwner string) *AppFeaturesWriteModel {
+	m := &AppFeaturesWriteModel{
+		WriteModel: &eventstore.WriteModel{
+			AggregateID:   appID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+	return m
+}
+
+func (m *AppFeaturesWriteModel) Reduce() (err error) {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *feature_v2.ResetEvent:
+			m.reduceReset()
+		case *feature_v2.SetEvent[bool]:
+			err = m.reduceBoolFeature(e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return m.WriteModel.Reduce()
+}
+
+func (m *AppFeaturesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(feature_v2.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			feature_v2.AppResetEventType,
+			feature_v2.AppTokenExchangeEventType,
+		).
+		Builder().ResourceOwner(m.ResourceOwner)
+}
+
+func (m *AppFeaturesWriteModel) reduceReset() {
+	m.TokenExchange = nil
+}
+
+func (m *AppFeaturesWriteModel) reduceBoolFeature(event *feature_v2.SetEvent[bool]) error {
+	_, key, err := event.FeatureInfo()
+	if err != nil {
+		return err
+	}
+	if key == feature.KeyTokenExchange {
+		m.TokenExchange = &event.Value
+	}
+	return nil
+}
+
+func (wm *AppFeaturesWriteModel) setCommands(ctx context.Context, f *AppFeatures) []eventstore.Command {
+	aggregate := feature_v2.NewAggregate(wm.AggregateID, wm.ResourceOwner)
+	cmds := make([]eventstore.Command, 0, 1)
+	cmds = appendFeatureUpdate(ctx, cmds, aggregate, wm.TokenExchange, f.TokenExchange, feature_v2.AppTokenExchangeEventType)
+	return cmds
+}
@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetAppFeatures(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := feature_v2.NewAggregate("app1", "org1")
+	appAdded := eventFromEventPusher(
+		project.NewApplicationAddedEvent(ctx, &project.NewAggregate("project1", "org1").Aggregate, "app1", "app"),
+	)
+
+	type args struct {
+		ctx       context.Context
+		projectID string
+		appID     string
+		f         *AppFeatures
+	}
+	tests := []struct {
+		name            string
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		args            args
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name:       "missing app id",
+			eventstore: expectEventstore(),
+			args: args{ctx, "project1", "", &AppFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieG4o", "Errors.IDMissing"),
+		},
+		{
+			name:       "all nil, No Change",
+			eventstore: expectEventstore(),
+			args:       args{ctx, "project1", "app1", &AppFeatures{}},
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Ba9ie", "Errors.NoChangesFound"),
+		},
+		{
+			name: "app not found",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{ctx, "project1", "app1", &AppFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Phae6", "Errors.Project.App.NotExisting"),
+		},
+		{
+			name: "permission denied",
+			eventstore: expectEventstore(
+				expectFilter(appAdded),
+			),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			args: args{ctx, "project1", "app1", &AppFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "set TokenExchange",
+			eventstore: expectEventstore(
+				expectFilter(appAdded),
+				expectFilter(),
+				expectPush(
+					feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.AppTokenExchangeEventType, false,
+					),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{ctx, "project1", "app1", &AppFeatures{
+				TokenExchange: gu.Ptr(false),
+			}},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.checkPermission,
+			}
+			got, err := c.SetAppFeatures(tt.args.ctx, tt.args.projectID, tt.args.appID, tt.args.f)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -27,6 +27,7 @@ type AuthRequest struct {
 	MaxAge        *time.Duration
 	LoginHint     *string
 	HintUserID    *string
+	ACRValues     []string
 }
 
 type CurrentAuthRequest struct {
@@ -69,6 +70,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.MaxAge,
 		authRequest.LoginHint,
 		authRequest.HintUserID,
+		authRequest.ACRValues,
 	))
 	if err != nil {
 		return nil, err
@@ -101,6 +103,9 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
+	if err := checkAuthRequestAuthenticationRequirement(writeModel, sessionWriteModel); err != nil {
+		return nil, nil, err
+	}
 
 	if err := c.pushAppendAndReduce(ctx, writeModel, authrequest.NewSessionLinkedEvent(
 		ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
@@ -114,6 +119,21 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	return writeModelToObjectDetails(&writeModel.WriteModel), authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
+// checkAuthRequestAuthenticationRequirement ensures the session fulfills the requested acr_values and max_age of the auth request
+func checkAuthRequestAuthenticationRequirement(writeModel *AuthRequestWriteModel, sessionWriteModel *SessionWriteModel) error {
+	requirement := &domain.AuthenticationRequirement{
+		Level:  domain.RequiredLevelOfAssurance(writeModel.ACRValues),
+		MaxAge: writeModel.MaxAge,
+	}
+	if requirement.IsEmpty() {
+		return nil
+	}
+	if !sessionWriteModel.Factors().AuthenticationStatus(requirement, time.Now()).Fulfilled {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Oow8e", "Errors.AuthRequest.AuthenticationRequirementNotMet")
+	}
+	return nil
+}
+
 func (c *Commands) FailAuthRequest(ctx context.Context, id string, reason domain.OIDCErrorReason) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
@@ -185,6 +205,7 @@ func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel
 			MaxAge:        writeModel.MaxAge,
 			LoginHint:     writeModel.LoginHint,
 			HintUserID:    writeModel.HintUserID,
+			ACRValues:     writeModel.ACRValues,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
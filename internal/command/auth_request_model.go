@@ -29,6 +29,7 @@ type AuthRequestWriteModel struct {
 	MaxAge           *time.Duration
 	LoginHint        *string
 	HintUserID       *string
+	ACRValues        []string
 	SessionID        string
 	UserID           string
 	AuthTime         time.Time
@@ -63,6 +64,7 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.MaxAge = e.MaxAge
 			m.LoginHint = e.LoginHint
 			m.HintUserID = e.HintUserID
+			m.ACRValues = e.ACRValues
 			m.AuthRequestState = domain.AuthRequestStateAdded
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
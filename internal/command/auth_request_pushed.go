@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddPushedAuthRequest stores the parameters of an authorization request pushed by the client (RFC 9126)
+// and returns the id, which is referenced by the request_uri.
+// The parameters must already be validated and must not contain any client credentials.
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, clientID string, parameters url.Values, expiration time.Time) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if clientID == "" || len(parameters) == 0 {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Oos1u", "Errors.AuthRequest.PushedInvalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	if err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedEvent(
+		ctx,
+		&authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+		clientID,
+		parameters,
+		expiration,
+	)); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// UsePushedAuthRequest returns the parameters of the pushed authorization request
+// and invalidates it, as a request_uri must only be used once.
+func (c *Commands) UsePushedAuthRequest(ctx context.Context, id, clientID string) (_ url.Values, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.IsUsable(clientID, time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gai3d", "Errors.AuthRequest.RequestURIInvalid")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedUsedEvent(
+		ctx,
+		&authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModel.Parameters, nil
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+)
+
+type PushedAuthRequestWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID   string
+	Parameters url.Values
+	Expiration time.Time
+	Used       bool
+}
+
+func NewPushedAuthRequestWriteModel(ctx context.Context, id string) *PushedAuthRequestWriteModel {
+	return &PushedAuthRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+	}
+}
+
+func (m *PushedAuthRequestWriteModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *authrequest.PushedEvent:
+			m.ClientID = e.ClientID
+			m.Parameters = e.Parameters
+			m.Expiration = e.Expiration
+		case *authrequest.PushedUsedEvent:
+			m.Used = true
+		}
+	}
+
+	return m.WriteModel.Reduce()
+}
+
+func (m *PushedAuthRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(authrequest.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			authrequest.PushedType,
+			authrequest.PushedUsedType,
+		).
+		Builder()
+}
+
+// IsUsable checks that the pushed request exists, was pushed by the client,
+// is not expired and was not used yet.
+func (m *PushedAuthRequestWriteModel) IsUsable(clientID string, now time.Time) bool {
+	return m.ClientID != "" &&
+		m.ClientID == clientID &&
+		!m.Used &&
+		now.Before(m.Expiration)
+}
@@ -0,0 +1,185 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddPushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	expiration := time.Now().Add(time.Minute)
+	parameters := url.Values{
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		clientID   string
+		parameters url.Values
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "missing client id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				parameters: parameters,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Oos1u", "Errors.AuthRequest.PushedInvalid"),
+		},
+		{
+			name: "missing parameters",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				clientID: "clientID",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Oos1u", "Errors.AuthRequest.PushedInvalid"),
+		},
+		{
+			name: "pushed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+							"clientID",
+							parameters,
+							expiration,
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args: args{
+				clientID:   "clientID",
+				parameters: parameters,
+			},
+			want: "id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddPushedAuthRequest(mockCtx, tt.args.clientID, tt.args.parameters, expiration)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_UsePushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	parameters := url.Values{
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	pushedEvent := func(expiration time.Time) eventstore.Event {
+		return eventFromEventPusher(
+			authrequest.NewPushedEvent(context.Background(), &authrequest.NewAggregate("id", "instanceID").Aggregate,
+				"clientID",
+				parameters,
+				expiration,
+			),
+		)
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		clientID   string
+		want       url.Values
+		wantErr    error
+	}{
+		{
+			name: "not existing",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			clientID: "clientID",
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "COMMAND-Gai3d", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			name: "other client",
+			eventstore: expectEventstore(
+				expectFilter(
+					pushedEvent(time.Now().Add(time.Minute)),
+				),
+			),
+			clientID: "otherClientID",
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "COMMAND-Gai3d", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			name: "expired",
+			eventstore: expectEventstore(
+				expectFilter(
+					pushedEvent(time.Now().Add(-time.Minute)),
+				),
+			),
+			clientID: "clientID",
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "COMMAND-Gai3d", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			name: "already used",
+			eventstore: expectEventstore(
+				expectFilter(
+					pushedEvent(time.Now().Add(time.Minute)),
+					eventFromEventPusher(
+						authrequest.NewPushedUsedEvent(context.Background(), &authrequest.NewAggregate("id", "instanceID").Aggregate),
+					),
+				),
+			),
+			clientID: "clientID",
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "COMMAND-Gai3d", "Errors.AuthRequest.RequestURIInvalid"),
+		},
+		{
+			name: "used",
+			eventstore: expectEventstore(
+				expectFilter(
+					pushedEvent(time.Now().Add(time.Minute)),
+				),
+				expectPush(
+					authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate),
+				),
+			),
+			clientID: "clientID",
+			want:     parameters,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.UsePushedAuthRequest(mockCtx, "id", tt.clientID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
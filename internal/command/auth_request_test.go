@@ -59,6 +59,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -96,6 +97,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 							gu.Ptr(time.Duration(0)),
 							gu.Ptr("loginHint"),
 							gu.Ptr("hintUserID"),
+							nil,
 						),
 					),
 				),
@@ -223,6 +225,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -263,6 +266,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -301,6 +305,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -338,6 +343,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -398,6 +404,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -426,6 +433,64 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-sGr42", "Errors.Session.Token.Invalid"),
 			},
 		},
+		{
+			"authentication requirement not met",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								[]string{domain.ACRMultiFactor},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Oow8e", "Errors.AuthRequest.AuthenticationRequirementNotMet"),
+			},
+		},
 		{
 			"linked",
 			fields{
@@ -447,6 +512,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -532,6 +598,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -674,6 +741,7 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								nil,
 								nil,
 								nil,
+								nil,
 							),
 						),
 					),
@@ -771,6 +839,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr(time.Duration(0)),
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
+								nil,
 							),
 						),
 					),
@@ -807,6 +876,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr(time.Duration(0)),
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -898,6 +968,7 @@ func TestCommands_ExchangeAuthCode(t *testing.T) {
 								gu.Ptr(time.Duration(0)),
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
+								nil,
 							),
 						),
 					),
@@ -935,6 +1006,7 @@ func TestCommands_ExchangeAuthCode(t *testing.T) {
 								gu.Ptr(time.Duration(0)),
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/deviceauth"
@@ -35,6 +36,59 @@ func (c *Commands) AddDeviceAuth(ctx context.Context, clientID, deviceCode, user
 	return writeModelToObjectDetails(&model.WriteModel), nil
 }
 
+// CIBARequest is a backchannel authentication request (OpenID CIBA),
+// which is stored as device authorization with the AuthReqID as device code.
+type CIBARequest struct {
+	ClientID       string
+	AuthReqID      string
+	UserCode       string
+	Expires        time.Time
+	Scopes         []string
+	UserID         string
+	LoginHint      string
+	BindingMessage string
+	// NotificationToken is the client_notification_token of the ping mode
+	NotificationToken string
+}
+
+func (c *Commands) AddCIBARequest(ctx context.Context, request *CIBARequest) (_ *domain.ObjectDetails, err error) {
+	if request.ClientID == "" || request.AuthReqID == "" || request.UserCode == "" || request.UserID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooP0u", "Errors.DeviceAuth.Invalid")
+	}
+	var notificationToken *crypto.CryptoValue
+	if request.NotificationToken != "" {
+		notificationToken, err = crypto.Encrypt([]byte(request.NotificationToken), c.keyAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+	}
+	aggr := deviceauth.NewAggregate(request.AuthReqID, authz.GetInstance(ctx).InstanceID())
+	model := NewDeviceAuthWriteModel(request.AuthReqID, aggr.ResourceOwner)
+
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewCIBAAddedEvent(
+		ctx,
+		aggr,
+		request.ClientID,
+		request.AuthReqID,
+		request.UserCode,
+		request.Expires,
+		request.Scopes,
+		request.UserID,
+		request.LoginHint,
+		request.BindingMessage,
+		notificationToken,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(model, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
 func (c *Commands) ApproveDeviceAuth(ctx context.Context, deviceCode, subject string, authMethods []domain.UserAuthMethodType, authTime time.Time) (*domain.ObjectDetails, error) {
 	model, err := c.getDeviceAuthWriteModelByDeviceCode(ctx, deviceCode)
 	if err != nil {
@@ -43,6 +97,10 @@ func (c *Commands) ApproveDeviceAuth(ctx context.Context, deviceCode, subject st
 	if !model.State.Exists() {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Hief9", "Errors.DeviceAuth.NotFound")
 	}
+	// a backchannel authentication request can only be approved by the requested user
+	if model.UserID != "" && model.UserID != subject {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-ieX7h", "Errors.DeviceAuth.UserMismatch")
+	}
 	aggr := deviceauth.NewAggregate(model.AggregateID, model.InstanceID)
 
 	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewApprovedEvent(ctx, aggr, subject, authMethods, authTime))
@@ -79,6 +137,37 @@ func (c *Commands) CancelDeviceAuth(ctx context.Context, id string, reason domai
 	return writeModelToObjectDetails(&model.WriteModel), nil
 }
 
+// CIBAUserNotified records that the user was asked to approve the backchannel authentication request
+func (c *Commands) CIBAUserNotified(ctx context.Context, authReqID string) error {
+	aggr, err := c.existingDeviceAuthAggregate(ctx, authReqID)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, deviceauth.NewUserNotifiedEvent(ctx, aggr))
+	return err
+}
+
+// CIBAClientNotified records that the client was notified about the completion of the backchannel authentication request (ping mode)
+func (c *Commands) CIBAClientNotified(ctx context.Context, authReqID string) error {
+	aggr, err := c.existingDeviceAuthAggregate(ctx, authReqID)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, deviceauth.NewClientNotifiedEvent(ctx, aggr))
+	return err
+}
+
+func (c *Commands) existingDeviceAuthAggregate(ctx context.Context, deviceCode string) (*eventstore.Aggregate, error) {
+	model, err := c.getDeviceAuthWriteModelByDeviceCode(ctx, deviceCode)
+	if err != nil {
+		return nil, err
+	}
+	if !model.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-aeW5i", "Errors.DeviceAuth.NotFound")
+	}
+	return deviceauth.NewAggregate(model.AggregateID, model.InstanceID), nil
+}
+
 func (c *Commands) getDeviceAuthWriteModelByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthWriteModel, error) {
 	model := &DeviceAuthWriteModel{WriteModel: eventstore.WriteModel{AggregateID: deviceCode}}
 	err := c.eventstore.FilterToQueryReducer(ctx, model)
@@ -20,6 +20,7 @@ type DeviceAuthWriteModel struct {
 	Subject         string
 	UserAuthMethods []domain.UserAuthMethodType
 	AuthTime        time.Time
+	UserID          string
 }
 
 func NewDeviceAuthWriteModel(deviceCode, resourceOwner string) *DeviceAuthWriteModel {
@@ -41,6 +42,7 @@ func (m *DeviceAuthWriteModel) Reduce() error {
 			m.Expires = e.Expires
 			m.Scopes = e.Scopes
 			m.State = e.State
+			m.UserID = e.UserID
 		case *deviceauth.ApprovedEvent:
 			m.State = domain.DeviceAuthStateApproved
 			m.Subject = e.Subject
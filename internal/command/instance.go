@@ -310,7 +310,9 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 		return "", "", nil, nil, err
 	}
 	setupCustomDomain(c, &validations, instanceAgg, setup.CustomDomain)
-	setupSMTPSettings(c, &validations, setup.SMTPConfiguration, instanceAgg)
+	if err := setupSMTPSettings(c, &validations, setup.SMTPConfiguration, instanceAgg); err != nil {
+		return "", "", nil, nil, err
+	}
 	setupOIDCSettings(c, &validations, setup.OIDCSettings, instanceAgg)
 	setupFeatures(&validations, setup.Features, instanceID)
 	setupLimits(c, &validations, limitsAgg, setup.Limits)
@@ -384,22 +386,26 @@ func setupOIDCSettings(commands *Commands, validations *[]preparation.Validation
 	)
 }
 
-func setupSMTPSettings(commands *Commands, validations *[]preparation.Validation, smtpConfig *smtp.Config, instanceAgg *instance.Aggregate) {
+func setupSMTPSettings(commands *Commands, validations *[]preparation.Validation, smtpConfig *smtp.Config, instanceAgg *instance.Aggregate) error {
 	if smtpConfig == nil {
-		return
+		return nil
+	}
+	id, err := commands.idGenerator.Next()
+	if err != nil {
+		return err
 	}
 	*validations = append(*validations,
 		commands.prepareAddSMTPConfig(
 			instanceAgg,
-			smtpConfig.From,
-			smtpConfig.FromName,
-			smtpConfig.ReplyToAddress,
-			smtpConfig.SMTP.Host,
-			smtpConfig.SMTP.User,
-			[]byte(smtpConfig.SMTP.Password),
-			smtpConfig.Tls,
+			id,
+			&SMTPConfig{
+				Description: "default",
+				Config:      smtpConfig,
+			},
+			true,
 		),
 	)
+	return nil
 }
 
 func setupCustomDomain(commands *Commands, validations *[]preparation.Validation, instanceAgg *instance.Aggregate, customDomain string) {
@@ -12,6 +12,10 @@ import (
 type InstanceSMTPConfigWriteModel struct {
 	eventstore.WriteModel
 
+	ID             string
+	OrgID          string
+	Description    string
+	Priority       int32
 	SenderAddress  string
 	SenderName     string
 	ReplyToAddress string
@@ -19,19 +23,30 @@ type InstanceSMTPConfigWriteModel struct {
 	Host           string
 	User           string
 	Password       *crypto.CryptoValue
-	State          domain.SMTPConfigState
+	// HTTP is set if the emails are sent using an HTTP API instead of SMTP
+	HTTP  *HTTPEmailConfig
+	State domain.SMTPConfigState
 
 	domain                                 string
 	domainState                            domain.InstanceDomainState
 	smtpSenderAddressMatchesInstanceDomain bool
 }
 
-func NewInstanceSMTPConfigWriteModel(instanceID, domain string) *InstanceSMTPConfigWriteModel {
+type HTTPEmailConfig struct {
+	Endpoint     string
+	Method       string
+	ContentType  string
+	BodyTemplate string
+	Headers      *crypto.CryptoValue
+}
+
+func NewInstanceSMTPConfigWriteModel(instanceID, id, domain string) *InstanceSMTPConfigWriteModel {
 	return &InstanceSMTPConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
 			AggregateID:   instanceID,
 			ResourceOwner: instanceID,
 		},
+		ID:     id,
 		domain: domain,
 	}
 }
@@ -49,6 +64,51 @@ func (wm *InstanceSMTPConfigWriteModel) AppendEvents(events ...eventstore.Event)
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigAddedEvent:
+			if instance.SMTPConfigID(e.ID, e.Aggregate()) != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigChangedEvent:
+			if instance.SMTPConfigID(e.ID, e.Aggregate()) != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigPasswordChangedEvent:
+			if instance.SMTPConfigID(e.ID, e.Aggregate()) != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigHTTPAddedEvent:
+			if e.ID != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigHTTPChangedEvent:
+			if e.ID != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigHTTPHeadersChangedEvent:
+			if e.ID != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigActivatedEvent:
+			if e.ID != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigDeactivatedEvent:
+			if e.ID != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.SMTPConfigRemovedEvent:
+			if instance.SMTPConfigID(e.ID, e.Aggregate()) != wm.ID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		default:
 			wm.WriteModel.AppendEvents(e)
 		}
@@ -60,6 +120,9 @@ func (wm *InstanceSMTPConfigWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
 		case *instance.SMTPConfigAddedEvent:
+			wm.OrgID = e.OrgID
+			wm.Description = e.Description
+			wm.Priority = e.Priority
 			wm.TLS = e.TLS
 			wm.SenderAddress = e.SenderAddress
 			wm.SenderName = e.SenderName
@@ -67,8 +130,18 @@ func (wm *InstanceSMTPConfigWriteModel) Reduce() error {
 			wm.Host = e.Host
 			wm.User = e.User
 			wm.Password = e.Password
-			wm.State = domain.SMTPConfigStateActive
+			wm.State = domain.SMTPConfigStateInactive
+			// the former single SMTP configuration was active as soon as it was added
+			if e.ID == "" {
+				wm.State = domain.SMTPConfigStateActive
+			}
 		case *instance.SMTPConfigChangedEvent:
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Priority != nil {
+				wm.Priority = *e.Priority
+			}
 			if e.TLS != nil {
 				wm.TLS = *e.TLS
 			}
@@ -87,8 +160,68 @@ func (wm *InstanceSMTPConfigWriteModel) Reduce() error {
 			if e.User != nil {
 				wm.User = *e.User
 			}
+		case *instance.SMTPConfigPasswordChangedEvent:
+			wm.Password = e.Password
+		case *instance.SMTPConfigHTTPAddedEvent:
+			wm.OrgID = e.OrgID
+			wm.Description = e.Description
+			wm.Priority = e.Priority
+			wm.SenderAddress = e.SenderAddress
+			wm.SenderName = e.SenderName
+			wm.ReplyToAddress = e.ReplyToAddress
+			wm.HTTP = &HTTPEmailConfig{
+				Endpoint:     e.Endpoint,
+				Method:       e.Method,
+				ContentType:  e.ContentType,
+				BodyTemplate: e.BodyTemplate,
+				Headers:      e.Headers,
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *instance.SMTPConfigHTTPChangedEvent:
+			if wm.HTTP == nil {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Priority != nil {
+				wm.Priority = *e.Priority
+			}
+			if e.SenderAddress != nil {
+				wm.SenderAddress = *e.SenderAddress
+			}
+			if e.SenderName != nil {
+				wm.SenderName = *e.SenderName
+			}
+			if e.ReplyToAddress != nil {
+				wm.ReplyToAddress = *e.ReplyToAddress
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+			if e.Method != nil {
+				wm.HTTP.Method = *e.Method
+			}
+			if e.ContentType != nil {
+				wm.HTTP.ContentType = *e.ContentType
+			}
+			if e.BodyTemplate != nil {
+				wm.HTTP.BodyTemplate = *e.BodyTemplate
+			}
+		case *instance.SMTPConfigHTTPHeadersChangedEvent:
+			if wm.HTTP == nil {
+				continue
+			}
+			wm.HTTP.Headers = e.Headers
+		case *instance.SMTPConfigActivatedEvent:
+			wm.State = domain.SMTPConfigStateActive
+		case *instance.SMTPConfigDeactivatedEvent:
+			wm.State = domain.SMTPConfigStateInactive
 		case *instance.SMTPConfigRemovedEvent:
 			wm.State = domain.SMTPConfigStateRemoved
+			wm.OrgID = ""
+			wm.Description = ""
+			wm.Priority = 0
 			wm.TLS = false
 			wm.SenderName = ""
 			wm.SenderAddress = ""
@@ -96,6 +229,7 @@ func (wm *InstanceSMTPConfigWriteModel) Reduce() error {
 			wm.Host = ""
 			wm.User = ""
 			wm.Password = nil
+			wm.HTTP = nil
 		case *instance.DomainAddedEvent:
 			wm.domainState = domain.InstanceDomainStateActive
 		case *instance.DomainRemovedEvent:
@@ -122,6 +256,11 @@ func (wm *InstanceSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMTPConfigRemovedEventType,
 			instance.SMTPConfigChangedEventType,
 			instance.SMTPConfigPasswordChangedEventType,
+			instance.SMTPConfigActivatedEventType,
+			instance.SMTPConfigDeactivatedEventType,
+			instance.SMTPConfigHTTPAddedEventType,
+			instance.SMTPConfigHTTPChangedEventType,
+			instance.SMTPConfigHTTPHeadersChangedEventType,
 			instance.InstanceDomainAddedEventType,
 			instance.InstanceDomainRemovedEventType,
 			instance.DomainPolicyAddedEventType,
@@ -129,10 +268,16 @@ func (wm *InstanceSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
-func (wm *InstanceSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string) (*instance.SMTPConfigChangedEvent, bool, error) {
+func (wm *InstanceSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description string, priority int32, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string) (*instance.SMTPConfigChangedEvent, bool, error) {
 	changes := make([]instance.SMTPConfigChanges, 0)
 	var err error
 
+	if wm.Description != description {
+		changes = append(changes, instance.ChangeSMTPConfigDescription(description))
+	}
+	if wm.Priority != priority {
+		changes = append(changes, instance.ChangeSMTPConfigPriority(priority))
+	}
 	if wm.TLS != tls {
 		changes = append(changes, instance.ChangeSMTPConfigTLS(tls))
 	}
@@ -155,7 +300,48 @@ func (wm *InstanceSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, agg
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
-	changeEvent, err := instance.NewSMTPConfigChangeEvent(ctx, aggregate, changes)
+	changeEvent, err := instance.NewSMTPConfigChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *InstanceSMTPConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description string, priority int32, senderAddress, senderName, replyToAddress, endpoint, method, contentType, bodyTemplate string) (*instance.SMTPConfigHTTPChangedEvent, bool, error) {
+	changes := make([]instance.SMTPConfigHTTPChanges, 0)
+
+	if wm.Description != description {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPDescription(description))
+	}
+	if wm.Priority != priority {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPPriority(priority))
+	}
+	if wm.SenderAddress != senderAddress {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPSenderAddress(senderAddress))
+	}
+	if wm.SenderName != senderName {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPSenderName(senderName))
+	}
+	if wm.ReplyToAddress != replyToAddress {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPReplyToAddress(replyToAddress))
+	}
+	if wm.HTTP.Endpoint != endpoint {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPEndpoint(endpoint))
+	}
+	if wm.HTTP.Method != method {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPMethod(method))
+	}
+	if wm.HTTP.ContentType != contentType {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPContentType(contentType))
+	}
+	if wm.HTTP.BodyTemplate != bodyTemplate {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPBodyTemplate(bodyTemplate))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMTPConfigHTTPChangedEvent(ctx, aggregate, id, changes)
 	if err != nil {
 		return nil, false, err
 	}
@@ -0,0 +1,286 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// minLDAPSyncInterval prevents directories from being queried permanently
+const minLDAPSyncInterval = time.Minute
+
+// LDAPSync configures the periodic import of the users of an LDAP identity provider
+type LDAPSync struct {
+	// OrgID is the organization the users are created in
+	OrgID    string
+	Interval time.Duration
+	// GroupsAttribute of the user entry containing the DNs of the groups, defaults to memberOf
+	GroupsAttribute string
+	// DeactivateMissing deactivates linked users which are not returned by the directory anymore
+	DeactivateMissing bool
+	GroupRoleMappings []*ldapsync.GroupRoleMapping
+}
+
+func (s *LDAPSync) IsValid() error {
+	if s.OrgID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ohd5X", "Errors.LDAPSync.OrgMissing")
+	}
+	if s.Interval < minLDAPSyncInterval {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieph3", "Errors.LDAPSync.IntervalTooShort")
+	}
+	for _, mapping := range s.GroupRoleMappings {
+		if mapping.Group == "" || mapping.ProjectID == "" || len(mapping.RoleKeys) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Bae6u", "Errors.LDAPSync.GroupRoleMappingInvalid")
+		}
+	}
+	return nil
+}
+
+// LDAPSyncRunResult summarizes the changes of a finished run
+type LDAPSyncRunResult struct {
+	UsersCreated     uint32
+	UsersUpdated     uint32
+	UsersDeactivated uint32
+	UsersReactivated uint32
+	GrantsChanged    uint32
+	// Errors of users which could not be synchronized
+	Errors []string
+}
+
+// SetInstanceLDAPSync configures the synchronization of the LDAP identity provider of the instance.
+func (c *Commands) SetInstanceLDAPSync(ctx context.Context, idpID string, sync *LDAPSync) (*domain.ObjectDetails, error) {
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Oot4u", "Errors.IDMissing")
+	}
+	if err := sync.IsValid(); err != nil {
+		return nil, err
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	idpWriteModel := NewLDAPInstanceIDPWriteModel(instanceID, idpID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, idpWriteModel); err != nil {
+		return nil, err
+	}
+	if !idpWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ahsh9", "Errors.IDPConfig.NotExisting")
+	}
+	if err := c.checkOrgExists(ctx, sync.OrgID); err != nil {
+		return nil, err
+	}
+	for _, mapping := range sync.GroupRoleMappings {
+		if err := c.checkProjectExists(ctx, mapping.ProjectID, ""); err != nil {
+			return nil, err
+		}
+	}
+	wm, err := c.getLDAPSyncWriteModel(ctx, idpID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.hasChanged(sync) {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, ldapsync.NewSetEvent(
+		ctx,
+		LDAPSyncAggregateFromWriteModel(&wm.WriteModel),
+		sync.OrgID,
+		sync.Interval,
+		sync.GroupsAttribute,
+		sync.DeactivateMissing,
+		sync.GroupRoleMappings,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveInstanceLDAPSync stops the synchronization of the LDAP identity provider of the instance.
+// Users already imported are kept.
+func (c *Commands) RemoveInstanceLDAPSync(ctx context.Context, idpID string) (*domain.ObjectDetails, error) {
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ub5ai", "Errors.IDMissing")
+	}
+	wm, err := c.getLDAPSyncWriteModel(ctx, idpID, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Eiv7o", "Errors.LDAPSync.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, ldapsync.NewRemovedEvent(
+		ctx,
+		LDAPSyncAggregateFromWriteModel(&wm.WriteModel),
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// StartLDAPSyncRun records the start of a run and returns its id.
+// It fails if another run was started less than staleAfter ago and is not yet finished.
+func (c *Commands) StartLDAPSyncRun(ctx context.Context, idpID, instanceID string, staleAfter time.Duration) (string, error) {
+	if idpID == "" || instanceID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooy1e", "Errors.IDMissing")
+	}
+	wm, err := c.getLDAPSyncWriteModel(ctx, idpID, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if !wm.State.Exists() {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-Xoo8a", "Errors.LDAPSync.NotFound")
+	}
+	if wm.isRunning(time.Now(), staleAfter) {
+		return "", zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gah2i", "Errors.LDAPSync.AlreadyRunning")
+	}
+	runID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, ldapsync.NewRunStartedEvent(
+		ctx,
+		LDAPSyncAggregateFromWriteModel(&wm.WriteModel),
+		runID,
+	)); err != nil {
+		return "", err
+	}
+	return runID, nil
+}
+
+// LDAPSyncRunSucceeded records the result of the run.
+func (c *Commands) LDAPSyncRunSucceeded(ctx context.Context, idpID, instanceID, runID string, result *LDAPSyncRunResult) error {
+	wm, err := c.runningLDAPSyncWriteModel(ctx, idpID, instanceID, runID)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, wm, ldapsync.NewRunSucceededEvent(
+		ctx,
+		LDAPSyncAggregateFromWriteModel(&wm.WriteModel),
+		runID,
+		result.UsersCreated,
+		result.UsersUpdated,
+		result.UsersDeactivated,
+		result.UsersReactivated,
+		result.GrantsChanged,
+		result.Errors,
+	))
+}
+
+// LDAPSyncRunFailed records the error which prevented the run from reading the directory.
+func (c *Commands) LDAPSyncRunFailed(ctx context.Context, idpID, instanceID, runID string, runErr error) error {
+	wm, err := c.runningLDAPSyncWriteModel(ctx, idpID, instanceID, runID)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, wm, ldapsync.NewRunFailedEvent(
+		ctx,
+		LDAPSyncAggregateFromWriteModel(&wm.WriteModel),
+		runID,
+		runErr,
+	))
+}
+
+// runningLDAPSyncWriteModel ensures the run wasn't finished in the meantime, e.g. after it was considered stale.
+func (c *Commands) runningLDAPSyncWriteModel(ctx context.Context, idpID, instanceID, runID string) (*LDAPSyncWriteModel, error) {
+	if idpID == "" || instanceID == "" || runID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-aiH4o", "Errors.IDMissing")
+	}
+	wm, err := c.getLDAPSyncWriteModel(ctx, idpID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if wm.RunID != runID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Que4a", "Errors.LDAPSync.RunNotRunning")
+	}
+	return wm, nil
+}
+
+func (c *Commands) getLDAPSyncWriteModel(ctx context.Context, idpID, resourceOwner string) (*LDAPSyncWriteModel, error) {
+	wm := NewLDAPSyncWriteModel(idpID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// LDAPSyncUserGrant is the state of the user grant on a project managed by group role mappings.
+type LDAPSyncUserGrant struct {
+	// GrantID of the existing user grant, empty if the user isn't granted yet
+	GrantID   string
+	ProjectID string
+	// RoleKeys granted by the groups of the user, the user grant is removed if empty
+	RoleKeys []string
+}
+
+// SetLDAPSyncUserGrants adds, changes or removes the user grants of a synchronized user in the organization.
+// In contrast to the user grant commands, the permissions on the projects are not checked,
+// as the grants are configured by the group role mappings of the LDAP sync.
+// It returns the amount of changed user grants.
+func (c *Commands) SetLDAPSyncUserGrants(ctx context.Context, userID, resourceOwner string, grants []*LDAPSyncUserGrant) (uint32, error) {
+	if userID == "" || resourceOwner == "" {
+		return 0, zerrors.ThrowInvalidArgument(nil, "COMMAND-eiT0u", "Errors.IDMissing")
+	}
+	var changed uint32
+	for _, grant := range grants {
+		if grant.GrantID == "" {
+			if len(grant.RoleKeys) == 0 {
+				continue
+			}
+			cmd, _, err := c.addUserGrant(ctx, &domain.UserGrant{
+				UserID:    userID,
+				ProjectID: grant.ProjectID,
+				RoleKeys:  grant.RoleKeys,
+			}, resourceOwner)
+			if err != nil {
+				return changed, err
+			}
+			if _, err = c.eventstore.Push(ctx, cmd); err != nil {
+				return changed, err
+			}
+			changed++
+			continue
+		}
+		existing, err := c.userGrantWriteModelByID(ctx, grant.GrantID, resourceOwner)
+		if err != nil {
+			return changed, err
+		}
+		if existing.State == domain.UserGrantStateUnspecified || existing.State == domain.UserGrantStateRemoved {
+			return changed, zerrors.ThrowNotFound(nil, "COMMAND-Ua7ae", "Errors.UserGrant.NotFound")
+		}
+		agg := UserGrantAggregateFromWriteModel(&existing.WriteModel)
+		if len(grant.RoleKeys) == 0 {
+			if _, err = c.eventstore.Push(ctx, usergrant.NewUserGrantRemovedEvent(ctx, agg, existing.UserID, existing.ProjectID, existing.ProjectGrantID)); err != nil {
+				return changed, err
+			}
+			changed++
+			continue
+		}
+		if equalRoleKeys(existing.RoleKeys, grant.RoleKeys) {
+			continue
+		}
+		err = c.checkUserGrantPreCondition(ctx, &domain.UserGrant{
+			UserID:         userID,
+			ProjectID:      existing.ProjectID,
+			ProjectGrantID: existing.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+		}, resourceOwner)
+		if err != nil {
+			return changed, err
+		}
+		if _, err = c.eventstore.Push(ctx, usergrant.NewUserGrantChangedEvent(ctx, agg, grant.RoleKeys)); err != nil {
+			return changed, err
+		}
+		changed++
+	}
+	return changed, nil
+}
+
+func equalRoleKeys(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
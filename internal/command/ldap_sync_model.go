@@ -0,0 +1,101 @@
+package command
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+)
+
+type LDAPSyncWriteModel struct {
+	eventstore.WriteModel
+
+	State             domain.LDAPSyncState
+	OrgID             string
+	Interval          time.Duration
+	GroupsAttribute   string
+	DeactivateMissing bool
+	GroupRoleMappings []*ldapsync.GroupRoleMapping
+
+	// RunID is set as long as a run is started and not yet finished
+	RunID        string
+	RunStartedAt time.Time
+}
+
+func NewLDAPSyncWriteModel(idpID, resourceOwner string) *LDAPSyncWriteModel {
+	return &LDAPSyncWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   idpID,
+			ResourceOwner: resourceOwner,
+			InstanceID:    resourceOwner,
+		},
+	}
+}
+
+func (wm *LDAPSyncWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *ldapsync.SetEvent:
+			wm.State = domain.LDAPSyncStateActive
+			wm.OrgID = e.OrgID
+			wm.Interval = e.Interval
+			wm.GroupsAttribute = e.GroupsAttribute
+			wm.DeactivateMissing = e.DeactivateMissing
+			wm.GroupRoleMappings = e.GroupRoleMappings
+		case *ldapsync.RemovedEvent:
+			wm.State = domain.LDAPSyncStateRemoved
+			wm.OrgID = ""
+			wm.Interval = 0
+			wm.GroupsAttribute = ""
+			wm.DeactivateMissing = false
+			wm.GroupRoleMappings = nil
+		case *ldapsync.RunStartedEvent:
+			wm.RunID = e.RunID
+			wm.RunStartedAt = e.CreationDate()
+		case *ldapsync.RunSucceededEvent:
+			wm.RunID = ""
+			wm.RunStartedAt = time.Time{}
+		case *ldapsync.RunFailedEvent:
+			wm.RunID = ""
+			wm.RunStartedAt = time.Time{}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *LDAPSyncWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(ldapsync.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(ldapsync.SetEventType,
+			ldapsync.RemovedEventType,
+			ldapsync.RunStartedEventType,
+			ldapsync.RunSucceededEventType,
+			ldapsync.RunFailedEventType).
+		Builder()
+}
+
+// isRunning returns true if a run was started and neither finished nor stale.
+// A run is stale if it wasn't finished after staleAfter, e.g. because the worker was stopped.
+func (wm *LDAPSyncWriteModel) isRunning(now time.Time, staleAfter time.Duration) bool {
+	return wm.RunID != "" && now.Before(wm.RunStartedAt.Add(staleAfter))
+}
+
+func (wm *LDAPSyncWriteModel) hasChanged(sync *LDAPSync) bool {
+	return wm.State != domain.LDAPSyncStateActive ||
+		wm.OrgID != sync.OrgID ||
+		wm.Interval != sync.Interval ||
+		wm.GroupsAttribute != sync.GroupsAttribute ||
+		wm.DeactivateMissing != sync.DeactivateMissing ||
+		!slices.EqualFunc(wm.GroupRoleMappings, sync.GroupRoleMappings, func(a, b *ldapsync.GroupRoleMapping) bool {
+			return a.Group == b.Group && a.ProjectID == b.ProjectID && slices.Equal(a.RoleKeys, b.RoleKeys)
+		})
+}
+
+func LDAPSyncAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return ldapsync.NewAggregate(wm.AggregateID, wm.ResourceOwner, wm.InstanceID)
+}
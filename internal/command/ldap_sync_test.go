@@ -0,0 +1,396 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func ldapIDPAddedEvent() eventstore.Event {
+	return eventFromEventPusher(
+		instance.NewLDAPIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+			"idp1",
+			"name",
+			[]string{"server"},
+			false,
+			"baseDN",
+			"dn",
+			&crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("password"),
+			},
+			"user",
+			[]string{"object"},
+			[]string{"filter"},
+			time.Second*30,
+			idp.LDAPAttributes{},
+			idp.Options{},
+		),
+	)
+}
+
+func ldapSyncSetEvent(mappings []*ldapsync.GroupRoleMapping) *ldapsync.SetEvent {
+	return ldapsync.NewSetEvent(context.Background(),
+		ldapsync.NewAggregate("idp1", "instance1", "instance1"),
+		"org1",
+		time.Hour,
+		"",
+		true,
+		mappings,
+	)
+}
+
+func TestCommands_SetInstanceLDAPSync(t *testing.T) {
+	mappings := []*ldapsync.GroupRoleMapping{
+		{Group: "cn=admins,dc=example,dc=com", ProjectID: "project1", RoleKeys: []string{"admin"}},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		idpID string
+		sync  *LDAPSync
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing org, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				idpID: "idp1",
+				sync:  &LDAPSync{Interval: time.Hour},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-ohd5X", "Errors.LDAPSync.OrgMissing"))
+				},
+			},
+		},
+		{
+			"interval too short, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				idpID: "idp1",
+				sync:  &LDAPSync{OrgID: "org1", Interval: time.Second},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieph3", "Errors.LDAPSync.IntervalTooShort"))
+				},
+			},
+		},
+		{
+			"invalid mapping, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				idpID: "idp1",
+				sync: &LDAPSync{OrgID: "org1", Interval: time.Hour, GroupRoleMappings: []*ldapsync.GroupRoleMapping{
+					{Group: "cn=admins,dc=example,dc=com", ProjectID: "project1"},
+				}},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bae6u", "Errors.LDAPSync.GroupRoleMappingInvalid"))
+				},
+			},
+		},
+		{
+			"idp not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				idpID: "idp1",
+				sync:  &LDAPSync{OrgID: "org1", Interval: time.Hour},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Ahsh9", "Errors.IDPConfig.NotExisting"))
+				},
+			},
+		},
+		{
+			"set, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(ldapIDPAddedEvent()),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(ldapSyncSetEvent(mappings)),
+				),
+			},
+			args{
+				idpID: "idp1",
+				sync: &LDAPSync{
+					OrgID:             "org1",
+					Interval:          time.Hour,
+					DeactivateMissing: true,
+					GroupRoleMappings: mappings,
+				},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			"unchanged, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(ldapIDPAddedEvent()),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(ldapSyncSetEvent(nil)),
+					),
+				),
+			},
+			args{
+				idpID: "idp1",
+				sync: &LDAPSync{
+					OrgID:             "org1",
+					Interval:          time.Hour,
+					DeactivateMissing: true,
+				},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetInstanceLDAPSync(authz.WithInstanceID(context.Background(), "instance1"), tt.args.idpID, tt.args.sync)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_StartLDAPSyncRun(t *testing.T) {
+	agg := ldapsync.NewAggregate("idp1", "instance1", "instance1")
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type res struct {
+		runID string
+		err   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Xoo8a", "Errors.LDAPSync.NotFound"))
+				},
+			},
+		},
+		{
+			"already running, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncSetEvent(nil)),
+						eventFromEventPusherWithCreationDateNow(
+							ldapsync.NewRunStartedEvent(context.Background(), agg, "run1"),
+						),
+					),
+				),
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gah2i", "Errors.LDAPSync.AlreadyRunning"))
+				},
+			},
+		},
+		{
+			"stale run, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncSetEvent(nil)),
+						eventFromEventPusher(
+							ldapsync.NewRunStartedEvent(context.Background(), agg, "run1"),
+						),
+					),
+					expectPush(
+						ldapsync.NewRunStartedEvent(context.Background(), agg, "run2"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "run2"),
+			},
+			res{
+				runID: "run2",
+			},
+		},
+		{
+			"finished run, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncSetEvent(nil)),
+						eventFromEventPusherWithCreationDateNow(
+							ldapsync.NewRunStartedEvent(context.Background(), agg, "run1"),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							ldapsync.NewRunFailedEvent(context.Background(), agg, "run1", errors.New("unreachable")),
+						),
+					),
+					expectPush(
+						ldapsync.NewRunStartedEvent(context.Background(), agg, "run2"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "run2"),
+			},
+			res{
+				runID: "run2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.StartLDAPSyncRun(context.Background(), "idp1", "instance1", time.Hour)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.runID, got)
+		})
+	}
+}
+
+func TestCommands_LDAPSyncRunSucceeded(t *testing.T) {
+	agg := ldapsync.NewAggregate("idp1", "instance1", "instance1")
+	result := &LDAPSyncRunResult{
+		UsersCreated: 2,
+		Errors:       []string{"user3: Errors.User.Email.Empty"},
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		err        func(error) bool
+	}{
+		{
+			"other run, error",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(ldapSyncSetEvent(nil)),
+					eventFromEventPusher(
+						ldapsync.NewRunStartedEvent(context.Background(), agg, "run2"),
+					),
+				),
+			),
+			func(err error) bool {
+				return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Que4a", "Errors.LDAPSync.RunNotRunning"))
+			},
+		},
+		{
+			"succeeded, ok",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(ldapSyncSetEvent(nil)),
+					eventFromEventPusher(
+						ldapsync.NewRunStartedEvent(context.Background(), agg, "run1"),
+					),
+				),
+				expectPush(
+					ldapsync.NewRunSucceededEvent(context.Background(), agg, "run1", 2, 0, 0, 0, 0, []string{"user3: Errors.User.Email.Empty"}),
+				),
+			),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.LDAPSyncRunSucceeded(context.Background(), "idp1", "instance1", "run1", result)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
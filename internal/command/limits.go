@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/limits"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -16,6 +17,9 @@ import (
 type SetLimits struct {
 	AuditLogRetention *time.Duration
 	Block             *bool
+	// RateLimits override the configured default rate limits.
+	// Only the rules which are not nil are changed.
+	RateLimits *ratelimit.Rules
 }
 
 // SetLimits creates new limits or updates existing limits.
@@ -144,9 +148,12 @@ func (c *Commands) getBulkInstanceLimitsWriteModel(ctx context.Context, target [
 
 func (c *Commands) SetLimitsCommand(a *limits.Aggregate, wm *limitsWriteModel, setLimits *SetLimits) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
-		if setLimits == nil || (setLimits.AuditLogRetention == nil && setLimits.Block == nil) {
+		if setLimits == nil || (setLimits.AuditLogRetention == nil && setLimits.Block == nil && setLimits.RateLimits.IsZero()) {
 			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-4M9vs", "Errors.Limits.NoneSpecified")
 		}
+		if !setLimits.RateLimits.Valid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeng7", "Errors.Limits.RateLimit.Invalid")
+		}
 		return func(ctx context.Context, _ preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			changes := wm.NewChanges(setLimits)
 			if len(changes) == 0 {
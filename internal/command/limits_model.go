@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/limits"
 )
 
@@ -12,6 +13,7 @@ type limitsWriteModel struct {
 	rollingAggregateID string
 	auditLogRetention  *time.Duration
 	block              *bool
+	rateLimits         ratelimit.Rules
 }
 
 // newLimitsWriteModel aggregateId is filled by reducing unit matching events
@@ -50,10 +52,12 @@ func (wm *limitsWriteModel) Reduce() error {
 			if e.Block != nil {
 				wm.block = e.Block
 			}
+			wm.rateLimits = wm.rateLimits.Override(e.RateLimits)
 		case *limits.ResetEvent:
 			wm.rollingAggregateID = ""
 			wm.auditLogRetention = nil
 			wm.block = nil
+			wm.rateLimits = ratelimit.Rules{}
 		}
 	}
 	if err := wm.WriteModel.Reduce(); err != nil {
@@ -77,5 +81,32 @@ func (wm *limitsWriteModel) NewChanges(setLimits *SetLimits) (changes []limits.L
 	if setLimits.Block != nil && (wm.block == nil || *wm.block != *setLimits.Block) {
 		changes = append(changes, limits.ChangeBlock(setLimits.Block))
 	}
+	if rateLimits := wm.rateLimitChanges(setLimits.RateLimits); rateLimits != nil {
+		changes = append(changes, limits.ChangeRateLimits(rateLimits))
+	}
+	return changes
+}
+
+// rateLimitChanges returns only the rules which differ from the current ones or nil if nothing changed
+func (wm *limitsWriteModel) rateLimitChanges(rateLimits *ratelimit.Rules) *ratelimit.Rules {
+	if rateLimits.IsZero() {
+		return nil
+	}
+	changes := new(ratelimit.Rules)
+	if rateLimits.Instance != nil && !rateLimits.Instance.Equal(wm.rateLimits.Instance) {
+		changes.Instance = rateLimits.Instance
+	}
+	if rateLimits.Org != nil && !rateLimits.Org.Equal(wm.rateLimits.Org) {
+		changes.Org = rateLimits.Org
+	}
+	if rateLimits.Client != nil && !rateLimits.Client.Equal(wm.rateLimits.Client) {
+		changes.Client = rateLimits.Client
+	}
+	if rateLimits.IP != nil && !rateLimits.IP.Equal(wm.rateLimits.IP) {
+		changes.IP = rateLimits.IP
+	}
+	if changes.IsZero() {
+		return nil
+	}
 	return changes
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/limits"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -159,6 +160,80 @@ func TestLimits_SetLimits(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "update rate limits, only changed rules pushed",
+			fields: func(*testing.T) (*eventstore.Eventstore, id.Generator) {
+				return eventstoreExpect(
+						t,
+						expectFilter(
+							eventFromEventPusher(
+								limits.NewSetEvent(
+									eventstore.NewBaseEventForPush(
+										context.Background(),
+										&limits.NewAggregate("limits1", "instance1").Aggregate,
+										limits.SetEventType,
+									),
+									limits.ChangeRateLimits(&ratelimit.Rules{
+										Instance: &ratelimit.Rule{RequestsPerSecond: 100, Burst: 200},
+										IP:       &ratelimit.Rule{RequestsPerSecond: 10, Burst: 20},
+									}),
+								),
+							),
+						),
+						expectPush(
+							eventFromEventPusherWithInstanceID(
+								"instance1",
+								limits.NewSetEvent(
+									eventstore.NewBaseEventForPush(
+										context.Background(),
+										&limits.NewAggregate("limits1", "instance1").Aggregate,
+										limits.SetEventType,
+									),
+									limits.ChangeRateLimits(&ratelimit.Rules{
+										IP: &ratelimit.Rule{RequestsPerSecond: 5, Burst: 10},
+									}),
+								),
+							),
+						),
+					),
+					nil
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				setLimits: &SetLimits{
+					RateLimits: &ratelimit.Rules{
+						Instance: &ratelimit.Rule{RequestsPerSecond: 100, Burst: 200},
+						IP:       &ratelimit.Rule{RequestsPerSecond: 5, Burst: 10},
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			name: "invalid rate limit, error",
+			fields: func(*testing.T) (*eventstore.Eventstore, id.Generator) {
+				return eventstoreExpect(
+						t,
+						expectFilter(),
+					),
+					id_mock.NewIDGeneratorExpectIDs(t, "limits1")
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				setLimits: &SetLimits{
+					RateLimits: &ratelimit.Rules{
+						Org: &ratelimit.Rule{RequestsPerSecond: 5},
+					},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "set limits after resetting limits, ok",
 			fields: func(*testing.T) (*eventstore.Eventstore, id.Generator) {
@@ -18,6 +18,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	"github.com/zitadel/zitadel/internal/eventstore/repository/mock"
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -184,6 +185,10 @@ func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) RateLimits() *ratelimit.Rules {
+	panic("shouldn't be called here")
+}
+
 func (m *mockInstance) InstanceID() string {
 	return "INSTANCE"
 }
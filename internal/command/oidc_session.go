@@ -30,8 +30,10 @@ const (
 
 // AddOIDCSessionAccessToken creates a new OIDC Session, creates an access token and returns its id and expiration.
 // If the underlying [AuthRequest] is a OIDC Auth Code Flow, it will set the code as exchanged.
-func (c *Commands) AddOIDCSessionAccessToken(ctx context.Context, authRequestID string) (string, time.Time, error) {
-	cmd, err := c.newOIDCSessionAddEvents(ctx, authRequestID)
+// If a dpopThumbprint is passed, the access token is bound to the key of the DPoP proof.
+// If a certificateThumbprint is passed, the access token is bound to the client certificate.
+func (c *Commands) AddOIDCSessionAccessToken(ctx context.Context, authRequestID, dpopThumbprint, certificateThumbprint string) (string, time.Time, error) {
+	cmd, err := c.newOIDCSessionAddEvents(ctx, authRequestID, dpopThumbprint, certificateThumbprint)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -47,8 +49,11 @@ func (c *Commands) AddOIDCSessionAccessToken(ctx context.Context, authRequestID
 // AddOIDCSessionRefreshAndAccessToken creates a new OIDC Session, creates an access token and refresh token.
 // It returns the access token id, expiration and the refresh token.
 // If the underlying [AuthRequest] is a OIDC Auth Code Flow, it will set the code as exchanged.
-func (c *Commands) AddOIDCSessionRefreshAndAccessToken(ctx context.Context, authRequestID string) (tokenID, refreshToken string, tokenExpiration time.Time, err error) {
-	cmd, err := c.newOIDCSessionAddEvents(ctx, authRequestID)
+// If a dpopThumbprint is passed, both tokens are bound to the key of the DPoP proof.
+// If a certificateThumbprint is passed, the access token is bound to the client certificate.
+// The refresh token is already bound to the client by its authentication.
+func (c *Commands) AddOIDCSessionRefreshAndAccessToken(ctx context.Context, authRequestID, dpopThumbprint, certificateThumbprint string) (tokenID, refreshToken string, tokenExpiration time.Time, err error) {
+	cmd, err := c.newOIDCSessionAddEvents(ctx, authRequestID, dpopThumbprint, certificateThumbprint)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
@@ -65,8 +70,9 @@ func (c *Commands) AddOIDCSessionRefreshAndAccessToken(ctx context.Context, auth
 
 // ExchangeOIDCSessionRefreshAndAccessToken updates an existing OIDC Session, creates a new access and refresh token.
 // It returns the access token id and expiration and the new refresh token.
-func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, oidcSessionID, refreshToken string, scope []string) (tokenID, newRefreshToken string, tokenExpiration time.Time, err error) {
-	cmd, err := c.newOIDCSessionUpdateEvents(ctx, oidcSessionID, refreshToken)
+// A refresh token bound to a DPoP key can only be used with a proof of the same key.
+func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, oidcSessionID, refreshToken string, scope []string, dpopThumbprint, certificateThumbprint string) (tokenID, newRefreshToken string, tokenExpiration time.Time, err error) {
+	cmd, err := c.newOIDCSessionUpdateEvents(ctx, oidcSessionID, refreshToken, dpopThumbprint, certificateThumbprint)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
@@ -146,7 +152,7 @@ func (c *Commands) RevokeOIDCSessionToken(ctx context.Context, token, clientID s
 	return c.pushAppendAndReduce(ctx, writeModel, oidcsession.NewAccessTokenRevokedEvent(ctx, writeModel.aggregate))
 }
 
-func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, authRequestID string) (*OIDCSessionEvents, error) {
+func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, authRequestID, dpopThumbprint, certificateThumbprint string) (*OIDCSessionEvents, error) {
 	authRequestWriteModel, err := c.getAuthRequestWriteModel(ctx, authRequestID)
 	if err != nil {
 		return nil, err
@@ -185,6 +191,8 @@ func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, authRequestID st
 		accessTokenLifetime:      accessTokenLifetime,
 		refreshTokenLifeTime:     refreshTokenLifeTime,
 		refreshTokenIdleLifetime: refreshTokenIdleLifetime,
+		dpopThumbprint:           dpopThumbprint,
+		certificateThumbprint:    certificateThumbprint,
 	}, nil
 }
 
@@ -227,7 +235,7 @@ func parseRefreshToken(refreshToken string) (oidcSessionID, refreshTokenID strin
 	return split[0], strings.Split(split[1], oidcTokenSubjectDelimiter)[0], nil
 }
 
-func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, oidcSessionID, refreshToken string) (*OIDCSessionEvents, error) {
+func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, oidcSessionID, refreshToken, dpopThumbprint, certificateThumbprint string) (*OIDCSessionEvents, error) {
 	refreshTokenID, err := c.decryptRefreshToken(refreshToken)
 	if err != nil {
 		return nil, err
@@ -239,6 +247,9 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, oidcSessionID
 	if err = sessionWriteModel.CheckRefreshToken(refreshTokenID); err != nil {
 		return nil, err
 	}
+	if err = sessionWriteModel.CheckRefreshTokenDPoPBinding(dpopThumbprint); err != nil {
+		return nil, err
+	}
 	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx)
 	if err != nil {
 		return nil, err
@@ -251,6 +262,8 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, oidcSessionID
 		accessTokenLifetime:      accessTokenLifetime,
 		refreshTokenLifeTime:     refreshTokenLifeTime,
 		refreshTokenIdleLifetime: refreshTokenIdleLifetime,
+		dpopThumbprint:           dpopThumbprint,
+		certificateThumbprint:    certificateThumbprint,
 	}, nil
 }
 
@@ -265,6 +278,8 @@ type OIDCSessionEvents struct {
 	accessTokenLifetime      time.Duration
 	refreshTokenLifeTime     time.Duration
 	refreshTokenIdleLifetime time.Duration
+	dpopThumbprint           string
+	certificateThumbprint    string
 
 	// accessTokenID is set by the command
 	accessTokenID string
@@ -297,7 +312,7 @@ func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string,
 		return err
 	}
 	c.accessTokenID = AccessTokenPrefix + accessTokenID
-	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor))
+	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor, c.dpopThumbprint, c.certificateThumbprint))
 	return nil
 }
 
@@ -307,7 +322,7 @@ func (c *OIDCSessionEvents) AddRefreshToken(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	c.events = append(c.events, oidcsession.NewRefreshTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, refreshTokenID, c.refreshTokenLifeTime, c.refreshTokenIdleLifetime))
+	c.events = append(c.events, oidcsession.NewRefreshTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, refreshTokenID, c.refreshTokenLifeTime, c.refreshTokenIdleLifetime, c.dpopThumbprint))
 	return nil
 }
 
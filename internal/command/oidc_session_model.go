@@ -29,6 +29,7 @@ type OIDCSessionWriteModel struct {
 	RefreshToken               string
 	RefreshTokenExpiration     time.Time
 	RefreshTokenIdleExpiration time.Time
+	RefreshTokenDPoPThumbprint string
 
 	aggregate *eventstore.Aggregate
 }
@@ -115,6 +116,7 @@ func (wm *OIDCSessionWriteModel) reduceRefreshTokenAdded(e *oidcsession.RefreshT
 	wm.RefreshTokenID = e.ID
 	wm.RefreshTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.RefreshTokenIdleExpiration = e.CreationDate().Add(e.IdleLifetime)
+	wm.RefreshTokenDPoPThumbprint = e.DPoPThumbprint
 }
 
 func (wm *OIDCSessionWriteModel) reduceRefreshTokenRenewed(e *oidcsession.RefreshTokenRenewedEvent) {
@@ -144,6 +146,15 @@ func (wm *OIDCSessionWriteModel) CheckRefreshToken(refreshTokenID string) error
 	return nil
 }
 
+// CheckRefreshTokenDPoPBinding checks that a refresh token bound to a DPoP key
+// is used with a proof of the same key
+func (wm *OIDCSessionWriteModel) CheckRefreshTokenDPoPBinding(dpopThumbprint string) error {
+	if wm.RefreshTokenDPoPThumbprint != "" && wm.RefreshTokenDPoPThumbprint != dpopThumbprint {
+		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-ohN8e", "Errors.Token.DPoPBindingInvalid")
+	}
+	return nil
+}
+
 func (wm *OIDCSessionWriteModel) CheckAccessToken(accessTokenID string) error {
 	if wm.State != domain.OIDCSessionStateActive {
 		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-KL2pk", "Errors.OIDCSession.Token.Invalid")
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type OrgFeatures struct {
+	UserSchema    *bool
+	TokenExchange *bool
+}
+
+func (m *OrgFeatures) isEmpty() bool {
+	return m.UserSchema == nil &&
+		m.TokenExchange == nil
+}
+
+func (c *Commands) SetOrgFeatures(ctx context.Context, orgID string, f *OrgFeatures) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iej4x", "Errors.IDMissing")
+	}
+	if f.isEmpty() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-aeKo3", "Errors.NoChangesFound")
+	}
+	if err := c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionOrgFeatureWrite, orgID, orgID); err != nil {
+		return nil, err
+	}
+	wm := NewOrgFeaturesWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	cmds := wm.setCommands(ctx, f)
+	if len(cmds) == 0 {
+		return writeModelToObjectDetails(wm.WriteModel), nil
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
+
+func (c *Commands) ResetOrgFeatures(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohx8i", "Errors.IDMissing")
+	}
+	if err := c.checkPermission(ctx, domain.PermissionOrgFeatureWrite, orgID, orgID); err != nil {
+		return nil, err
+	}
+	wm := NewOrgFeaturesWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if wm.isEmpty() {
+		return writeModelToObjectDetails(wm.WriteModel), nil
+	}
+	aggregate := feature_v2.NewAggregate(orgID, orgID)
+	events, err := c.eventstore.Push(ctx, feature_v2.NewResetEvent(ctx, aggregate, feature_v2.OrgResetEventType))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
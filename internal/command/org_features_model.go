@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+)
+
+type OrgFeaturesWriteModel struct {
+	*eventstore.WriteModel
+	OrgFeatures
+}
+
+func NewOrgFeaturesWriteModel(orgID string) *OrgFeaturesWriteModel {
+	m := &OrgFeaturesWriteModel{
+		WriteModel: &eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+	return m
+}
+
+func (m *OrgFeaturesWriteModel) Reduce() (err error) {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *feature_v2.ResetEvent:
+			m.reduceReset()
+		case *feature_v2.SetEvent[bool]:
+			err = m.reduceBoolFeature(e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return m.WriteModel.Reduce()
+}
+
+func (m *OrgFeaturesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(feature_v2.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			feature_v2.OrgResetEventType,
+			feature_v2.OrgUserSchemaEventType,
+			feature_v2.OrgTokenExchangeEventType,
+		).
+		Builder().ResourceOwner(m.ResourceOwner)
+}
+
+func (m *OrgFeaturesWriteModel) reduceReset() {
+	m.UserSchema = nil
+	m.TokenExchange = nil
+}
+
+func (m *OrgFeaturesWriteModel) reduceBoolFeature(event *feature_v2.SetEvent[bool]) error {
+	_, key, err := event.FeatureInfo()
+	if err != nil {
+		return err
+	}
+	switch key {
+	case feature.KeyUserSchema:
+		m.UserSchema = &event.Value
+	case feature.KeyTokenExchange:
+		m.TokenExchange = &event.Value
+	}
+	return nil
+}
+
+func (wm *OrgFeaturesWriteModel) setCommands(ctx context.Context, f *OrgFeatures) []eventstore.Command {
+	aggregate := feature_v2.NewAggregate(wm.AggregateID, wm.ResourceOwner)
+	cmds := make([]eventstore.Command, 0, 2)
+	cmds = appendFeatureUpdate(ctx, cmds, aggregate, wm.UserSchema, f.UserSchema, feature_v2.OrgUserSchemaEventType)
+	cmds = appendFeatureUpdate(ctx, cmds, aggregate, wm.TokenExchange, f.TokenExchange, feature_v2.OrgTokenExchangeEventType)
+	return cmds
+}
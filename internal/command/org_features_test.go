@@ -0,0 +1,228 @@
+package command
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetOrgFeatures(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := feature_v2.NewAggregate("org1", "org1")
+	orgAdded := eventFromEventPusher(
+		org.NewOrgAddedEvent(ctx, &org.NewAggregate("org1").Aggregate, "org"),
+	)
+
+	type args struct {
+		ctx   context.Context
+		orgID string
+		f     *OrgFeatures
+	}
+	tests := []struct {
+		name            string
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		args            args
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name:       "missing org id",
+			eventstore: expectEventstore(),
+			args: args{ctx, "", &OrgFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Iej4x", "Errors.IDMissing"),
+		},
+		{
+			name:       "all nil, No Change",
+			eventstore: expectEventstore(),
+			args:       args{ctx, "org1", &OrgFeatures{}},
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-aeKo3", "Errors.NoChangesFound"),
+		},
+		{
+			name: "org not found",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{ctx, "org1", &OrgFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+		},
+		{
+			name: "permission denied",
+			eventstore: expectEventstore(
+				expectFilter(orgAdded),
+			),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			args: args{ctx, "org1", &OrgFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilter(orgAdded),
+				expectFilterError(io.ErrClosedPipe),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{ctx, "org1", &OrgFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "set TokenExchange",
+			eventstore: expectEventstore(
+				expectFilter(orgAdded),
+				expectFilter(),
+				expectPush(
+					feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, true,
+					),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{ctx, "org1", &OrgFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "set all, some unchanged",
+			eventstore: expectEventstore(
+				expectFilter(orgAdded),
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgUserSchemaEventType, true,
+					)),
+				),
+				expectPush(
+					feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, false,
+					),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{ctx, "org1", &OrgFeatures{
+				UserSchema:    gu.Ptr(true),
+				TokenExchange: gu.Ptr(false),
+			}},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.checkPermission,
+			}
+			got, err := c.SetOrgFeatures(tt.args.ctx, tt.args.orgID, tt.args.f)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_ResetOrgFeatures(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := feature_v2.NewAggregate("org1", "org1")
+	tests := []struct {
+		name            string
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name:            "permission denied",
+			eventstore:      expectEventstore(),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			wantErr:         zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "push error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, true,
+					)),
+				),
+				expectPushFailed(io.ErrClosedPipe,
+					feature_v2.NewResetEvent(ctx, aggregate, feature_v2.OrgResetEventType),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			wantErr:         io.ErrClosedPipe,
+		},
+		{
+			name: "success",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, true,
+					)),
+				),
+				expectPush(
+					feature_v2.NewResetEvent(ctx, aggregate, feature_v2.OrgResetEventType),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "no change after previous reset",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, true,
+					)),
+					eventFromEventPusher(feature_v2.NewResetEvent(
+						ctx, aggregate,
+						feature_v2.OrgResetEventType,
+					)),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.checkPermission,
+			}
+			got, err := c.ResetOrgFeatures(ctx, "org1")
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -65,6 +65,7 @@ func (c *Commands) AddAPIAppCommand(app *addAPIApp, clientSecretAlg crypto.HashA
 					app.ClientID,
 					app.ClientSecret,
 					app.AuthMethodType,
+					"",
 				),
 			}, nil
 		}, nil
@@ -132,7 +133,8 @@ func (c *Commands) addAPIApplicationWithID(ctx context.Context, apiApp *domain.A
 		apiApp.AppID,
 		apiApp.ClientID,
 		apiApp.ClientSecret,
-		apiApp.AuthMethodType))
+		apiApp.AuthMethodType,
+		strings.TrimSpace(apiApp.TLSClientAuthSubjectDN)))
 
 	addedApplication.AppID = apiApp.AppID
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
@@ -168,7 +170,8 @@ func (c *Commands) ChangeAPIApplication(ctx context.Context, apiApp *domain.APIA
 		ctx,
 		projectAgg,
 		apiApp.AppID,
-		apiApp.AuthMethodType)
+		apiApp.AuthMethodType,
+		strings.TrimSpace(apiApp.TLSClientAuthSubjectDN))
 	if err != nil {
 		return nil, err
 	}
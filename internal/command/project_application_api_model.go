@@ -13,14 +13,15 @@ import (
 type APIApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID              string
-	AppName            string
-	ClientID           string
-	ClientSecret       *crypto.CryptoValue
-	ClientSecretString string
-	AuthMethodType     domain.APIAuthMethodType
-	State              domain.AppState
-	api                bool
+	AppID                  string
+	AppName                string
+	ClientID               string
+	ClientSecret           *crypto.CryptoValue
+	ClientSecretString     string
+	AuthMethodType         domain.APIAuthMethodType
+	TLSClientAuthSubjectDN string
+	State                  domain.AppState
+	api                    bool
 }
 
 func NewAPIApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *APIApplicationWriteModel {
@@ -128,12 +129,16 @@ func (wm *APIApplicationWriteModel) appendAddAPIEvent(e *project.APIConfigAddedE
 	wm.ClientID = e.ClientID
 	wm.ClientSecret = e.ClientSecret
 	wm.AuthMethodType = e.AuthMethodType
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
 }
 
 func (wm *APIApplicationWriteModel) appendChangeAPIEvent(e *project.APIConfigChangedEvent) {
 	if e.AuthMethodType != nil {
 		wm.AuthMethodType = *e.AuthMethodType
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
 }
 
 func (wm *APIApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -160,6 +165,7 @@ func (wm *APIApplicationWriteModel) NewChangedEvent(
 	aggregate *eventstore.Aggregate,
 	appID string,
 	authMethodType domain.APIAuthMethodType,
+	tlsClientAuthSubjectDN string,
 ) (*project.APIConfigChangedEvent, bool, error) {
 	changes := make([]project.APIConfigChanges, 0)
 	var err error
@@ -167,6 +173,9 @@ func (wm *APIApplicationWriteModel) NewChangedEvent(
 	if wm.AuthMethodType != authMethodType {
 		changes = append(changes, project.ChangeAPIAuthMethodType(authMethodType))
 	}
+	if wm.TLSClientAuthSubjectDN != tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeAPITLSClientAuthSubjectDN(tlsClientAuthSubjectDN))
+	}
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
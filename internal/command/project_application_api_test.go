@@ -116,6 +116,7 @@ func TestAddAPIConfig(t *testing.T) {
 						"clientID@project",
 						nil,
 						domain.APIAuthMethodTypePrivateKeyJWT,
+						"",
 					),
 				},
 			},
@@ -262,7 +263,9 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 								KeyID:      "id",
 								Crypted:    []byte("a"),
 							},
-							domain.APIAuthMethodTypeBasic),
+							domain.APIAuthMethodTypeBasic,
+							"",
+						),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -318,7 +321,9 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							"app1",
 							"client1@project",
 							nil,
-							domain.APIAuthMethodTypePrivateKeyJWT),
+							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
+						),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -476,7 +481,9 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 								"app1",
 								"client1@project",
 								nil,
-								domain.APIAuthMethodTypePrivateKeyJWT),
+								domain.APIAuthMethodTypePrivateKeyJWT,
+								"",
+							),
 						),
 					),
 				),
@@ -521,7 +528,9 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("a"),
 								},
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+							),
 						),
 					),
 					expectPush(
@@ -675,7 +684,9 @@ func TestCommandSide_ChangeAPIApplicationSecret(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("a"),
 								},
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+							),
 						),
 					),
 					expectPush(
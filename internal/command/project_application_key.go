@@ -67,8 +67,8 @@ func (c *Commands) addApplicationKey(ctx context.Context, key *domain.Applicatio
 		if err != nil {
 			return nil, err
 		}
-		key.ClientID = keyWriteModel.ClientID
 	}
+	key.ClientID = keyWriteModel.ClientID
 
 	pushedEvents, err := c.eventstore.Push(ctx,
 		project.NewApplicationKeyAddedEvent(
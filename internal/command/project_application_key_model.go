@@ -106,26 +106,36 @@ func (wm *ApplicationKeyWriteModel) Reduce() error {
 
 func (wm *ApplicationKeyWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAddedEvent) {
 	wm.ClientID = e.ClientID
-	wm.KeysAllowed = e.AuthMethodType == domain.OIDCAuthMethodTypePrivateKeyJWT
+	wm.KeysAllowed = oidcAuthMethodUsesKeys(e.AuthMethodType)
 }
 
 func (wm *ApplicationKeyWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
 	if e.AuthMethodType != nil {
-		wm.KeysAllowed = *e.AuthMethodType == domain.OIDCAuthMethodTypePrivateKeyJWT
+		wm.KeysAllowed = oidcAuthMethodUsesKeys(*e.AuthMethodType)
 	}
 }
 
 func (wm *ApplicationKeyWriteModel) appendAddAPIEvent(e *project.APIConfigAddedEvent) {
 	wm.ClientID = e.ClientID
-	wm.KeysAllowed = e.AuthMethodType == domain.APIAuthMethodTypePrivateKeyJWT
+	wm.KeysAllowed = apiAuthMethodUsesKeys(e.AuthMethodType)
 }
 
 func (wm *ApplicationKeyWriteModel) appendChangeAPIEvent(e *project.APIConfigChangedEvent) {
 	if e.AuthMethodType != nil {
-		wm.KeysAllowed = *e.AuthMethodType == domain.APIAuthMethodTypePrivateKeyJWT
+		wm.KeysAllowed = apiAuthMethodUsesKeys(*e.AuthMethodType)
 	}
 }
 
+// oidcAuthMethodUsesKeys returns if the client authenticates with a registered public key,
+// either by a signed assertion or a self-signed certificate
+func oidcAuthMethodUsesKeys(authMethod domain.OIDCAuthMethodType) bool {
+	return authMethod == domain.OIDCAuthMethodTypePrivateKeyJWT || authMethod == domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
+}
+
+func apiAuthMethodUsesKeys(authMethod domain.APIAuthMethodType) bool {
+	return authMethod == domain.APIAuthMethodTypePrivateKeyJWT || authMethod == domain.APIAuthMethodTypeSelfSignedTLSClientAuth
+}
+
 func (wm *ApplicationKeyWriteModel) Query() *eventstore.SearchQueryBuilder {
 	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		ResourceOwner(wm.ResourceOwner).
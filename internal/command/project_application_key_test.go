@@ -122,7 +122,9 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("a"),
 								},
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+							),
 						),
 					),
 				),
@@ -168,7 +170,9 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("a"),
 								},
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+							),
 						),
 					),
 				),
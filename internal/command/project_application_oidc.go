@@ -112,6 +112,13 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp, clientSecretAlg crypto.Has
 					app.ClockSkew,
 					trimStringSliceWhiteSpaces(app.AdditionalOrigins),
 					app.SkipSuccessPageForNativeApp,
+					"",
+					"",
+					false,
+					false,
+					false,
+					"",
+					"",
 				),
 			}, nil
 		}, nil
@@ -196,6 +203,13 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		oidcApp.ClockSkew,
 		trimStringSliceWhiteSpaces(oidcApp.AdditionalOrigins),
 		oidcApp.SkipNativeAppSuccessPage,
+		strings.TrimSpace(oidcApp.BackChannelLogoutURI),
+		strings.TrimSpace(oidcApp.FrontChannelLogoutURI),
+		oidcApp.RequirePushedAuthRequest,
+		oidcApp.RequireRequestObject,
+		oidcApp.RequireDPoP,
+		strings.TrimSpace(oidcApp.TLSClientAuthSubjectDN),
+		strings.TrimSpace(oidcApp.BackChannelClientNotificationEndpoint),
 	))
 
 	addedApplication.AppID = oidcApp.AppID
@@ -248,6 +262,13 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		oidc.ClockSkew,
 		trimStringSliceWhiteSpaces(oidc.AdditionalOrigins),
 		oidc.SkipNativeAppSuccessPage,
+		strings.TrimSpace(oidc.BackChannelLogoutURI),
+		strings.TrimSpace(oidc.FrontChannelLogoutURI),
+		oidc.RequirePushedAuthRequest,
+		oidc.RequireRequestObject,
+		oidc.RequireDPoP,
+		strings.TrimSpace(oidc.TLSClientAuthSubjectDN),
+		strings.TrimSpace(oidc.BackChannelClientNotificationEndpoint),
 	)
 	if err != nil {
 		return nil, err
@@ -14,29 +14,36 @@ import (
 type OIDCApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	ClientSecret             *crypto.CryptoValue
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []domain.OIDCResponseType
-	GrantTypes               []domain.OIDCGrantType
-	ApplicationType          domain.OIDCApplicationType
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              domain.OIDCVersion
-	Compliance               *domain.Compliance
-	DevMode                  bool
-	AccessTokenType          domain.OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	State                    domain.AppState
-	AdditionalOrigins        []string
-	SkipNativeAppSuccessPage bool
-	oidc                     bool
+	AppID                                 string
+	AppName                               string
+	ClientID                              string
+	ClientSecret                          *crypto.CryptoValue
+	ClientSecretString                    string
+	RedirectUris                          []string
+	ResponseTypes                         []domain.OIDCResponseType
+	GrantTypes                            []domain.OIDCGrantType
+	ApplicationType                       domain.OIDCApplicationType
+	AuthMethodType                        domain.OIDCAuthMethodType
+	PostLogoutRedirectUris                []string
+	OIDCVersion                           domain.OIDCVersion
+	Compliance                            *domain.Compliance
+	DevMode                               bool
+	AccessTokenType                       domain.OIDCTokenType
+	AccessTokenRoleAssertion              bool
+	IDTokenRoleAssertion                  bool
+	IDTokenUserinfoAssertion              bool
+	ClockSkew                             time.Duration
+	State                                 domain.AppState
+	AdditionalOrigins                     []string
+	SkipNativeAppSuccessPage              bool
+	BackChannelLogoutURI                  string
+	FrontChannelLogoutURI                 string
+	RequirePushedAuthRequest              bool
+	RequireRequestObject                  bool
+	RequireDPoP                           bool
+	TLSClientAuthSubjectDN                string
+	BackChannelClientNotificationEndpoint string
+	oidc                                  bool
 }
 
 func NewOIDCApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *OIDCApplicationWriteModel {
@@ -158,6 +165,13 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.ClockSkew = e.ClockSkew
 	wm.AdditionalOrigins = e.AdditionalOrigins
 	wm.SkipNativeAppSuccessPage = e.SkipNativeAppSuccessPage
+	wm.BackChannelLogoutURI = e.BackChannelLogoutURI
+	wm.FrontChannelLogoutURI = e.FrontChannelLogoutURI
+	wm.RequirePushedAuthRequest = e.RequirePushedAuthRequest
+	wm.RequireRequestObject = e.RequireRequestObject
+	wm.RequireDPoP = e.RequireDPoP
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
+	wm.BackChannelClientNotificationEndpoint = e.BackChannelClientNotificationEndpoint
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -206,6 +220,27 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.SkipNativeAppSuccessPage != nil {
 		wm.SkipNativeAppSuccessPage = *e.SkipNativeAppSuccessPage
 	}
+	if e.BackChannelLogoutURI != nil {
+		wm.BackChannelLogoutURI = *e.BackChannelLogoutURI
+	}
+	if e.FrontChannelLogoutURI != nil {
+		wm.FrontChannelLogoutURI = *e.FrontChannelLogoutURI
+	}
+	if e.RequirePushedAuthRequest != nil {
+		wm.RequirePushedAuthRequest = *e.RequirePushedAuthRequest
+	}
+	if e.RequireRequestObject != nil {
+		wm.RequireRequestObject = *e.RequireRequestObject
+	}
+	if e.RequireDPoP != nil {
+		wm.RequireDPoP = *e.RequireDPoP
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
+	if e.BackChannelClientNotificationEndpoint != nil {
+		wm.BackChannelClientNotificationEndpoint = *e.BackChannelClientNotificationEndpoint
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -246,6 +281,13 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	clockSkew time.Duration,
 	additionalOrigins []string,
 	skipNativeAppSuccessPage bool,
+	backChannelLogoutURI,
+	frontChannelLogoutURI string,
+	requirePushedAuthRequest,
+	requireRequestObject,
+	requireDPoP bool,
+	tlsClientAuthSubjectDN string,
+	backChannelClientNotificationEndpoint string,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -295,6 +337,27 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if wm.SkipNativeAppSuccessPage != skipNativeAppSuccessPage {
 		changes = append(changes, project.ChangeSkipNativeAppSuccessPage(skipNativeAppSuccessPage))
 	}
+	if wm.BackChannelLogoutURI != backChannelLogoutURI {
+		changes = append(changes, project.ChangeBackChannelLogoutURI(backChannelLogoutURI))
+	}
+	if wm.FrontChannelLogoutURI != frontChannelLogoutURI {
+		changes = append(changes, project.ChangeFrontChannelLogoutURI(frontChannelLogoutURI))
+	}
+	if wm.RequirePushedAuthRequest != requirePushedAuthRequest {
+		changes = append(changes, project.ChangeRequirePushedAuthRequest(requirePushedAuthRequest))
+	}
+	if wm.RequireRequestObject != requireRequestObject {
+		changes = append(changes, project.ChangeRequireRequestObject(requireRequestObject))
+	}
+	if wm.RequireDPoP != requireDPoP {
+		changes = append(changes, project.ChangeRequireDPoP(requireDPoP))
+	}
+	if wm.TLSClientAuthSubjectDN != tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeTLSClientAuthSubjectDN(tlsClientAuthSubjectDN))
+	}
+	if wm.BackChannelClientNotificationEndpoint != backChannelClientNotificationEndpoint {
+		changes = append(changes, project.ChangeBackChannelClientNotificationEndpoint(backChannelClientNotificationEndpoint))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
@@ -171,6 +171,13 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						[]string{"https://sub.test.ch"},
 						false,
+						"",
+						"",
+						false,
+						false,
+						false,
+						"",
+						"",
 					),
 				},
 			},
@@ -236,6 +243,13 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						"",
+						"",
+						false,
+						false,
+						false,
+						"",
+						"",
 					),
 				},
 			},
@@ -390,6 +404,13 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							"",
+							"",
+							false,
+							false,
+							false,
+							"",
+							"",
 						),
 					),
 				),
@@ -495,6 +516,13 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							"",
+							"",
+							false,
+							false,
+							false,
+							"",
+							"",
 						),
 					),
 				),
@@ -617,6 +645,31 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "invalid back channel logout uri, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				oidcApp: &domain.OIDCApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppID:                "app1",
+					AuthMethodType:       domain.OIDCAuthMethodTypePost,
+					GrantTypes:           []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ResponseTypes:        []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					BackChannelLogoutURI: "/logout#fragment",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "missing appid, invalid argument error",
 			fields: fields{
@@ -729,6 +782,13 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								"",
+								false,
+								false,
+								false,
+								"",
+								"",
 							),
 						),
 					),
@@ -803,6 +863,13 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								"",
+								false,
+								false,
+								false,
+								"",
+								"",
 							),
 						),
 					),
@@ -877,6 +944,13 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								"",
+								"",
+								false,
+								false,
+								false,
+								"",
+								"",
 							),
 						),
 					),
@@ -911,6 +985,11 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ClockSkew:                time.Second * 2,
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: true,
+					BackChannelLogoutURI:     " https://test-change.ch/backchannel ",
+					FrontChannelLogoutURI:    "https://test-change.ch/frontchannel",
+					RequirePushedAuthRequest: true,
+					RequireRequestObject:     true,
+					RequireDPoP:              true,
 				},
 				resourceOwner: "org1",
 			},
@@ -938,6 +1017,11 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ClockSkew:                time.Second * 2,
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: true,
+					BackChannelLogoutURI:     "https://test-change.ch/backchannel",
+					FrontChannelLogoutURI:    "https://test-change.ch/frontchannel",
+					RequirePushedAuthRequest: true,
+					RequireRequestObject:     true,
+					RequireDPoP:              true,
 					Compliance:               &domain.Compliance{},
 					State:                    domain.AppStateActive,
 				},
@@ -1074,6 +1158,13 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								false,
+								"",
+								"",
+								false,
+								false,
+								false,
+								"",
+								"",
 							),
 						),
 					),
@@ -1157,6 +1248,11 @@ func newOIDCAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner
 		project.ChangeIDTokenRoleAssertion(false),
 		project.ChangeIDTokenUserinfoAssertion(false),
 		project.ChangeClockSkew(time.Second * 2),
+		project.ChangeBackChannelLogoutURI("https://test-change.ch/backchannel"),
+		project.ChangeFrontChannelLogoutURI("https://test-change.ch/frontchannel"),
+		project.ChangeRequirePushedAuthRequest(true),
+		project.ChangeRequireRequestObject(true),
+		project.ChangeRequireDPoP(true),
 	}
 	event, _ := project.NewOIDCConfigChangedEvent(ctx,
 		&project.NewAggregate(projectID, resourceOwner).Aggregate,
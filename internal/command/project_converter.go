@@ -27,26 +27,33 @@ func projectGrantWriteModelToProjectGrant(writeModel *ProjectGrantWriteModel) *d
 
 func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.OIDCApp {
 	return &domain.OIDCApp{
-		ObjectRoot:               writeModelToObjectRoot(writeModel.WriteModel),
-		AppID:                    writeModel.AppID,
-		AppName:                  writeModel.AppName,
-		State:                    writeModel.State,
-		ClientID:                 writeModel.ClientID,
-		RedirectUris:             writeModel.RedirectUris,
-		ResponseTypes:            writeModel.ResponseTypes,
-		GrantTypes:               writeModel.GrantTypes,
-		ApplicationType:          writeModel.ApplicationType,
-		AuthMethodType:           writeModel.AuthMethodType,
-		PostLogoutRedirectUris:   writeModel.PostLogoutRedirectUris,
-		OIDCVersion:              writeModel.OIDCVersion,
-		DevMode:                  writeModel.DevMode,
-		AccessTokenType:          writeModel.AccessTokenType,
-		AccessTokenRoleAssertion: writeModel.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     writeModel.IDTokenRoleAssertion,
-		IDTokenUserinfoAssertion: writeModel.IDTokenUserinfoAssertion,
-		ClockSkew:                writeModel.ClockSkew,
-		AdditionalOrigins:        writeModel.AdditionalOrigins,
-		SkipNativeAppSuccessPage: writeModel.SkipNativeAppSuccessPage,
+		ObjectRoot:                            writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:                                 writeModel.AppID,
+		AppName:                               writeModel.AppName,
+		State:                                 writeModel.State,
+		ClientID:                              writeModel.ClientID,
+		RedirectUris:                          writeModel.RedirectUris,
+		ResponseTypes:                         writeModel.ResponseTypes,
+		GrantTypes:                            writeModel.GrantTypes,
+		ApplicationType:                       writeModel.ApplicationType,
+		AuthMethodType:                        writeModel.AuthMethodType,
+		PostLogoutRedirectUris:                writeModel.PostLogoutRedirectUris,
+		OIDCVersion:                           writeModel.OIDCVersion,
+		DevMode:                               writeModel.DevMode,
+		AccessTokenType:                       writeModel.AccessTokenType,
+		AccessTokenRoleAssertion:              writeModel.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  writeModel.IDTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              writeModel.IDTokenUserinfoAssertion,
+		ClockSkew:                             writeModel.ClockSkew,
+		AdditionalOrigins:                     writeModel.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              writeModel.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  writeModel.BackChannelLogoutURI,
+		FrontChannelLogoutURI:                 writeModel.FrontChannelLogoutURI,
+		RequirePushedAuthRequest:              writeModel.RequirePushedAuthRequest,
+		RequireRequestObject:                  writeModel.RequireRequestObject,
+		RequireDPoP:                           writeModel.RequireDPoP,
+		TLSClientAuthSubjectDN:                writeModel.TLSClientAuthSubjectDN,
+		BackChannelClientNotificationEndpoint: writeModel.BackChannelClientNotificationEndpoint,
 	}
 }
 
@@ -64,12 +71,13 @@ func samlWriteModelToSAMLConfig(writeModel *SAMLApplicationWriteModel) *domain.S
 
 func apiWriteModelToAPIConfig(writeModel *APIApplicationWriteModel) *domain.APIApp {
 	return &domain.APIApp{
-		ObjectRoot:     writeModelToObjectRoot(writeModel.WriteModel),
-		AppID:          writeModel.AppID,
-		AppName:        writeModel.AppName,
-		State:          writeModel.State,
-		ClientID:       writeModel.ClientID,
-		AuthMethodType: writeModel.AuthMethodType,
+		ObjectRoot:             writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:                  writeModel.AppID,
+		AppName:                writeModel.AppName,
+		State:                  writeModel.State,
+		ClientID:               writeModel.ClientID,
+		AuthMethodType:         writeModel.AuthMethodType,
+		TLSClientAuthSubjectDN: writeModel.TLSClientAuthSubjectDN,
 	}
 }
 
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ProjectFeatures struct {
+	TokenExchange *bool
+}
+
+func (m *ProjectFeatures) isEmpty() bool {
+	return m.TokenExchange == nil
+}
+
+func (c *Commands) SetProjectFeatures(ctx context.Context, projectID string, f *ProjectFeatures) (*domain.ObjectDetails, error) {
+	if projectID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooQu4", "Errors.IDMissing")
+	}
+	if f.isEmpty() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aip2o", "Errors.NoChangesFound")
+	}
+	resourceOwner, err := c.checkProjectFeaturesPermission(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	wm := NewProjectFeaturesWriteModel(projectID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	cmds := wm.setCommands(ctx, f)
+	if len(cmds) == 0 {
+		return writeModelToObjectDetails(wm.WriteModel), nil
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
+
+func (c *Commands) ResetProjectFeatures(ctx context.Context, projectID string) (*domain.ObjectDetails, error) {
+	if projectID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wae7u", "Errors.IDMissing")
+	}
+	resourceOwner, err := c.checkProjectFeaturesPermission(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	wm := NewProjectFeaturesWriteModel(projectID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if wm.isEmpty() {
+		return writeModelToObjectDetails(wm.WriteModel), nil
+	}
+	aggregate := feature_v2.NewAggregate(projectID, resourceOwner)
+	events, err := c.eventstore.Push(ctx, feature_v2.NewResetEvent(ctx, aggregate, feature_v2.ProjectResetEventType))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(events), nil
+}
+
+// checkProjectFeaturesPermission ensures the project exists and the caller is allowed to manage its features.
+// It returns the resource owner (organization) of the project.
+func (c *Commands) checkProjectFeaturesPermission(ctx context.Context, projectID string) (string, error) {
+	project, err := c.getProjectWriteModelByID(ctx, projectID, "")
+	if err != nil {
+		return "", err
+	}
+	if project.State == domain.ProjectStateUnspecified || project.State == domain.ProjectStateRemoved {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-ahX8e", "Errors.Project.NotFound")
+	}
+	if err = c.checkPermission(ctx, domain.PermissionProjectFeatureWrite, project.ResourceOwner, projectID); err != nil {
+		return "", err
+	}
+	return project.ResourceOwner, nil
+}
@@ -0,0 +1,189 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetProjectFeatures(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := feature_v2.NewAggregate("project1", "org1")
+	projectAdded := eventFromEventPusher(
+		project.NewProjectAddedEvent(ctx, &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+	)
+
+	type args struct {
+		ctx       context.Context
+		projectID string
+		f         *ProjectFeatures
+	}
+	tests := []struct {
+		name            string
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		args            args
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name:       "missing project id",
+			eventstore: expectEventstore(),
+			args: args{ctx, "", &ProjectFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooQu4", "Errors.IDMissing"),
+		},
+		{
+			name:       "all nil, No Change",
+			eventstore: expectEventstore(),
+			args:       args{ctx, "project1", &ProjectFeatures{}},
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Aip2o", "Errors.NoChangesFound"),
+		},
+		{
+			name: "project not found",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{ctx, "project1", &ProjectFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ahX8e", "Errors.Project.NotFound"),
+		},
+		{
+			name: "permission denied",
+			eventstore: expectEventstore(
+				expectFilter(projectAdded),
+			),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			args: args{ctx, "project1", &ProjectFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "set TokenExchange",
+			eventstore: expectEventstore(
+				expectFilter(projectAdded),
+				expectFilter(),
+				expectPush(
+					feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.ProjectTokenExchangeEventType, true,
+					),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{ctx, "project1", &ProjectFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "unchanged",
+			eventstore: expectEventstore(
+				expectFilter(projectAdded),
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.ProjectTokenExchangeEventType, true,
+					)),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			args: args{ctx, "project1", &ProjectFeatures{
+				TokenExchange: gu.Ptr(true),
+			}},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.checkPermission,
+			}
+			got, err := c.SetProjectFeatures(tt.args.ctx, tt.args.projectID, tt.args.f)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_ResetProjectFeatures(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := feature_v2.NewAggregate("project1", "org1")
+	projectAdded := eventFromEventPusher(
+		project.NewProjectAddedEvent(ctx, &project.NewAggregate("project1", "org1").Aggregate, "project", false, false, false, domain.PrivateLabelingSettingUnspecified),
+	)
+	tests := []struct {
+		name            string
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name: "project not found",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ahX8e", "Errors.Project.NotFound"),
+		},
+		{
+			name: "success",
+			eventstore: expectEventstore(
+				expectFilter(projectAdded),
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.ProjectTokenExchangeEventType, true,
+					)),
+				),
+				expectPush(
+					feature_v2.NewResetEvent(ctx, aggregate, feature_v2.ProjectResetEventType),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "no change without previous events",
+			eventstore: expectEventstore(
+				expectFilter(projectAdded),
+				expectFilter(),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.checkPermission,
+			}
+			got, err := c.ResetProjectFeatures(ctx, "project1")
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const registrationTokenDelimiter = ":"
+
+// ProjectRegistrationToken is an initial access token,
+// which allows to register OIDC applications in the project with the dynamic client registration (RFC 7591)
+type ProjectRegistrationToken struct {
+	models.ObjectRoot
+
+	ExpirationDate time.Time
+
+	TokenID string
+	Token   string
+}
+
+func NewProjectRegistrationToken(resourceOwner, projectID string, expirationDate time.Time) *ProjectRegistrationToken {
+	return &ProjectRegistrationToken{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		ExpirationDate: expirationDate,
+	}
+}
+
+func (c *Commands) AddProjectRegistrationToken(ctx context.Context, token *ProjectRegistrationToken) (_ *domain.ObjectDetails, err error) {
+	if token.AggregateID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohx7e", "Errors.Project.ProjectIDMissing")
+	}
+	token.ExpirationDate, err = domain.ValidateExpirationDate(token.ExpirationDate)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkProjectExists(ctx, token.AggregateID, token.ResourceOwner); err != nil {
+		return nil, err
+	}
+	token.TokenID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewProjectRegistrationTokenWriteModel(token.AggregateID, token.TokenID, token.ResourceOwner)
+	if err = c.pushAppendAndReduce(ctx, writeModel, project.NewRegistrationTokenAddedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		token.TokenID,
+		token.ExpirationDate,
+	)); err != nil {
+		return nil, err
+	}
+	token.Token, err = createRegistrationToken(c.keyAlgorithm, token.TokenID, token.AggregateID)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveProjectRegistrationToken(ctx context.Context, projectID, tokenID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || tokenID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahph3", "Errors.IDMissing")
+	}
+	writeModel := NewProjectRegistrationTokenWriteModel(projectID, tokenID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ieL4u", "Errors.Project.RegistrationToken.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, project.NewRegistrationTokenRemovedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		tokenID,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RegisterOIDCApplication adds an OIDC application to the project of the initial access token (RFC 7591).
+// The returned registration access token allows the client to read, update and delete its registration (RFC 7592).
+func (c *Commands) RegisterOIDCApplication(ctx context.Context, initialAccessToken string, app *domain.OIDCApp) (_ *domain.OIDCApp, registrationAccessToken string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	ids, err := parseRegistrationToken(c.keyAlgorithm, initialAccessToken, 2)
+	if err != nil {
+		return nil, "", err
+	}
+	tokenWriteModel := NewProjectRegistrationTokenWriteModel(ids[1], ids[0], "")
+	if err = c.eventstore.FilterToQueryReducer(ctx, tokenWriteModel); err != nil {
+		return nil, "", err
+	}
+	if !tokenWriteModel.IsUsable(time.Now()) {
+		return nil, "", zerrors.ThrowUnauthenticated(nil, "COMMAND-Ceeb4", "Errors.Project.RegistrationToken.Invalid")
+	}
+	appSecretGenerator, _, err := secretGenerator(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeAppSecret, c.codeAlg, emptyConfig) //nolint:staticcheck
+	if err != nil {
+		return nil, "", err
+	}
+	app.AggregateID = tokenWriteModel.AggregateID
+	app, err = c.AddOIDCApplication(ctx, app, tokenWriteModel.ResourceOwner, appSecretGenerator)
+	if err != nil {
+		return nil, "", err
+	}
+	registrationAccessToken, err = c.addApplicationRegistrationToken(ctx, app.AggregateID, app.AppID, app.ResourceOwner)
+	if err != nil {
+		return nil, "", err
+	}
+	return app, registrationAccessToken, nil
+}
+
+// GetRegisteredOIDCApplication returns the current configuration of a dynamically registered application
+func (c *Commands) GetRegisteredOIDCApplication(ctx context.Context, registrationAccessToken, clientID string) (_ *domain.OIDCApp, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	registration, err := c.verifyRegistrationAccessToken(ctx, registrationAccessToken, clientID)
+	if err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getOIDCAppWriteModel(ctx, registration.AggregateID, registration.AppID, registration.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return oidcWriteModelToOIDCConfig(writeModel), nil
+}
+
+// ChangeRegisteredOIDCApplication replaces the configuration of a dynamically registered application.
+// Unlike [Commands.ChangeOIDCApplication] an unchanged configuration is not an error.
+func (c *Commands) ChangeRegisteredOIDCApplication(ctx context.Context, registrationAccessToken string, app *domain.OIDCApp) (_ *domain.OIDCApp, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	registration, err := c.verifyRegistrationAccessToken(ctx, registrationAccessToken, app.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := c.getOIDCAppWriteModel(ctx, registration.AggregateID, registration.AppID, registration.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	app.AggregateID = existing.AggregateID
+	app.AppID = existing.AppID
+	if app.AppName != "" && app.AppName != existing.AppName {
+		if _, err = c.ChangeApplication(ctx, app.AggregateID, &domain.ChangeApp{AppID: app.AppID, AppName: app.AppName}, existing.ResourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	changed, err := c.ChangeOIDCApplication(ctx, app, existing.ResourceOwner)
+	if zerrors.IsPreconditionFailed(err) {
+		return c.GetRegisteredOIDCApplication(ctx, registrationAccessToken, app.ClientID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// RemoveRegisteredOIDCApplication removes a dynamically registered application,
+// which invalidates its registration access token as well
+func (c *Commands) RemoveRegisteredOIDCApplication(ctx context.Context, registrationAccessToken, clientID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	registration, err := c.verifyRegistrationAccessToken(ctx, registrationAccessToken, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return c.RemoveApplication(ctx, registration.AggregateID, registration.AppID, registration.ResourceOwner)
+}
+
+func (c *Commands) addApplicationRegistrationToken(ctx context.Context, projectID, appID, resourceOwner string) (string, error) {
+	tokenID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	if _, err = c.eventstore.Push(ctx, project.NewApplicationRegistrationTokenAddedEvent(
+		ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		tokenID,
+	)); err != nil {
+		return "", err
+	}
+	return createRegistrationToken(c.keyAlgorithm, tokenID, projectID, appID)
+}
+
+func (c *Commands) verifyRegistrationAccessToken(ctx context.Context, registrationAccessToken, clientID string) (*OIDCApplicationRegistrationWriteModel, error) {
+	ids, err := parseRegistrationToken(c.keyAlgorithm, registrationAccessToken, 3)
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewOIDCApplicationRegistrationWriteModel(ids[1], ids[2], "")
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.IsValidToken(ids[0], clientID) {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-ohSh1", "Errors.Project.RegistrationToken.Invalid")
+	}
+	return writeModel, nil
+}
+
+// createRegistrationToken encrypts the ids the same way as personal access tokens.
+// Initial access tokens consist of the token and project id,
+// registration access tokens additionally contain the app id.
+func createRegistrationToken(algorithm crypto.EncryptionAlgorithm, ids ...string) (string, error) {
+	encrypted, err := algorithm.Encrypt([]byte(strings.Join(ids, registrationTokenDelimiter)))
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encrypted), nil
+}
+
+func parseRegistrationToken(algorithm crypto.EncryptionAlgorithm, token string, count int) ([]string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "COMMAND-Ool6e", "Errors.Project.RegistrationToken.Invalid")
+	}
+	decrypted, err := algorithm.DecryptString(decoded, algorithm.EncryptionKeyID())
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "COMMAND-ooDa4", "Errors.Project.RegistrationToken.Invalid")
+	}
+	ids := strings.Split(decrypted, registrationTokenDelimiter)
+	if len(ids) != count {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-Chai0", "Errors.Project.RegistrationToken.Invalid")
+	}
+	return ids, nil
+}
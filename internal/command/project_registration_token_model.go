@@ -0,0 +1,189 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectRegistrationTokenWriteModel struct {
+	eventstore.WriteModel
+
+	TokenID    string
+	Expiration time.Time
+
+	State        domain.RegistrationTokenState
+	ProjectState domain.ProjectState
+}
+
+func NewProjectRegistrationTokenWriteModel(projectID, tokenID, resourceOwner string) *ProjectRegistrationTokenWriteModel {
+	return &ProjectRegistrationTokenWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		TokenID: tokenID,
+	}
+}
+
+func (wm *ProjectRegistrationTokenWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.RegistrationTokenAddedEvent:
+			if wm.TokenID != e.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.RegistrationTokenRemovedEvent:
+			if wm.TokenID != e.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		default:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *ProjectRegistrationTokenWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.ProjectState = domain.ProjectStateActive
+		case *project.ProjectDeactivatedEvent:
+			wm.ProjectState = domain.ProjectStateInactive
+		case *project.ProjectReactivatedEvent:
+			wm.ProjectState = domain.ProjectStateActive
+		case *project.ProjectRemovedEvent:
+			wm.ProjectState = domain.ProjectStateRemoved
+			wm.State = domain.RegistrationTokenStateRemoved
+		case *project.RegistrationTokenAddedEvent:
+			wm.Expiration = e.Expiration
+			wm.State = domain.RegistrationTokenStateActive
+		case *project.RegistrationTokenRemovedEvent:
+			wm.State = domain.RegistrationTokenStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectRegistrationTokenWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectDeactivatedType,
+			project.ProjectReactivatedType,
+			project.ProjectRemovedType,
+			project.RegistrationTokenAddedType,
+			project.RegistrationTokenRemovedType).
+		Builder()
+}
+
+func (wm *ProjectRegistrationTokenWriteModel) Exists() bool {
+	return wm.State != domain.RegistrationTokenStateUnspecified && wm.State != domain.RegistrationTokenStateRemoved
+}
+
+// IsUsable checks that the token exists, is not expired and the project is active
+func (wm *ProjectRegistrationTokenWriteModel) IsUsable(now time.Time) bool {
+	return wm.Exists() &&
+		wm.ProjectState == domain.ProjectStateActive &&
+		now.Before(wm.Expiration)
+}
+
+// OIDCApplicationRegistrationWriteModel holds the current registration access token
+// of a dynamically registered application
+type OIDCApplicationRegistrationWriteModel struct {
+	eventstore.WriteModel
+
+	AppID    string
+	ClientID string
+	TokenID  string
+	State    domain.AppState
+}
+
+func NewOIDCApplicationRegistrationWriteModel(projectID, appID, resourceOwner string) *OIDCApplicationRegistrationWriteModel {
+	return &OIDCApplicationRegistrationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		AppID: appID,
+	}
+}
+
+func (wm *OIDCApplicationRegistrationWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.ApplicationAddedEvent:
+			if wm.AppID != e.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationRemovedEvent:
+			if wm.AppID != e.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.OIDCConfigAddedEvent:
+			if wm.AppID != e.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationRegistrationTokenAddedEvent:
+			if wm.AppID != e.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OIDCApplicationRegistrationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ApplicationAddedEvent:
+			wm.State = domain.AppStateActive
+		case *project.OIDCConfigAddedEvent:
+			wm.ClientID = e.ClientID
+		case *project.ApplicationRegistrationTokenAddedEvent:
+			wm.TokenID = e.TokenID
+		case *project.ApplicationRemovedEvent:
+			wm.State = domain.AppStateRemoved
+		case *project.ProjectRemovedEvent:
+			wm.State = domain.AppStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OIDCApplicationRegistrationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ApplicationAddedType,
+			project.ApplicationRemovedType,
+			project.OIDCConfigAddedType,
+			project.ApplicationRegistrationTokenAddedType,
+			project.ProjectRemovedType).
+		Builder()
+}
+
+// IsValidToken checks that the application exists and the token is the latest one issued for the client
+func (wm *OIDCApplicationRegistrationWriteModel) IsValidToken(tokenID, clientID string) bool {
+	return wm.State != domain.AppStateUnspecified &&
+		wm.State != domain.AppStateRemoved &&
+		wm.TokenID != "" &&
+		wm.TokenID == tokenID &&
+		wm.ClientID == clientID
+}
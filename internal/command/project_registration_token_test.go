@@ -0,0 +1,532 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddProjectRegistrationToken(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		idGenerator  id.Generator
+		keyAlgorithm crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx   context.Context
+		token *ProjectRegistrationToken
+	}
+	type res struct {
+		want  *domain.ObjectDetails
+		token string
+		err   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no projectID, error",
+			fields{},
+			args{
+				ctx:   context.Background(),
+				token: NewProjectRegistrationToken("org1", "", time.Time{}),
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"invalid expiration date, error",
+			fields{},
+			args{
+				ctx:   context.Background(),
+				token: NewProjectRegistrationToken("org1", "project1", time.Now().Add(-24*time.Hour)),
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"project does not exist, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:   context.Background(),
+				token: NewProjectRegistrationToken("org1", "project1", time.Time{}),
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"token added",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						project.NewRegistrationTokenAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"token1",
+							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+						),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "token1"),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:   context.Background(),
+				token: NewProjectRegistrationToken("org1", "project1", time.Time{}),
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				token: base64.RawURLEncoding.EncodeToString([]byte("token1:project1")),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore,
+				idGenerator:  tt.fields.idGenerator,
+				keyAlgorithm: tt.fields.keyAlgorithm,
+			}
+			got, err := c.AddProjectRegistrationToken(tt.args.ctx, tt.args.token)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+				assert.Equal(t, tt.res.token, tt.args.token.Token)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveProjectRegistrationToken(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		tokenID       string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no tokenID, error",
+			fields{},
+			args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"token does not exist, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				tokenID:       "token1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"token already removed, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+							),
+						),
+						eventFromEventPusher(
+							project.NewRegistrationTokenRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				tokenID:       "token1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"token removed",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+							),
+						),
+					),
+					expectPush(
+						project.NewRegistrationTokenRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"token1",
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				tokenID:       "token1",
+				resourceOwner: "org1",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := c.RemoveProjectRegistrationToken(tt.args.ctx, tt.args.projectID, tt.args.tokenID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RegisterOIDCApplication(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		keyAlgorithm crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx                context.Context
+		initialAccessToken string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    func(error) bool
+	}{
+		{
+			"malformed token, error",
+			fields{
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                context.Background(),
+				initialAccessToken: "token!",
+			},
+			zerrors.IsUnauthenticated,
+		},
+		{
+			"registration access token, error",
+			fields{
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                context.Background(),
+				initialAccessToken: base64.RawURLEncoding.EncodeToString([]byte("token1:project1:app1")),
+			},
+			zerrors.IsUnauthenticated,
+		},
+		{
+			"token removed, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+							),
+						),
+						eventFromEventPusher(
+							project.NewRegistrationTokenRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                context.Background(),
+				initialAccessToken: base64.RawURLEncoding.EncodeToString([]byte("token1:project1")),
+			},
+			zerrors.IsUnauthenticated,
+		},
+		{
+			"token expired, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Now().Add(-time.Hour),
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                context.Background(),
+				initialAccessToken: base64.RawURLEncoding.EncodeToString([]byte("token1:project1")),
+			},
+			zerrors.IsUnauthenticated,
+		},
+		{
+			"project deactivated, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"token1",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectDeactivatedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                context.Background(),
+				initialAccessToken: base64.RawURLEncoding.EncodeToString([]byte("token1:project1")),
+			},
+			zerrors.IsUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore,
+				keyAlgorithm: tt.fields.keyAlgorithm,
+			}
+			_, _, err := c.RegisterOIDCApplication(tt.args.ctx, tt.args.initialAccessToken, &domain.OIDCApp{AppName: "app"})
+			assert.True(t, tt.err(err), "got wrong err: %v", err)
+		})
+	}
+}
+
+func TestCommands_RemoveRegisteredOIDCApplication(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		keyAlgorithm crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx                     context.Context
+		registrationAccessToken string
+		clientID                string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    func(error) bool
+	}{
+		{
+			"initial access token, error",
+			fields{
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                     context.Background(),
+				registrationAccessToken: base64.RawURLEncoding.EncodeToString([]byte("token1:project1")),
+				clientID:                "client1",
+			},
+			zerrors.IsUnauthenticated,
+		},
+		{
+			"token rotated, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewApplicationRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"token1",
+							),
+						),
+						eventFromEventPusher(
+							project.NewApplicationRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"token2",
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                     context.Background(),
+				registrationAccessToken: base64.RawURLEncoding.EncodeToString([]byte("token1:project1:app1")),
+				clientID:                "client1",
+			},
+			zerrors.IsUnauthenticated,
+		},
+		{
+			"application removed, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewApplicationRegistrationTokenAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"token1",
+							),
+						),
+						eventFromEventPusher(
+							project.NewApplicationRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+								"",
+							),
+						),
+					),
+				),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                     context.Background(),
+				registrationAccessToken: base64.RawURLEncoding.EncodeToString([]byte("token1:project1:app1")),
+				clientID:                "client1",
+			},
+			zerrors.IsUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore,
+				keyAlgorithm: tt.fields.keyAlgorithm,
+			}
+			_, err := c.RemoveRegisteredOIDCApplication(tt.args.ctx, tt.args.registrationAccessToken, tt.args.clientID)
+			assert.True(t, tt.err(err), "got wrong err: %v", err)
+		})
+	}
+}
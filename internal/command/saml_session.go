@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SAMLSession is the participation of a SAML service provider in the session of a user agent.
+type SAMLSession struct {
+	UserID            string
+	UserResourceOwner string
+	UserAgentID       string
+	AppID             string
+	EntityID          string
+	// NameID is the subject the service provider knows the user by
+	NameID string
+}
+
+func (s *SAMLSession) IsValid() error {
+	if s.UserID == "" || s.UserAgentID == "" || s.AppID == "" || s.EntityID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Uph7e", "Errors.SAMLSession.Invalid")
+	}
+	return nil
+}
+
+// AddSAMLSession records that a SAML response was issued to a service provider,
+// so a logout of the user agent can be propagated to it.
+func (c *Commands) AddSAMLSession(ctx context.Context, session *SAMLSession) (string, *domain.ObjectDetails, error) {
+	if err := session.IsValid(); err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	wm := NewSAMLSessionWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	if err := c.pushAppendAndReduce(ctx, wm, samlsession.NewAddedEvent(ctx,
+		wm.aggregate,
+		session.UserID,
+		session.UserResourceOwner,
+		session.UserAgentID,
+		session.AppID,
+		session.EntityID,
+		session.NameID,
+	)); err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// TerminateSAMLSession marks the session as logged out.
+// Terminating an already terminated session has no effect.
+func (c *Commands) TerminateSAMLSession(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	wm := NewSAMLSessionWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	switch wm.State {
+	case domain.SAMLSessionStateUnspecified:
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ieY3u", "Errors.SAMLSession.NotFound")
+	case domain.SAMLSessionStateTerminated:
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, samlsession.NewTerminatedEvent(ctx, wm.aggregate)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
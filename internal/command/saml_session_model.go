@@ -0,0 +1,58 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+)
+
+type SAMLSessionWriteModel struct {
+	eventstore.WriteModel
+
+	UserID      string
+	UserAgentID string
+	AppID       string
+	EntityID    string
+	State       domain.SAMLSessionState
+
+	aggregate *eventstore.Aggregate
+}
+
+func NewSAMLSessionWriteModel(id string, resourceOwner string) *SAMLSessionWriteModel {
+	return &SAMLSessionWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+		aggregate: &samlsession.NewAggregate(id, resourceOwner).Aggregate,
+	}
+}
+
+func (wm *SAMLSessionWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *samlsession.AddedEvent:
+			wm.UserID = e.UserID
+			wm.UserAgentID = e.UserAgentID
+			wm.AppID = e.AppID
+			wm.EntityID = e.EntityID
+			wm.State = domain.SAMLSessionStateActive
+		case *samlsession.TerminatedEvent:
+			wm.State = domain.SAMLSessionStateTerminated
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLSessionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			samlsession.AddedType,
+			samlsession.TerminatedType,
+		).
+		Builder()
+}
@@ -0,0 +1,194 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddSAMLSession(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		session *SAMLSession
+	}
+	type res struct {
+		id      string
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"user agent missing, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				session: &SAMLSession{
+					UserID:   "user1",
+					AppID:    "app1",
+					EntityID: "https://sp.example.com/metadata",
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Uph7e", "Errors.SAMLSession.Invalid"))
+				},
+			},
+		},
+		{
+			"added, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						samlsession.NewAddedEvent(context.Background(),
+							&samlsession.NewAggregate("session1", "instance1").Aggregate,
+							"user1",
+							"org1",
+							"agent1",
+							"app1",
+							"https://sp.example.com/metadata",
+							"user@example.com",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "session1"),
+			},
+			args{
+				session: &SAMLSession{
+					UserID:            "user1",
+					UserResourceOwner: "org1",
+					UserAgentID:       "agent1",
+					AppID:             "app1",
+					EntityID:          "https://sp.example.com/metadata",
+					NameID:            "user@example.com",
+				},
+			},
+			res{
+				id:      "session1",
+				details: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			gotID, got, err := c.AddSAMLSession(authz.WithInstanceID(context.Background(), "instance1"), tt.args.session)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.id, gotID)
+			assert.Equal(t, tt.res.details, got)
+		})
+	}
+}
+
+func TestCommands_TerminateSAMLSession(t *testing.T) {
+	agg := &samlsession.NewAggregate("session1", "instance1").Aggregate
+	addedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			samlsession.NewAddedEvent(context.Background(), agg,
+				"user1",
+				"org1",
+				"agent1",
+				"app1",
+				"https://sp.example.com/metadata",
+				"user@example.com",
+			),
+		)
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-ieY3u", "Errors.SAMLSession.NotFound"))
+				},
+			},
+		},
+		{
+			"already terminated, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						addedEvent(),
+						eventFromEventPusher(samlsession.NewTerminatedEvent(context.Background(), agg)),
+					),
+				),
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+		{
+			"terminated, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						addedEvent(),
+					),
+					expectPush(
+						samlsession.NewTerminatedEvent(context.Background(), agg),
+					),
+				),
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.TerminateSAMLSession(authz.WithInstanceID(context.Background(), "instance1"), "session1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.details, got)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/scimtarget"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddSCIMTarget struct {
+	models.ObjectRoot
+
+	ProjectID string
+	AppID     string
+	Name      string
+	Endpoint  string
+	Token     string
+	Timeout   time.Duration
+}
+
+func (a *AddSCIMTarget) IsValid() error {
+	if a.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct1p", "Errors.SCIMTarget.Invalid")
+	}
+	if a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct1n", "Errors.SCIMTarget.Invalid")
+	}
+	if a.Timeout <= 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct1t", "Errors.SCIMTarget.NoTimeout")
+	}
+	return validateSCIMEndpoint(a.Endpoint)
+}
+
+func validateSCIMEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Sct1u", "Errors.SCIMTarget.InvalidEndpoint")
+	}
+	return nil
+}
+
+func (c *Commands) AddSCIMTarget(ctx context.Context, add *AddSCIMTarget, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct2r", "Errors.IDMissing")
+	}
+	if err := add.IsValid(); err != nil {
+		return nil, err
+	}
+	if err := c.checkSCIMTargetScope(ctx, add.ProjectID, add.AppID, resourceOwner); err != nil {
+		return nil, err
+	}
+	if add.AggregateID == "" {
+		add.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	wm, err := c.getSCIMTargetWriteModelByID(ctx, add.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Sct2e", "Errors.SCIMTarget.AlreadyExists")
+	}
+	var token *crypto.CryptoValue
+	if add.Token != "" {
+		token, err = crypto.Encrypt([]byte(add.Token), c.idpConfigEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, scimtarget.NewAddedEvent(
+		ctx,
+		SCIMTargetAggregateFromWriteModel(&wm.WriteModel),
+		add.ProjectID,
+		add.AppID,
+		add.Name,
+		add.Endpoint,
+		token,
+		add.Timeout,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+type ChangeSCIMTarget struct {
+	models.ObjectRoot
+
+	ProjectID string
+	AppID     *string
+	Name      *string
+	Endpoint  *string
+	Token     *string
+	Timeout   *time.Duration
+}
+
+func (a *ChangeSCIMTarget) IsValid() error {
+	if a.AggregateID == "" || a.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct3i", "Errors.IDMissing")
+	}
+	if a.Name != nil && *a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct3n", "Errors.SCIMTarget.Invalid")
+	}
+	if a.Timeout != nil && *a.Timeout <= 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct3t", "Errors.SCIMTarget.NoTimeout")
+	}
+	if a.Endpoint != nil {
+		return validateSCIMEndpoint(*a.Endpoint)
+	}
+	return nil
+}
+
+func (c *Commands) ChangeSCIMTarget(ctx context.Context, change *ChangeSCIMTarget, resourceOwner string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct4r", "Errors.IDMissing")
+	}
+	if err := change.IsValid(); err != nil {
+		return nil, err
+	}
+	existing, err := c.getSCIMTargetWriteModelByID(ctx, change.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() || existing.ProjectID != change.ProjectID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sct4n", "Errors.SCIMTarget.NotFound")
+	}
+	if change.AppID != nil && *change.AppID != "" {
+		if err := c.checkSCIMTargetScope(ctx, existing.ProjectID, *change.AppID, resourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	changedEvent, err := existing.NewChangedEvent(
+		ctx,
+		SCIMTargetAggregateFromWriteModel(&existing.WriteModel),
+		change.AppID,
+		change.Name,
+		change.Endpoint,
+		change.Token,
+		change.Timeout,
+		c.idpConfigEncryption,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if changedEvent == nil {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, existing, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) RemoveSCIMTarget(ctx context.Context, projectID, id, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || id == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sct5i", "Errors.IDMissing")
+	}
+	existing, err := c.getSCIMTargetWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() || existing.ProjectID != projectID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sct5n", "Errors.SCIMTarget.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx,
+		existing,
+		scimtarget.NewRemovedEvent(ctx, SCIMTargetAggregateFromWriteModel(&existing.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// SCIMUserProvisioned records that the user was created or updated at the SCIM target.
+func (c *Commands) SCIMUserProvisioned(ctx context.Context, targetID, resourceOwner, userID, userGrantID, remoteID string) error {
+	_, err := c.eventstore.Push(ctx, scimtarget.NewUserProvisionedEvent(ctx, scimtarget.NewAggregate(targetID, resourceOwner, authz.GetInstance(ctx).InstanceID()), userID, userGrantID, remoteID))
+	return err
+}
+
+// SCIMUserDeprovisioned records that the user was deleted at the SCIM target.
+func (c *Commands) SCIMUserDeprovisioned(ctx context.Context, targetID, resourceOwner, userID string) error {
+	_, err := c.eventstore.Push(ctx, scimtarget.NewUserDeprovisionedEvent(ctx, scimtarget.NewAggregate(targetID, resourceOwner, authz.GetInstance(ctx).InstanceID()), userID))
+	return err
+}
+
+// SCIMUserProvisioningFailed records a failed attempt to provision or deprovision the user at the SCIM target.
+func (c *Commands) SCIMUserProvisioningFailed(ctx context.Context, targetID, resourceOwner, userID string, provisioningErr error) error {
+	_, err := c.eventstore.Push(ctx, scimtarget.NewUserProvisioningFailedEvent(ctx, scimtarget.NewAggregate(targetID, resourceOwner, authz.GetInstance(ctx).InstanceID()), userID, provisioningErr.Error()))
+	return err
+}
+
+func (c *Commands) checkSCIMTargetScope(ctx context.Context, projectID, appID, resourceOwner string) error {
+	if err := c.checkProjectExists(ctx, projectID, resourceOwner); err != nil {
+		return err
+	}
+	if appID == "" {
+		return nil
+	}
+	app, err := c.getApplicationWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !app.State.Exists() {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sct6a", "Errors.Project.App.NotExisting")
+	}
+	return nil
+}
+
+func (c *Commands) getSCIMTargetWriteModelByID(ctx context.Context, id, resourceOwner string) (*SCIMTargetWriteModel, error) {
+	wm := NewSCIMTargetWriteModel(id, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
@@ -0,0 +1,121 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/scimtarget"
+)
+
+type SCIMTargetWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectID string
+	AppID     string
+	Name      string
+	Endpoint  string
+	Token     *crypto.CryptoValue
+	Timeout   time.Duration
+
+	State domain.SCIMTargetState
+}
+
+func NewSCIMTargetWriteModel(id, resourceOwner string) *SCIMTargetWriteModel {
+	return &SCIMTargetWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *SCIMTargetWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *scimtarget.AddedEvent:
+			wm.ProjectID = e.ProjectID
+			wm.AppID = e.AppID
+			wm.Name = e.Name
+			wm.Endpoint = e.Endpoint
+			wm.Token = e.Token
+			wm.Timeout = e.Timeout
+			wm.State = domain.SCIMTargetStateActive
+		case *scimtarget.ChangedEvent:
+			if e.AppID != nil {
+				wm.AppID = *e.AppID
+			}
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Endpoint != nil {
+				wm.Endpoint = *e.Endpoint
+			}
+			if e.Token != nil {
+				wm.Token = e.Token
+			}
+			if e.Timeout != nil {
+				wm.Timeout = *e.Timeout
+			}
+		case *scimtarget.RemovedEvent:
+			wm.State = domain.SCIMTargetStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SCIMTargetWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(scimtarget.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			scimtarget.AddedEventType,
+			scimtarget.ChangedEventType,
+			scimtarget.RemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *SCIMTargetWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	appID,
+	name,
+	endpoint,
+	token *string,
+	timeout *time.Duration,
+	tokenAlg crypto.EncryptionAlgorithm,
+) (*scimtarget.ChangedEvent, error) {
+	changes := make([]scimtarget.Changes, 0)
+	if appID != nil && wm.AppID != *appID {
+		changes = append(changes, scimtarget.ChangeAppID(*appID))
+	}
+	if name != nil && wm.Name != *name {
+		changes = append(changes, scimtarget.ChangeName(*name))
+	}
+	if endpoint != nil && wm.Endpoint != *endpoint {
+		changes = append(changes, scimtarget.ChangeEndpoint(*endpoint))
+	}
+	if token != nil && *token != "" {
+		encrypted, err := crypto.Encrypt([]byte(*token), tokenAlg)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, scimtarget.ChangeToken(encrypted))
+	}
+	if timeout != nil && wm.Timeout != *timeout {
+		changes = append(changes, scimtarget.ChangeTimeout(*timeout))
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return scimtarget.NewChangedEvent(ctx, agg, changes), nil
+}
+
+func SCIMTargetAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, scimtarget.AggregateType, scimtarget.AggregateVersion)
+}
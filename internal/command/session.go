@@ -38,6 +38,8 @@ type SessionCommands struct {
 	createCode  cryptoCodeWithDefaultFunc
 	createToken func(sessionID string) (id string, token string, err error)
 	now         func() time.Time
+
+	authenticationRequirement *domain.AuthenticationRequirement
 }
 
 func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWriteModel) *SessionCommands {
@@ -157,6 +159,38 @@ func CheckTOTP(code string) SessionCommand {
 	}
 }
 
+// CheckRecoveryCode defines a check for a recovery code to be executed for a session update.
+// The used code is invalidated, so it can not be used again.
+func CheckRecoveryCode(code string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) (err error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohb3u", "Errors.User.UserIDMissing")
+		}
+		recoveryCodesWriteModel := NewHumanRecoveryCodesWriteModel(cmd.sessionWriteModel.UserID, "")
+		err = cmd.eventstore.FilterToQueryReducer(ctx, recoveryCodesWriteModel)
+		if err != nil {
+			return err
+		}
+		index, err := verifyRecoveryCode(ctx, cmd.hasher, recoveryCodesWriteModel, code)
+		if err != nil {
+			return err
+		}
+		cmd.eventCommands = append(cmd.eventCommands, user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, UserAggregateFromWriteModel(&recoveryCodesWriteModel.WriteModel), index, nil))
+		cmd.RecoveryCodeChecked(ctx, cmd.now())
+		return nil
+	}
+}
+
+// RequireAuthentication defines the level of assurance the session should reach.
+// It is evaluated after all checks have been executed and
+// the result is returned as [SessionChanged.AuthenticationStatus].
+func RequireAuthentication(requirement *domain.AuthenticationRequirement) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) error {
+		cmd.authenticationRequirement = requirement
+		return nil
+	}
+}
+
 // Exec will execute the commands specified and returns an error on the first occurrence
 func (s *SessionCommands) Exec(ctx context.Context) error {
 	for _, cmd := range s.sessionCommands {
@@ -226,6 +260,10 @@ func (s *SessionCommands) OTPEmailChecked(ctx context.Context, checkedAt time.Ti
 	s.eventCommands = append(s.eventCommands, session.NewOTPEmailCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
+func (s *SessionCommands) RecoveryCodeChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewRecoveryCodeCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
+
 func (s *SessionCommands) SetToken(ctx context.Context, tokenID string) {
 	// trigger activity log for session for user
 	activity.Trigger(ctx, s.sessionWriteModel.UserResourceOwner, s.sessionWriteModel.UserID, activity.SessionAPI, s.eventstore.FilterToQueryReducer)
@@ -375,7 +413,9 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 		return nil, err
 	}
 	if len(cmds) == 0 {
-		return sessionWriteModelToSessionChanged(checks.sessionWriteModel), nil
+		changed := sessionWriteModelToSessionChanged(checks.sessionWriteModel)
+		changed.AuthenticationStatus = checks.authenticationStatus()
+		return changed, nil
 	}
 	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
@@ -387,9 +427,17 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 	}
 	changed := sessionWriteModelToSessionChanged(checks.sessionWriteModel)
 	changed.NewToken = sessionToken
+	changed.AuthenticationStatus = checks.authenticationStatus()
 	return changed, nil
 }
 
+func (s *SessionCommands) authenticationStatus() *domain.AuthenticationStatus {
+	if s.authenticationRequirement == nil {
+		return nil
+	}
+	return s.sessionWriteModel.Factors().AuthenticationStatus(s.authenticationRequirement, s.now())
+}
+
 // checkSessionTerminationPermission will check that the provided sessionToken is correct or
 // if empty, check that the caller is either terminating the own session or
 // is granted the "session.delete" permission on the resource owner of the authenticated user.
@@ -441,6 +489,8 @@ type SessionChanged struct {
 	*domain.ObjectDetails
 	ID       string
 	NewToken string
+	// AuthenticationStatus is only set if requested by [RequireAuthentication]
+	AuthenticationStatus *domain.AuthenticationStatus
 }
 
 func sessionWriteModelToSessionChanged(wm *SessionWriteModel) *SessionChanged {
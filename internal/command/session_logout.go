@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RegisterSessionLogout records that an id_token with the session id (`sid` claim) was issued to the client,
+// so the client can be notified on the logout of the session.
+// Registering an already registered client has no effect.
+func (c *Commands) RegisterSessionLogout(ctx context.Context, sessionID, userID, clientID, issuer string) error {
+	if sessionID == "" || userID == "" || clientID == "" || issuer == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Xae4o", "Errors.SessionLogout.Invalid")
+	}
+	wm, err := c.sessionLogoutWriteModel(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if wm.IsRegistered(userID, clientID) {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, wm, sessionlogout.NewRegisteredEvent(ctx, wm.aggregate, userID, clientID, issuer))
+}
+
+// BackChannelLogoutSent records the delivery of the logout token to the back-channel logout uri of the client.
+func (c *Commands) BackChannelLogoutSent(ctx context.Context, sessionID, userID, clientID string) error {
+	wm, err := c.sessionLogoutWriteModel(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if !wm.IsRegistered(userID, clientID) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-eeT5a", "Errors.SessionLogout.NotRegistered")
+	}
+	return c.pushAppendAndReduce(ctx, wm, sessionlogout.NewBackChannelLogoutSentEvent(ctx, wm.aggregate, userID, clientID))
+}
+
+func (c *Commands) sessionLogoutWriteModel(ctx context.Context, sessionID string) (*SessionLogoutWriteModel, error) {
+	wm := NewSessionLogoutWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
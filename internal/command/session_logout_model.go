@@ -0,0 +1,61 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
+)
+
+type sessionLogoutParticipant struct {
+	userID   string
+	clientID string
+}
+
+type SessionLogoutWriteModel struct {
+	eventstore.WriteModel
+
+	// registered contains the participants which were not yet notified by the back-channel
+	registered map[sessionLogoutParticipant]struct{}
+
+	aggregate *eventstore.Aggregate
+}
+
+func NewSessionLogoutWriteModel(sessionID string, resourceOwner string) *SessionLogoutWriteModel {
+	return &SessionLogoutWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   sessionID,
+			ResourceOwner: resourceOwner,
+		},
+		registered: make(map[sessionLogoutParticipant]struct{}),
+		aggregate:  &sessionlogout.NewAggregate(sessionID, resourceOwner).Aggregate,
+	}
+}
+
+func (wm *SessionLogoutWriteModel) IsRegistered(userID, clientID string) bool {
+	_, ok := wm.registered[sessionLogoutParticipant{userID: userID, clientID: clientID}]
+	return ok
+}
+
+func (wm *SessionLogoutWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *sessionlogout.RegisteredEvent:
+			wm.registered[sessionLogoutParticipant{userID: e.UserID, clientID: e.ClientID}] = struct{}{}
+		case *sessionlogout.BackChannelLogoutSentEvent:
+			delete(wm.registered, sessionLogoutParticipant{userID: e.UserID, clientID: e.ClientID})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SessionLogoutWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(sessionlogout.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			sessionlogout.RegisteredType,
+			sessionlogout.BackChannelLogoutSentType,
+		).
+		Builder()
+}
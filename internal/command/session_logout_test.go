@@ -0,0 +1,199 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RegisterSessionLogout(t *testing.T) {
+	agg := &sessionlogout.NewAggregate("session1", "instance1").Aggregate
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		sessionID string
+		userID    string
+		clientID  string
+		issuer    string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"client missing, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				sessionID: "session1",
+				userID:    "user1",
+				issuer:    "https://issuer.com",
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xae4o", "Errors.SessionLogout.Invalid"))
+				},
+			},
+		},
+		{
+			"already registered, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewRegisteredEvent(context.Background(), agg, "user1", "client1", "https://issuer.com"),
+						),
+					),
+				),
+			},
+			args{
+				sessionID: "session1",
+				userID:    "user1",
+				clientID:  "client1",
+				issuer:    "https://issuer.com",
+			},
+			res{},
+		},
+		{
+			"registered, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewRegisteredEvent(context.Background(), agg, "user2", "client1", "https://issuer.com"),
+						),
+					),
+					expectPush(
+						sessionlogout.NewRegisteredEvent(context.Background(), agg, "user1", "client1", "https://issuer.com"),
+					),
+				),
+			},
+			args{
+				sessionID: "session1",
+				userID:    "user1",
+				clientID:  "client1",
+				issuer:    "https://issuer.com",
+			},
+			res{},
+		},
+		{
+			"registered after back-channel logout, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewRegisteredEvent(context.Background(), agg, "user1", "client1", "https://issuer.com"),
+						),
+						eventFromEventPusher(
+							sessionlogout.NewBackChannelLogoutSentEvent(context.Background(), agg, "user1", "client1"),
+						),
+					),
+					expectPush(
+						sessionlogout.NewRegisteredEvent(context.Background(), agg, "user1", "client1", "https://issuer.com"),
+					),
+				),
+			},
+			args{
+				sessionID: "session1",
+				userID:    "user1",
+				clientID:  "client1",
+				issuer:    "https://issuer.com",
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.RegisterSessionLogout(authz.WithInstanceID(context.Background(), "instance1"), tt.args.sessionID, tt.args.userID, tt.args.clientID, tt.args.issuer)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_BackChannelLogoutSent(t *testing.T) {
+	agg := &sessionlogout.NewAggregate("session1", "instance1").Aggregate
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			"not registered, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewRegisteredEvent(context.Background(), agg, "user1", "client1", "https://issuer.com"),
+						),
+						eventFromEventPusher(
+							sessionlogout.NewBackChannelLogoutSentEvent(context.Background(), agg, "user1", "client1"),
+						),
+					),
+				),
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-eeT5a", "Errors.SessionLogout.NotRegistered"))
+				},
+			},
+		},
+		{
+			"sent, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewRegisteredEvent(context.Background(), agg, "user1", "client1", "https://issuer.com"),
+						),
+					),
+					expectPush(
+						sessionlogout.NewBackChannelLogoutSentEvent(context.Background(), agg, "user1", "client1"),
+					),
+				),
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.BackChannelLogoutSent(authz.WithInstanceID(context.Background(), "instance1"), "session1", "user1", "client1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
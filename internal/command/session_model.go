@@ -37,20 +37,21 @@ func (p *WebAuthNChallengeModel) WebAuthNLogin(human *domain.Human, credentialAs
 type SessionWriteModel struct {
 	eventstore.WriteModel
 
-	TokenID              string
-	UserID               string
-	UserResourceOwner    string
-	UserCheckedAt        time.Time
-	PasswordCheckedAt    time.Time
-	IntentCheckedAt      time.Time
-	WebAuthNCheckedAt    time.Time
-	TOTPCheckedAt        time.Time
-	OTPSMSCheckedAt      time.Time
-	OTPEmailCheckedAt    time.Time
-	WebAuthNUserVerified bool
-	Metadata             map[string][]byte
-	State                domain.SessionState
-	Expiration           time.Time
+	TokenID               string
+	UserID                string
+	UserResourceOwner     string
+	UserCheckedAt         time.Time
+	PasswordCheckedAt     time.Time
+	IntentCheckedAt       time.Time
+	WebAuthNCheckedAt     time.Time
+	TOTPCheckedAt         time.Time
+	OTPSMSCheckedAt       time.Time
+	OTPEmailCheckedAt     time.Time
+	RecoveryCodeCheckedAt time.Time
+	WebAuthNUserVerified  bool
+	Metadata              map[string][]byte
+	State                 domain.SessionState
+	Expiration            time.Time
 
 	WebAuthNChallenge     *WebAuthNChallengeModel
 	OTPSMSCodeChallenge   *OTPCode
@@ -94,6 +95,8 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceOTPEmailChallenged(e)
 		case *session.OTPEmailCheckedEvent:
 			wm.reduceOTPEmailChecked(e)
+		case *session.RecoveryCodeCheckedEvent:
+			wm.reduceRecoveryCodeChecked(e)
 		case *session.TokenSetEvent:
 			wm.reduceTokenSet(e)
 		case *session.LifetimeSetEvent:
@@ -122,6 +125,7 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.OTPSMSCheckedType,
 			session.OTPEmailChallengedType,
 			session.OTPEmailCheckedType,
+			session.RecoveryCodeCheckedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.LifetimeSetType,
@@ -198,6 +202,10 @@ func (wm *SessionWriteModel) reduceOTPEmailChecked(e *session.OTPEmailCheckedEve
 	wm.OTPEmailCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reduceRecoveryCodeChecked(e *session.RecoveryCodeCheckedEvent) {
+	wm.RecoveryCodeCheckedAt = e.CheckedAt
+}
+
 func (wm *SessionWriteModel) reduceTokenSet(e *session.TokenSetEvent) {
 	wm.TokenID = e.TokenID
 }
@@ -220,6 +228,7 @@ func (wm *SessionWriteModel) AuthenticationTime() time.Time {
 		wm.IntentCheckedAt,
 		wm.OTPSMSCheckedAt,
 		wm.OTPEmailCheckedAt,
+		wm.RecoveryCodeCheckedAt,
 	} {
 		if check.After(authTime) {
 			authTime = check
@@ -253,9 +262,27 @@ func (wm *SessionWriteModel) AuthMethodTypes() []domain.UserAuthMethodType {
 	if !wm.OTPEmailCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeOTPEmail)
 	}
+	if !wm.RecoveryCodeCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
+	}
 	return types
 }
 
+// Factors returns the times of the succeeded checks
+func (wm *SessionWriteModel) Factors() *domain.SessionFactors {
+	return &domain.SessionFactors{
+		UserCheckedAt:         wm.UserCheckedAt,
+		PasswordCheckedAt:     wm.PasswordCheckedAt,
+		IntentCheckedAt:       wm.IntentCheckedAt,
+		WebAuthNCheckedAt:     wm.WebAuthNCheckedAt,
+		WebAuthNUserVerified:  wm.WebAuthNUserVerified,
+		TOTPCheckedAt:         wm.TOTPCheckedAt,
+		OTPSMSCheckedAt:       wm.OTPSMSCheckedAt,
+		OTPEmailCheckedAt:     wm.OTPEmailCheckedAt,
+		RecoveryCodeCheckedAt: wm.RecoveryCodeCheckedAt,
+	}
+}
+
 // CheckNotInvalidated checks that the session was not invalidated either manually ([session.TerminateType])
 // or automatically (expired).
 func (wm *SessionWriteModel) CheckNotInvalidated() error {
@@ -493,6 +493,35 @@ func TestCommands_updateSession(t *testing.T) {
 				},
 			},
 		},
+		{
+			"no change, authentication required",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						RequireAuthentication(&domain.AuthenticationRequirement{Level: domain.LevelOfAssuranceMultiFactor}),
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{},
+					ID:            "sessionID",
+					NewToken:      "",
+					AuthenticationStatus: &domain.AuthenticationStatus{
+						Level:          domain.LevelOfAssuranceNone,
+						RequiredChecks: []domain.SessionCheckType{domain.SessionCheckTypeUser},
+					},
+				},
+			},
+		},
 		{
 			"negative lifetime",
 			fields{
@@ -955,6 +984,125 @@ func TestCheckTOTP(t *testing.T) {
 	}
 }
 
+func TestCheckRecoveryCode(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+
+	sessAgg := &session.NewAggregate("session1", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+
+	type fields struct {
+		sessionWriteModel *SessionWriteModel
+		eventstore        func(*testing.T) *eventstore.Eventstore
+	}
+
+	tests := []struct {
+		name              string
+		code              string
+		fields            fields
+		wantEventCommands []eventstore.Command
+		wantErr           error
+	}{
+		{
+			name: "missing userID",
+			code: "ABCDE-FGHJK",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					aggregate: sessAgg,
+				},
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohb3u", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "filter error",
+			code: "ABCDE-FGHJK",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilterError(io.ErrClosedPipe),
+				),
+			},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "no recovery codes",
+			code: "ABCDE-FGHJK",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Thai9", "Errors.User.MFA.RecoveryCodes.NotExisting"),
+		},
+		{
+			name: "code already used",
+			code: "ABCDE-FGHJK",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, []string{"$plain$x$ABCDEFGHJK", "$plain$x$KLMNPQRSTU"}),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, nil),
+						),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoo8a", "Errors.User.MFA.RecoveryCodes.InvalidCode"),
+		},
+		{
+			name: "ok",
+			code: "klmnp-qrstu",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, []string{"$plain$x$ABCDEFGHJK", "$plain$x$KLMNPQRSTU"}),
+						),
+					),
+				),
+			},
+			wantEventCommands: []eventstore.Command{
+				user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 1, nil),
+				session.NewRecoveryCodeCheckedEvent(ctx, sessAgg, testNow),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &SessionCommands{
+				sessionWriteModel: tt.fields.sessionWriteModel,
+				eventstore:        tt.fields.eventstore(t),
+				hasher:            mockPasswordHasher("x"),
+				now:               func() time.Time { return testNow },
+			}
+			err := CheckRecoveryCode(tt.code)(ctx, cmd)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantEventCommands, cmd.eventCommands)
+		})
+	}
+}
+
 func TestCommands_TerminateSession(t *testing.T) {
 	type fields struct {
 		eventstore      func(t *testing.T) *eventstore.Eventstore
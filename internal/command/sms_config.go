@@ -5,7 +5,10 @@ import (
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -110,6 +113,321 @@ func (c *Commands) ChangeSMSConfigTwilioToken(ctx context.Context, instanceID, i
 	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
 }
 
+func (c *Commands) AddSMSConfigVonage(ctx context.Context, instanceID string, config *vonage.Config) (string, *domain.ObjectDetails, error) {
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var apiSecret *crypto.CryptoValue
+	if config.APISecret != "" {
+		apiSecret, err = crypto.Encrypt([]byte(config.APISecret), c.smsEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigVonageAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.APIKey,
+		config.SenderNumber,
+		apiSecret))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigVonage(ctx context.Context, instanceID, id string, config *vonage.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Vo1sa", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Vonage == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Vo2sb", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewVonageChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.APIKey,
+		config.SenderNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Vo3sc", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigVonageAPISecret(ctx context.Context, instanceID, id, apiSecret string) (*domain.ObjectDetails, error) {
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Vonage == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Vo4sd", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	newAPISecret, err := crypto.Encrypt([]byte(apiSecret), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigVonageAPISecretChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		newAPISecret))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddSMSConfigMessageBird(ctx context.Context, instanceID string, config *messagebird.Config) (string, *domain.ObjectDetails, error) {
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var accessKey *crypto.CryptoValue
+	if config.AccessKey != "" {
+		accessKey, err = crypto.Encrypt([]byte(config.AccessKey), c.smsEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigMessageBirdAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Originator,
+		accessKey))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigMessageBird(ctx context.Context, instanceID, id string, config *messagebird.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Mb1sa", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.MessageBird == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Mb2sb", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewMessageBirdChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Originator)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mb3sc", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigMessageBirdAccessKey(ctx context.Context, instanceID, id, accessKey string) (*domain.ObjectDetails, error) {
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.MessageBird == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Mb4sd", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	newAccessKey, err := crypto.Encrypt([]byte(accessKey), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigMessageBirdAccessKeyChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		newAccessKey))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddSMSConfigHTTP(ctx context.Context, instanceID string, config *httpsms.Config) (string, *domain.ObjectDetails, error) {
+	if err := config.Validate(); err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var authorization *crypto.CryptoValue
+	if config.Authorization != "" {
+		authorization, err = crypto.Encrypt([]byte(config.Authorization), c.smsEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Endpoint,
+		config.Method,
+		config.ContentType,
+		config.BodyTemplate,
+		config.SenderNumber,
+		authorization))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, instanceID, id string, config *httpsms.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Ht1sa", "Errors.IDMissing")
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ht2sb", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Endpoint,
+		config.Method,
+		config.ContentType,
+		config.BodyTemplate,
+		config.SenderNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ht3sc", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// ChangeSMSConfigHTTPAuthorization sets the value of the Authorization header sent to the provider.
+// An empty authorization removes the header.
+func (c *Commands) ChangeSMSConfigHTTPAuthorization(ctx context.Context, instanceID, id, authorization string) (*domain.ObjectDetails, error) {
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ht4sd", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	var newAuthorization *crypto.CryptoValue
+	if authorization != "" {
+		newAuthorization, err = crypto.Encrypt([]byte(authorization), c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigHTTPAuthorizationChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		newAuthorization))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
 func (c *Commands) ActivateSMSConfig(ctx context.Context, instanceID, id string) (*domain.ObjectDetails, error) {
 	if id == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-dn93n", "Errors.IDMissing")
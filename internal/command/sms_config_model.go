@@ -12,9 +12,12 @@ import (
 type IAMSMSConfigWriteModel struct {
 	eventstore.WriteModel
 
-	ID     string
-	Twilio *TwilioConfig
-	State  domain.SMSConfigState
+	ID          string
+	Twilio      *TwilioConfig
+	Vonage      *VonageConfig
+	MessageBird *MessageBirdConfig
+	HTTP        *HTTPSMSConfig
+	State       domain.SMSConfigState
 }
 
 type TwilioConfig struct {
@@ -23,6 +26,26 @@ type TwilioConfig struct {
 	SenderNumber string
 }
 
+type VonageConfig struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type MessageBirdConfig struct {
+	AccessKey  *crypto.CryptoValue
+	Originator string
+}
+
+type HTTPSMSConfig struct {
+	Endpoint      string
+	Method        string
+	ContentType   string
+	BodyTemplate  string
+	SenderNumber  string
+	Authorization *crypto.CryptoValue
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -62,6 +85,89 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio.Token = e.Token
+		case *instance.SMSConfigVonageAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage = &VonageConfig{
+				APIKey:       e.APIKey,
+				APISecret:    e.APISecret,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigVonageChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.APIKey != nil {
+				wm.Vonage.APIKey = *e.APIKey
+			}
+			if e.SenderNumber != nil {
+				wm.Vonage.SenderNumber = *e.SenderNumber
+			}
+		case *instance.SMSConfigVonageAPISecretChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage.APISecret = e.APISecret
+		case *instance.SMSConfigMessageBirdAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.MessageBird = &MessageBirdConfig{
+				AccessKey:  e.AccessKey,
+				Originator: e.Originator,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigMessageBirdChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Originator != nil {
+				wm.MessageBird.Originator = *e.Originator
+			}
+		case *instance.SMSConfigMessageBirdAccessKeyChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.MessageBird.AccessKey = e.AccessKey
+		case *instance.SMSConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP = &HTTPSMSConfig{
+				Endpoint:      e.Endpoint,
+				Method:        e.Method,
+				ContentType:   e.ContentType,
+				BodyTemplate:  e.BodyTemplate,
+				SenderNumber:  e.SenderNumber,
+				Authorization: e.Authorization,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigHTTPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+			if e.Method != nil {
+				wm.HTTP.Method = *e.Method
+			}
+			if e.ContentType != nil {
+				wm.HTTP.ContentType = *e.ContentType
+			}
+			if e.BodyTemplate != nil {
+				wm.HTTP.BodyTemplate = *e.BodyTemplate
+			}
+			if e.SenderNumber != nil {
+				wm.HTTP.SenderNumber = *e.SenderNumber
+			}
+		case *instance.SMSConfigHTTPAuthorizationChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP.Authorization = e.Authorization
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -77,6 +183,9 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio = nil
+			wm.Vonage = nil
+			wm.MessageBird = nil
+			wm.HTTP = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -92,6 +201,15 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioAddedEventType,
 			instance.SMSConfigTwilioChangedEventType,
 			instance.SMSConfigTwilioTokenChangedEventType,
+			instance.SMSConfigVonageAddedEventType,
+			instance.SMSConfigVonageChangedEventType,
+			instance.SMSConfigVonageAPISecretChangedEventType,
+			instance.SMSConfigMessageBirdAddedEventType,
+			instance.SMSConfigMessageBirdChangedEventType,
+			instance.SMSConfigMessageBirdAccessKeyChangedEventType,
+			instance.SMSConfigHTTPAddedEventType,
+			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigHTTPAuthorizationChangedEventType,
 			instance.SMSConfigActivatedEventType,
 			instance.SMSConfigDeactivatedEventType,
 			instance.SMSConfigRemovedEventType).
@@ -118,3 +236,69 @@ func (wm *IAMSMSConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate
 	}
 	return changeEvent, true, nil
 }
+
+func (wm *IAMSMSConfigWriteModel) NewVonageChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, apiKey, senderNumber string) (*instance.SMSConfigVonageChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigVonageChanges, 0)
+
+	if wm.Vonage.APIKey != apiKey {
+		changes = append(changes, instance.ChangeSMSConfigVonageAPIKey(apiKey))
+	}
+	if wm.Vonage.SenderNumber != senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigVonageSenderNumber(senderNumber))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigVonageChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewMessageBirdChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, originator string) (*instance.SMSConfigMessageBirdChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigMessageBirdChanges, 0)
+
+	if wm.MessageBird.Originator != originator {
+		changes = append(changes, instance.ChangeSMSConfigMessageBirdOriginator(originator))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigMessageBirdChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, endpoint, method, contentType, bodyTemplate, senderNumber string) (*instance.SMSConfigHTTPChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigHTTPChanges, 0)
+
+	if wm.HTTP.Endpoint != endpoint {
+		changes = append(changes, instance.ChangeSMSConfigHTTPEndpoint(endpoint))
+	}
+	if wm.HTTP.Method != method {
+		changes = append(changes, instance.ChangeSMSConfigHTTPMethod(method))
+	}
+	if wm.HTTP.ContentType != contentType {
+		changes = append(changes, instance.ChangeSMSConfigHTTPContentType(contentType))
+	}
+	if wm.HTTP.BodyTemplate != bodyTemplate {
+		changes = append(changes, instance.ChangeSMSConfigHTTPBodyTemplate(bodyTemplate))
+	}
+	if wm.HTTP.SenderNumber != senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigHTTPSenderNumber(senderNumber))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
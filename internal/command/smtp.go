@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"strings"
 
@@ -10,32 +11,48 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpemail"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func (c *Commands) AddSMTPConfig(ctx context.Context, config *smtp.Config) (*domain.ObjectDetails, error) {
+// SMTPConfig is one of the SMTP providers of an instance.
+// If OrgID is set, the provider is only used for notifications to users of the organization.
+// Active providers are tried in ascending order of their Priority until a message could be delivered.
+type SMTPConfig struct {
+	ID          string
+	OrgID       string
+	Description string
+	Priority    int32
+	Config      *smtp.Config
+}
+
+func (c *Commands) AddSMTPConfig(ctx context.Context, config *SMTPConfig) (string, *domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	validation := c.prepareAddSMTPConfig(instanceAgg, config.From, config.FromName, config.ReplyToAddress, config.SMTP.Host, config.SMTP.User, []byte(config.SMTP.Password), config.Tls)
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	validation := c.prepareAddSMTPConfig(instanceAgg, id, config, false)
 	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 	events, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
-	return &domain.ObjectDetails{
+	return id, &domain.ObjectDetails{
 		Sequence:      events[len(events)-1].Sequence(),
 		EventDate:     events[len(events)-1].CreatedAt(),
 		ResourceOwner: events[len(events)-1].Aggregate().InstanceID,
 	}, nil
 }
 
-func (c *Commands) ChangeSMTPConfig(ctx context.Context, config *smtp.Config) (*domain.ObjectDetails, error) {
+func (c *Commands) ChangeSMTPConfig(ctx context.Context, config *SMTPConfig) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	validation := c.prepareChangeSMTPConfig(instanceAgg, config.From, config.FromName, config.ReplyToAddress, config.SMTP.Host, config.SMTP.User, config.Tls)
+	validation := c.prepareChangeSMTPConfig(instanceAgg, config)
 	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
 	if err != nil {
 		return nil, err
@@ -51,13 +68,16 @@ func (c *Commands) ChangeSMTPConfig(ctx context.Context, config *smtp.Config) (*
 	}, nil
 }
 
-func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, password string) (*domain.ObjectDetails, error) {
+func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, id, password string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-5Jf2x", "Errors.IDMissing")
+	}
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	smtpConfigWriteModel, err := getSMTPConfigWriteModel(ctx, c.eventstore.Filter, "")
+	smtpConfigWriteModel, err := getSMTPConfigWriteModel(ctx, c.eventstore.Filter, id, "")
 	if err != nil {
 		return nil, err
 	}
-	if smtpConfigWriteModel.State != domain.SMTPConfigStateActive {
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTP != nil {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-3n9ls", "Errors.SMTPConfig.NotFound")
 	}
 	var smtpPassword *crypto.CryptoValue
@@ -70,6 +90,7 @@ func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, password string
 	events, err := c.eventstore.Push(ctx, instance.NewSMTPConfigPasswordChangedEvent(
 		ctx,
 		&instanceAgg.Aggregate,
+		id,
 		smtpPassword))
 	if err != nil {
 		return nil, err
@@ -81,9 +102,67 @@ func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, password string
 	}, nil
 }
 
-func (c *Commands) RemoveSMTPConfig(ctx context.Context) (*domain.ObjectDetails, error) {
+func (c *Commands) ActivateSMTPConfig(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-nm56k", "Errors.IDMissing")
+	}
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	validation := c.prepareRemoveSMTPConfig(instanceAgg)
+	smtpConfigWriteModel, err := getSMTPConfigWriteModel(ctx, c.eventstore.Filter, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-kg8yr", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.State == domain.SMTPConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ed3lr", "Errors.SMTPConfig.AlreadyActive")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigActivatedEvent(
+		ctx,
+		&instanceAgg.Aggregate,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) DeactivateSMTPConfig(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-98ikl", "Errors.IDMissing")
+	}
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	smtpConfigWriteModel, err := getSMTPConfigWriteModel(ctx, c.eventstore.Filter, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-k39PJ", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.State == domain.SMTPConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-km8g3", "Errors.SMTPConfig.AlreadyDeactivated")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigDeactivatedEvent(
+		ctx,
+		&instanceAgg.Aggregate,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveSMTPConfig(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	validation := c.prepareRemoveSMTPConfig(instanceAgg, id)
 	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
 	if err != nil {
 		return nil, err
@@ -99,90 +178,121 @@ func (c *Commands) RemoveSMTPConfig(ctx context.Context) (*domain.ObjectDetails,
 	}, nil
 }
 
-func (c *Commands) prepareAddSMTPConfig(a *instance.Aggregate, from, name, replyTo, hostAndPort, user string, password []byte, tls bool) preparation.Validation {
+func (c *Commands) prepareAddSMTPConfig(a *instance.Aggregate, id string, config *SMTPConfig, activate bool) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
-		if from = strings.TrimSpace(from); from == "" {
+		if config == nil || config.Config == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Bs3kf", "Errors.Invalid.Argument")
+		}
+		from := strings.TrimSpace(config.Config.From)
+		if from == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-mruNY", "Errors.Invalid.Argument")
 		}
 
-		replyTo = strings.TrimSpace(replyTo)
+		replyTo := strings.TrimSpace(config.Config.ReplyToAddress)
 
-		hostAndPort = strings.TrimSpace(hostAndPort)
+		hostAndPort := strings.TrimSpace(config.Config.SMTP.Host)
 		if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-9JdRe", "Errors.Invalid.Argument")
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			fromSplitted := strings.Split(from, "@")
 			senderDomain := fromSplitted[len(fromSplitted)-1]
-			writeModel, err := getSMTPConfigWriteModel(ctx, filter, senderDomain)
+			writeModel, err := getSMTPConfigWriteModel(ctx, filter, id, senderDomain)
 			if err != nil {
 				return nil, err
 			}
-			if writeModel.State == domain.SMTPConfigStateActive {
+			if writeModel.State.Exists() {
 				return nil, zerrors.ThrowAlreadyExists(nil, "INST-W3VS2", "Errors.SMTPConfig.AlreadyExists")
 			}
-			err = checkSenderAddress(writeModel)
+			if config.OrgID != "" {
+				exists, err := ExistsOrg(ctx, filter, config.OrgID)
+				if err != nil {
+					return nil, err
+				}
+				if !exists {
+					return nil, zerrors.ThrowPreconditionFailed(nil, "INST-Hf4ml", "Errors.Org.NotFound")
+				}
+			}
+			err = checkSenderAddress(ctx, filter, writeModel, config.OrgID, senderDomain)
 			if err != nil {
 				return nil, err
 			}
 			var smtpPassword *crypto.CryptoValue
-			if password != nil {
-				smtpPassword, err = crypto.Encrypt(password, c.smtpEncryption)
+			if config.Config.SMTP.Password != "" {
+				smtpPassword, err = crypto.Encrypt([]byte(config.Config.SMTP.Password), c.smtpEncryption)
 				if err != nil {
 					return nil, err
 				}
 			}
-			return []eventstore.Command{
+			cmds := []eventstore.Command{
 				instance.NewSMTPConfigAddedEvent(
 					ctx,
 					&a.Aggregate,
-					tls,
+					id,
+					config.OrgID,
+					config.Description,
+					config.Priority,
+					config.Config.Tls,
 					from,
-					name,
+					config.Config.FromName,
 					replyTo,
 					hostAndPort,
-					user,
+					config.Config.SMTP.User,
 					smtpPassword,
 				),
-			}, nil
+			}
+			if activate {
+				cmds = append(cmds, instance.NewSMTPConfigActivatedEvent(ctx, &a.Aggregate, id))
+			}
+			return cmds, nil
 		}, nil
 	}
 }
 
-func (c *Commands) prepareChangeSMTPConfig(a *instance.Aggregate, from, name, replyTo, hostAndPort, user string, tls bool) preparation.Validation {
+func (c *Commands) prepareChangeSMTPConfig(a *instance.Aggregate, config *SMTPConfig) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
-		if from = strings.TrimSpace(from); from == "" {
+		if config == nil || config.Config == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Rg2nx", "Errors.Invalid.Argument")
+		}
+		if config.ID == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-x8vo9", "Errors.IDMissing")
+		}
+		from := strings.TrimSpace(config.Config.From)
+		if from == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-ASv2d", "Errors.Invalid.Argument")
 		}
 
-		replyTo = strings.TrimSpace(replyTo)
-		hostAndPort = strings.TrimSpace(hostAndPort)
+		replyTo := strings.TrimSpace(config.Config.ReplyToAddress)
+		hostAndPort := strings.TrimSpace(config.Config.SMTP.Host)
 		if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Kv875", "Errors.Invalid.Argument")
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			fromSplitted := strings.Split(from, "@")
 			senderDomain := fromSplitted[len(fromSplitted)-1]
-			writeModel, err := getSMTPConfigWriteModel(ctx, filter, senderDomain)
+			writeModel, err := getSMTPConfigWriteModel(ctx, filter, config.ID, senderDomain)
 			if err != nil {
 				return nil, err
 			}
-			if writeModel.State != domain.SMTPConfigStateActive {
+			if !writeModel.State.Exists() || writeModel.HTTP != nil {
 				return nil, zerrors.ThrowNotFound(nil, "INST-Svq1a", "Errors.SMTPConfig.NotFound")
 			}
-			err = checkSenderAddress(writeModel)
+			err = checkSenderAddress(ctx, filter, writeModel, writeModel.OrgID, senderDomain)
 			if err != nil {
 				return nil, err
 			}
 			changedEvent, hasChanged, err := writeModel.NewChangedEvent(
 				ctx,
 				&a.Aggregate,
-				tls,
+				config.ID,
+				config.Description,
+				config.Priority,
+				config.Config.Tls,
 				from,
-				name,
+				config.Config.FromName,
 				replyTo,
 				hostAndPort,
-				user,
+				config.Config.SMTP.User,
 			)
 			if err != nil {
 				return nil, err
@@ -197,35 +307,273 @@ func (c *Commands) prepareChangeSMTPConfig(a *instance.Aggregate, from, name, re
 	}
 }
 
-func (c *Commands) prepareRemoveSMTPConfig(a *instance.Aggregate) preparation.Validation {
+func (c *Commands) prepareRemoveSMTPConfig(a *instance.Aggregate, id string) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		if id == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-t2WsP", "Errors.IDMissing")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
-			writeModel, err := getSMTPConfigWriteModel(ctx, filter, "")
+			writeModel, err := getSMTPConfigWriteModel(ctx, filter, id, "")
 			if err != nil {
 				return nil, err
 			}
-			if writeModel.State != domain.SMTPConfigStateActive {
+			if !writeModel.State.Exists() {
 				return nil, zerrors.ThrowNotFound(nil, "INST-Sfefg", "Errors.SMTPConfig.NotFound")
 			}
 			return []eventstore.Command{
-				instance.NewSMTPConfigRemovedEvent(ctx, &a.Aggregate),
+				instance.NewSMTPConfigRemovedEvent(ctx, &a.Aggregate, id),
+			}, nil
+		}, nil
+	}
+}
+
+// SMTPConfigHTTP is an email provider of an instance, which sends the emails using an HTTP API instead of SMTP.
+// It is activated, prioritized and removed the same way as an SMTPConfig.
+type SMTPConfigHTTP struct {
+	ID          string
+	OrgID       string
+	Description string
+	Priority    int32
+	Config      *httpemail.Config
+}
+
+func (c *Commands) AddSMTPConfigHTTP(ctx context.Context, config *SMTPConfigHTTP) (string, *domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	validation := c.prepareAddSMTPConfigHTTP(instanceAgg, id, config)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
+	if err != nil {
+		return "", nil, err
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, &domain.ObjectDetails{
+		Sequence:      events[len(events)-1].Sequence(),
+		EventDate:     events[len(events)-1].CreatedAt(),
+		ResourceOwner: events[len(events)-1].Aggregate().InstanceID,
+	}, nil
+}
+
+func (c *Commands) ChangeSMTPConfigHTTP(ctx context.Context, config *SMTPConfigHTTP) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	validation := c.prepareChangeSMTPConfigHTTP(instanceAgg, config)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ObjectDetails{
+		Sequence:      events[len(events)-1].Sequence(),
+		EventDate:     events[len(events)-1].CreatedAt(),
+		ResourceOwner: events[len(events)-1].Aggregate().InstanceID,
+	}, nil
+}
+
+// ChangeSMTPConfigHTTPHeaders replaces the headers sent to the provider.
+// The headers are handled like a password, as they usually contain the credentials of the provider.
+func (c *Commands) ChangeSMTPConfigHTTPHeaders(ctx context.Context, id string, headers map[string]string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Hd1ka", "Errors.IDMissing")
+	}
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	smtpConfigWriteModel, err := getSMTPConfigWriteModel(ctx, c.eventstore.Filter, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Hd2kb", "Errors.SMTPConfig.NotFound")
+	}
+	httpConfig := &httpemail.Config{
+		Endpoint:      smtpConfigWriteModel.HTTP.Endpoint,
+		SenderAddress: smtpConfigWriteModel.SenderAddress,
+		BodyTemplate:  smtpConfigWriteModel.HTTP.BodyTemplate,
+		Headers:       headers,
+	}
+	if err = httpConfig.Validate(); err != nil {
+		return nil, err
+	}
+	encryptedHeaders, err := c.encryptSMTPConfigHTTPHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigHTTPHeadersChangedEvent(
+		ctx,
+		&instanceAgg.Aggregate,
+		id,
+		encryptedHeaders))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) prepareAddSMTPConfigHTTP(a *instance.Aggregate, id string, config *SMTPConfigHTTP) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if config == nil || config.Config == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Hq1ka", "Errors.Invalid.Argument")
+		}
+		config.Config.SenderAddress = strings.TrimSpace(config.Config.SenderAddress)
+		config.Config.ReplyToAddress = strings.TrimSpace(config.Config.ReplyToAddress)
+		if err := config.Config.Validate(); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			fromSplitted := strings.Split(config.Config.SenderAddress, "@")
+			senderDomain := fromSplitted[len(fromSplitted)-1]
+			writeModel, err := getSMTPConfigWriteModel(ctx, filter, id, senderDomain)
+			if err != nil {
+				return nil, err
+			}
+			if writeModel.State.Exists() {
+				return nil, zerrors.ThrowAlreadyExists(nil, "INST-Hq2kb", "Errors.SMTPConfig.AlreadyExists")
+			}
+			if config.OrgID != "" {
+				exists, err := ExistsOrg(ctx, filter, config.OrgID)
+				if err != nil {
+					return nil, err
+				}
+				if !exists {
+					return nil, zerrors.ThrowPreconditionFailed(nil, "INST-Hq3kc", "Errors.Org.NotFound")
+				}
+			}
+			err = checkSenderAddress(ctx, filter, writeModel, config.OrgID, senderDomain)
+			if err != nil {
+				return nil, err
+			}
+			headers, err := c.encryptSMTPConfigHTTPHeaders(config.Config.Headers)
+			if err != nil {
+				return nil, err
+			}
+			return []eventstore.Command{
+				instance.NewSMTPConfigHTTPAddedEvent(
+					ctx,
+					&a.Aggregate,
+					id,
+					config.OrgID,
+					config.Description,
+					config.Priority,
+					config.Config.SenderAddress,
+					config.Config.SenderName,
+					config.Config.ReplyToAddress,
+					config.Config.Endpoint,
+					config.Config.Method,
+					config.Config.ContentType,
+					config.Config.BodyTemplate,
+					headers,
+				),
 			}, nil
 		}, nil
 	}
 }
 
-func checkSenderAddress(writeModel *InstanceSMTPConfigWriteModel) error {
+// prepareChangeSMTPConfigHTTP changes everything except the headers, see [Commands.ChangeSMTPConfigHTTPHeaders]
+func (c *Commands) prepareChangeSMTPConfigHTTP(a *instance.Aggregate, config *SMTPConfigHTTP) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if config == nil || config.Config == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Hr1ka", "Errors.Invalid.Argument")
+		}
+		if config.ID == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Hr2kb", "Errors.IDMissing")
+		}
+		config.Config.SenderAddress = strings.TrimSpace(config.Config.SenderAddress)
+		config.Config.ReplyToAddress = strings.TrimSpace(config.Config.ReplyToAddress)
+		if err := config.Config.Validate(); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			fromSplitted := strings.Split(config.Config.SenderAddress, "@")
+			senderDomain := fromSplitted[len(fromSplitted)-1]
+			writeModel, err := getSMTPConfigWriteModel(ctx, filter, config.ID, senderDomain)
+			if err != nil {
+				return nil, err
+			}
+			if !writeModel.State.Exists() || writeModel.HTTP == nil {
+				return nil, zerrors.ThrowNotFound(nil, "INST-Hr3kc", "Errors.SMTPConfig.NotFound")
+			}
+			err = checkSenderAddress(ctx, filter, writeModel, writeModel.OrgID, senderDomain)
+			if err != nil {
+				return nil, err
+			}
+			changedEvent, hasChanged, err := writeModel.NewHTTPChangedEvent(
+				ctx,
+				&a.Aggregate,
+				config.ID,
+				config.Description,
+				config.Priority,
+				config.Config.SenderAddress,
+				config.Config.SenderName,
+				config.Config.ReplyToAddress,
+				config.Config.Endpoint,
+				config.Config.Method,
+				config.Config.ContentType,
+				config.Config.BodyTemplate,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if !hasChanged {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hr4kd", "Errors.NoChangesFound")
+			}
+			return []eventstore.Command{
+				changedEvent,
+			}, nil
+		}, nil
+	}
+}
+
+func (c *Commands) encryptSMTPConfigHTTPHeaders(headers map[string]string) (*crypto.CryptoValue, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Hs1ka", "Errors.Internal")
+	}
+	return crypto.Encrypt(encoded, c.smtpEncryption)
+}
+
+// checkSenderAddress ensures the domain of the sender address is a custom domain of the instance,
+// if required by the domain policy.
+// For SMTP configurations of an organization the domain must be a verified domain of the organization instead.
+func checkSenderAddress(ctx context.Context, filter preparation.FilterToQueryReducer, writeModel *InstanceSMTPConfigWriteModel, orgID, senderDomain string) error {
 	if !writeModel.smtpSenderAddressMatchesInstanceDomain {
 		return nil
 	}
-	if !writeModel.domainState.Exists() {
-		return zerrors.ThrowInvalidArgument(nil, "INST-83nl8", "Errors.SMTPConfig.SenderAdressNotCustomDomain")
+	if orgID == "" {
+		if !writeModel.domainState.Exists() {
+			return zerrors.ThrowInvalidArgument(nil, "INST-83nl8", "Errors.SMTPConfig.SenderAdressNotCustomDomain")
+		}
+		return nil
+	}
+	orgDomain := NewOrgDomainWriteModel(orgID, senderDomain)
+	events, err := filter(ctx, orgDomain.Query())
+	if err != nil {
+		return err
+	}
+	orgDomain.AppendEvents(events...)
+	if err = orgDomain.Reduce(); err != nil {
+		return err
+	}
+	if orgDomain.State != domain.OrgDomainStateActive || !orgDomain.Verified {
+		return zerrors.ThrowInvalidArgument(nil, "INST-Wq9vm", "Errors.SMTPConfig.SenderAdressNotOrgDomain")
 	}
 	return nil
 }
 
-func getSMTPConfigWriteModel(ctx context.Context, filter preparation.FilterToQueryReducer, domain string) (_ *InstanceSMTPConfigWriteModel, err error) {
-	writeModel := NewInstanceSMTPConfigWriteModel(authz.GetInstance(ctx).InstanceID(), domain)
+func getSMTPConfigWriteModel(ctx context.Context, filter preparation.FilterToQueryReducer, id, domain string) (_ *InstanceSMTPConfigWriteModel, err error) {
+	writeModel := NewInstanceSMTPConfigWriteModel(authz.GetInstance(ctx).InstanceID(), id, domain)
 	events, err := filter(ctx, writeModel.Query())
 	if err != nil {
 		return nil, err
@@ -11,21 +11,28 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpemail"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func TestCommandSide_AddSMTPConfig(t *testing.T) {
 	type fields struct {
-		eventstore *eventstore.Eventstore
-		alg        crypto.EncryptionAlgorithm
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
 	}
 	type args struct {
-		ctx  context.Context
-		smtp *smtp.Config
+		ctx   context.Context
+		orgID string
+		smtp  *smtp.Config
 	}
 	type res struct {
+		id   string
 		want *domain.ObjectDetails
 		err  func(error) bool
 	}
@@ -38,6 +45,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		{
 			name: "smtp config, custom domain not existing",
 			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
@@ -72,6 +80,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		{
 			name: "smtp config, error already exists",
 			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
@@ -91,6 +100,10 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 						eventFromEventPusher(
 							instance.NewSMTPConfigAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
 								true,
 								"from@domain.ch",
 								"name",
@@ -124,6 +137,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		{
 			name: "add smtp config, ok",
 			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
@@ -145,6 +159,10 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 						instance.NewSMTPConfigAddedEvent(
 							context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							"",
+							"test",
+							0,
 							true,
 							"from@domain.ch",
 							"name",
@@ -176,6 +194,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 				},
 			},
 			res: res{
+				id: "configid",
 				want: &domain.ObjectDetails{
 					ResourceOwner: "INSTANCE",
 				},
@@ -184,6 +203,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		{
 			name: "add smtp config with reply to address, ok",
 			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
@@ -205,6 +225,10 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 						instance.NewSMTPConfigAddedEvent(
 							context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							"",
+							"test",
+							0,
 							true,
 							"from@domain.ch",
 							"name",
@@ -237,6 +261,174 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 				},
 			},
 			res: res{
+				id: "configid",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "add smtp config for organization, organization not existing",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								true, true, true,
+							),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   authz.WithInstanceID(context.Background(), "INSTANCE"),
+				orgID: "org1",
+				smtp: &smtp.Config{
+					Tls:      true,
+					From:     "from@org.ch",
+					FromName: "name",
+					SMTP: smtp.SMTP{
+						Host:     "host:587",
+						User:     "user",
+						Password: "password",
+					},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add smtp config for organization, domain not verified",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								true, true, true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org.ch",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   authz.WithInstanceID(context.Background(), "INSTANCE"),
+				orgID: "org1",
+				smtp: &smtp.Config{
+					Tls:      true,
+					From:     "from@org.ch",
+					FromName: "name",
+					SMTP: smtp.SMTP{
+						Host:     "host:587",
+						User:     "user",
+						Password: "password",
+					},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add smtp config for organization, ok",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								true, true, true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org.ch",
+							),
+						),
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org.ch",
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMTPConfigAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							"org1",
+							"test",
+							0,
+							true,
+							"from@org.ch",
+							"name",
+							"",
+							"host:587",
+							"user",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("password"),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   authz.WithInstanceID(context.Background(), "INSTANCE"),
+				orgID: "org1",
+				smtp: &smtp.Config{
+					Tls:      true,
+					From:     "from@org.ch",
+					FromName: "name",
+					SMTP: smtp.SMTP{
+						Host:     "host:587",
+						User:     "user",
+						Password: "password",
+					},
+				},
+			},
+			res: res{
+				id: "configid",
 				want: &domain.ObjectDetails{
 					ResourceOwner: "INSTANCE",
 				},
@@ -245,7 +437,8 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		{
 			name: "smtp config, port is missing",
 			fields: fields{
-				eventstore: eventstoreExpect(t),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore:  eventstoreExpect(t),
 			},
 			args: args{
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
@@ -267,7 +460,8 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		{
 			name: "smtp config, host is empty",
 			fields: fields{
-				eventstore: eventstoreExpect(t),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore:  eventstoreExpect(t),
 			},
 			args: args{
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
@@ -289,6 +483,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		{
 			name: "add smtp config, ipv6 works",
 			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
 				eventstore: eventstoreExpect(
 					t,
 					expectFilter(
@@ -310,6 +505,10 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 						instance.NewSMTPConfigAddedEvent(
 							context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							"",
+							"test",
+							0,
 							true,
 							"from@domain.ch",
 							"name",
@@ -341,6 +540,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 				},
 			},
 			res: res{
+				id: "configid",
 				want: &domain.ObjectDetails{
 					ResourceOwner: "INSTANCE",
 				},
@@ -351,9 +551,14 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &Commands{
 				eventstore:     tt.fields.eventstore,
+				idGenerator:    tt.fields.idGenerator,
 				smtpEncryption: tt.fields.alg,
 			}
-			got, err := r.AddSMTPConfig(tt.args.ctx, tt.args.smtp)
+			id, got, err := r.AddSMTPConfig(tt.args.ctx, &SMTPConfig{
+				OrgID:       tt.args.orgID,
+				Description: "test",
+				Config:      tt.args.smtp,
+			})
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -361,6 +566,7 @@ func TestCommandSide_AddSMTPConfig(t *testing.T) {
 				t.Errorf("got wrong err: %v ", err)
 			}
 			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
 				assert.Equal(t, tt.res.want, got)
 			}
 		})
@@ -372,6 +578,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
+		id   string
 		ctx  context.Context
 		smtp *smtp.Config
 	}
@@ -393,6 +600,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				),
 			},
 			args: args{
+				id:   "configid",
 				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{},
 			},
@@ -409,6 +617,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				),
 			},
 			args: args{
+				id:  "configid",
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{
 					Tls:      true,
@@ -447,6 +656,10 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 							instance.NewSMTPConfigAddedEvent(
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
 								true,
 								"from@domain.ch",
 								"name",
@@ -460,6 +673,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				),
 			},
 			args: args{
+				id:  "configid",
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{
 					Tls:      true,
@@ -498,6 +712,10 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 							instance.NewSMTPConfigAddedEvent(
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
 								true,
 								"from@domain.ch",
 								"name",
@@ -511,6 +729,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				),
 			},
 			args: args{
+				id:  "configid",
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{
 					Tls:      true,
@@ -549,6 +768,10 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 							instance.NewSMTPConfigAddedEvent(
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
 								true,
 								"from@domain.ch",
 								"name",
@@ -573,6 +796,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				),
 			},
 			args: args{
+				id:  "configid",
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{
 					Tls:            false,
@@ -597,6 +821,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				eventstore: eventstoreExpect(t),
 			},
 			args: args{
+				id:  "configid",
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{
 					Tls:      true,
@@ -619,6 +844,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				eventstore: eventstoreExpect(t),
 			},
 			args: args{
+				id:  "configid",
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{
 					Tls:      true,
@@ -658,6 +884,10 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 							instance.NewSMTPConfigAddedEvent(
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
 								true,
 								"from@domain.ch",
 								"name",
@@ -682,6 +912,7 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 				),
 			},
 			args: args{
+				id:  "configid",
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
 				smtp: &smtp.Config{
 					Tls:            false,
@@ -706,7 +937,11 @@ func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.ChangeSMTPConfig(tt.args.ctx, tt.args.smtp)
+			got, err := r.ChangeSMTPConfig(tt.args.ctx, &SMTPConfig{
+				ID:          tt.args.id,
+				Description: "test",
+				Config:      tt.args.smtp,
+			})
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -726,6 +961,7 @@ func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
 		alg        crypto.EncryptionAlgorithm
 	}
 	type args struct {
+		id       string
 		ctx      context.Context
 		password string
 	}
@@ -748,6 +984,7 @@ func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
 				),
 			},
 			args: args{
+				id:       "configid",
 				ctx:      context.Background(),
 				password: "",
 			},
@@ -765,6 +1002,10 @@ func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
 							instance.NewSMTPConfigAddedEvent(
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
 								true,
 								"from",
 								"name",
@@ -779,6 +1020,7 @@ func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
 						instance.NewSMTPConfigPasswordChangedEvent(
 							context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
 							&crypto.CryptoValue{
 								CryptoType: crypto.TypeEncryption,
 								Algorithm:  "enc",
@@ -791,6 +1033,7 @@ func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
 				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
 			},
 			args: args{
+				id:       "configid",
 				ctx:      authz.WithInstanceID(context.Background(), "INSTANCE"),
 				password: "password",
 			},
@@ -807,7 +1050,7 @@ func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
 				eventstore:     tt.fields.eventstore,
 				smtpEncryption: tt.fields.alg,
 			}
-			got, err := r.ChangeSMTPConfigPassword(tt.args.ctx, tt.args.password)
+			got, err := r.ChangeSMTPConfigPassword(tt.args.ctx, tt.args.id, tt.args.password)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -821,13 +1064,13 @@ func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
 	}
 }
 
-func TestCommandSide_RemoveSMTPConfig(t *testing.T) {
+func TestCommandSide_ActivateSMTPConfig(t *testing.T) {
 	type fields struct {
 		eventstore *eventstore.Eventstore
-		alg        crypto.EncryptionAlgorithm
 	}
 	type args struct {
 		ctx context.Context
+		id  string
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -840,7 +1083,19 @@ func TestCommandSide_RemoveSMTPConfig(t *testing.T) {
 		res    res
 	}{
 		{
-			name: "smtp config, error not found",
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "smtp config not existing, not found error",
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
@@ -848,14 +1103,15 @@ func TestCommandSide_RemoveSMTPConfig(t *testing.T) {
 				),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "configid",
 			},
 			res: res{
 				err: zerrors.IsNotFound,
 			},
 		},
 		{
-			name: "remove smtp config, ok",
+			name: "smtp config already active, precondition error",
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
@@ -864,8 +1120,87 @@ func TestCommandSide_RemoveSMTPConfig(t *testing.T) {
 							instance.NewSMTPConfigAddedEvent(
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
 								true,
-								"from",
+								"from@domain.ch",
+								"name",
+								"",
+								"host:587",
+								"user",
+								&crypto.CryptoValue{},
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigActivatedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "configid",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "former instance smtp config already active, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"",
+								"",
+								"test",
+								0,
+								true,
+								"from@domain.ch",
+								"name",
+								"",
+								"host:587",
+								"user",
+								&crypto.CryptoValue{},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "INSTANCE",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "activate smtp config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								true,
+								"from@domain.ch",
 								"name",
 								"",
 								"host:587",
@@ -875,15 +1210,638 @@ func TestCommandSide_RemoveSMTPConfig(t *testing.T) {
 						),
 					),
 					expectPush(
-						instance.NewSMTPConfigRemovedEvent(
+						instance.NewSMTPConfigActivatedEvent(
 							context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
 						),
 					),
 				),
 			},
 			args: args{
 				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "configid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ActivateSMTPConfig(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_DeactivateSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "smtp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "configid",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "smtp config already inactive, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								true,
+								"from@domain.ch",
+								"name",
+								"",
+								"host:587",
+								"user",
+								&crypto.CryptoValue{},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "configid",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "deactivate smtp config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								true,
+								"from@domain.ch",
+								"name",
+								"",
+								"host:587",
+								"user",
+								&crypto.CryptoValue{},
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigActivatedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMTPConfigDeactivatedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:  "configid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.DeactivateSMTPConfig(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		id  string
+		ctx context.Context
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "smtp config, error not found",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				id:  "configid",
+				ctx: context.Background(),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove smtp config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								true,
+								"from",
+								"name",
+								"",
+								"host:587",
+								"user",
+								&crypto.CryptoValue{},
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMTPConfigRemovedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+						),
+					),
+				),
+			},
+			args: args{
+				id:  "configid",
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore,
+				smtpEncryption: tt.fields.alg,
+			}
+			got, err := r.RemoveSMTPConfig(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddSMTPConfigHTTP(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx    context.Context
+		config *httpemail.Config
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "endpoint missing, invalid argument error",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore:  eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &httpemail.Config{
+					SenderAddress: "from@domain.ch",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid body template, invalid argument error",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore:  eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &httpemail.Config{
+					Endpoint:      "https://api.domain.ch/mail",
+					BodyTemplate:  "{{.Subject",
+					SenderAddress: "from@domain.ch",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "error already exists",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"domain.ch",
+								false,
+							),
+						),
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								true, true, false,
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								"from@domain.ch",
+								"name",
+								"",
+								"https://api.domain.ch/mail",
+								"",
+								"",
+								"",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &httpemail.Config{
+					Endpoint:      "https://api.domain.ch/mail",
+					SenderAddress: "from@domain.ch",
+					SenderName:    "name",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add http config, ok",
+			fields: fields{
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"domain.ch",
+								false,
+							),
+						),
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								true, true, false,
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMTPConfigHTTPAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							"",
+							"test",
+							0,
+							"from@domain.ch",
+							"name",
+							"",
+							"https://api.domain.ch/mail",
+							"POST",
+							"application/json",
+							`{"subject":{{json .Subject}}}`,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte(`{"Authorization":"Bearer key"}`),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &httpemail.Config{
+					Endpoint:      "https://api.domain.ch/mail",
+					Method:        "POST",
+					ContentType:   "application/json",
+					BodyTemplate:  `{"subject":{{json .Subject}}}`,
+					Headers:       map[string]string{"Authorization": "Bearer key"},
+					SenderAddress: "from@domain.ch",
+					SenderName:    "name",
+				},
+			},
+			res: res{
+				id: "configid",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore,
+				idGenerator:    tt.fields.idGenerator,
+				smtpEncryption: tt.fields.alg,
+			}
+			id, got, err := r.AddSMTPConfigHTTP(tt.args.ctx, &SMTPConfigHTTP{
+				Description: "test",
+				Config:      tt.args.config,
+			})
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMTPConfigHTTPHeaders(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx     context.Context
+		id      string
+		headers map[string]string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "smtp config, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								true,
+								"from",
+								"name",
+								"",
+								"host:587",
+								"user",
+								&crypto.CryptoValue{},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:      "configid",
+				headers: map[string]string{"Authorization": "Bearer key"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "invalid header template, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								"from@domain.ch",
+								"name",
+								"",
+								"https://api.domain.ch/mail",
+								"",
+								"",
+								"",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:      "configid",
+				headers: map[string]string{"Authorization": "{{.Subject"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "change headers, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"",
+								"test",
+								0,
+								"from@domain.ch",
+								"name",
+								"",
+								"https://api.domain.ch/mail",
+								"",
+								"",
+								"",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMTPConfigHTTPHeadersChangedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte(`{"Authorization":"Bearer key"}`),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "INSTANCE"),
+				id:      "configid",
+				headers: map[string]string{"Authorization": "Bearer key"},
 			},
 			res: res{
 				want: &domain.ObjectDetails{
@@ -898,7 +1856,7 @@ func TestCommandSide_RemoveSMTPConfig(t *testing.T) {
 				eventstore:     tt.fields.eventstore,
 				smtpEncryption: tt.fields.alg,
 			}
-			got, err := r.RemoveSMTPConfig(tt.args.ctx)
+			got, err := r.ChangeSMTPConfigHTTPHeaders(tt.args.ctx, tt.args.id, tt.args.headers)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -923,6 +1881,7 @@ func newSMTPConfigChangedEvent(ctx context.Context, tls bool, fromAddress, fromN
 	}
 	event, _ := instance.NewSMTPConfigChangeEvent(ctx,
 		&instance.NewAggregate("INSTANCE").Aggregate,
+		"configid",
 		changes,
 	)
 	return event
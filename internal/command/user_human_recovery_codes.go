@@ -0,0 +1,145 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/passwap"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GenerateRecoveryCodes creates a new set of recovery codes for the user.
+// Previously generated codes will no longer be valid.
+// The plain codes are only returned once and can not be retrieved later on.
+func (c *Commands) GenerateRecoveryCodes(ctx context.Context, userID, resourceOwner string) (_ *domain.RecoveryCodes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eeb2a", "Errors.User.UserIDMissing")
+	}
+	if err := authz.UserIDInCTX(ctx, userID); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-oeD9s", "Errors.User.NotFound")
+	}
+	codes, err := domain.GenerateRecoveryCodes(domain.RecoveryCodesCount)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Ahng4", "Errors.Internal")
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		_, spanHash := tracing.NewNamedSpan(ctx, "passwap.Hash")
+		hashes[i], err = c.userPasswordHasher.Hash(domain.NormalizeRecoveryCode(code))
+		spanHash.EndWithError(err)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "COMMAND-Ied0a", "Errors.Internal")
+		}
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, hashes)); err != nil {
+		return nil, err
+	}
+	return &domain.RecoveryCodes{
+		ObjectDetails: writeModelToObjectDetails(&writeModel.WriteModel),
+		Codes:         codes,
+	}, nil
+}
+
+// RemoveRecoveryCodes invalidates all recovery codes of the user.
+func (c *Commands) RemoveRecoveryCodes(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gah4o", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if userID != authz.GetCtxData(ctx).UserID {
+		if err := c.checkPermission(ctx, domain.PermissionUserWrite, writeModel.ResourceOwner, userID); err != nil {
+			return nil, err
+		}
+	}
+	if !writeModel.CodesAdded() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ieK6i", "Errors.User.MFA.RecoveryCodes.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanRecoveryCodesRemovedEvent(ctx, userAgg)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// HumanCheckRecoveryCode checks the provided recovery code during the login
+// and marks it as used on success, so it can not be used again.
+func (c *Commands) HumanCheckRecoveryCode(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest) error {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ooR3e", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Uu3ei", "Errors.User.Code.Empty")
+	}
+	writeModel, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	index, err := verifyRecoveryCode(ctx, c.userPasswordHasher, writeModel, code)
+	if err == nil {
+		_, err = c.eventstore.Push(ctx, user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, index, authRequestDomainToAuthRequestInfo(authRequest)))
+		return err
+	}
+	if !writeModel.CodesAdded() {
+		return err
+	}
+	_, pushErr := c.eventstore.Push(ctx, user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	logging.WithFields("userID", userID).OnError(pushErr).Error("recovery code failure check push failed")
+	return err
+}
+
+// verifyRecoveryCode compares the code with all unused code hashes and returns the index of the matching one
+func verifyRecoveryCode(ctx context.Context, hasher *crypto.PasswordHasher, writeModel *HumanRecoveryCodesWriteModel, code string) (_ int, err error) {
+	if !writeModel.CodesAdded() {
+		return 0, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Thai9", "Errors.User.MFA.RecoveryCodes.NotExisting")
+	}
+	code = domain.NormalizeRecoveryCode(code)
+	_, spanVerify := tracing.NewNamedSpan(ctx, "passwap.Verify")
+	defer func() { spanVerify.EndWithError(err) }()
+	for i, hash := range writeModel.CodeHashes {
+		if writeModel.UsedCodes[i] {
+			continue
+		}
+		_, err = hasher.Verify(hash, code)
+		if err == nil {
+			return i, nil
+		}
+		if !errors.Is(err, passwap.ErrPasswordMismatch) {
+			return 0, zerrors.ThrowInternal(err, "COMMAND-Ahp2u", "Errors.Internal")
+		}
+	}
+	return 0, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoo8a", "Errors.User.MFA.RecoveryCodes.InvalidCode")
+}
+
+func (c *Commands) recoveryCodesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanRecoveryCodesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanRecoveryCodesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
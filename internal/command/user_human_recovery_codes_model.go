@@ -0,0 +1,81 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanRecoveryCodesWriteModel struct {
+	eventstore.WriteModel
+
+	UserState  domain.UserState
+	CodeHashes []string
+	// UsedCodes contains the indexes of the [CodeHashes] which have already been used
+	UsedCodes map[int]bool
+}
+
+func NewHumanRecoveryCodesWriteModel(userID, resourceOwner string) *HumanRecoveryCodesWriteModel {
+	return &HumanRecoveryCodesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		UsedCodes: make(map[int]bool),
+	}
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanRecoveryCodesAddedEvent:
+			wm.CodeHashes = e.CodeHashes
+			wm.UsedCodes = make(map[int]bool, len(e.CodeHashes))
+		case *user.HumanRecoveryCodeCheckSucceededEvent:
+			wm.UsedCodes[e.CodeIndex] = true
+		case *user.HumanRecoveryCodesRemovedEvent:
+			wm.CodeHashes = nil
+			wm.UsedCodes = make(map[int]bool)
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.CodeHashes = nil
+			wm.UsedCodes = make(map[int]bool)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanRecoveryCodesAddedType,
+			user.HumanRecoveryCodeCheckSucceededType,
+			user.HumanRecoveryCodesRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// CodesAdded returns true if a set of recovery codes was added, regardless if all codes were already used
+func (wm *HumanRecoveryCodesWriteModel) CodesAdded() bool {
+	return len(wm.CodeHashes) > 0
+}
+
+// RemainingCodes returns the amount of recovery codes not yet used
+func (wm *HumanRecoveryCodesWriteModel) RemainingCodes() int {
+	return len(wm.CodeHashes) - len(wm.UsedCodes)
+}
@@ -25,6 +25,12 @@ type TLS struct {
 	Key []byte
 	//Certificate for the TLS connection (CertPath will this overwrite, if specified)
 	Cert []byte
+	//If enabled, clients are asked for a certificate in the TLS handshake,
+	//which is used for the mutual TLS client authentication (RFC 8705)
+	RequestClientCertificate bool
+	//Header from which the client certificate is taken, if the TLS connection is terminated by a proxy
+	//the proxy must always set or remove the header, as its value is trusted
+	ClientCertificateHeader string
 }
 
 func (t *TLS) Config() (_ *tls.Config, err error) {
@@ -50,7 +56,12 @@ func (t *TLS) Config() (_ *tls.Config, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return &tls.Config{
+	config := &tls.Config{
 		Certificates: []tls.Certificate{tlsCert},
-	}, nil
+	}
+	if t.RequestClientCertificate {
+		// the certificate is verified depending on the authentication method of the client
+		config.ClientAuth = tls.RequestClientCert
+	}
+	return config, nil
 }
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"strings"
+
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
@@ -14,6 +16,8 @@ type APIApp struct {
 	ClientSecret       *crypto.CryptoValue
 	ClientSecretString string
 	AuthMethodType     APIAuthMethodType
+	// TLSClientAuthSubjectDN is the subject of the certificate expected for tls_client_auth (RFC 8705)
+	TLSClientAuthSubjectDN string
 
 	State AppState
 }
@@ -31,10 +35,17 @@ type APIAuthMethodType int32
 const (
 	APIAuthMethodTypeBasic APIAuthMethodType = iota
 	APIAuthMethodTypePrivateKeyJWT
+	APIAuthMethodTypeTLSClientAuth
+	APIAuthMethodTypeSelfSignedTLSClientAuth
 )
 
 func (a *APIApp) IsValid() bool {
-	return a.AppName != ""
+	return a.AppName != "" && a.TLSClientAuthValid()
+}
+
+// TLSClientAuthValid checks that the subject of the client certificate is set for tls_client_auth
+func (a *APIApp) TLSClientAuthValid() bool {
+	return a.AuthMethodType != APIAuthMethodTypeTLSClientAuth || strings.TrimSpace(a.TLSClientAuthSubjectDN) != ""
 }
 
 func (a *APIApp) setClientID(clientID string) {
@@ -50,7 +61,7 @@ func (a *APIApp) requiresClientSecret() bool {
 }
 
 func (a *APIApp) GenerateClientSecretIfNeeded(generator crypto.Generator) (secret string, err error) {
-	if a.AuthMethodType == APIAuthMethodTypePrivateKeyJWT {
+	if !a.requiresClientSecret() {
 		return "", nil
 	}
 	a.ClientSecret, secret, err = NewClientSecret(generator)
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"net/url"
 	"strings"
 	"time"
 
@@ -46,6 +47,15 @@ type OIDCApp struct {
 	ClockSkew                time.Duration
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
+	BackChannelLogoutURI     string
+	FrontChannelLogoutURI    string
+	RequirePushedAuthRequest bool
+	RequireRequestObject     bool
+	RequireDPoP              bool
+	TLSClientAuthSubjectDN   string
+	// BackChannelClientNotificationEndpoint enables the ping mode of CIBA,
+	// without it the client has to poll the token endpoint
+	BackChannelClientNotificationEndpoint string
 
 	State AppState
 }
@@ -92,6 +102,7 @@ const (
 	OIDCGrantTypeRefreshToken
 	OIDCGrantTypeDeviceCode
 	OIDCGrantTypeTokenExchange
+	OIDCGrantTypeCIBA
 )
 
 type OIDCApplicationType int32
@@ -109,8 +120,15 @@ const (
 	OIDCAuthMethodTypePost
 	OIDCAuthMethodTypeNone
 	OIDCAuthMethodTypePrivateKeyJWT
+	OIDCAuthMethodTypeTLSClientAuth
+	OIDCAuthMethodTypeSelfSignedTLSClientAuth
 )
 
+// IsTLSClientAuth returns if the client authenticates with a certificate (RFC 8705)
+func (a OIDCAuthMethodType) IsTLSClientAuth() bool {
+	return a == OIDCAuthMethodTypeTLSClientAuth || a == OIDCAuthMethodTypeSelfSignedTLSClientAuth
+}
+
 type Compliance struct {
 	NoneCompliant bool
 	Problems      []string
@@ -124,7 +142,7 @@ const (
 )
 
 func (a *OIDCApp) IsValid() bool {
-	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
+	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() || !a.LogoutURIsValid() || !a.TLSClientAuthValid() || !a.BackChannelClientNotificationEndpointValid() {
 		return false
 	}
 	grantTypes := a.getRequiredGrantTypes()
@@ -149,6 +167,41 @@ func (a *OIDCApp) OriginsValid() bool {
 	return true
 }
 
+// LogoutURIsValid checks that the back- and front-channel logout URIs, if set,
+// are absolute http(s) URLs without a fragment.
+func (a *OIDCApp) LogoutURIsValid() bool {
+	return IsLogoutURI(a.BackChannelLogoutURI) && IsLogoutURI(a.FrontChannelLogoutURI)
+}
+
+// TLSClientAuthValid checks that the subject of the client certificate is set for tls_client_auth
+func (a *OIDCApp) TLSClientAuthValid() bool {
+	return a.AuthMethodType != OIDCAuthMethodTypeTLSClientAuth || strings.TrimSpace(a.TLSClientAuthSubjectDN) != ""
+}
+
+// BackChannelClientNotificationEndpointValid checks that the CIBA client notification endpoint, if set,
+// is an absolute https URL without a fragment.
+func (a *OIDCApp) BackChannelClientNotificationEndpointValid() bool {
+	if a.BackChannelClientNotificationEndpoint == "" {
+		return true
+	}
+	u, err := url.Parse(strings.TrimSpace(a.BackChannelClientNotificationEndpoint))
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "https" && u.Host != "" && u.Fragment == ""
+}
+
+func IsLogoutURI(uri string) bool {
+	if uri == "" {
+		return true
+	}
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.Fragment == ""
+}
+
 func ContainsRequiredGrantTypes(responseTypes []OIDCResponseType, grantTypes []OIDCGrantType) bool {
 	required := RequiredOIDCGrantTypes(responseTypes, grantTypes)
 	return ContainsOIDCGrantTypes(required, grantTypes)
@@ -161,10 +214,14 @@ func RequiredOIDCGrantTypes(responseTypes []OIDCResponseType, grantTypesSet []OI
 		switch r {
 		case OIDCResponseTypeCode:
 			// #5684 when "Device Code" is selected, "Authorization Code" is no longer a hard requirement
-			if !containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode) {
-				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
-			} else {
+			// the same applies to CIBA, where the user authenticates on another device as well
+			switch {
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode):
 				grantTypes = append(grantTypes, OIDCGrantTypeDeviceCode)
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeCIBA):
+				grantTypes = append(grantTypes, OIDCGrantTypeCIBA)
+			default:
+				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
 			}
 		case OIDCResponseTypeIDToken, OIDCResponseTypeIDTokenToken:
 			if !implicit {
@@ -199,6 +256,12 @@ func containsOIDCGrantType(grantTypes []OIDCGrantType, grantType OIDCGrantType)
 	return false
 }
 
+// containsDecoupledGrantType returns if the user authenticates on a separate device (device code or CIBA),
+// so no redirect to the client is involved
+func containsDecoupledGrantType(grantTypes []OIDCGrantType) bool {
+	return containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || containsOIDCGrantType(grantTypes, OIDCGrantTypeCIBA)
+}
+
 func (a *OIDCApp) FillCompliance() {
 	a.Compliance = GetOIDCCompliance(a.OIDCVersion, a.ApplicationType, a.GrantTypes, a.ResponseTypes, a.AuthMethodType, a.RedirectUris)
 }
@@ -228,7 +291,7 @@ func GetOIDCV1Compliance(appType OIDCApplicationType, grantTypes []OIDCGrantType
 }
 
 func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantType) {
-	if !containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
+	if !containsDecoupledGrantType(grantTypes) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append(compliance.Problems, "Application.OIDC.V1.GrantType.Refresh.NoAuthCode")
 	}
@@ -236,7 +299,7 @@ func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantTy
 
 func checkRedirectURIs(compliance *Compliance, grantTypes []OIDCGrantType, appType OIDCApplicationType, redirectUris []string) {
 	// See #5684 for OIDCGrantTypeDeviceCode and redirectUris further explanation
-	if len(redirectUris) == 0 && (!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || (containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode))) {
+	if len(redirectUris) == 0 && (!containsDecoupledGrantType(grantTypes) || (containsDecoupledGrantType(grantTypes) && containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode))) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append([]string{"Application.OIDC.V1.NoRedirectUris"}, compliance.Problems...)
 	}
@@ -177,6 +177,49 @@ func TestApplicationValid(t *testing.T) {
 			},
 			result: false,
 		},
+		{
+			name: "valid oidc application: logout uris",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:            models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                 "AppID",
+					AppName:               "Name",
+					ResponseTypes:         []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:            []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					BackChannelLogoutURI:  "https://test.com/backchannel",
+					FrontChannelLogoutURI: "http://localhost:8080/frontchannel?tenant=1",
+				},
+			},
+			result: true,
+		},
+		{
+			name: "invalid oidc application: relative back channel logout uri",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:           models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                "AppID",
+					AppName:              "Name",
+					ResponseTypes:        []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:           []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					BackChannelLogoutURI: "/backchannel",
+				},
+			},
+			result: false,
+		},
+		{
+			name: "invalid oidc application: front channel logout uri with fragment",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:            models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                 "AppID",
+					AppName:               "Name",
+					ResponseTypes:         []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:            []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					FrontChannelLogoutURI: "https://test.com/frontchannel#logout",
+				},
+			},
+			result: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
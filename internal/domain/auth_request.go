@@ -98,8 +98,76 @@ type LevelOfAssurance int
 
 const (
 	LevelOfAssuranceNone LevelOfAssurance = iota
+	// LevelOfAssuranceSingleFactor requires the user to be authenticated by at least one factor
+	LevelOfAssuranceSingleFactor
+	// LevelOfAssuranceMultiFactor requires the user to be authenticated by at least two factors of different types
+	// or a passkey with user verification
+	LevelOfAssuranceMultiFactor
+	// LevelOfAssurancePhishingResistant requires a multi factor authentication including a WebAuthN check
+	LevelOfAssurancePhishingResistant
 )
 
+const (
+	ACRSingleFactor          = "urn:zitadel:iam:acr:sf"
+	ACRMultiFactor           = "urn:zitadel:iam:acr:mfa"
+	ACRPhishingResistant     = "urn:zitadel:iam:acr:phr"
+	ACRPAPEMultiFactor       = "http://schemas.openid.net/pape/policies/2007/06/multi-factor"
+	ACRPAPEPhishingResistant = "http://schemas.openid.net/pape/policies/2007/06/phishing-resistant"
+)
+
+// SupportedACRValues are the Authentication Context Class References, which can be requested by a client.
+var SupportedACRValues = []string{
+	ACRSingleFactor,
+	ACRMultiFactor,
+	ACRPhishingResistant,
+	ACRPAPEMultiFactor,
+	ACRPAPEPhishingResistant,
+}
+
+// LevelOfAssuranceFromACR returns the level of assurance required by the Authentication Context Class Reference.
+// Unknown values return false.
+func LevelOfAssuranceFromACR(acr string) (LevelOfAssurance, bool) {
+	switch acr {
+	case ACRSingleFactor:
+		return LevelOfAssuranceSingleFactor, true
+	case ACRMultiFactor, ACRPAPEMultiFactor:
+		return LevelOfAssuranceMultiFactor, true
+	case ACRPhishingResistant, ACRPAPEPhishingResistant:
+		return LevelOfAssurancePhishingResistant, true
+	default:
+		return LevelOfAssuranceNone, false
+	}
+}
+
+// RequiredLevelOfAssurance returns the lowest level of assurance of the requested Authentication Context Class References,
+// as the authentication satisfies the request if it meets any of them.
+// If none of the values is supported, [LevelOfAssuranceNone] is returned.
+func RequiredLevelOfAssurance(acrValues []string) LevelOfAssurance {
+	required := LevelOfAssuranceNone
+	for _, acr := range acrValues {
+		level, ok := LevelOfAssuranceFromACR(acr)
+		if !ok {
+			continue
+		}
+		if required == LevelOfAssuranceNone || level < required {
+			required = level
+		}
+	}
+	return required
+}
+
+// ACRForLevelOfAssurance returns the first of the requested Authentication Context Class References,
+// which is satisfied by the level. If none is satisfied, an empty string is returned.
+func ACRForLevelOfAssurance(level LevelOfAssurance, acrValues []string) string {
+	for _, acr := range acrValues {
+		required, ok := LevelOfAssuranceFromACR(acr)
+		if ok && required <= level {
+			return acr
+		}
+	}
+	return ""
+}
+
 type MFAType int
 
 const (
@@ -108,6 +176,7 @@ const (
 	MFATypeU2FUserVerification
 	MFATypeOTPSMS
 	MFATypeOTPEmail
+	MFATypeRecoveryCode
 )
 
 func (m MFAType) UserAuthMethodType() UserAuthMethodType {
@@ -122,6 +191,8 @@ func (m MFAType) UserAuthMethodType() UserAuthMethodType {
 		return UserAuthMethodTypeOTPSMS
 	case MFATypeOTPEmail:
 		return UserAuthMethodTypeOTPEmail
+	case MFATypeRecoveryCode:
+		return UserAuthMethodTypeRecoveryCode
 	default:
 		return UserAuthMethodTypeUnspecified
 	}
@@ -0,0 +1,19 @@
+package domain
+
+type ExecutionDeliveryState int32
+
+const (
+	ExecutionDeliveryStateUnspecified ExecutionDeliveryState = iota
+	ExecutionDeliveryStatePending
+	ExecutionDeliveryStateSucceeded
+	ExecutionDeliveryStateDeadLetter
+	executionDeliveryStateCount
+)
+
+func (s ExecutionDeliveryState) Valid() bool {
+	return s >= 0 && s < executionDeliveryStateCount
+}
+
+func (s ExecutionDeliveryState) Exists() bool {
+	return s != ExecutionDeliveryStateUnspecified
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
+
+const (
+	RecoveryCodesCount  = 10
+	recoveryCodeLength  = 10
+	recoveryCodeDivider = "-"
+)
+
+var (
+	// recoveryCodeRunes omits characters which are easily mixed up (0/O, 1/I/L)
+	recoveryCodeRunes = []rune("ABCDEFGHJKMNPQRSTUVWXYZ23456789")
+)
+
+type RecoveryCodes struct {
+	*ObjectDetails
+
+	// Codes are the plain text codes, which are only returned once on generation
+	Codes []string
+}
+
+// GenerateRecoveryCodes returns a set of random one-time codes formatted as XXXXX-XXXXX
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, count)
+	for i := range codes {
+		code, err := crypto.GenerateRandomString(recoveryCodeLength, recoveryCodeRunes)
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code[:recoveryCodeLength/2] + recoveryCodeDivider + code[recoveryCodeLength/2:]
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode removes the divider and whitespace and converts the code to upper case,
+// so users can enter the code without formatting
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ReplaceAll(strings.TrimSpace(code), recoveryCodeDivider, "")
+	return strings.ToUpper(strings.ReplaceAll(code, " ", ""))
+}
@@ -0,0 +1,32 @@
+package domain
+
+type LDAPSyncState int32
+
+const (
+	LDAPSyncStateUnspecified LDAPSyncState = iota
+	LDAPSyncStateActive
+	LDAPSyncStateRemoved
+	ldapSyncStateCount
+)
+
+func (s LDAPSyncState) Valid() bool {
+	return s >= 0 && s < ldapSyncStateCount
+}
+
+func (s LDAPSyncState) Exists() bool {
+	return s != LDAPSyncStateUnspecified && s != LDAPSyncStateRemoved
+}
+
+type LDAPSyncRunState int32
+
+const (
+	LDAPSyncRunStateUnspecified LDAPSyncRunState = iota
+	LDAPSyncRunStateRunning
+	LDAPSyncRunStateSucceeded
+	LDAPSyncRunStateFailed
+	ldapSyncRunStateCount
+)
+
+func (s LDAPSyncRunState) Valid() bool {
+	return s >= 0 && s < ldapSyncRunStateCount
+}
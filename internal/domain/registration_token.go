@@ -0,0 +1,15 @@
+package domain
+
+type RegistrationTokenState int32
+
+const (
+	RegistrationTokenStateUnspecified RegistrationTokenState = iota
+	RegistrationTokenStateActive
+	RegistrationTokenStateRemoved
+
+	registrationTokenStateCount
+)
+
+func (f RegistrationTokenState) Valid() bool {
+	return f >= 0 && f < registrationTokenStateCount
+}
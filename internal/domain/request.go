@@ -63,6 +63,8 @@ type AuthRequestDevice struct {
 	DeviceCode string
 	UserCode   string
 	Scopes     []string
+	// BindingMessage is shown to the user on approval of a backchannel authentication request (CIBA)
+	BindingMessage string
 }
 
 func (*AuthRequestDevice) Type() AuthRequestType {
@@ -0,0 +1,28 @@
+package domain
+
+type SCIMTargetState int32
+
+const (
+	SCIMTargetStateUnspecified SCIMTargetState = iota
+	SCIMTargetStateActive
+	SCIMTargetStateRemoved
+	scimTargetStateCount
+)
+
+func (s SCIMTargetState) Valid() bool {
+	return s >= 0 && s < scimTargetStateCount
+}
+
+func (s SCIMTargetState) Exists() bool {
+	return s != SCIMTargetStateUnspecified && s != SCIMTargetStateRemoved
+}
+
+// SCIMProvisioningState is the state of a user at a SCIM target
+type SCIMProvisioningState int32
+
+const (
+	SCIMProvisioningStateUnspecified SCIMProvisioningState = iota
+	SCIMProvisioningStateProvisioned
+	SCIMProvisioningStateDeprovisioned
+	SCIMProvisioningStateFailed
+)
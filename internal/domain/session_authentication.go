@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"time"
+)
+
+type SessionCheckType int32
+
+const (
+	SessionCheckTypeUnspecified SessionCheckType = iota
+	SessionCheckTypeUser
+	SessionCheckTypePassword
+	SessionCheckTypeIntent
+	SessionCheckTypeWebAuthN
+	SessionCheckTypeTOTP
+	SessionCheckTypeOTPSMS
+	SessionCheckTypeOTPEmail
+	SessionCheckTypeRecoveryCode
+)
+
+// AuthenticationRequirement defines the level of assurance a session has to reach.
+// If MaxAge is set, only checks which succeeded within that duration are taken into account
+// and at least one factor has to be checked within it.
+type AuthenticationRequirement struct {
+	Level  LevelOfAssurance
+	MaxAge *time.Duration
+}
+
+// IsEmpty returns true if neither a level nor a max age is required
+func (r *AuthenticationRequirement) IsEmpty() bool {
+	return r == nil || (r.Level == LevelOfAssuranceNone && r.MaxAge == nil)
+}
+
+// SessionFactors are the times of the succeeded checks of a session
+type SessionFactors struct {
+	UserCheckedAt         time.Time
+	PasswordCheckedAt     time.Time
+	IntentCheckedAt       time.Time
+	WebAuthNCheckedAt     time.Time
+	WebAuthNUserVerified  bool
+	TOTPCheckedAt         time.Time
+	OTPSMSCheckedAt       time.Time
+	OTPEmailCheckedAt     time.Time
+	RecoveryCodeCheckedAt time.Time
+}
+
+// AuthenticationStatus is the result of the evaluation of an [AuthenticationRequirement] against [SessionFactors]
+type AuthenticationStatus struct {
+	// Level is the level of assurance reached by the checks taken into account
+	Level     LevelOfAssurance
+	Fulfilled bool
+	// RequiredChecks are the checks of which one has to succeed next to get closer to the required level.
+	// It is empty if the requirement is fulfilled.
+	RequiredChecks []SessionCheckType
+}
+
+var (
+	firstFactorChecks  = []SessionCheckType{SessionCheckTypePassword, SessionCheckTypeIntent, SessionCheckTypeWebAuthN}
+	secondFactorChecks = []SessionCheckType{SessionCheckTypeWebAuthN, SessionCheckTypeTOTP, SessionCheckTypeOTPSMS, SessionCheckTypeOTPEmail}
+	knowledgeAndOTP    = []SessionCheckType{SessionCheckTypePassword, SessionCheckTypeIntent, SessionCheckTypeTOTP, SessionCheckTypeOTPSMS, SessionCheckTypeOTPEmail}
+)
+
+// AuthenticationStatus evaluates which checks are still required to fulfill the requirement at the time of now.
+func (f *SessionFactors) AuthenticationStatus(requirement *AuthenticationRequirement, now time.Time) *AuthenticationStatus {
+	if requirement == nil {
+		requirement = new(AuthenticationRequirement)
+	}
+	factors := f.validFactors(requirement.MaxAge, now)
+	status := &AuthenticationStatus{
+		Level: factors.level(),
+	}
+	required := requirement.Level
+	if required == LevelOfAssuranceNone && requirement.MaxAge != nil {
+		required = LevelOfAssuranceSingleFactor
+	}
+	if f.UserCheckedAt.IsZero() {
+		status.RequiredChecks = []SessionCheckType{SessionCheckTypeUser}
+		return status
+	}
+	if status.Level >= required {
+		status.Fulfilled = true
+		return status
+	}
+	status.RequiredChecks = factors.requiredChecks(required)
+	return status
+}
+
+type validFactors struct {
+	firstFactor          bool
+	secondFactor         bool
+	webAuthN             bool
+	webAuthNUserVerified bool
+	checked              map[SessionCheckType]bool
+}
+
+func (f *SessionFactors) validFactors(maxAge *time.Duration, now time.Time) *validFactors {
+	valid := func(checkedAt time.Time) bool {
+		if checkedAt.IsZero() {
+			return false
+		}
+		return maxAge == nil || !checkedAt.Before(now.Add(-*maxAge))
+	}
+	factors := &validFactors{
+		checked: map[SessionCheckType]bool{
+			SessionCheckTypePassword:     valid(f.PasswordCheckedAt),
+			SessionCheckTypeIntent:       valid(f.IntentCheckedAt),
+			SessionCheckTypeWebAuthN:     valid(f.WebAuthNCheckedAt),
+			SessionCheckTypeTOTP:         valid(f.TOTPCheckedAt),
+			SessionCheckTypeOTPSMS:       valid(f.OTPSMSCheckedAt),
+			SessionCheckTypeOTPEmail:     valid(f.OTPEmailCheckedAt),
+			SessionCheckTypeRecoveryCode: valid(f.RecoveryCodeCheckedAt),
+		},
+	}
+	factors.firstFactor = factors.checked[SessionCheckTypePassword] || factors.checked[SessionCheckTypeIntent]
+	// recovery codes count as second factor, but are never suggested as required check,
+	// since they are only meant to be used if no other second factor is available
+	factors.secondFactor = factors.checked[SessionCheckTypeTOTP] || factors.checked[SessionCheckTypeOTPSMS] ||
+		factors.checked[SessionCheckTypeOTPEmail] || factors.checked[SessionCheckTypeRecoveryCode]
+	factors.webAuthN = factors.checked[SessionCheckTypeWebAuthN]
+	factors.webAuthNUserVerified = factors.webAuthN && f.WebAuthNUserVerified
+	return factors
+}
+
+// count returns the number of different factor types,
+// a WebAuthN check with user verification (passkey) counts as two factors
+func (f *validFactors) count() int {
+	var count int
+	if f.firstFactor {
+		count++
+	}
+	if f.secondFactor {
+		count++
+	}
+	if f.webAuthNUserVerified {
+		count += 2
+	} else if f.webAuthN {
+		count++
+	}
+	return count
+}
+
+func (f *validFactors) level() LevelOfAssurance {
+	count := f.count()
+	switch {
+	case count >= 2 && f.webAuthN:
+		return LevelOfAssurancePhishingResistant
+	case count >= 2:
+		return LevelOfAssuranceMultiFactor
+	case count == 1:
+		return LevelOfAssuranceSingleFactor
+	default:
+		return LevelOfAssuranceNone
+	}
+}
+
+func (f *validFactors) requiredChecks(required LevelOfAssurance) []SessionCheckType {
+	if f.count() == 0 {
+		if required == LevelOfAssurancePhishingResistant {
+			return []SessionCheckType{SessionCheckTypeWebAuthN}
+		}
+		return f.unchecked(firstFactorChecks)
+	}
+	switch {
+	case required == LevelOfAssurancePhishingResistant && !f.webAuthN:
+		return []SessionCheckType{SessionCheckTypeWebAuthN}
+	case f.webAuthN:
+		// a WebAuthN check without user verification, which needs to be combined with another factor
+		return f.unchecked(knowledgeAndOTP)
+	case f.firstFactor:
+		return f.unchecked(secondFactorChecks)
+	default:
+		return f.unchecked(firstFactorChecks)
+	}
+}
+
+func (f *validFactors) unchecked(checks []SessionCheckType) []SessionCheckType {
+	unchecked := make([]SessionCheckType, 0, len(checks))
+	for _, check := range checks {
+		if !f.checked[check] {
+			unchecked = append(unchecked, check)
+		}
+	}
+	return unchecked
+}
@@ -0,0 +1,273 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionFactors_AuthenticationStatus(t *testing.T) {
+	now := time.Now()
+	recently := now.Add(-time.Minute)
+	longAgo := now.Add(-time.Hour)
+	type args struct {
+		requirement *AuthenticationRequirement
+	}
+	tests := []struct {
+		name    string
+		factors *SessionFactors
+		args    args
+		want    *AuthenticationStatus
+	}{
+		{
+			name:    "no user, user check required",
+			factors: &SessionFactors{},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssuranceSingleFactor},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceNone,
+				RequiredChecks: []SessionCheckType{SessionCheckTypeUser},
+			},
+		},
+		{
+			name: "no requirement, fulfilled",
+			factors: &SessionFactors{
+				UserCheckedAt: longAgo,
+			},
+			args: args{},
+			want: &AuthenticationStatus{
+				Level:     LevelOfAssuranceNone,
+				Fulfilled: true,
+			},
+		},
+		{
+			name: "single factor, first factor required",
+			factors: &SessionFactors{
+				UserCheckedAt: longAgo,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssuranceSingleFactor},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceNone,
+				RequiredChecks: []SessionCheckType{SessionCheckTypePassword, SessionCheckTypeIntent, SessionCheckTypeWebAuthN},
+			},
+		},
+		{
+			name: "single factor, fulfilled",
+			factors: &SessionFactors{
+				UserCheckedAt:     longAgo,
+				PasswordCheckedAt: longAgo,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssuranceSingleFactor},
+			},
+			want: &AuthenticationStatus{
+				Level:     LevelOfAssuranceSingleFactor,
+				Fulfilled: true,
+			},
+		},
+		{
+			name: "max age only, password expired",
+			factors: &SessionFactors{
+				UserCheckedAt:     longAgo,
+				PasswordCheckedAt: longAgo,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{MaxAge: gu.Ptr(5 * time.Minute)},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceNone,
+				RequiredChecks: []SessionCheckType{SessionCheckTypePassword, SessionCheckTypeIntent, SessionCheckTypeWebAuthN},
+			},
+		},
+		{
+			name: "multi factor, second factor required",
+			factors: &SessionFactors{
+				UserCheckedAt:     longAgo,
+				PasswordCheckedAt: recently,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssuranceMultiFactor},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceSingleFactor,
+				RequiredChecks: []SessionCheckType{SessionCheckTypeWebAuthN, SessionCheckTypeTOTP, SessionCheckTypeOTPSMS, SessionCheckTypeOTPEmail},
+			},
+		},
+		{
+			name: "multi factor, first factor required",
+			factors: &SessionFactors{
+				UserCheckedAt: longAgo,
+				TOTPCheckedAt: recently,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssuranceMultiFactor},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceSingleFactor,
+				RequiredChecks: []SessionCheckType{SessionCheckTypePassword, SessionCheckTypeIntent, SessionCheckTypeWebAuthN},
+			},
+		},
+		{
+			name: "multi factor within max age, second factor expired",
+			factors: &SessionFactors{
+				UserCheckedAt:     longAgo,
+				PasswordCheckedAt: recently,
+				TOTPCheckedAt:     longAgo,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{
+					Level:  LevelOfAssuranceMultiFactor,
+					MaxAge: gu.Ptr(5 * time.Minute),
+				},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceSingleFactor,
+				RequiredChecks: []SessionCheckType{SessionCheckTypeWebAuthN, SessionCheckTypeTOTP, SessionCheckTypeOTPSMS, SessionCheckTypeOTPEmail},
+			},
+		},
+		{
+			name: "multi factor, fulfilled",
+			factors: &SessionFactors{
+				UserCheckedAt:     longAgo,
+				PasswordCheckedAt: recently,
+				OTPSMSCheckedAt:   recently,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{
+					Level:  LevelOfAssuranceMultiFactor,
+					MaxAge: gu.Ptr(5 * time.Minute),
+				},
+			},
+			want: &AuthenticationStatus{
+				Level:     LevelOfAssuranceMultiFactor,
+				Fulfilled: true,
+			},
+		},
+		{
+			name: "multi factor, recovery code, fulfilled",
+			factors: &SessionFactors{
+				UserCheckedAt:         longAgo,
+				PasswordCheckedAt:     recently,
+				RecoveryCodeCheckedAt: recently,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssuranceMultiFactor},
+			},
+			want: &AuthenticationStatus{
+				Level:     LevelOfAssuranceMultiFactor,
+				Fulfilled: true,
+			},
+		},
+		{
+			name: "phishing resistant, webauthn required",
+			factors: &SessionFactors{
+				UserCheckedAt:     longAgo,
+				PasswordCheckedAt: recently,
+				TOTPCheckedAt:     recently,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssurancePhishingResistant},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceMultiFactor,
+				RequiredChecks: []SessionCheckType{SessionCheckTypeWebAuthN},
+			},
+		},
+		{
+			name: "phishing resistant, security key without user verification",
+			factors: &SessionFactors{
+				UserCheckedAt:     longAgo,
+				WebAuthNCheckedAt: recently,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssurancePhishingResistant},
+			},
+			want: &AuthenticationStatus{
+				Level:          LevelOfAssuranceSingleFactor,
+				RequiredChecks: []SessionCheckType{SessionCheckTypePassword, SessionCheckTypeIntent, SessionCheckTypeTOTP, SessionCheckTypeOTPSMS, SessionCheckTypeOTPEmail},
+			},
+		},
+		{
+			name: "phishing resistant, passkey",
+			factors: &SessionFactors{
+				UserCheckedAt:        longAgo,
+				WebAuthNCheckedAt:    recently,
+				WebAuthNUserVerified: true,
+			},
+			args: args{
+				requirement: &AuthenticationRequirement{Level: LevelOfAssurancePhishingResistant},
+			},
+			want: &AuthenticationStatus{
+				Level:     LevelOfAssurancePhishingResistant,
+				Fulfilled: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.factors.AuthenticationStatus(tt.args.requirement, now)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRequiredLevelOfAssurance(t *testing.T) {
+	tests := []struct {
+		name      string
+		acrValues []string
+		want      LevelOfAssurance
+	}{
+		{
+			name:      "none",
+			acrValues: nil,
+			want:      LevelOfAssuranceNone,
+		},
+		{
+			name:      "unsupported",
+			acrValues: []string{"urn:unknown"},
+			want:      LevelOfAssuranceNone,
+		},
+		{
+			name:      "lowest of multiple",
+			acrValues: []string{ACRPhishingResistant, ACRPAPEMultiFactor},
+			want:      LevelOfAssuranceMultiFactor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RequiredLevelOfAssurance(tt.acrValues))
+		})
+	}
+}
+
+func TestACRForLevelOfAssurance(t *testing.T) {
+	tests := []struct {
+		name      string
+		level     LevelOfAssurance
+		acrValues []string
+		want      string
+	}{
+		{
+			name:      "not satisfied",
+			level:     LevelOfAssuranceSingleFactor,
+			acrValues: []string{ACRMultiFactor},
+			want:      "",
+		},
+		{
+			name:      "first satisfied",
+			level:     LevelOfAssuranceMultiFactor,
+			acrValues: []string{ACRPhishingResistant, ACRPAPEMultiFactor, ACRSingleFactor},
+			want:      ACRPAPEMultiFactor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ACRForLevelOfAssurance(tt.level, tt.acrValues))
+		})
+	}
+}
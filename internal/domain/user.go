@@ -43,6 +43,7 @@ const (
 	UserAuthMethodTypeOTPSMS
 	UserAuthMethodTypeOTPEmail
 	UserAuthMethodTypeOTP // generic OTP when parsing AMR from OIDC
+	UserAuthMethodTypeRecoveryCode
 	userAuthMethodTypeCount
 )
 
@@ -61,7 +62,8 @@ func HasMFA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeOTPSMS,
 			UserAuthMethodTypeOTPEmail,
 			UserAuthMethodTypeIDP,
-			UserAuthMethodTypeOTP:
+			UserAuthMethodTypeOTP,
+			UserAuthMethodTypeRecoveryCode:
 			factors++
 		case UserAuthMethodTypeUnspecified,
 			userAuthMethodTypeCount:
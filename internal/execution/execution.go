@@ -0,0 +1,45 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+var (
+	projections []*handler.Handler
+	workers     []*worker
+)
+
+func Register(
+	ctx context.Context,
+	executionHandlerCustomConfig projection.CustomConfig,
+	workerConfig *WorkerConfig,
+	commands *command.Commands,
+	queries *query.Queries,
+	es *eventstore.Eventstore,
+	targetEncryption crypto.EncryptionAlgorithm,
+) {
+	projections = append(projections, NewEventHandler(ctx, projection.ApplyCustomConfig(executionHandlerCustomConfig), commands, queries, es.EventTypes()))
+	if workerConfig != nil && workerConfig.Enabled {
+		workers = append(workers, newWorker(workerConfig, commands, queries, targetEncryption))
+	}
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+	for _, worker := range workers {
+		worker.start(ctx)
+	}
+}
+
+func Projections() []*handler.Handler {
+	return projections
+}
@@ -0,0 +1,207 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/repository/executiondelivery"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	ExecutionHandlerTable = "projections.execution_handler"
+
+	ExecutionUserID = "EXECUTION"
+)
+
+type Commands interface {
+	QueueExecutionDeliveries(ctx context.Context, event eventstore.Event, body json.RawMessage, targets []*command.ExecutionDeliveryTarget) error
+	ExecutionDeliverySucceeded(ctx context.Context, id, instanceID string, attempt uint16, statusCode int) error
+	ExecutionDeliveryFailed(ctx context.Context, id, instanceID string, attempt uint16, statusCode int, deliveryErr error, nextAttempt time.Time) error
+	ExecutionDeliveryDeadLettered(ctx context.Context, id, instanceID string, attempt uint16, statusCode int, deliveryErr error) error
+}
+
+type Queries interface {
+	SearchExecutions(ctx context.Context, queries *query.ExecutionSearchQueries) (*query.Executions, error)
+	GetExecutionByID(ctx context.Context, id string) (*query.Execution, error)
+	GetTargetByID(ctx context.Context, id string) (*query.Target, error)
+	SearchDueExecutionDeliveries(ctx context.Context, due time.Time, limit uint64) (*query.ExecutionDeliveries, error)
+}
+
+// eventHandler queues a delivery for every target of the event executions matching a handled event.
+// The deliveries are stored as events and sent by the [worker],
+// so that an unavailable target does not block the handler and no call gets lost.
+type eventHandler struct {
+	commands   Commands
+	queries    Queries
+	eventTypes []string
+}
+
+func NewEventHandler(
+	ctx context.Context,
+	config handler.Config,
+	commands Commands,
+	queries Queries,
+	eventTypes []string,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &eventHandler{
+		commands:   commands,
+		queries:    queries,
+		eventTypes: eventTypes,
+	})
+}
+
+func (h *eventHandler) Name() string {
+	return ExecutionHandlerTable
+}
+
+// Reducers handles all registered events, except the ones of the deliveries themselves.
+func (h *eventHandler) Reducers() []handler.AggregateReducer {
+	reducers := make([]handler.AggregateReducer, 0)
+	for _, eventType := range h.eventTypes {
+		aggregateType := eventstore.AggregateTypeFromEventType(eventstore.EventType(eventType))
+		if aggregateType == "" || aggregateType == executiondelivery.AggregateType {
+			continue
+		}
+		index := slices.IndexFunc(reducers, func(reducer handler.AggregateReducer) bool {
+			return reducer.Aggregate == aggregateType
+		})
+		if index < 0 {
+			reducers = append(reducers, handler.AggregateReducer{Aggregate: aggregateType})
+			index = len(reducers) - 1
+		}
+		reducers[index].EventReducers = append(reducers[index].EventReducers, handler.EventReducer{
+			Event:  eventstore.EventType(eventType),
+			Reduce: h.reduce,
+		})
+	}
+	return reducers
+}
+
+func (h *eventHandler) reduce(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(event.Aggregate())
+		targets, err := h.targets(ctx, string(event.Type()))
+		if err != nil || len(targets) == 0 {
+			return err
+		}
+		body, err := eventBody(event)
+		if err != nil {
+			return err
+		}
+		return h.commands.QueueExecutionDeliveries(ctx, event, body, targets)
+	}), nil
+}
+
+// targets returns the targets of all executions matching the event type,
+// which are the executions of the event itself, of its groups (e.g. `user.human`) and of all events.
+func (h *eventHandler) targets(ctx context.Context, eventType string) ([]*command.ExecutionDeliveryTarget, error) {
+	idQuery, err := query.NewExecutionInIDsSearchQuery(executionIDs(eventType))
+	if err != nil {
+		return nil, err
+	}
+	executions, err := h.queries.SearchExecutions(ctx, &query.ExecutionSearchQueries{Queries: []query.SearchQuery{idQuery}})
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]*command.ExecutionDeliveryTarget, 0)
+	visited := make(map[string]bool)
+	for _, e := range executions.Executions {
+		targets, err = h.appendTargets(ctx, targets, visited, e)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return targets, nil
+}
+
+// appendTargets appends the targets of the execution and of its included executions,
+// every target is only called once per event.
+func (h *eventHandler) appendTargets(ctx context.Context, targets []*command.ExecutionDeliveryTarget, visited map[string]bool, e *query.Execution) (_ []*command.ExecutionDeliveryTarget, err error) {
+	if visited[e.ID] {
+		return targets, nil
+	}
+	visited[e.ID] = true
+	for _, targetID := range e.Targets {
+		if slices.ContainsFunc(targets, func(target *command.ExecutionDeliveryTarget) bool { return target.TargetID == targetID }) {
+			continue
+		}
+		targets = append(targets, &command.ExecutionDeliveryTarget{TargetID: targetID, ExecutionID: e.ID})
+	}
+	for _, include := range e.Includes {
+		if visited[include] {
+			continue
+		}
+		included, err := h.queries.GetExecutionByID(ctx, include)
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		targets, err = h.appendTargets(ctx, targets, visited, included)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return targets, nil
+}
+
+// executionIDs returns the ids of all event executions the event type is part of,
+// e.g. for `user.human.added`: `event.user.human.added`, `event.user.human`, `event.user` and `event`
+func executionIDs(eventType string) []string {
+	ids := []string{execution.IDAll(domain.ExecutionTypeEvent)}
+	parts := strings.Split(eventType, ".")
+	for i := range parts {
+		ids = append(ids, execution.ID(domain.ExecutionTypeEvent, strings.Join(parts[:i+1], ".")))
+	}
+	return ids
+}
+
+// Body is sent to the target for every delivery
+type Body struct {
+	AggregateID   string                   `json:"aggregateID"`
+	AggregateType eventstore.AggregateType `json:"aggregateType"`
+	ResourceOwner string                   `json:"resourceOwner"`
+	InstanceID    string                   `json:"instanceID"`
+	Version       eventstore.Version       `json:"version"`
+	Sequence      uint64                   `json:"sequence"`
+	EventType     eventstore.EventType     `json:"eventType"`
+	CreatedAt     time.Time                `json:"createdAt"`
+	UserID        string                   `json:"userID"`
+	EventPayload  json.RawMessage          `json:"eventPayload,omitempty"`
+}
+
+func eventBody(event eventstore.Event) (json.RawMessage, error) {
+	var payload json.RawMessage
+	if err := event.Unmarshal(&payload); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&Body{
+		AggregateID:   event.Aggregate().ID,
+		AggregateType: event.Aggregate().Type,
+		ResourceOwner: event.Aggregate().ResourceOwner,
+		InstanceID:    event.Aggregate().InstanceID,
+		Version:       event.Aggregate().Version,
+		Sequence:      event.Sequence(),
+		EventType:     event.Type(),
+		CreatedAt:     event.CreatedAt(),
+		UserID:        event.Creator(),
+		EventPayload:  payload,
+	})
+}
+
+func handlerContext(aggregate *eventstore.Aggregate) context.Context {
+	ctx := authz.WithInstanceID(context.Background(), aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ExecutionUserID, OrgID: aggregate.ResourceOwner})
+}
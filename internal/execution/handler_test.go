@@ -0,0 +1,14 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_executionIDs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"event", "event.user", "event.user.human", "event.user.human.added"},
+		executionIDs("user.human.added"),
+	)
+}
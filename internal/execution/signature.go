@@ -0,0 +1,73 @@
+package execution
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	SigningHeader = "ZITADEL-Signature"
+
+	signingTimestamp = "t"
+	signingVersion   = "v1"
+)
+
+// ComputeSignatureHeader returns the value of the [SigningHeader] for the payload.
+// The signature is a HMAC-SHA256 of the timestamp and the payload, separated by a dot,
+// e.g. `t=1704067200,v1=5257a869e7ecebeda32affa62cdca3fa51cad7e77a0e56ff536d0ce8e108d8bd`
+func ComputeSignatureHeader(t time.Time, payload []byte, signingKey string) string {
+	return signingTimestamp + "=" + strconv.FormatInt(t.Unix(), 10) + "," +
+		signingVersion + "=" + computeSignature(t, payload, signingKey)
+}
+
+// ValidatePayload validates the header of a received payload,
+// signatures older than the tolerance are rejected to prevent replay attacks.
+func ValidatePayload(payload []byte, header, signingKey string, tolerance time.Duration) error {
+	var (
+		timestamp  time.Time
+		signatures []string
+	)
+	for _, pair := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return zerrors.ThrowInvalidArgument(nil, "EXEC-Sg2ha", "Errors.Execution.Delivery.InvalidSignature")
+		}
+		switch key {
+		case signingTimestamp:
+			unix, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return zerrors.ThrowInvalidArgument(err, "EXEC-Sg3tm", "Errors.Execution.Delivery.InvalidSignature")
+			}
+			timestamp = time.Unix(unix, 0)
+		case signingVersion:
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp.IsZero() || len(signatures) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "EXEC-Sg4mi", "Errors.Execution.Delivery.InvalidSignature")
+	}
+	if tolerance > 0 && time.Since(timestamp) > tolerance {
+		return zerrors.ThrowInvalidArgument(nil, "EXEC-Sg5ex", "Errors.Execution.Delivery.InvalidSignature")
+	}
+	expected := computeSignature(timestamp, payload, signingKey)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+	return zerrors.ThrowInvalidArgument(nil, "EXEC-Sg6no", "Errors.Execution.Delivery.InvalidSignature")
+}
+
+func computeSignature(t time.Time, payload []byte, signingKey string) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(strconv.FormatInt(t.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,60 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePayload(t *testing.T) {
+	payload := []byte(`{"aggregateID":"user1"}`)
+	now := time.Now()
+	tests := []struct {
+		name      string
+		header    string
+		tolerance time.Duration
+		wantErr   bool
+	}{
+		{
+			name:   "valid",
+			header: ComputeSignatureHeader(now, payload, "key"),
+		},
+		{
+			name:      "valid within tolerance",
+			header:    ComputeSignatureHeader(now.Add(-time.Minute), payload, "key"),
+			tolerance: 5 * time.Minute,
+		},
+		{
+			name:      "expired",
+			header:    ComputeSignatureHeader(now.Add(-time.Hour), payload, "key"),
+			tolerance: 5 * time.Minute,
+			wantErr:   true,
+		},
+		{
+			name:    "wrong key",
+			header:  ComputeSignatureHeader(now, payload, "other"),
+			wantErr: true,
+		},
+		{
+			name:    "missing signature",
+			header:  "t=1704067200",
+			wantErr: true,
+		},
+		{
+			name:    "malformed",
+			header:  "signature",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePayload(payload, tt.header, "key", tt.tolerance)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
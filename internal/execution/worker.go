@@ -0,0 +1,142 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type WorkerConfig struct {
+	// Enabled starts the worker sending the queued deliveries
+	Enabled bool
+	// Interval in which the due deliveries are queried
+	Interval time.Duration
+	// BatchSize is the maximum amount of deliveries sent per interval
+	BatchSize uint64
+	// MaxAttempts until a delivery is dead lettered
+	MaxAttempts uint16
+	// MinBackoff is the delay after the first failed attempt, it's doubled on every further failed attempt
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between two attempts
+	MaxBackoff time.Duration
+}
+
+// worker sends the queued deliveries to their targets.
+// Deliveries are sent at least once: if an attempt can't be recorded, the target might receive the body again.
+type worker struct {
+	config           *WorkerConfig
+	commands         Commands
+	queries          Queries
+	targetEncryption crypto.EncryptionAlgorithm
+	client           *http.Client
+	now              func() time.Time
+}
+
+func newWorker(config *WorkerConfig, commands Commands, queries Queries, targetEncryption crypto.EncryptionAlgorithm) *worker {
+	return &worker{
+		config:           config,
+		commands:         commands,
+		queries:          queries,
+		targetEncryption: targetEncryption,
+		client:           http.DefaultClient,
+		now:              time.Now,
+	}
+}
+
+func (w *worker) start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.sendDue(ctx)
+			}
+		}
+	}()
+}
+
+func (w *worker) sendDue(ctx context.Context) {
+	deliveries, err := w.queries.SearchDueExecutionDeliveries(ctx, w.now(), w.config.BatchSize)
+	if err != nil {
+		logging.OnError(err).Warn("unable to query due execution deliveries")
+		return
+	}
+	for _, delivery := range deliveries.ExecutionDeliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		err = w.send(authz.WithInstanceID(ctx, delivery.InstanceID), delivery)
+		logging.WithFields("instance", delivery.InstanceID, "delivery", delivery.ID).OnError(err).Warn("unable to record execution delivery")
+	}
+}
+
+// send calls the target of the delivery and records the result of the attempt
+func (w *worker) send(ctx context.Context, delivery *query.ExecutionDelivery) error {
+	attempt := delivery.Attempts + 1
+	statusCode, err := w.call(ctx, delivery)
+	if err == nil {
+		return w.commands.ExecutionDeliverySucceeded(ctx, delivery.ID, delivery.InstanceID, attempt, statusCode)
+	}
+	if attempt >= w.config.MaxAttempts {
+		return w.commands.ExecutionDeliveryDeadLettered(ctx, delivery.ID, delivery.InstanceID, attempt, statusCode, err)
+	}
+	return w.commands.ExecutionDeliveryFailed(ctx, delivery.ID, delivery.InstanceID, attempt, statusCode, err, w.now().Add(w.backoff(attempt)))
+}
+
+func (w *worker) call(ctx context.Context, delivery *query.ExecutionDelivery) (statusCode int, err error) {
+	target, err := w.queries.GetTargetByID(ctx, delivery.TargetID)
+	if err != nil {
+		return 0, err
+	}
+	if target.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, target.Timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if target.SigningKey != nil {
+		signingKey, err := crypto.DecryptString(target.SigningKey, w.targetEncryption)
+		if err != nil {
+			return 0, err
+		}
+		req.Header.Set(SigningHeader, ComputeSignatureHeader(w.now(), delivery.Body, signingKey))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, zerrors.ThrowUnavailable(fmt.Errorf("target responded with status %d", resp.StatusCode), "EXEC-Wk2st", "Errors.Execution.Delivery.Failed")
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay until the next attempt after the failed attempt,
+// starting with MinBackoff and doubled on every attempt up to MaxBackoff.
+func (w *worker) backoff(attempt uint16) time.Duration {
+	backoff := w.config.MinBackoff
+	for i := uint16(1); i < attempt && backoff < w.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > w.config.MaxBackoff {
+		return w.config.MaxBackoff
+	}
+	return backoff
+}
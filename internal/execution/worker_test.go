@@ -0,0 +1,30 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_worker_backoff(t *testing.T) {
+	w := &worker{
+		config: &WorkerConfig{
+			MinBackoff: time.Second,
+			MaxBackoff: time.Minute,
+		},
+	}
+	tests := []struct {
+		attempt uint16
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 4, want: 8 * time.Second},
+		{attempt: 7, want: time.Minute},
+		{attempt: 100, want: time.Minute},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, w.backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
@@ -0,0 +1,114 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// DefaultGroupsAttribute is the attribute of the user entry listing the DNs of the groups the user is member of
+const DefaultGroupsAttribute = "memberOf"
+
+// searchPageSize is the amount of entries requested per page when searching the whole directory
+const searchPageSize = 500
+
+// DirectoryUser is a user found in the directory with the DNs of the groups the user is member of
+type DirectoryUser struct {
+	*User
+	Groups []string
+}
+
+// SearchUsers binds with the configured bind DN and returns all users of the directory
+// matching the configured object classes.
+// The servers are tried in order until one of them responds.
+func (p *Provider) SearchUsers(ctx context.Context, groupsAttribute string) (users []*DirectoryUser, err error) {
+	if groupsAttribute == "" {
+		groupsAttribute = DefaultGroupsAttribute
+	}
+	err = errors.New("no server configured")
+	for _, server := range p.servers {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		users, err = p.searchUsers(server, groupsAttribute)
+		if err == nil {
+			return users, nil
+		}
+	}
+	return nil, err
+}
+
+func (p *Provider) searchUsers(server, groupsAttribute string) ([]*DirectoryUser, error) {
+	conn, err := getConnection(server, p.startTLS, p.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(p.bindDN, p.bindPassword); err != nil {
+		return nil, err
+	}
+
+	searchRequest := ldap.NewSearchRequest(
+		p.baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(p.timeout.Seconds()), false,
+		usersSearchQuery(p.userObjectClasses),
+		append(p.getNecessaryAttributes(), groupsAttribute),
+		nil,
+	)
+	sr, err := conn.SearchWithPaging(searchRequest, searchPageSize)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*DirectoryUser, 0, len(sr.Entries))
+	for _, entry := range sr.Entries {
+		user, err := p.mapDirectoryUser(entry, groupsAttribute)
+		if err != nil {
+			return nil, err
+		}
+		// entries without the id attribute can't be linked and are ignored
+		if user.ID == "" {
+			continue
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (p *Provider) mapDirectoryUser(entry *ldap.Entry, groupsAttribute string) (*DirectoryUser, error) {
+	user, err := mapLDAPEntryToUser(
+		entry,
+		p.idAttribute,
+		p.firstNameAttribute,
+		p.lastNameAttribute,
+		p.displayNameAttribute,
+		p.nickNameAttribute,
+		p.preferredUsernameAttribute,
+		p.emailAttribute,
+		p.emailVerifiedAttribute,
+		p.phoneAttribute,
+		p.phoneVerifiedAttribute,
+		p.preferredLanguageAttribute,
+		p.avatarURLAttribute,
+		p.profileAttribute,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &DirectoryUser{
+		User:   user,
+		Groups: entry.GetAttributeValues(groupsAttribute),
+	}, nil
+}
+
+func usersSearchQuery(objectClasses []string) string {
+	switch len(objectClasses) {
+	case 0:
+		return "(objectClass=*)"
+	case 1:
+		return objectClassesToSearchQuery(objectClasses)
+	default:
+		return "(&" + objectClassesToSearchQuery(objectClasses) + ")"
+	}
+}
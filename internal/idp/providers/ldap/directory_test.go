@@ -0,0 +1,68 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestProvider_usersSearchQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		objectClasses []string
+		want          string
+	}{
+		{
+			name:          "zero",
+			objectClasses: []string{},
+			want:          "(objectClass=*)",
+		},
+		{
+			name:          "one",
+			objectClasses: []string{"user"},
+			want:          "(objectClass=user)",
+		},
+		{
+			name:          "multiple",
+			objectClasses: []string{"user", "person"},
+			want:          "(&(objectClass=user)(objectClass=person))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, usersSearchQuery(tt.objectClasses))
+		})
+	}
+}
+
+func TestProvider_mapDirectoryUser(t *testing.T) {
+	provider := New("ldap", nil, "", "", "", "", nil, nil, 0, "",
+		WithCustomIDAttribute("uid"),
+		WithFirstNameAttribute("givenName"),
+		WithEmailAttribute("mail"),
+	)
+	entry := &ldap.Entry{
+		DN: "uid=user,ou=people,dc=example,dc=com",
+		Attributes: []*ldap.EntryAttribute{
+			{Name: "uid", Values: []string{"user"}},
+			{Name: "givenName", Values: []string{"first"}},
+			{Name: "mail", Values: []string{"user@example.com"}},
+			{Name: "memberOf", Values: []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=users,ou=groups,dc=example,dc=com"}},
+		},
+	}
+
+	got, err := provider.mapDirectoryUser(entry, DefaultGroupsAttribute)
+	require.NoError(t, err)
+	assert.Equal(t, &DirectoryUser{
+		User: &User{
+			ID:                "user",
+			FirstName:         "first",
+			Email:             "user@example.com",
+			PreferredLanguage: language.Und,
+		},
+		Groups: []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=users,ou=groups,dc=example,dc=com"},
+	}, got)
+}
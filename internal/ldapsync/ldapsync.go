@@ -0,0 +1,29 @@
+package ldapsync
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+var workers []*worker
+
+func Register(
+	ctx context.Context,
+	workerConfig *WorkerConfig,
+	commands *command.Commands,
+	queries *query.Queries,
+	userCodeAlg crypto.EncryptionAlgorithm,
+) {
+	if workerConfig != nil && workerConfig.Enabled {
+		workers = append(workers, newWorker(workerConfig, commands, queries, userCodeAlg))
+	}
+}
+
+func Start(ctx context.Context) {
+	for _, worker := range workers {
+		worker.start(ctx)
+	}
+}
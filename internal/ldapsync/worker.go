@@ -0,0 +1,411 @@
+package ldapsync
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+	ldapsync_repo "github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// syncUserID is set as editor of the changes made by the synchronization
+const syncUserID = "LDAP-SYNC"
+
+type WorkerConfig struct {
+	// Enabled starts the worker running the due synchronizations
+	Enabled bool
+	// Interval in which the due synchronizations are queried
+	Interval time.Duration
+	// BatchSize is the maximum amount of synchronizations run per interval
+	BatchSize uint64
+	// StaleAfter is the duration after which an unfinished run is considered aborted,
+	// e.g. because the process was stopped, and the synchronization is run again
+	StaleAfter time.Duration
+}
+
+type Commands interface {
+	GetProvider(ctx context.Context, idpID string, idpCallback string, samlRootURL string) (idp.Provider, error)
+	StartLDAPSyncRun(ctx context.Context, idpID, instanceID string, staleAfter time.Duration) (string, error)
+	LDAPSyncRunSucceeded(ctx context.Context, idpID, instanceID, runID string, result *command.LDAPSyncRunResult) error
+	LDAPSyncRunFailed(ctx context.Context, idpID, instanceID, runID string, runErr error) error
+	AddHuman(ctx context.Context, resourceOwner string, human *command.AddHuman, allowInitMail bool) error
+	ChangeHumanProfile(ctx context.Context, profile *domain.Profile) (*domain.Profile, error)
+	ChangeHumanEmail(ctx context.Context, email *domain.Email, emailCodeGenerator crypto.Generator) (*domain.Email, error)
+	ChangeHumanPhone(ctx context.Context, phone *domain.Phone, resourceOwner string, phoneCodeGenerator crypto.Generator) (*domain.Phone, error)
+	DeactivateUser(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	ReactivateUser(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	SetLDAPSyncUserGrants(ctx context.Context, userID, resourceOwner string, grants []*command.LDAPSyncUserGrant) (uint32, error)
+}
+
+type Queries interface {
+	SearchDueLDAPSyncs(ctx context.Context, now time.Time, staleAfter time.Duration, limit uint64) (*query.LDAPSyncs, error)
+	IDPUserLinks(ctx context.Context, queries *query.IDPUserLinksSearchQuery, withOwnerRemoved bool) (*query.IDPUserLinks, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+	InitEncryptionGenerator(ctx context.Context, generatorType domain.SecretGeneratorType, algorithm crypto.EncryptionAlgorithm) (crypto.Generator, error)
+}
+
+// worker runs the due synchronizations of the LDAP identity providers.
+// Users of the directory are created, updated and linked to the identity provider in the organization of the synchronization,
+// the user grants on the mapped projects are derived from the groups of the users.
+type worker struct {
+	config      *WorkerConfig
+	commands    Commands
+	queries     Queries
+	userCodeAlg crypto.EncryptionAlgorithm
+	now         func() time.Time
+}
+
+func newWorker(config *WorkerConfig, commands Commands, queries Queries, userCodeAlg crypto.EncryptionAlgorithm) *worker {
+	return &worker{
+		config:      config,
+		commands:    commands,
+		queries:     queries,
+		userCodeAlg: userCodeAlg,
+		now:         time.Now,
+	}
+}
+
+func (w *worker) start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.runDue(ctx)
+			}
+		}
+	}()
+}
+
+func (w *worker) runDue(ctx context.Context) {
+	syncs, err := w.queries.SearchDueLDAPSyncs(ctx, w.now(), w.config.StaleAfter, w.config.BatchSize)
+	if err != nil {
+		logging.OnError(err).Warn("unable to query due ldap syncs")
+		return
+	}
+	for _, sync := range syncs.LDAPSyncs {
+		if ctx.Err() != nil {
+			return
+		}
+		err = w.run(authz.WithInstanceID(ctx, sync.InstanceID), sync)
+		logging.WithFields("instance", sync.InstanceID, "idp", sync.IDPID).OnError(err).Warn("unable to run ldap sync")
+	}
+}
+
+// run records the start and the result of a run.
+// If the run was already started by another worker, e.g. of another process, it's skipped.
+func (w *worker) run(ctx context.Context, sync *query.LDAPSync) error {
+	runID, err := w.commands.StartLDAPSyncRun(ctx, sync.IDPID, sync.InstanceID, w.config.StaleAfter)
+	if zerrors.IsPreconditionFailed(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: syncUserID, OrgID: sync.OrgID})
+	result, err := w.sync(ctx, sync)
+	if err != nil {
+		return w.commands.LDAPSyncRunFailed(ctx, sync.IDPID, sync.InstanceID, runID, err)
+	}
+	return w.commands.LDAPSyncRunSucceeded(ctx, sync.IDPID, sync.InstanceID, runID, result)
+}
+
+// sync reads the directory and synchronizes every user.
+// An error is only returned if the directory can't be read,
+// errors of single users are collected in the result, so that they don't prevent the synchronization of the others.
+func (w *worker) sync(ctx context.Context, sync *query.LDAPSync) (*command.LDAPSyncRunResult, error) {
+	provider, err := w.commands.GetProvider(ctx, sync.IDPID, "", "")
+	if err != nil {
+		return nil, err
+	}
+	ldapProvider, ok := provider.(*ldap.Provider)
+	if !ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "LDAPS-ooS3e", "Errors.IDPConfig.NotExisting")
+	}
+	users, err := ldapProvider.SearchUsers(ctx, sync.GroupsAttribute)
+	if err != nil {
+		return nil, err
+	}
+	links, err := w.linkedUsers(ctx, sync)
+	if err != nil {
+		return nil, err
+	}
+	result := new(command.LDAPSyncRunResult)
+	for _, user := range users {
+		userID := links[user.ID]
+		delete(links, user.ID)
+		if err := w.syncUser(ctx, sync, user, userID, result); err != nil {
+			result.Errors = append(result.Errors, user.ID+": "+err.Error())
+		}
+	}
+	// an empty directory is most likely caused by a misconfiguration,
+	// therefore the linked users are only deactivated if at least one user was found
+	if !sync.DeactivateMissing || len(users) == 0 {
+		return result, nil
+	}
+	for externalID, userID := range links {
+		if err := w.deactivateUser(ctx, userID, result); err != nil {
+			result.Errors = append(result.Errors, externalID+": "+err.Error())
+		}
+	}
+	return result, nil
+}
+
+// linkedUsers returns the ids of the users of the organization linked to the identity provider by their external id.
+func (w *worker) linkedUsers(ctx context.Context, sync *query.LDAPSync) (map[string]string, error) {
+	idpQuery, err := query.NewIDPUserLinkIDPIDSearchQuery(sync.IDPID)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewIDPUserLinksResourceOwnerSearchQuery(sync.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := w.queries.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{idpQuery, ownerQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string]string, len(links.Links))
+	for _, link := range links.Links {
+		users[link.ProvidedUserID] = link.UserID
+	}
+	return users, nil
+}
+
+func (w *worker) syncUser(ctx context.Context, sync *query.LDAPSync, user *ldap.DirectoryUser, userID string, result *command.LDAPSyncRunResult) (err error) {
+	if userID == "" {
+		if userID, err = w.createUser(ctx, sync, user); err != nil {
+			return err
+		}
+		result.UsersCreated++
+	} else if err = w.updateUser(ctx, sync, user, userID, result); err != nil {
+		return err
+	}
+	if len(sync.GroupRoleMappings) == 0 {
+		return nil
+	}
+	changed, err := w.syncUserGrants(ctx, sync, userID, user.Groups)
+	result.GrantsChanged += changed
+	return err
+}
+
+func (w *worker) createUser(ctx context.Context, sync *query.LDAPSync, user *ldap.DirectoryUser) (string, error) {
+	username := user.PreferredUsername
+	if username == "" {
+		username = user.ID
+	}
+	human := &command.AddHuman{
+		Username:          username,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		NickName:          user.NickName,
+		DisplayName:       user.DisplayName,
+		Email:             command.Email{Address: user.Email, Verified: user.EmailVerified},
+		Phone:             command.Phone{Number: user.Phone, Verified: user.PhoneVerified},
+		PreferredLanguage: user.PreferredLanguage,
+		ExternalIDP:       true,
+		Links: []*command.AddLink{
+			{
+				IDPID:         sync.IDPID,
+				DisplayName:   username,
+				IDPExternalID: user.ID,
+			},
+		},
+	}
+	if err := w.commands.AddHuman(ctx, sync.OrgID, human, false); err != nil {
+		return "", err
+	}
+	return human.ID, nil
+}
+
+// updateUser applies the attributes of the directory to the linked user.
+// Attributes which aren't provided by the directory are kept.
+func (w *worker) updateUser(ctx context.Context, sync *query.LDAPSync, user *ldap.DirectoryUser, userID string, result *command.LDAPSyncRunResult) error {
+	existing, err := w.queries.GetUserByID(ctx, false, userID)
+	if err != nil {
+		return err
+	}
+	if existing.Human == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "LDAPS-Ew3ei", "Errors.User.NotHuman")
+	}
+	root := es_models.ObjectRoot{AggregateID: existing.ID, ResourceOwner: existing.ResourceOwner}
+	var updated bool
+	if profile, changed := mergeProfile(existing.Human, user.User); changed {
+		profile.ObjectRoot = root
+		if _, err = w.commands.ChangeHumanProfile(ctx, profile); err != nil {
+			return err
+		}
+		updated = true
+	}
+	if emailChanged(existing.Human, user.User) {
+		generator, err := w.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyEmailCode, w.userCodeAlg)
+		if err != nil {
+			return err
+		}
+		if _, err = w.commands.ChangeHumanEmail(ctx, &domain.Email{ObjectRoot: root, EmailAddress: user.Email, IsEmailVerified: user.EmailVerified}, generator); err != nil {
+			return err
+		}
+		updated = true
+	}
+	if phoneChanged(existing.Human, user.User) {
+		generator, err := w.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, w.userCodeAlg)
+		if err != nil {
+			return err
+		}
+		if _, err = w.commands.ChangeHumanPhone(ctx, &domain.Phone{ObjectRoot: root, PhoneNumber: user.Phone, IsPhoneVerified: user.PhoneVerified}, existing.ResourceOwner, generator); err != nil {
+			return err
+		}
+		updated = true
+	}
+	if updated {
+		result.UsersUpdated++
+	}
+	if sync.DeactivateMissing && existing.State == domain.UserStateInactive {
+		if _, err = w.commands.ReactivateUser(ctx, existing.ID, existing.ResourceOwner); err != nil {
+			return err
+		}
+		result.UsersReactivated++
+	}
+	return nil
+}
+
+func (w *worker) deactivateUser(ctx context.Context, userID string, result *command.LDAPSyncRunResult) error {
+	existing, err := w.queries.GetUserByID(ctx, false, userID)
+	if err != nil {
+		return err
+	}
+	if existing.State != domain.UserStateActive {
+		return nil
+	}
+	if _, err = w.commands.DeactivateUser(ctx, existing.ID, existing.ResourceOwner); err != nil {
+		return err
+	}
+	result.UsersDeactivated++
+	return nil
+}
+
+func (w *worker) syncUserGrants(ctx context.Context, sync *query.LDAPSync, userID string, groups []string) (uint32, error) {
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return 0, err
+	}
+	projectsQuery, err := query.NewUserGrantProjectIDsSearchQuery(mappedProjectIDs(sync.GroupRoleMappings))
+	if err != nil {
+		return 0, err
+	}
+	ownerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(sync.OrgID)
+	if err != nil {
+		return 0, err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userQuery, projectsQuery, ownerQuery}}, false)
+	if err != nil {
+		return 0, err
+	}
+	return w.commands.SetLDAPSyncUserGrants(ctx, userID, sync.OrgID, userGrantChanges(sync.GroupRoleMappings, groups, grants.UserGrants))
+}
+
+// mergeProfile returns the profile of the user with the attributes provided by the directory
+// and whether any of them differs from the current profile.
+func mergeProfile(human *query.Human, user *ldap.User) (*domain.Profile, bool) {
+	profile := &domain.Profile{
+		FirstName:         human.FirstName,
+		LastName:          human.LastName,
+		NickName:          human.NickName,
+		DisplayName:       human.DisplayName,
+		PreferredLanguage: human.PreferredLanguage,
+		Gender:            human.Gender,
+	}
+	var changed bool
+	set := func(current *string, value string) {
+		if value != "" && value != *current {
+			*current = value
+			changed = true
+		}
+	}
+	set(&profile.FirstName, user.FirstName)
+	set(&profile.LastName, user.LastName)
+	set(&profile.NickName, user.NickName)
+	set(&profile.DisplayName, user.DisplayName)
+	if !user.PreferredLanguage.IsRoot() && user.PreferredLanguage != human.PreferredLanguage {
+		profile.PreferredLanguage = user.PreferredLanguage
+		changed = true
+	}
+	return profile, changed
+}
+
+func emailChanged(human *query.Human, user *ldap.User) bool {
+	return user.Email != "" && (user.Email != human.Email || user.EmailVerified && !human.IsEmailVerified)
+}
+
+func phoneChanged(human *query.Human, user *ldap.User) bool {
+	return user.Phone != "" && (user.Phone != human.Phone || user.PhoneVerified && !human.IsPhoneVerified)
+}
+
+// desiredProjectRoles returns the role keys per project granted by the groups of the user.
+// Group DNs are compared case-insensitively as in LDAP.
+func desiredProjectRoles(mappings []*ldapsync_repo.GroupRoleMapping, groups []string) map[string][]string {
+	roles := make(map[string][]string)
+	for _, mapping := range mappings {
+		if !slices.ContainsFunc(groups, func(group string) bool { return strings.EqualFold(group, mapping.Group) }) {
+			continue
+		}
+		for _, roleKey := range mapping.RoleKeys {
+			if !slices.Contains(roles[mapping.ProjectID], roleKey) {
+				roles[mapping.ProjectID] = append(roles[mapping.ProjectID], roleKey)
+			}
+		}
+	}
+	return roles
+}
+
+func mappedProjectIDs(mappings []*ldapsync_repo.GroupRoleMapping) []string {
+	projectIDs := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		if !slices.Contains(projectIDs, mapping.ProjectID) {
+			projectIDs = append(projectIDs, mapping.ProjectID)
+		}
+	}
+	return projectIDs
+}
+
+// userGrantChanges returns the desired state of the user grants on every mapped project.
+// The user grants on the mapped projects are managed by the synchronization,
+// so existing grants are removed if none of the groups of the user grants a role on the project anymore.
+func userGrantChanges(mappings []*ldapsync_repo.GroupRoleMapping, groups []string, existing []*query.UserGrant) []*command.LDAPSyncUserGrant {
+	desired := desiredProjectRoles(mappings, groups)
+	changes := make([]*command.LDAPSyncUserGrant, 0, len(mappings))
+	for _, projectID := range mappedProjectIDs(mappings) {
+		change := &command.LDAPSyncUserGrant{
+			ProjectID: projectID,
+			RoleKeys:  desired[projectID],
+		}
+		for _, grant := range existing {
+			// grants on granted projects aren't managed by the synchronization
+			if grant.ProjectID == projectID && grant.GrantID == "" {
+				change.GrantID = grant.ID
+				break
+			}
+		}
+		if change.GrantID == "" && len(change.RoleKeys) == 0 {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
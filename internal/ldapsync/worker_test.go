@@ -0,0 +1,117 @@
+package ldapsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+	ldapsync_repo "github.com/zitadel/zitadel/internal/repository/ldapsync"
+)
+
+func Test_userGrantChanges(t *testing.T) {
+	mappings := []*ldapsync_repo.GroupRoleMapping{
+		{Group: "cn=admins,dc=example,dc=com", ProjectID: "project1", RoleKeys: []string{"admin", "user"}},
+		{Group: "cn=users,dc=example,dc=com", ProjectID: "project1", RoleKeys: []string{"user"}},
+		{Group: "cn=users,dc=example,dc=com", ProjectID: "project2", RoleKeys: []string{"viewer"}},
+	}
+	tests := []struct {
+		name     string
+		groups   []string
+		existing []*query.UserGrant
+		want     []*command.LDAPSyncUserGrant
+	}{
+		{
+			name: "no groups, no grants",
+			want: []*command.LDAPSyncUserGrant{},
+		},
+		{
+			name:   "new grants, roles merged",
+			groups: []string{"CN=Admins,DC=example,DC=com", "cn=users,dc=example,dc=com"},
+			want: []*command.LDAPSyncUserGrant{
+				{ProjectID: "project1", RoleKeys: []string{"admin", "user"}},
+				{ProjectID: "project2", RoleKeys: []string{"viewer"}},
+			},
+		},
+		{
+			name:   "existing grant changed and removed",
+			groups: []string{"cn=admins,dc=example,dc=com"},
+			existing: []*query.UserGrant{
+				{ID: "grant1", ProjectID: "project1", Roles: []string{"user"}},
+				{ID: "grant2", ProjectID: "project2", Roles: []string{"viewer"}},
+			},
+			want: []*command.LDAPSyncUserGrant{
+				{GrantID: "grant1", ProjectID: "project1", RoleKeys: []string{"admin", "user"}},
+				{GrantID: "grant2", ProjectID: "project2"},
+			},
+		},
+		{
+			name:   "grant on granted project ignored",
+			groups: []string{"cn=users,dc=example,dc=com"},
+			existing: []*query.UserGrant{
+				{ID: "grant1", ProjectID: "project2", GrantID: "projectgrant1", Roles: []string{"viewer"}},
+			},
+			want: []*command.LDAPSyncUserGrant{
+				{ProjectID: "project1", RoleKeys: []string{"user"}},
+				{ProjectID: "project2", RoleKeys: []string{"viewer"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, userGrantChanges(mappings, tt.groups, tt.existing))
+		})
+	}
+}
+
+func Test_mergeProfile(t *testing.T) {
+	human := &query.Human{
+		FirstName:         "first",
+		LastName:          "last",
+		DisplayName:       "first last",
+		PreferredLanguage: language.German,
+		Gender:            domain.GenderDiverse,
+	}
+	tests := []struct {
+		name        string
+		user        *ldap.User
+		want        *domain.Profile
+		wantChanged bool
+	}{
+		{
+			name: "missing attributes kept",
+			user: &ldap.User{FirstName: "first"},
+			want: &domain.Profile{
+				FirstName:         "first",
+				LastName:          "last",
+				DisplayName:       "first last",
+				PreferredLanguage: language.German,
+				Gender:            domain.GenderDiverse,
+			},
+		},
+		{
+			name: "attributes changed",
+			user: &ldap.User{LastName: "changed", NickName: "nick", PreferredLanguage: language.English},
+			want: &domain.Profile{
+				FirstName:         "first",
+				LastName:          "changed",
+				NickName:          "nick",
+				DisplayName:       "first last",
+				PreferredLanguage: language.English,
+				Gender:            domain.GenderDiverse,
+			},
+			wantChanged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := mergeProfile(human, tt.user)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantChanged, changed)
+		})
+	}
+}
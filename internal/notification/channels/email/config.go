@@ -0,0 +1,13 @@
+package email
+
+import (
+	"github.com/zitadel/zitadel/internal/notification/channels/httpemail"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+)
+
+// Config is the configuration of an email provider.
+// Exactly one of the provider configs is set.
+type Config struct {
+	SMTPConfig *smtp.Config
+	HTTPConfig *httpemail.Config
+}
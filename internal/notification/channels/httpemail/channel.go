@@ -0,0 +1,100 @@
+package httpemail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func InitChannel(ctx context.Context, config Config) (channels.NotificationChannel, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	templates, err := config.templates()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Debug("successfully initialized http email channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		emailMsg, ok := message.(*messages.Email)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "HTTPEMAIL-s0pLc", "message is not EmailMessage")
+		}
+		if emailMsg.Content == "" || emailMsg.Subject == "" || len(emailMsg.Recipients) == 0 {
+			return zerrors.ThrowInternalf(nil, "HTTPEMAIL-zGe2Z", "subject, recipients and content must be set but got subject %s, recipients length %d and content length %d", emailMsg.Subject, len(emailMsg.Recipients), len(emailMsg.Content))
+		}
+		emailMsg.SenderEmail = config.SenderAddress
+		emailMsg.SenderName = config.SenderName
+		emailMsg.ReplyToAddress = config.ReplyToAddress
+		req, err := newRequest(requestCtx, &config, templates, &TemplateData{
+			SenderAddress:  emailMsg.SenderEmail,
+			SenderName:     emailMsg.SenderName,
+			ReplyToAddress: emailMsg.ReplyToAddress,
+			Recipients:     emailMsg.Recipients,
+			CC:             emailMsg.CC,
+			BCC:            emailMsg.BCC,
+			Subject:        emailMsg.Subject,
+			Content:        emailMsg.Content,
+			HTML:           emailMsg.IsHTML(),
+		})
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "HTTPEMAIL-osk3S", "could not send email")
+		}
+		if err = resp.Body.Close(); err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowInternal(fmt.Errorf("calling url %s returned %s", req.URL.Redacted(), resp.Status), "HTTPEMAIL-St3sf", "could not send email")
+		}
+		logging.WithFields("method", req.Method, "status", resp.Status).Debug("email sent")
+		return nil
+	}), nil
+}
+
+func newRequest(ctx context.Context, config *Config, templates *templates, data *TemplateData) (*http.Request, error) {
+	endpoint := new(strings.Builder)
+	if err := templates.endpoint.Execute(endpoint, data); err != nil {
+		return nil, zerrors.ThrowInternal(err, "HTTPEMAIL-Ex1ea", "could not execute endpoint template")
+	}
+	callURL, err := url.Parse(endpoint.String())
+	if err != nil || (callURL.Scheme != "http" && callURL.Scheme != "https") {
+		return nil, zerrors.ThrowInternal(err, "HTTPEMAIL-Ur2eb", "invalid endpoint")
+	}
+	body := new(bytes.Buffer)
+	if err = templates.body.Execute(body, data); err != nil {
+		return nil, zerrors.ThrowInternal(err, "HTTPEMAIL-Ex3ec", "could not execute body template")
+	}
+	req, err := http.NewRequestWithContext(ctx, config.method(), callURL.String(), body)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "HTTPEMAIL-Rq4ed", "could not create request")
+	}
+	if config.ContentType != "" {
+		req.Header.Set("Content-Type", config.ContentType)
+	}
+	for name, headerTemplate := range templates.headers {
+		value := new(strings.Builder)
+		if err = headerTemplate.Execute(value, data); err != nil {
+			return nil, zerrors.ThrowInternal(err, "HTTPEMAIL-Ex5ee", "could not execute header template")
+		}
+		req.Header.Set(name, value.String())
+	}
+	return req, nil
+}
@@ -0,0 +1,148 @@
+package httpemail
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newRequest(t *testing.T) {
+	data := &TemplateData{
+		SenderAddress: "noreply@zitadel.cloud",
+		SenderName:    "ZITADEL",
+		Recipients:    []string{"user@example.com"},
+		Subject:       "Verify email",
+		Content:       `<html>Your code is "123456"</html>`,
+		HTML:          true,
+	}
+	type want struct {
+		method      string
+		url         string
+		body        string
+		contentType string
+		headers     map[string]string
+	}
+	tests := []struct {
+		name    string
+		config  *Config
+		want    want
+		wantErr bool
+	}{
+		{
+			name: "json body",
+			config: &Config{
+				Endpoint:     "https://api.example.com/v3/mail/send",
+				ContentType:  "application/json",
+				BodyTemplate: `{"from":{"email":{{json .SenderAddress}},"name":{{json .SenderName}}},"to":{{json .Recipients}},"subject":{{json .Subject}},"{{if .HTML}}html{{else}}text{{end}}":{{json .Content}}}`,
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+			},
+			want: want{
+				method:      http.MethodPost,
+				url:         "https://api.example.com/v3/mail/send",
+				body:        `{"from":{"email":"noreply@zitadel.cloud","name":"ZITADEL"},"to":["user@example.com"],"subject":"Verify email","html":"<html>Your code is \"123456\"</html>"}`,
+				contentType: "application/json",
+				headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+			},
+		},
+		{
+			name: "form body with templated header",
+			config: &Config{
+				Endpoint:     "https://api.example.com/messages",
+				Method:       http.MethodPut,
+				ContentType:  "application/x-www-form-urlencoded",
+				BodyTemplate: `to={{urlquery (index .Recipients 0)}}&subject={{urlquery .Subject}}`,
+				Headers: map[string]string{
+					"X-Sender": "{{.SenderAddress}}",
+				},
+			},
+			want: want{
+				method:      http.MethodPut,
+				url:         "https://api.example.com/messages",
+				body:        `to=user%40example.com&subject=Verify+email`,
+				contentType: "application/x-www-form-urlencoded",
+				headers: map[string]string{
+					"X-Sender": "noreply@zitadel.cloud",
+				},
+			},
+		},
+		{
+			name: "invalid scheme",
+			config: &Config{
+				Endpoint: "ftp://api.example.com/{{.Subject}}",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates, err := tt.config.templates()
+			require.NoError(t, err)
+			got, err := newRequest(context.Background(), tt.config, templates, data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			gotBody, err := io.ReadAll(got.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.method, got.Method)
+			assert.Equal(t, tt.want.url, got.URL.String())
+			assert.Equal(t, tt.want.body, string(gotBody))
+			assert.Equal(t, tt.want.contentType, got.Header.Get("Content-Type"))
+			for name, value := range tt.want.headers {
+				assert.Equal(t, value, got.Header.Get(name))
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:    "missing endpoint",
+			config:  &Config{SenderAddress: "noreply@zitadel.cloud"},
+			wantErr: true,
+		},
+		{
+			name:    "missing sender address",
+			config:  &Config{Endpoint: "https://api.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid body template",
+			config:  &Config{Endpoint: "https://api.example.com", SenderAddress: "noreply@zitadel.cloud", BodyTemplate: "{{.Content"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid header template",
+			config:  &Config{Endpoint: "https://api.example.com", SenderAddress: "noreply@zitadel.cloud", Headers: map[string]string{"X-Subject": "{{.Subject"}},
+			wantErr: true,
+		},
+		{
+			name:   "valid",
+			config: &Config{Endpoint: "https://api.example.com", SenderAddress: "noreply@zitadel.cloud", BodyTemplate: "{{.Content}}"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package httpemail
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Config of an email provider offering an HTTP API (e.g. SendGrid, Mailgun or Postmark).
+// Endpoint, BodyTemplate and the values of Headers are Go templates, which are executed with the TemplateData of the message.
+type Config struct {
+	Endpoint       string
+	Method         string
+	ContentType    string
+	BodyTemplate   string
+	Headers        map[string]string
+	SenderAddress  string
+	SenderName     string
+	ReplyToAddress string
+}
+
+// TemplateData is available in the Endpoint, BodyTemplate and Headers, e.g. {{.Subject}}.
+// Values can be escaped using the `urlquery` and `json` functions.
+type TemplateData struct {
+	SenderAddress  string
+	SenderName     string
+	ReplyToAddress string
+	Recipients     []string
+	CC             []string
+	BCC            []string
+	Subject        string
+	Content        string
+	// HTML is true if the Content is an HTML document, plain text otherwise
+	HTML bool
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		// HTML characters are not escaped, as the body is not rendered by a browser
+		var encoded strings.Builder
+		encoder := json.NewEncoder(&encoded)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(value); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(encoded.String(), "\n"), nil
+	},
+}
+
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return zerrors.ThrowInvalidArgument(nil, "HTTPEMAIL-Vf1ea", "Errors.SMTPConfig.HTTP.EndpointMissing")
+	}
+	if c.SenderAddress == "" {
+		return zerrors.ThrowInvalidArgument(nil, "HTTPEMAIL-Vf2eb", "Errors.Invalid.Argument")
+	}
+	if _, err := c.templates(); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "HTTPEMAIL-Vf3ec", "Errors.SMTPConfig.HTTP.InvalidTemplate")
+	}
+	return nil
+}
+
+func (c *Config) method() string {
+	if c.Method == "" {
+		return http.MethodPost
+	}
+	return c.Method
+}
+
+type templates struct {
+	endpoint *template.Template
+	body     *template.Template
+	headers  map[string]*template.Template
+}
+
+func (c *Config) templates() (t *templates, err error) {
+	t = &templates{
+		headers: make(map[string]*template.Template, len(c.Headers)),
+	}
+	t.endpoint, err = template.New("endpoint").Funcs(templateFuncs).Parse(c.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	t.body, err = template.New("body").Funcs(templateFuncs).Parse(c.BodyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range c.Headers {
+		t.headers[name], err = template.New(name).Funcs(templateFuncs).Parse(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
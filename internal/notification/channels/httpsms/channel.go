@@ -0,0 +1,89 @@
+package httpsms
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func InitChannel(ctx context.Context, config Config) (channels.NotificationChannel, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	endpointTemplate, bodyTemplate, err := config.templates()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Debug("successfully initialized http sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		smsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "HTTPSMS-s0pLc", "message is not SMS")
+		}
+		content, err := smsMsg.GetContent()
+		if err != nil {
+			return err
+		}
+		req, err := newRequest(requestCtx, &config, endpointTemplate, bodyTemplate, &TemplateData{
+			SenderNumber:    smsMsg.SenderPhoneNumber,
+			RecipientNumber: smsMsg.RecipientPhoneNumber,
+			Content:         content,
+		})
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "HTTPSMS-osk3S", "could not send message")
+		}
+		if err = resp.Body.Close(); err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowInternal(fmt.Errorf("calling url %s returned %s", req.URL.Redacted(), resp.Status), "HTTPSMS-St3sf", "could not send message")
+		}
+		logging.WithFields("method", req.Method, "status", resp.Status).Debug("sms sent")
+		return nil
+	}), nil
+}
+
+func newRequest(ctx context.Context, config *Config, endpointTemplate, bodyTemplate *template.Template, data *TemplateData) (*http.Request, error) {
+	endpoint := new(strings.Builder)
+	if err := endpointTemplate.Execute(endpoint, data); err != nil {
+		return nil, zerrors.ThrowInternal(err, "HTTPSMS-Ex1sa", "could not execute endpoint template")
+	}
+	callURL, err := url.Parse(endpoint.String())
+	if err != nil || (callURL.Scheme != "http" && callURL.Scheme != "https") {
+		return nil, zerrors.ThrowInternal(err, "HTTPSMS-Ur2sb", "invalid endpoint")
+	}
+	body := new(bytes.Buffer)
+	if err = bodyTemplate.Execute(body, data); err != nil {
+		return nil, zerrors.ThrowInternal(err, "HTTPSMS-Ex3sc", "could not execute body template")
+	}
+	req, err := http.NewRequestWithContext(ctx, config.method(), callURL.String(), body)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "HTTPSMS-Rq4sd", "could not create request")
+	}
+	if config.ContentType != "" {
+		req.Header.Set("Content-Type", config.ContentType)
+	}
+	if config.Authorization != "" {
+		req.Header.Set("Authorization", config.Authorization)
+	}
+	return req, nil
+}
@@ -0,0 +1,119 @@
+package httpsms
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newRequest(t *testing.T) {
+	data := &TemplateData{
+		SenderNumber:    "ZITADEL",
+		RecipientNumber: "+41791234567",
+		Content:         `Your code is "123456" & valid`,
+	}
+	type want struct {
+		method        string
+		url           string
+		body          string
+		contentType   string
+		authorization string
+	}
+	tests := []struct {
+		name    string
+		config  *Config
+		want    want
+		wantErr bool
+	}{
+		{
+			name: "query parameters",
+			config: &Config{
+				Endpoint: "https://sms.example.com/send?to={{urlquery .RecipientNumber}}&text={{urlquery .Content}}",
+				Method:   http.MethodGet,
+			},
+			want: want{
+				method: http.MethodGet,
+				url:    "https://sms.example.com/send?to=%2B41791234567&text=Your+code+is+%22123456%22+%26+valid",
+			},
+		},
+		{
+			name: "json body",
+			config: &Config{
+				Endpoint:      "https://sms.example.com/messages",
+				ContentType:   "application/json",
+				BodyTemplate:  `{"from":{{json .SenderNumber}},"to":{{json .RecipientNumber}},"text":{{json .Content}}}`,
+				Authorization: "Bearer token",
+			},
+			want: want{
+				method:        http.MethodPost,
+				url:           "https://sms.example.com/messages",
+				body:          `{"from":"ZITADEL","to":"+41791234567","text":"Your code is \"123456\" & valid"}`,
+				contentType:   "application/json",
+				authorization: "Bearer token",
+			},
+		},
+		{
+			name: "invalid scheme",
+			config: &Config{
+				Endpoint: "ftp://sms.example.com/{{.RecipientNumber}}",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, body, err := tt.config.templates()
+			require.NoError(t, err)
+			got, err := newRequest(context.Background(), tt.config, endpoint, body, data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			gotBody, err := io.ReadAll(got.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.method, got.Method)
+			assert.Equal(t, tt.want.url, got.URL.String())
+			assert.Equal(t, tt.want.body, string(gotBody))
+			assert.Equal(t, tt.want.contentType, got.Header.Get("Content-Type"))
+			assert.Equal(t, tt.want.authorization, got.Header.Get("Authorization"))
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:    "missing endpoint",
+			config:  &Config{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid template",
+			config:  &Config{Endpoint: "https://sms.example.com", BodyTemplate: "{{.Content"},
+			wantErr: true,
+		},
+		{
+			name:   "valid",
+			config: &Config{Endpoint: "https://sms.example.com", BodyTemplate: "{{.Content}}"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package httpsms
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Config of a generic HTTP SMS provider.
+// Endpoint and BodyTemplate are Go templates, which are executed with the TemplateData of the message.
+type Config struct {
+	Endpoint      string
+	Method        string
+	ContentType   string
+	BodyTemplate  string
+	Authorization string
+	SenderNumber  string
+}
+
+// TemplateData is available in the Endpoint and BodyTemplate, e.g. {{.RecipientNumber}}.
+// Values can be escaped using the `urlquery` and `json` functions.
+type TemplateData struct {
+	SenderNumber    string
+	RecipientNumber string
+	Content         string
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(value string) (string, error) {
+		// HTML characters are not escaped, as the body is not rendered by a browser
+		var encoded strings.Builder
+		encoder := json.NewEncoder(&encoded)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(value); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(encoded.String(), "\n"), nil
+	},
+}
+
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return zerrors.ThrowInvalidArgument(nil, "HTTPSMS-Cf1sa", "Errors.SMSConfig.HTTP.EndpointMissing")
+	}
+	if _, _, err := c.templates(); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "HTTPSMS-Cf2sb", "Errors.SMSConfig.HTTP.InvalidTemplate")
+	}
+	return nil
+}
+
+func (c *Config) method() string {
+	if c.Method == "" {
+		return http.MethodPost
+	}
+	return c.Method
+}
+
+func (c *Config) templates() (endpoint *template.Template, body *template.Template, err error) {
+	endpoint, err = template.New("endpoint").Funcs(templateFuncs).Parse(c.Endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err = template.New("body").Funcs(templateFuncs).Parse(c.BodyTemplate)
+	if err != nil {
+		return nil, nil, err
+	}
+	return endpoint, body, nil
+}
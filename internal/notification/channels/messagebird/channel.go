@@ -0,0 +1,74 @@
+package messagebird
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// messagesURL is the endpoint of the MessageBird (Bird) messaging API
+var messagesURL = "https://rest.messagebird.com/messages"
+
+type messageRequest struct {
+	Originator string   `json:"originator"`
+	Recipients []string `json:"recipients"`
+	Body       string   `json:"body"`
+}
+
+type messageResponse struct {
+	ID string `json:"id"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized messagebird sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		smsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "MBIRD-s0pLc", "message is not SMS")
+		}
+		content, err := smsMsg.GetContent()
+		if err != nil {
+			return err
+		}
+		body, err := json.Marshal(&messageRequest{
+			Originator: smsMsg.SenderPhoneNumber,
+			Recipients: []string{smsMsg.RecipientPhoneNumber},
+			Body:       content,
+		})
+		if err != nil {
+			return zerrors.ThrowInternal(err, "MBIRD-Mr1sa", "could not create request")
+		}
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, messagesURL, bytes.NewReader(body))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "MBIRD-Rq2sd", "could not create request")
+		}
+		req.Header.Set("Authorization", "AccessKey "+config.AccessKey)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "MBIRD-osk3S", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowInternal(fmt.Errorf("messagebird returned %s", resp.Status), "MBIRD-St3sf", "could not send message")
+		}
+		response := new(messageResponse)
+		if err = json.NewDecoder(resp.Body).Decode(response); err != nil {
+			return zerrors.ThrowInternal(err, "MBIRD-Dc4sg", "could not parse response")
+		}
+		logging.WithFields("message_id", response.ID).Debug("sms sent")
+		return nil
+	})
+}
@@ -0,0 +1,6 @@
+package messagebird
+
+type Config struct {
+	AccessKey  string
+	Originator string
+}
@@ -0,0 +1,33 @@
+package sms
+
+import (
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
+)
+
+// Config is the configuration of the active SMS provider.
+// Exactly one of the provider configs is set.
+type Config struct {
+	TwilioConfig      *twilio.Config
+	VonageConfig      *vonage.Config
+	MessageBirdConfig *messagebird.Config
+	HTTPConfig        *httpsms.Config
+}
+
+// SenderNumber returns the number (or alphanumeric originator) messages are sent from
+func (c *Config) SenderNumber() string {
+	switch {
+	case c.TwilioConfig != nil:
+		return c.TwilioConfig.SenderNumber
+	case c.VonageConfig != nil:
+		return c.VonageConfig.SenderNumber
+	case c.MessageBirdConfig != nil:
+		return c.MessageBirdConfig.Originator
+	case c.HTTPConfig != nil:
+		return c.HTTPConfig.SenderNumber
+	default:
+		return ""
+	}
+}
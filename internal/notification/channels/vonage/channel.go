@@ -0,0 +1,78 @@
+package vonage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const statusSuccess = "0"
+
+// smsURL is the endpoint of the Vonage (formerly Nexmo) SMS API
+var smsURL = "https://rest.nexmo.com/sms/json"
+
+type smsResponse struct {
+	Messages []struct {
+		MessageID string `json:"message-id"`
+		Status    string `json:"status"`
+		ErrorText string `json:"error-text"`
+	} `json:"messages"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized vonage sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		smsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "VONAG-s0pLc", "message is not SMS")
+		}
+		content, err := smsMsg.GetContent()
+		if err != nil {
+			return err
+		}
+		form := url.Values{
+			"api_key":    {config.APIKey},
+			"api_secret": {config.APISecret},
+			"from":       {smsMsg.SenderPhoneNumber},
+			"to":         {strings.TrimPrefix(smsMsg.RecipientPhoneNumber, "+")},
+			"text":       {content},
+		}
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, smsURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-Rq2sd", "could not create request")
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-osk3S", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowInternal(fmt.Errorf("vonage returned %s", resp.Status), "VONAG-St3sf", "could not send message")
+		}
+		response := new(smsResponse)
+		if err = json.NewDecoder(resp.Body).Decode(response); err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-Dc4sg", "could not parse response")
+		}
+		for _, m := range response.Messages {
+			if m.Status != statusSuccess {
+				return zerrors.ThrowInternal(fmt.Errorf("status %s: %s", m.Status, m.ErrorText), "VONAG-Ms5sh", "could not send message")
+			}
+			logging.WithFields("message_id", m.MessageID).Debug("sms sent")
+		}
+		return nil
+	})
+}
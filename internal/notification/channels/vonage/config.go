@@ -0,0 +1,7 @@
+package vonage
+
+type Config struct {
+	APIKey       string
+	APISecret    string
+	SenderNumber string
+}
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelLogoutNotificationsProjectionTable = "projections.notifications_back_channel_logout"
+
+	backChannelLogoutEvent    = "http://schemas.openid.net/event/backchannel-logout"
+	logoutTokenType           = "logout+jwt"
+	logoutTokenLifetime       = 2 * time.Minute
+	backChannelLogoutTimeout  = 5 * time.Second
+	backChannelLogoutFormName = "logout_token"
+)
+
+type backChannelLogoutNotifier struct {
+	commands      *command.Commands
+	queries       *NotificationQueries
+	keyEncryption crypto.EncryptionAlgorithm
+	idGenerator   id.Generator
+}
+
+func NewBackChannelLogoutNotifier(
+	ctx context.Context,
+	config handler.Config,
+	commands *command.Commands,
+	queries *NotificationQueries,
+	keyEncryption crypto.EncryptionAlgorithm,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &backChannelLogoutNotifier{
+		commands:      commands,
+		queries:       queries,
+		keyEncryption: keyEncryption,
+		idGenerator:   id.SonyFlakeGenerator(),
+	})
+}
+
+func (*backChannelLogoutNotifier) Name() string {
+	return BackChannelLogoutNotificationsProjectionTable
+}
+
+func (u *backChannelLogoutNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.TerminateType,
+					Reduce: u.reduceSessionTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanSignedOutType,
+					Reduce: u.reduceHumanSignedOut,
+				},
+			},
+		},
+	}
+}
+
+func (u *backChannelLogoutNotifier) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohb7o", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+	return u.terminateSession(e, e.Aggregate().ID, ""), nil
+}
+
+// reduceHumanSignedOut handles the logout of a user from a user agent (V1 session),
+// where the user agent is communicated as session to the clients
+func (u *backChannelLogoutNotifier) reduceHumanSignedOut(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSignedOutEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-quu6E", "reduce.wrong.event.type %s", user.HumanSignedOutType)
+	}
+	return u.terminateSession(e, e.UserAgentID, e.Aggregate().ID), nil
+}
+
+// terminateSession sends a logout token to every registered client of the session,
+// which was not notified yet. If userID is set, only the clients of the user are notified.
+// Every delivery is recorded, so a failed delivery is retried without notifying the other clients again.
+func (u *backChannelLogoutNotifier) terminateSession(event eventstore.Event, sessionID, userID string) *handler.Statement {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		if sessionID == "" {
+			return nil
+		}
+		ctx := HandlerContext(event.Aggregate())
+		clients, err := u.queries.SessionLogoutClients(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		var signer jose.Signer
+		for _, client := range clients {
+			if client.BackChannelLogoutSent || (userID != "" && client.UserID != userID) {
+				continue
+			}
+			logoutURI, err := u.backChannelLogoutURI(ctx, client.ClientID)
+			if err != nil {
+				return err
+			}
+			if logoutURI == "" {
+				continue
+			}
+			if signer == nil {
+				if signer, err = u.signer(ctx); err != nil {
+					return err
+				}
+			}
+			jti, err := u.idGenerator.Next()
+			if err != nil {
+				return err
+			}
+			token, err := signLogoutToken(signer, newLogoutTokenClaims(client, sessionID, jti, time.Now()))
+			if err != nil {
+				return err
+			}
+			if err = sendLogoutToken(ctx, logoutURI, token); err != nil {
+				return err
+			}
+			if err = u.commands.BackChannelLogoutSent(ctx, sessionID, client.UserID, client.ClientID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// backChannelLogoutURI returns the currently configured uri of the client.
+// Removed clients are not notified.
+func (u *backChannelLogoutNotifier) backChannelLogoutURI(ctx context.Context, clientID string) (string, error) {
+	app, err := u.queries.AppByOIDCClientID(ctx, clientID)
+	if zerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if app.OIDCConfig == nil {
+		return "", nil
+	}
+	return app.OIDCConfig.BackChannelLogoutURI, nil
+}
+
+// signer creates a signer with the active OIDC signing key of the instance,
+// the same key the id_tokens are signed with
+func (u *backChannelLogoutNotifier) signer(ctx context.Context) (jose.Signer, error) {
+	keys, err := u.queries.ActivePrivateSigningKey(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(keys.Keys) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "HANDL-Ieb3a", "Errors.Internal")
+	}
+	key := keys.Keys[len(keys.Keys)-1]
+	keyData, err := crypto.Decrypt(key.Key(), u.keyEncryption)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := crypto.BytesToPrivateKey(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.SignatureAlgorithm(key.Algorithm()),
+			Key:       &jose.JSONWebKey{Key: privateKey, KeyID: key.ID()},
+		},
+		(&jose.SignerOptions{}).WithType(logoutTokenType),
+	)
+}
+
+type logoutTokenClaims struct {
+	Issuer     string              `json:"iss"`
+	Subject    string              `json:"sub"`
+	Audience   []string            `json:"aud"`
+	IssuedAt   int64               `json:"iat"`
+	Expiration int64               `json:"exp"`
+	JWTID      string              `json:"jti"`
+	SessionID  string              `json:"sid"`
+	Events     map[string]struct{} `json:"events"`
+}
+
+func newLogoutTokenClaims(client *query.SessionLogoutClient, sessionID, jti string, now time.Time) *logoutTokenClaims {
+	return &logoutTokenClaims{
+		Issuer:     client.Issuer,
+		Subject:    client.UserID,
+		Audience:   []string{client.ClientID},
+		IssuedAt:   now.Unix(),
+		Expiration: now.Add(logoutTokenLifetime).Unix(),
+		JWTID:      jti,
+		SessionID:  sessionID,
+		Events:     map[string]struct{}{backChannelLogoutEvent: {}},
+	}
+}
+
+func signLogoutToken(signer jose.Signer, claims *logoutTokenClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signature, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+	return signature.CompactSerialize()
+}
+
+func sendLogoutToken(ctx context.Context, logoutURI, token string) error {
+	ctx, cancel := context.WithTimeout(ctx, backChannelLogoutTimeout)
+	defer cancel()
+	form := url.Values{backChannelLogoutFormName: {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, logoutURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return zerrors.ThrowUnavailablef(nil, "HANDL-Aib2a", "back-channel logout failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_signLogoutToken(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: &jose.JSONWebKey{Key: privateKey, KeyID: "key1"}},
+		(&jose.SignerOptions{}).WithType(logoutTokenType),
+	)
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+
+	token, err := signLogoutToken(signer, newLogoutTokenClaims(
+		&query.SessionLogoutClient{UserID: "user1", ClientID: "client1", Issuer: "https://issuer.com"},
+		"session1", "jti1", now,
+	))
+	require.NoError(t, err)
+
+	signature, err := jose.ParseSigned(token)
+	require.NoError(t, err)
+	assert.Equal(t, "key1", signature.Signatures[0].Header.KeyID)
+	assert.Equal(t, logoutTokenType, signature.Signatures[0].Header.ExtraHeaders[jose.HeaderType])
+	payload, err := signature.Verify(&privateKey.PublicKey)
+	require.NoError(t, err)
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	assert.Equal(t, map[string]any{
+		"iss":    "https://issuer.com",
+		"sub":    "user1",
+		"aud":    []any{"client1"},
+		"iat":    float64(now.Unix()),
+		"exp":    float64(now.Add(logoutTokenLifetime).Unix()),
+		"jti":    "jti1",
+		"sid":    "session1",
+		"events": map[string]any{backChannelLogoutEvent: map[string]any{}},
+	}, claims)
+}
+
+func Test_sendLogoutToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+		},
+		{
+			name:   "no content",
+			status: http.StatusNoContent,
+		},
+		{
+			name:    "bad request",
+			status:  http.StatusBadRequest,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "token", r.PostFormValue(backChannelLogoutFormName))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := sendLogoutToken(context.Background(), server.URL, "token")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/deviceauth"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	CIBANotificationsProjectionTable = "projections.notifications_ciba"
+
+	cibaPingTimeout = 5 * time.Second
+)
+
+// cibaNotifier asks users to approve backchannel authentication requests (CIBA)
+// and notifies clients using the ping mode, when a request was approved or denied.
+type cibaNotifier struct {
+	commands      *command.Commands
+	queries       *NotificationQueries
+	channels      types.ChannelChains
+	keyEncryption crypto.EncryptionAlgorithm
+}
+
+func NewCIBANotifier(
+	ctx context.Context,
+	config handler.Config,
+	commands *command.Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+	keyEncryption crypto.EncryptionAlgorithm,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &cibaNotifier{
+		commands:      commands,
+		queries:       queries,
+		channels:      channels,
+		keyEncryption: keyEncryption,
+	})
+}
+
+func (*cibaNotifier) Name() string {
+	return CIBANotificationsProjectionTable
+}
+
+func (u *cibaNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: deviceauth.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  deviceauth.AddedEventType,
+					Reduce: u.reduceAdded,
+				},
+				{
+					Event:  deviceauth.ApprovedEventType,
+					Reduce: u.reduceCompleted,
+				},
+				{
+					Event:  deviceauth.CanceledEventType,
+					Reduce: u.reduceCompleted,
+				},
+			},
+		},
+	}
+}
+
+// reduceAdded sends the user code of a backchannel authentication request to the requested user,
+// so they can approve it in the device authorization flow of the login UI.
+func (u *cibaNotifier) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Aek4o", "reduce.wrong.event.type %s", deviceauth.AddedEventType)
+	}
+	if !e.IsCIBA() {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		if e.Expires.Before(time.Now()) {
+			return nil
+		}
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, deviceauth.UserNotifiedEventType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, notifyUser.ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+		template, err := u.queries.MailTemplateByOrg(ctx, notifyUser.ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.CIBARequestMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+			SendCIBARequest(ctx, e.UserCode, e.BindingMessage)
+		if err != nil {
+			return err
+		}
+		return u.commands.CIBAUserNotified(ctx, e.Aggregate().ID)
+	}), nil
+}
+
+// reduceCompleted notifies the client about an approved or denied backchannel authentication request
+// on its notification endpoint (CIBA ping mode, section 10.2).
+// Expired requests are only canceled when the client polls the token endpoint, so they are not notified.
+func (u *cibaNotifier) reduceCompleted(event eventstore.Event) (*handler.Statement, error) {
+	switch e := event.(type) {
+	case *deviceauth.ApprovedEvent:
+	case *deviceauth.CanceledEvent:
+		if e.Reason == domain.DeviceAuthCanceledExpired {
+			return handler.NewNoOpStatement(e), nil
+		}
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-xee8T", "reduce.wrong.event.type %v", []eventstore.EventType{deviceauth.ApprovedEventType, deviceauth.CanceledEventType})
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		deviceAuth, err := u.queries.DeviceAuthByDeviceCode(ctx, event.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		if deviceAuth.UserID == "" || deviceAuth.NotificationToken == nil {
+			return nil
+		}
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, deviceauth.ClientNotifiedEventType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		endpoint, err := u.clientNotificationEndpoint(ctx, deviceAuth.ClientID)
+		if err != nil {
+			return err
+		}
+		if endpoint == "" {
+			return nil
+		}
+		token, err := crypto.DecryptString(deviceAuth.NotificationToken, u.keyEncryption)
+		if err != nil {
+			return err
+		}
+		if err = sendCIBAPing(ctx, endpoint, token, deviceAuth.DeviceCode); err != nil {
+			return err
+		}
+		return u.commands.CIBAClientNotified(ctx, deviceAuth.DeviceCode)
+	}), nil
+}
+
+// clientNotificationEndpoint returns the currently configured endpoint of the client.
+// Removed clients are not notified.
+func (u *cibaNotifier) clientNotificationEndpoint(ctx context.Context, clientID string) (string, error) {
+	app, err := u.queries.AppByOIDCClientID(ctx, clientID)
+	if zerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if app.OIDCConfig == nil {
+		return "", nil
+	}
+	return app.OIDCConfig.BackChannelClientNotificationEndpoint, nil
+}
+
+type cibaPing struct {
+	AuthReqID string `json:"auth_req_id"`
+}
+
+func sendCIBAPing(ctx context.Context, endpoint, notificationToken, authReqID string) error {
+	ctx, cancel := context.WithTimeout(ctx, cibaPingTimeout)
+	defer cancel()
+	body, err := json.Marshal(&cibaPing{AuthReqID: authReqID})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+notificationToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return zerrors.ThrowUnavailablef(nil, "HANDL-Ohj3u", "ciba ping failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
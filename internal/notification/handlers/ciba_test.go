@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sendCIBAPing(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+		},
+		{
+			name:   "no content",
+			status: http.StatusNoContent,
+		},
+		{
+			name:    "unauthorized",
+			status:  http.StatusUnauthorized,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				var ping cibaPing
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&ping))
+				assert.Equal(t, "authReqID", ping.AuthReqID)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := sendCIBAPing(context.Background(), server.URL, "token", "authReqID")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GetActiveSMSConfig reads the active iam SMS provider config
+func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Config, error) {
+	active, err := query.NewSMSProviderStateQuery(domain.SMSConfigStateActive)
+	if err != nil {
+		return nil, err
+	}
+	config, err := n.SMSProviderConfig(ctx, active)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case config.TwilioConfig != nil:
+		token, err := crypto.DecryptString(config.TwilioConfig.Token, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			TwilioConfig: &twilio.Config{
+				SID:          config.TwilioConfig.SID,
+				Token:        token,
+				SenderNumber: config.TwilioConfig.SenderNumber,
+			},
+		}, nil
+	case config.VonageConfig != nil:
+		apiSecret, err := crypto.DecryptString(config.VonageConfig.APISecret, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			VonageConfig: &vonage.Config{
+				APIKey:       config.VonageConfig.APIKey,
+				APISecret:    apiSecret,
+				SenderNumber: config.VonageConfig.SenderNumber,
+			},
+		}, nil
+	case config.MessageBirdConfig != nil:
+		accessKey, err := crypto.DecryptString(config.MessageBirdConfig.AccessKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			MessageBirdConfig: &messagebird.Config{
+				AccessKey:  accessKey,
+				Originator: config.MessageBirdConfig.Originator,
+			},
+		}, nil
+	case config.HTTPConfig != nil:
+		var authorization string
+		if config.HTTPConfig.Authorization != nil {
+			authorization, err = crypto.DecryptString(config.HTTPConfig.Authorization, n.SMSTokenCrypto)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &sms.Config{
+			HTTPConfig: &httpsms.Config{
+				Endpoint:      config.HTTPConfig.Endpoint,
+				Method:        config.HTTPConfig.Method,
+				ContentType:   config.HTTPConfig.ContentType,
+				BodyTemplate:  config.HTTPConfig.BodyTemplate,
+				Authorization: authorization,
+				SenderNumber:  config.HTTPConfig.SenderNumber,
+			},
+		}, nil
+	default:
+		return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMSConfig.NotFound")
+	}
+}
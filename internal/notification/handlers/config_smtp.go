@@ -2,31 +2,85 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 
-	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpemail"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetSMTPConfig reads the iam SMTP provider config
-func (n *NotificationQueries) GetSMTPConfig(ctx context.Context) (*smtp.Config, error) {
-	config, err := n.SMTPConfigByAggregateID(ctx, authz.GetInstance(ctx).InstanceID())
+// GetActiveSMTPConfigs reads the active email provider configs for notifications to users of the organization
+// in the order they have to be tried
+func (n *NotificationQueries) GetActiveSMTPConfigs(ctx context.Context, orgID string) ([]*email.Config, error) {
+	configs, err := n.ActiveSMTPConfigs(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
-	password, err := crypto.DecryptString(config.Password, n.SMTPPasswordCrypto)
-	if err != nil {
-		return nil, err
+	if len(configs) == 0 {
+		return nil, zerrors.ThrowNotFound(nil, "HANDLER-Rk3ls", "Errors.SMTPConfig.NotFound")
+	}
+	emailConfigs := make([]*email.Config, 0, len(configs))
+	for _, config := range configs {
+		emailConfig, err := n.emailConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		emailConfigs = append(emailConfigs, emailConfig)
+	}
+	return emailConfigs, nil
+}
+
+func (n *NotificationQueries) emailConfig(config *query.SMTPConfig) (*email.Config, error) {
+	switch {
+	case config.SMTPConfig != nil:
+		var password string
+		if config.SMTPConfig.Password != nil {
+			var err error
+			password, err = crypto.DecryptString(config.SMTPConfig.Password, n.SMTPPasswordCrypto)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &email.Config{
+			SMTPConfig: &smtp.Config{
+				From:           config.SenderAddress,
+				FromName:       config.SenderName,
+				ReplyToAddress: config.ReplyToAddress,
+				Tls:            config.SMTPConfig.TLS,
+				SMTP: smtp.SMTP{
+					Host:     config.SMTPConfig.Host,
+					User:     config.SMTPConfig.User,
+					Password: password,
+				},
+			},
+		}, nil
+	case config.HTTPConfig != nil:
+		var headers map[string]string
+		if config.HTTPConfig.Headers != nil {
+			decrypted, err := crypto.Decrypt(config.HTTPConfig.Headers, n.SMTPPasswordCrypto)
+			if err != nil {
+				return nil, err
+			}
+			if err = json.Unmarshal(decrypted, &headers); err != nil {
+				return nil, zerrors.ThrowInternal(err, "HANDLER-Hm1ka", "Errors.Internal")
+			}
+		}
+		return &email.Config{
+			HTTPConfig: &httpemail.Config{
+				Endpoint:       config.HTTPConfig.Endpoint,
+				Method:         config.HTTPConfig.Method,
+				ContentType:    config.HTTPConfig.ContentType,
+				BodyTemplate:   config.HTTPConfig.BodyTemplate,
+				Headers:        headers,
+				SenderAddress:  config.SenderAddress,
+				SenderName:     config.SenderName,
+				ReplyToAddress: config.ReplyToAddress,
+			},
+		}, nil
+	default:
+		return nil, zerrors.ThrowInternal(nil, "HANDLER-Hm2kb", "Errors.SMTPConfig.NotFound")
 	}
-	return &smtp.Config{
-		From:           config.SenderAddress,
-		FromName:       config.SenderName,
-		ReplyToAddress: config.ReplyToAddress,
-		Tls:            config.TLS,
-		SMTP: smtp.SMTP{
-			Host:     config.Host,
-			User:     config.User,
-			Password: password,
-		},
-	}, nil
 }
@@ -11,6 +11,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/zitadel/zitadel/internal/domain"
 	query "github.com/zitadel/zitadel/internal/query"
@@ -56,6 +57,51 @@ func (mr *MockQueriesMockRecorder) ActiveLabelPolicyByOrg(arg0, arg1, arg2 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveLabelPolicyByOrg", reflect.TypeOf((*MockQueries)(nil).ActiveLabelPolicyByOrg), arg0, arg1, arg2)
 }
 
+// ActivePrivateSigningKey mocks base method.
+func (m *MockQueries) ActivePrivateSigningKey(arg0 context.Context, arg1 time.Time) (*query.PrivateKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivePrivateSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(*query.PrivateKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivePrivateSigningKey indicates an expected call of ActivePrivateSigningKey.
+func (mr *MockQueriesMockRecorder) ActivePrivateSigningKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivePrivateSigningKey", reflect.TypeOf((*MockQueries)(nil).ActivePrivateSigningKey), arg0, arg1)
+}
+
+// ActiveSMTPConfigs mocks base method.
+func (m *MockQueries) ActiveSMTPConfigs(arg0 context.Context, arg1 string) ([]*query.SMTPConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveSMTPConfigs", arg0, arg1)
+	ret0, _ := ret[0].([]*query.SMTPConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveSMTPConfigs indicates an expected call of ActiveSMTPConfigs.
+func (mr *MockQueriesMockRecorder) ActiveSMTPConfigs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveSMTPConfigs", reflect.TypeOf((*MockQueries)(nil).ActiveSMTPConfigs), arg0, arg1)
+}
+
+// AppByOIDCClientID mocks base method.
+func (m *MockQueries) AppByOIDCClientID(arg0 context.Context, arg1 string) (*query.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppByOIDCClientID", arg0, arg1)
+	ret0, _ := ret[0].(*query.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppByOIDCClientID indicates an expected call of AppByOIDCClientID.
+func (mr *MockQueriesMockRecorder) AppByOIDCClientID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppByOIDCClientID", reflect.TypeOf((*MockQueries)(nil).AppByOIDCClientID), arg0, arg1)
+}
+
 // CustomTextListByTemplate mocks base method.
 func (m *MockQueries) CustomTextListByTemplate(arg0 context.Context, arg1, arg2 string, arg3 bool) (*query.CustomTexts, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +117,21 @@ func (mr *MockQueriesMockRecorder) CustomTextListByTemplate(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomTextListByTemplate", reflect.TypeOf((*MockQueries)(nil).CustomTextListByTemplate), arg0, arg1, arg2, arg3)
 }
 
+// DeviceAuthByDeviceCode mocks base method.
+func (m *MockQueries) DeviceAuthByDeviceCode(arg0 context.Context, arg1 string) (*query.DeviceAuth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceAuthByDeviceCode", arg0, arg1)
+	ret0, _ := ret[0].(*query.DeviceAuth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeviceAuthByDeviceCode indicates an expected call of DeviceAuthByDeviceCode.
+func (mr *MockQueriesMockRecorder) DeviceAuthByDeviceCode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceAuthByDeviceCode", reflect.TypeOf((*MockQueries)(nil).DeviceAuthByDeviceCode), arg0, arg1)
+}
+
 // GetDefaultLanguage mocks base method.
 func (m *MockQueries) GetDefaultLanguage(arg0 context.Context) language.Tag {
 	m.ctrl.T.Helper()
@@ -180,21 +241,6 @@ func (mr *MockQueriesMockRecorder) SMSProviderConfig(arg0 any, arg1 ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMSProviderConfig", reflect.TypeOf((*MockQueries)(nil).SMSProviderConfig), varargs...)
 }
 
-// SMTPConfigByAggregateID mocks base method.
-func (m *MockQueries) SMTPConfigByAggregateID(arg0 context.Context, arg1 string) (*query.SMTPConfig, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SMTPConfigByAggregateID", arg0, arg1)
-	ret0, _ := ret[0].(*query.SMTPConfig)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// SMTPConfigByAggregateID indicates an expected call of SMTPConfigByAggregateID.
-func (mr *MockQueriesMockRecorder) SMTPConfigByAggregateID(arg0, arg1 any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMTPConfigByAggregateID", reflect.TypeOf((*MockQueries)(nil).SMTPConfigByAggregateID), arg0, arg1)
-}
-
 // SearchInstanceDomains mocks base method.
 func (m *MockQueries) SearchInstanceDomains(arg0 context.Context, arg1 *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error) {
 	m.ctrl.T.Helper()
@@ -239,3 +285,18 @@ func (mr *MockQueriesMockRecorder) SessionByID(arg0, arg1, arg2, arg3 any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionByID", reflect.TypeOf((*MockQueries)(nil).SessionByID), arg0, arg1, arg2, arg3)
 }
+
+// SessionLogoutClients mocks base method.
+func (m *MockQueries) SessionLogoutClients(arg0 context.Context, arg1 string) ([]*query.SessionLogoutClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionLogoutClients", arg0, arg1)
+	ret0, _ := ret[0].([]*query.SessionLogoutClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SessionLogoutClients indicates an expected call of SessionLogoutClients.
+func (mr *MockQueriesMockRecorder) SessionLogoutClients(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionLogoutClients", reflect.TypeOf((*MockQueries)(nil).SessionLogoutClients), arg0, arg1)
+}
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"context"
+	"time"
+
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -21,9 +23,13 @@ type Queries interface {
 	SearchMilestones(ctx context.Context, instanceIDs []string, queries *query.MilestonesSearchQueries) (*query.Milestones, error)
 	NotificationProviderByIDAndType(ctx context.Context, aggID string, providerType domain.NotificationProviderType) (*query.DebugNotificationProvider, error)
 	SMSProviderConfig(ctx context.Context, queries ...query.SearchQuery) (*query.SMSConfig, error)
-	SMTPConfigByAggregateID(ctx context.Context, aggregateID string) (*query.SMTPConfig, error)
+	ActiveSMTPConfigs(ctx context.Context, orgID string) ([]*query.SMTPConfig, error)
 	GetDefaultLanguage(ctx context.Context) language.Tag
 	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
+	SessionLogoutClients(ctx context.Context, sessionID string) ([]*query.SessionLogoutClient, error)
+	AppByOIDCClientID(ctx context.Context, clientID string) (*query.App, error)
+	ActivePrivateSigningKey(ctx context.Context, t time.Time) (*query.PrivateKeys, error)
+	DeviceAuthByDeviceCode(ctx context.Context, deviceCode string) (*query.DeviceAuth, error)
 }
 
 type NotificationQueries struct {
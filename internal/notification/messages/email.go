@@ -65,6 +65,11 @@ func (msg *Email) GetTriggeringEvent() eventstore.Event {
 	return msg.TriggeringEvent
 }
 
+// IsHTML returns true if the content of the email is an HTML document
+func (msg *Email) IsHTML() bool {
+	return isHTML(msg.Content)
+}
+
 func isHTML(input string) bool {
 	return isHTMLRgx.MatchString(input)
 }
@@ -17,7 +17,7 @@ var projections []*handler.Handler
 
 func Register(
 	ctx context.Context,
-	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig projection.CustomConfig,
+	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig, backChannelLogoutHandlerCustomConfig, cibaHandlerCustomConfig projection.CustomConfig,
 	telemetryCfg handlers.TelemetryPusherConfig,
 	externalDomain string,
 	externalPort uint16,
@@ -27,12 +27,14 @@ func Register(
 	es *eventstore.Eventstore,
 	otpEmailTmpl string,
 	fileSystemPath string,
-	userEncryption, smtpEncryption, smsEncryption crypto.EncryptionAlgorithm,
+	userEncryption, smtpEncryption, smsEncryption, keyEncryption crypto.EncryptionAlgorithm,
 ) {
 	q := handlers.NewNotificationQueries(queries, es, externalDomain, externalPort, externalSecure, fileSystemPath, userEncryption, smtpEncryption, smsEncryption)
 	c := newChannels(q)
 	projections = append(projections, handlers.NewUserNotifier(ctx, projection.ApplyCustomConfig(userHandlerCustomConfig), commands, q, c, otpEmailTmpl))
 	projections = append(projections, handlers.NewQuotaNotifier(ctx, projection.ApplyCustomConfig(quotaHandlerCustomConfig), commands, q, c))
+	projections = append(projections, handlers.NewBackChannelLogoutNotifier(ctx, projection.ApplyCustomConfig(backChannelLogoutHandlerCustomConfig), commands, q, keyEncryption))
+	projections = append(projections, handlers.NewCIBANotifier(ctx, projection.ApplyCustomConfig(cibaHandlerCustomConfig), commands, q, c, keyEncryption))
 	if telemetryCfg.Enabled {
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
@@ -1,6 +1,12 @@
 package senders
 
-import "github.com/zitadel/zitadel/internal/notification/channels"
+import (
+	"errors"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+)
 
 var _ channels.NotificationChannel = (*Chain)(nil)
 
@@ -26,3 +32,35 @@ func (c *Chain) HandleMessage(message channels.Message) error {
 func (c *Chain) Len() int {
 	return len(c.channels)
 }
+
+var _ channels.NotificationChannel = (*Failover)(nil)
+
+// Failover sends a message to the first channel able to handle it.
+// If a channel returns an error, the message is passed to the next one.
+type Failover struct {
+	channels []channels.NotificationChannel
+}
+
+func FailoverChannels(channel ...channels.NotificationChannel) *Failover {
+	return &Failover{channels: channel}
+}
+
+// HandleMessage returns nil as soon as a channel handled the message successfully
+// channels are tried in the same order they were provided to FailoverChannels()
+// if all channels fail, the errors of all of them are returned
+func (f *Failover) HandleMessage(message channels.Message) error {
+	errs := make([]error, 0, len(f.channels))
+	for i := range f.channels {
+		err := f.channels[i].HandleMessage(message)
+		if err == nil {
+			return nil
+		}
+		logging.WithFields("channel", i).WithError(err).Info("handling message failed, trying next channel")
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (f *Failover) Len() int {
+	return len(f.channels)
+}
@@ -4,41 +4,85 @@ import (
 	"context"
 
 	"github.com/zitadel/logging"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/channels/fs"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpemail"
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 )
 
-const smtpSpanName = "smtp.NotificationChannel"
+const (
+	smtpSpanName      = "smtp.NotificationChannel"
+	httpEmailSpanName = "httpemail.NotificationChannel"
+)
 
+// EmailChannels chains the email providers as failover, followed by the debug channels.
+// The providers are used in the passed order, if one fails to send the message the next one is used.
 func EmailChannels(
 	ctx context.Context,
-	emailConfig *smtp.Config,
+	emailConfigs []*email.Config,
 	getFileSystemProvider func(ctx context.Context) (*fs.Config, error),
 	getLogProvider func(ctx context.Context) (*log.Config, error),
 	successMetricName,
 	failureMetricName string,
 ) (chain *Chain, err error) {
-	channels := make([]channels.NotificationChannel, 0, 3)
-	p, err := smtp.InitChannel(emailConfig)
-	logging.WithFields(
-		"instance", authz.GetInstance(ctx).InstanceID(),
-	).OnError(err).Debug("initializing SMTP channel failed")
-	if err == nil {
-		channels = append(
-			channels,
-			instrumenting.Wrap(
+	emailChannels := make([]channels.NotificationChannel, 0, 3)
+	if len(emailConfigs) > 0 {
+		providers := make([]channels.NotificationChannel, 0, len(emailConfigs))
+		for _, emailConfig := range emailConfigs {
+			provider, spanName := emailProviderChannel(ctx, emailConfig)
+			if provider == nil {
+				continue
+			}
+			providers = append(providers, instrumenting.Wrap(
 				ctx,
-				p,
-				smtpSpanName,
+				provider,
+				spanName,
 				successMetricName,
 				failureMetricName,
-			),
-		)
+			))
+		}
+		if len(providers) > 0 {
+			emailChannels = append(emailChannels, FailoverChannels(providers...))
+		}
+	}
+	emailChannels = append(emailChannels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
+	return ChainChannels(emailChannels...), nil
+}
+
+func emailProviderChannel(ctx context.Context, emailConfig *email.Config) (channels.NotificationChannel, string) {
+	if emailConfig == nil {
+		return nil, ""
+	}
+	switch {
+	case emailConfig.SMTPConfig != nil:
+		return smtpChannel(emailConfig.SMTPConfig), smtpSpanName
+	case emailConfig.HTTPConfig != nil:
+		p, err := httpemail.InitChannel(ctx, *emailConfig.HTTPConfig)
+		logging.WithFields(
+			"instance", authz.GetInstance(ctx).InstanceID(),
+		).OnError(err).Debug("initializing HTTP email channel failed")
+		if err != nil {
+			return nil, ""
+		}
+		return p, httpEmailSpanName
+	default:
+		return nil, ""
 	}
-	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
-	return ChainChannels(channels...), nil
+}
+
+// smtpChannel only connects to the SMTP server when a message is handled,
+// so that fallback providers are only connected if needed.
+func smtpChannel(emailConfig *smtp.Config) channels.NotificationChannel {
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		channel, err := smtp.InitChannel(emailConfig)
+		if err != nil {
+			return err
+		}
+		return channel.HandleMessage(message)
+	})
 }
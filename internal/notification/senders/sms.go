@@ -3,31 +3,43 @@ package senders
 import (
 	"context"
 
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/channels/fs"
+	"github.com/zitadel/zitadel/internal/notification/channels/httpsms"
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 )
 
-const twilioSpanName = "twilio.NotificationChannel"
+const (
+	twilioSpanName      = "twilio.NotificationChannel"
+	vonageSpanName      = "vonage.NotificationChannel"
+	messageBirdSpanName = "messagebird.NotificationChannel"
+	httpSMSSpanName     = "httpsms.NotificationChannel"
+)
 
 func SMSChannels(
 	ctx context.Context,
-	twilioConfig *twilio.Config,
+	smsConfig *sms.Config,
 	getFileSystemProvider func(ctx context.Context) (*fs.Config, error),
 	getLogProvider func(ctx context.Context) (*log.Config, error),
 	successMetricName,
 	failureMetricName string,
 ) (chain *Chain, err error) {
 	channels := make([]channels.NotificationChannel, 0, 3)
-	if twilioConfig != nil {
+	if provider, spanName := smsProviderChannel(ctx, smsConfig); provider != nil {
 		channels = append(
 			channels,
 			instrumenting.Wrap(
 				ctx,
-				twilio.InitChannel(*twilioConfig),
-				twilioSpanName,
+				provider,
+				spanName,
 				successMetricName,
 				failureMetricName,
 			),
@@ -36,3 +48,28 @@ func SMSChannels(
 	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
 	return ChainChannels(channels...), nil
 }
+
+func smsProviderChannel(ctx context.Context, smsConfig *sms.Config) (channels.NotificationChannel, string) {
+	if smsConfig == nil {
+		return nil, ""
+	}
+	switch {
+	case smsConfig.TwilioConfig != nil:
+		return twilio.InitChannel(*smsConfig.TwilioConfig), twilioSpanName
+	case smsConfig.VonageConfig != nil:
+		return vonage.InitChannel(ctx, *smsConfig.VonageConfig), vonageSpanName
+	case smsConfig.MessageBirdConfig != nil:
+		return messagebird.InitChannel(ctx, *smsConfig.MessageBirdConfig), messageBirdSpanName
+	case smsConfig.HTTPConfig != nil:
+		p, err := httpsms.InitChannel(ctx, *smsConfig.HTTPConfig)
+		logging.WithFields(
+			"instance", authz.GetInstance(ctx).InstanceID(),
+		).OnError(err).Debug("initializing HTTP SMS channel failed")
+		if err != nil {
+			return nil, ""
+		}
+		return p, httpSMSSpanName
+	default:
+		return nil, ""
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"context"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func (notify Notify) SendCIBARequest(ctx context.Context, userCode, bindingMessage string) error {
+	url := login.DeviceAuthLink(http_utils.ComposedOrigin(ctx), userCode)
+	args := make(map[string]interface{})
+	args["BindingMessage"] = bindingMessage
+	return notify(url, args, domain.CIBARequestMessageType, false)
+}
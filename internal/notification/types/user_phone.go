@@ -20,13 +20,13 @@ func generateSms(
 	triggeringEvent eventstore.Event,
 ) error {
 	number := ""
-	smsChannels, twilioConfig, err := channels.SMS(ctx)
+	smsChannels, smsConfig, err := channels.SMS(ctx)
 	logging.OnError(err).Error("could not create sms channel")
 	if smsChannels == nil || smsChannels.Len() == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "PHONE-w8nfow", "Errors.Notification.Channels.NotPresent")
 	}
 	if err == nil {
-		number = twilioConfig.SenderNumber
+		number = smsConfig.SenderNumber()
 	}
 	message := &messages.SMS{
 		SenderPhoneNumber:    number,
@@ -0,0 +1,184 @@
+package provisioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	schemaUser      = "urn:ietf:params:scim:schemas:core:2.0:User"
+	contentTypeSCIM = "application/scim+json"
+
+	// maxErrorBodyLength limits the part of an error response kept for the provisioning state
+	maxErrorBodyLength = 512
+)
+
+// User is the SCIM representation of a ZITADEL user sent to the targets.
+// The id of the ZITADEL user is sent as externalId.
+type User struct {
+	Schemas           []string       `json:"schemas"`
+	ID                string         `json:"id,omitempty"`
+	ExternalID        string         `json:"externalId"`
+	UserName          string         `json:"userName"`
+	Name              *Name          `json:"name,omitempty"`
+	DisplayName       string         `json:"displayName,omitempty"`
+	NickName          string         `json:"nickName,omitempty"`
+	PreferredLanguage string         `json:"preferredLanguage,omitempty"`
+	Active            bool           `json:"active"`
+	Emails            []*MultiValued `json:"emails,omitempty"`
+	PhoneNumbers      []*MultiValued `json:"phoneNumbers,omitempty"`
+	Roles             []*MultiValued `json:"roles,omitempty"`
+}
+
+type Name struct {
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type MultiValued struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type listResponse struct {
+	TotalResults int     `json:"totalResults"`
+	Resources    []*User `json:"Resources"`
+}
+
+// StatusError is returned if the target responded with an unexpected status code
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("scim target responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// client calls the user endpoints of a SCIM 2.0 service provider (RFC 7644)
+type client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+func newClient(endpoint, token string, timeout time.Duration) *client {
+	return &client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// provisionUser creates the user at the target or replaces it if it already exists.
+// If the remoteID is unknown, an existing user is searched by its userName.
+// It returns the id the target uses for the user.
+func (c *client) provisionUser(ctx context.Context, remoteID string, user *User) (string, error) {
+	if remoteID != "" {
+		id, err := c.replaceUser(ctx, remoteID, user)
+		if !isStatus(err, http.StatusNotFound) {
+			return id, err
+		}
+	}
+	id, err := c.createUser(ctx, user)
+	if !isStatus(err, http.StatusConflict) {
+		return id, err
+	}
+	remoteID, err = c.findUser(ctx, user.UserName)
+	if err != nil {
+		return "", err
+	}
+	return c.replaceUser(ctx, remoteID, user)
+}
+
+func (c *client) createUser(ctx context.Context, user *User) (string, error) {
+	created := new(User)
+	if err := c.do(ctx, http.MethodPost, "/Users", user, created, http.StatusCreated); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+func (c *client) replaceUser(ctx context.Context, remoteID string, user *User) (string, error) {
+	replaced := new(User)
+	if err := c.do(ctx, http.MethodPut, "/Users/"+url.PathEscape(remoteID), user, replaced, http.StatusOK); err != nil {
+		return "", err
+	}
+	if replaced.ID == "" {
+		return remoteID, nil
+	}
+	return replaced.ID, nil
+}
+
+// deleteUser deletes the user at the target, a user which no longer exists is ignored
+func (c *client) deleteUser(ctx context.Context, remoteID string) error {
+	err := c.do(ctx, http.MethodDelete, "/Users/"+url.PathEscape(remoteID), nil, nil, http.StatusNoContent, http.StatusOK)
+	if isStatus(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *client) findUser(ctx context.Context, userName string) (string, error) {
+	filter := fmt.Sprintf("userName eq %s", strconv.Quote(userName))
+	list := new(listResponse)
+	if err := c.do(ctx, http.MethodGet, "/Users?filter="+url.QueryEscape(filter), nil, list, http.StatusOK); err != nil {
+		return "", err
+	}
+	if len(list.Resources) != 1 || list.Resources[0].ID == "" {
+		return "", &StatusError{StatusCode: http.StatusConflict, Body: "user exists but could not be found by userName"}
+	}
+	return list.Resources[0].ID, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, response any, expectedStatus ...int) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", contentTypeSCIM)
+	if body != nil {
+		req.Header.Set("Content-Type", contentTypeSCIM)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if !slices.Contains(expectedStatus, resp.StatusCode) {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+		return &StatusError{StatusCode: resp.StatusCode, Body: string(errBody)}
+	}
+	if response == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+func isStatus(err error, status int) bool {
+	statusErr := new(StatusError)
+	return errors.As(err, &statusErr) && statusErr.StatusCode == status
+}
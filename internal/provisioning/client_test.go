@@ -0,0 +1,178 @@
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	Method string
+	Path   string
+}
+
+func testServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*client, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		requests = append(requests, recordedRequest{Method: r.Method, Path: r.URL.RequestURI()})
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return newClient(server.URL+"/scim/v2/", "token", time.Second), &requests
+}
+
+func writeUser(w http.ResponseWriter, status int, id string) {
+	w.Header().Set("Content-Type", contentTypeSCIM)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&User{Schemas: []string{schemaUser}, ID: id})
+}
+
+func Test_client_provisionUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		remoteID     string
+		handler      func(w http.ResponseWriter, r *http.Request)
+		want         string
+		wantRequests []recordedRequest
+		wantErr      bool
+	}{
+		{
+			name: "create",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeUser(w, http.StatusCreated, "remote")
+			},
+			want: "remote",
+			wantRequests: []recordedRequest{
+				{Method: http.MethodPost, Path: "/scim/v2/Users"},
+			},
+		},
+		{
+			name:     "replace",
+			remoteID: "remote",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeUser(w, http.StatusOK, "remote")
+			},
+			want: "remote",
+			wantRequests: []recordedRequest{
+				{Method: http.MethodPut, Path: "/scim/v2/Users/remote"},
+			},
+		},
+		{
+			name:     "replace not found, create",
+			remoteID: "deleted",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				writeUser(w, http.StatusCreated, "remote")
+			},
+			want: "remote",
+			wantRequests: []recordedRequest{
+				{Method: http.MethodPut, Path: "/scim/v2/Users/deleted"},
+				{Method: http.MethodPost, Path: "/scim/v2/Users"},
+			},
+		},
+		{
+			name: "create conflict, replace existing",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(http.StatusConflict)
+				case http.MethodGet:
+					w.Header().Set("Content-Type", contentTypeSCIM)
+					_, _ = w.Write([]byte(`{"totalResults":1,"Resources":[{"id":"existing","userName":"bjensen"}]}`))
+				default:
+					writeUser(w, http.StatusOK, "existing")
+				}
+			},
+			want: "existing",
+			wantRequests: []recordedRequest{
+				{Method: http.MethodPost, Path: "/scim/v2/Users"},
+				{Method: http.MethodGet, Path: "/scim/v2/Users?filter=userName+eq+%22bjensen%22"},
+				{Method: http.MethodPut, Path: "/scim/v2/Users/existing"},
+			},
+		},
+		{
+			name: "create conflict, existing not found",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					w.WriteHeader(http.StatusConflict)
+					return
+				}
+				_, _ = w.Write([]byte(`{"totalResults":0,"Resources":[]}`))
+			},
+			wantRequests: []recordedRequest{
+				{Method: http.MethodPost, Path: "/scim/v2/Users"},
+				{Method: http.MethodGet, Path: "/scim/v2/Users?filter=userName+eq+%22bjensen%22"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unavailable",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			wantRequests: []recordedRequest{
+				{Method: http.MethodPost, Path: "/scim/v2/Users"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := testServer(t, tt.handler)
+			got, err := c.provisionUser(context.Background(), tt.remoteID, &User{Schemas: []string{schemaUser}, ExternalID: "user", UserName: "bjensen"})
+			assert.Equal(t, tt.wantRequests, *requests)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_client_deleteUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "deleted",
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "already deleted",
+			status: http.StatusNotFound,
+		},
+		{
+			name:    "error",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			})
+			err := c.deleteUser(context.Background(), "remote")
+			assert.Equal(t, []recordedRequest{{Method: http.MethodDelete, Path: "/scim/v2/Users/remote"}}, *requests)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
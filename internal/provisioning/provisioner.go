@@ -0,0 +1,394 @@
+package provisioning
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	SCIMProvisioningProjectionTable = "projections.scim_provisioning"
+
+	ProvisioningUserID = "SCIM_PROVISIONING"
+)
+
+type Commands interface {
+	SCIMUserProvisioned(ctx context.Context, targetID, resourceOwner, userID, userGrantID, remoteID string) error
+	SCIMUserDeprovisioned(ctx context.Context, targetID, resourceOwner, userID string) error
+	SCIMUserProvisioningFailed(ctx context.Context, targetID, resourceOwner, userID string, provisioningErr error) error
+}
+
+type Queries interface {
+	SearchSCIMTargets(ctx context.Context, shouldTriggerBulk bool, queries *query.SCIMTargetSearchQueries) (*query.SCIMTargets, error)
+	SearchSCIMProvisioningStates(ctx context.Context, shouldTriggerBulk bool, queries *query.SCIMProvisioningStateSearchQueries) (*query.SCIMProvisioningStates, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	UserGrant(ctx context.Context, shouldTriggerBulk bool, queries ...query.SearchQuery) (*query.UserGrant, error)
+}
+
+// provisioner pushes users to the SCIM targets of the projects they are granted to.
+// Every call to a target results in an event on the target,
+// failed calls are retried by the handler and recorded as failed events after the configured amount of failures.
+type provisioner struct {
+	commands        Commands
+	queries         Queries
+	tokenEncryption crypto.EncryptionAlgorithm
+}
+
+func NewProvisioner(
+	ctx context.Context,
+	config handler.Config,
+	commands Commands,
+	queries Queries,
+	tokenEncryption crypto.EncryptionAlgorithm,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &provisioner{
+		commands:        commands,
+		queries:         queries,
+		tokenEncryption: tokenEncryption,
+	})
+}
+
+func (p *provisioner) Name() string {
+	return SCIMProvisioningProjectionTable
+}
+
+func (p *provisioner) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantAddedType,
+					Reduce: p.reduceUserGrantChanged,
+				},
+				{
+					Event:  usergrant.UserGrantChangedType,
+					Reduce: p.reduceUserGrantChanged,
+				},
+				{
+					Event:  usergrant.UserGrantCascadeChangedType,
+					Reduce: p.reduceUserGrantChanged,
+				},
+				{
+					Event:  usergrant.UserGrantDeactivatedType,
+					Reduce: p.reduceUserGrantChanged,
+				},
+				{
+					Event:  usergrant.UserGrantReactivatedType,
+					Reduce: p.reduceUserGrantChanged,
+				},
+				{
+					Event:  usergrant.UserGrantRemovedType,
+					Reduce: p.reduceUserGrantRemoved,
+				},
+				{
+					Event:  usergrant.UserGrantCascadeRemovedType,
+					Reduce: p.reduceUserGrantRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserV1ProfileChangedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.HumanProfileChangedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserV1EmailChangedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.HumanEmailChangedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserV1PhoneChangedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.HumanPhoneChangedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserV1PhoneRemovedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.HumanPhoneRemovedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserUserNameChangedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.MachineChangedEventType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserDeactivatedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserReactivatedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserUnlockedType,
+					Reduce: p.reduceUserChanged,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+	}
+}
+
+// reduceUserGrantChanged provisions the user to all targets of the granted project
+func (p *provisioner) reduceUserGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(event.Aggregate())
+		grantID, err := query.NewUserGrantIDSearchQuery(event.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		grant, err := p.queries.UserGrant(ctx, true, grantID)
+		if zerrors.IsNotFound(err) {
+			// the grant was removed in the meantime
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		targets, err := p.targetsByProject(ctx, grant.ProjectID)
+		if err != nil || len(targets) == 0 {
+			return err
+		}
+		states, err := p.provisioningStates(ctx, query.NewSCIMProvisioningUserIDSearchQuery, grant.UserID)
+		if err != nil {
+			return err
+		}
+		zitadelUser, err := p.queries.GetUserByID(ctx, true, grant.UserID)
+		if err != nil {
+			return err
+		}
+		var errs []error
+		for _, target := range targets {
+			resource := userToResource(zitadelUser, grant)
+			errs = append(errs, p.provision(ctx, target, remoteIDOfUser(states, target.ID), resource, grant.ID))
+		}
+		return errors.Join(errs...)
+	}), nil
+}
+
+// reduceUserGrantRemoved deprovisions the user from all targets the grant was provisioned to
+func (p *provisioner) reduceUserGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(event.Aggregate())
+		states, err := p.provisioningStates(ctx, query.NewSCIMProvisioningUserGrantIDSearchQuery, event.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		return p.deprovision(ctx, states)
+	}), nil
+}
+
+// reduceUserChanged updates the user at all targets it was provisioned to
+func (p *provisioner) reduceUserChanged(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(event.Aggregate())
+		states, err := p.provisioningStates(ctx, query.NewSCIMProvisioningUserIDSearchQuery, event.Aggregate().ID)
+		if err != nil || len(states) == 0 {
+			return err
+		}
+		targets, err := p.targetsByStates(ctx, states)
+		if err != nil {
+			return err
+		}
+		zitadelUser, err := p.queries.GetUserByID(ctx, true, event.Aggregate().ID)
+		if zerrors.IsNotFound(err) {
+			// the user was removed in the meantime
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var errs []error
+		for _, target := range targets {
+			grant, err := p.userGrant(ctx, zitadelUser.ID, target.ProjectID)
+			if zerrors.IsNotFound(err) {
+				// the removal of the grant deprovisions the user
+				continue
+			}
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			errs = append(errs, p.provision(ctx, target, remoteIDOfUser(states, target.ID), userToResource(zitadelUser, grant), ""))
+		}
+		return errors.Join(errs...)
+	}), nil
+}
+
+// reduceUserRemoved deletes the user at all targets it was provisioned to
+func (p *provisioner) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := handlerContext(event.Aggregate())
+		states, err := p.provisioningStates(ctx, query.NewSCIMProvisioningUserIDSearchQuery, event.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		return p.deprovision(ctx, states)
+	}), nil
+}
+
+func (p *provisioner) provision(ctx context.Context, target *query.SCIMTarget, remoteID string, resource *User, userGrantID string) error {
+	c, err := p.client(target)
+	if err == nil {
+		remoteID, err = c.provisionUser(ctx, remoteID, resource)
+	}
+	if err != nil {
+		return p.failed(ctx, target.ID, target.ResourceOwner, resource.ExternalID, err)
+	}
+	return p.commands.SCIMUserProvisioned(ctx, target.ID, target.ResourceOwner, resource.ExternalID, userGrantID, remoteID)
+}
+
+func (p *provisioner) deprovision(ctx context.Context, states []*query.SCIMProvisioningState) error {
+	if len(states) == 0 {
+		return nil
+	}
+	targets, err := p.targetsByStates(ctx, states)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, target := range targets {
+		for _, state := range states {
+			if state.TargetID != target.ID {
+				continue
+			}
+			c, err := p.client(target)
+			if err == nil {
+				err = c.deleteUser(ctx, state.RemoteID)
+			}
+			if err != nil {
+				errs = append(errs, p.failed(ctx, target.ID, target.ResourceOwner, state.UserID, err))
+				continue
+			}
+			errs = append(errs, p.commands.SCIMUserDeprovisioned(ctx, target.ID, target.ResourceOwner, state.UserID))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// failed records the error on the target and returns it, so that the event is retried
+func (p *provisioner) failed(ctx context.Context, targetID, resourceOwner, userID string, provisioningErr error) error {
+	err := p.commands.SCIMUserProvisioningFailed(ctx, targetID, resourceOwner, userID, provisioningErr)
+	logging.WithFields("target", targetID, "user", userID).OnError(err).Error("unable to record failed provisioning")
+	return provisioningErr
+}
+
+func (p *provisioner) client(target *query.SCIMTarget) (*client, error) {
+	var token string
+	if target.Token != nil {
+		var err error
+		token, err = crypto.DecryptString(target.Token, p.tokenEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newClient(target.Endpoint, token, target.Timeout), nil
+}
+
+func (p *provisioner) targetsByProject(ctx context.Context, projectID string) ([]*query.SCIMTarget, error) {
+	projectQuery, err := query.NewSCIMTargetProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := p.queries.SearchSCIMTargets(ctx, true, &query.SCIMTargetSearchQueries{Queries: []query.SearchQuery{projectQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return targets.SCIMTargets, nil
+}
+
+func (p *provisioner) targetsByStates(ctx context.Context, states []*query.SCIMProvisioningState) ([]*query.SCIMTarget, error) {
+	ids := make([]string, len(states))
+	for i, state := range states {
+		ids[i] = state.TargetID
+	}
+	idsQuery, err := query.NewSCIMTargetInIDsSearchQuery(ids)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := p.queries.SearchSCIMTargets(ctx, false, &query.SCIMTargetSearchQueries{Queries: []query.SearchQuery{idsQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return targets.SCIMTargets, nil
+}
+
+// provisioningStates returns the states of all provisioned users matching the query
+func (p *provisioner) provisioningStates(ctx context.Context, newQuery func(string) (query.SearchQuery, error), value string) ([]*query.SCIMProvisioningState, error) {
+	valueQuery, err := newQuery(value)
+	if err != nil {
+		return nil, err
+	}
+	provisioned, err := query.NewSCIMProvisioningStateSearchQuery(domain.SCIMProvisioningStateProvisioned)
+	if err != nil {
+		return nil, err
+	}
+	states, err := p.queries.SearchSCIMProvisioningStates(ctx, true, &query.SCIMProvisioningStateSearchQueries{Queries: []query.SearchQuery{valueQuery, provisioned}})
+	if err != nil {
+		return nil, err
+	}
+	return states.States, nil
+}
+
+func (p *provisioner) userGrant(ctx context.Context, userID, projectID string) (*query.UserGrant, error) {
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return p.queries.UserGrant(ctx, false, userQuery, projectQuery)
+}
+
+func remoteIDOfUser(states []*query.SCIMProvisioningState, targetID string) string {
+	for _, state := range states {
+		if state.TargetID == targetID {
+			return state.RemoteID
+		}
+	}
+	return ""
+}
+
+func handlerContext(aggregate *eventstore.Aggregate) context.Context {
+	ctx := authz.WithInstanceID(context.Background(), aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ProvisioningUserID, OrgID: aggregate.ResourceOwner})
+}
@@ -0,0 +1,33 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+var projections []*handler.Handler
+
+func Register(
+	ctx context.Context,
+	provisioningHandlerCustomConfig projection.CustomConfig,
+	commands *command.Commands,
+	queries *query.Queries,
+	tokenEncryption crypto.EncryptionAlgorithm,
+) {
+	projections = append(projections, NewProvisioner(ctx, projection.ApplyCustomConfig(provisioningHandlerCustomConfig), commands, queries, tokenEncryption))
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
+
+func Projections() []*handler.Handler {
+	return projections
+}
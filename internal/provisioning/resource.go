@@ -0,0 +1,42 @@
+package provisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// userToResource maps the user and its grant on the project of the target to the SCIM user.
+// The user is only active at the target if both the user and the grant are active.
+func userToResource(user *query.User, grant *query.UserGrant) *User {
+	resource := &User{
+		Schemas:    []string{schemaUser},
+		ExternalID: user.ID,
+		UserName:   user.Username,
+		Active:     user.State == domain.UserStateActive && grant.State == domain.UserGrantStateActive,
+		Roles:      make([]*MultiValued, len(grant.Roles)),
+	}
+	for i, role := range grant.Roles {
+		resource.Roles[i] = &MultiValued{Value: role}
+	}
+	if user.Human != nil {
+		resource.Name = &Name{
+			FamilyName: user.Human.LastName,
+			GivenName:  user.Human.FirstName,
+		}
+		resource.DisplayName = user.Human.DisplayName
+		resource.NickName = user.Human.NickName
+		if !user.Human.PreferredLanguage.IsRoot() {
+			resource.PreferredLanguage = user.Human.PreferredLanguage.String()
+		}
+		if user.Human.Email != "" {
+			resource.Emails = []*MultiValued{{Value: string(user.Human.Email), Type: "work", Primary: true}}
+		}
+		if user.Human.Phone != "" {
+			resource.PhoneNumbers = []*MultiValued{{Value: string(user.Human.Phone), Type: "mobile", Primary: true}}
+		}
+	}
+	if user.Machine != nil {
+		resource.DisplayName = user.Machine.Name
+	}
+	return resource
+}
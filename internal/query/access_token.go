@@ -30,6 +30,8 @@ type OIDCSessionAccessTokenReadModel struct {
 	AccessTokenExpiration time.Time
 	Reason                domain.TokenReason
 	Actor                 *domain.TokenActor
+	DPoPThumbprint        string
+	CertificateThumbprint string
 }
 
 func newOIDCSessionAccessTokenReadModel(id string) *OIDCSessionAccessTokenReadModel {
@@ -88,6 +90,8 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAccessTokenAdded(e *oidcsession
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.Reason = e.Reason
 	wm.Actor = e.Actor
+	wm.DPoPThumbprint = e.DPoPThumbprint
+	wm.CertificateThumbprint = e.CertificateThumbprint
 }
 
 func (wm *OIDCSessionAccessTokenReadModel) reduceTokenRevoked(e eventstore.Event) {
@@ -41,24 +41,31 @@ type App struct {
 }
 
 type OIDCApp struct {
-	RedirectURIs             database.TextArray[string]
-	ResponseTypes            database.NumberArray[domain.OIDCResponseType]
-	GrantTypes               database.NumberArray[domain.OIDCGrantType]
-	AppType                  domain.OIDCApplicationType
-	ClientID                 string
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectURIs   database.TextArray[string]
-	Version                  domain.OIDCVersion
-	ComplianceProblems       database.TextArray[string]
-	IsDevMode                bool
-	AccessTokenType          domain.OIDCTokenType
-	AssertAccessTokenRole    bool
-	AssertIDTokenRole        bool
-	AssertIDTokenUserinfo    bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        database.TextArray[string]
-	AllowedOrigins           database.TextArray[string]
-	SkipNativeAppSuccessPage bool
+	RedirectURIs                          database.TextArray[string]
+	ResponseTypes                         database.NumberArray[domain.OIDCResponseType]
+	GrantTypes                            database.NumberArray[domain.OIDCGrantType]
+	AppType                               domain.OIDCApplicationType
+	ClientID                              string
+	AuthMethodType                        domain.OIDCAuthMethodType
+	PostLogoutRedirectURIs                database.TextArray[string]
+	Version                               domain.OIDCVersion
+	ComplianceProblems                    database.TextArray[string]
+	IsDevMode                             bool
+	AccessTokenType                       domain.OIDCTokenType
+	AssertAccessTokenRole                 bool
+	AssertIDTokenRole                     bool
+	AssertIDTokenUserinfo                 bool
+	ClockSkew                             time.Duration
+	AdditionalOrigins                     database.TextArray[string]
+	AllowedOrigins                        database.TextArray[string]
+	SkipNativeAppSuccessPage              bool
+	BackChannelLogoutURI                  string
+	FrontChannelLogoutURI                 string
+	RequirePushedAuthRequest              bool
+	RequireRequestObject                  bool
+	RequireDPoP                           bool
+	TLSClientAuthSubjectDN                string
+	BackChannelClientNotificationEndpoint string
 }
 
 type SAMLApp struct {
@@ -68,8 +75,9 @@ type SAMLApp struct {
 }
 
 type APIApp struct {
-	ClientID       string
-	AuthMethodType domain.APIAuthMethodType
+	ClientID               string
+	AuthMethodType         domain.APIAuthMethodType
+	TLSClientAuthSubjectDN string
 }
 
 type AppSearchQueries struct {
@@ -168,6 +176,10 @@ var (
 		name:  projection.AppAPIConfigColumnAuthMethod,
 		table: appAPIConfigsTable,
 	}
+	AppAPIConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppAPIConfigColumnTLSClientAuthSubjectDN,
+		table: appAPIConfigsTable,
+	}
 )
 
 var (
@@ -243,6 +255,34 @@ var (
 		name:  projection.AppOIDCConfigColumnSkipNativeAppSuccessPage,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnBackChannelLogoutURI = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelLogoutURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnFrontChannelLogoutURI = Column{
+		name:  projection.AppOIDCConfigColumnFrontChannelLogoutURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequirePushedAuthRequest = Column{
+		name:  projection.AppOIDCConfigColumnRequirePushedAuthRequest,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequireRequestObject = Column{
+		name:  projection.AppOIDCConfigColumnRequireRequestObject,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequireDPoP = Column{
+		name:  projection.AppOIDCConfigColumnRequireDPoP,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthSubjectDN,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackChannelClientNotificationEndpoint = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelClientNotificationEndpoint,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -526,6 +566,7 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			AppAPIConfigColumnAppID.identifier(),
 			AppAPIConfigColumnClientID.identifier(),
 			AppAPIConfigColumnAuthMethod.identifier(),
+			AppAPIConfigColumnTLSClientAuthSubjectDN.identifier(),
 
 			AppOIDCConfigColumnAppID.identifier(),
 			AppOIDCConfigColumnVersion.identifier(),
@@ -544,6 +585,13 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrontChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequest.identifier(),
+			AppOIDCConfigColumnRequireRequestObject.identifier(),
+			AppOIDCConfigColumnRequireDPoP.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationEndpoint.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -575,6 +623,7 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&apiConfig.appID,
 				&apiConfig.clientID,
 				&apiConfig.authMethod,
+				&apiConfig.tlsClientAuthSubjectDN,
 
 				&oidcConfig.appID,
 				&oidcConfig.version,
@@ -593,6 +642,13 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&oidcConfig.clockSkew,
 				&oidcConfig.additionalOrigins,
 				&oidcConfig.skipNativeAppSuccessPage,
+				&oidcConfig.backChannelLogoutURI,
+				&oidcConfig.frontChannelLogoutURI,
+				&oidcConfig.requirePushedAuthRequest,
+				&oidcConfig.requireRequestObject,
+				&oidcConfig.requireDPoP,
+				&oidcConfig.tlsClientAuthSubjectDN,
+				&oidcConfig.backChannelClientNotificationEndpoint,
 
 				&samlConfig.appID,
 				&samlConfig.entityID,
@@ -696,6 +752,7 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			AppAPIConfigColumnAppID.identifier(),
 			AppAPIConfigColumnClientID.identifier(),
 			AppAPIConfigColumnAuthMethod.identifier(),
+			AppAPIConfigColumnTLSClientAuthSubjectDN.identifier(),
 
 			AppOIDCConfigColumnAppID.identifier(),
 			AppOIDCConfigColumnVersion.identifier(),
@@ -714,6 +771,13 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrontChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequest.identifier(),
+			AppOIDCConfigColumnRequireRequestObject.identifier(),
+			AppOIDCConfigColumnRequireDPoP.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationEndpoint.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -748,6 +812,7 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&apiConfig.appID,
 					&apiConfig.clientID,
 					&apiConfig.authMethod,
+					&apiConfig.tlsClientAuthSubjectDN,
 
 					&oidcConfig.appID,
 					&oidcConfig.version,
@@ -766,6 +831,13 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&oidcConfig.clockSkew,
 					&oidcConfig.additionalOrigins,
 					&oidcConfig.skipNativeAppSuccessPage,
+					&oidcConfig.backChannelLogoutURI,
+					&oidcConfig.frontChannelLogoutURI,
+					&oidcConfig.requirePushedAuthRequest,
+					&oidcConfig.requireRequestObject,
+					&oidcConfig.requireDPoP,
+					&oidcConfig.tlsClientAuthSubjectDN,
+					&oidcConfig.backChannelClientNotificationEndpoint,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -821,23 +893,30 @@ func prepareClientIDsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 }
 
 type sqlOIDCConfig struct {
-	appID                    sql.NullString
-	version                  sql.NullInt32
-	clientID                 sql.NullString
-	redirectUris             database.TextArray[string]
-	applicationType          sql.NullInt16
-	authMethodType           sql.NullInt16
-	postLogoutRedirectUris   database.TextArray[string]
-	devMode                  sql.NullBool
-	accessTokenType          sql.NullInt16
-	accessTokenRoleAssertion sql.NullBool
-	iDTokenRoleAssertion     sql.NullBool
-	iDTokenUserinfoAssertion sql.NullBool
-	clockSkew                sql.NullInt64
-	additionalOrigins        database.TextArray[string]
-	responseTypes            database.NumberArray[domain.OIDCResponseType]
-	grantTypes               database.NumberArray[domain.OIDCGrantType]
-	skipNativeAppSuccessPage sql.NullBool
+	appID                                 sql.NullString
+	version                               sql.NullInt32
+	clientID                              sql.NullString
+	redirectUris                          database.TextArray[string]
+	applicationType                       sql.NullInt16
+	authMethodType                        sql.NullInt16
+	postLogoutRedirectUris                database.TextArray[string]
+	devMode                               sql.NullBool
+	accessTokenType                       sql.NullInt16
+	accessTokenRoleAssertion              sql.NullBool
+	iDTokenRoleAssertion                  sql.NullBool
+	iDTokenUserinfoAssertion              sql.NullBool
+	clockSkew                             sql.NullInt64
+	additionalOrigins                     database.TextArray[string]
+	responseTypes                         database.NumberArray[domain.OIDCResponseType]
+	grantTypes                            database.NumberArray[domain.OIDCGrantType]
+	skipNativeAppSuccessPage              sql.NullBool
+	backChannelLogoutURI                  sql.NullString
+	frontChannelLogoutURI                 sql.NullString
+	requirePushedAuthRequest              sql.NullBool
+	requireRequestObject                  sql.NullBool
+	requireDPoP                           sql.NullBool
+	tlsClientAuthSubjectDN                sql.NullString
+	backChannelClientNotificationEndpoint sql.NullString
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -845,22 +924,29 @@ func (c sqlOIDCConfig) set(app *App) {
 		return
 	}
 	app.OIDCConfig = &OIDCApp{
-		Version:                  domain.OIDCVersion(c.version.Int32),
-		ClientID:                 c.clientID.String,
-		RedirectURIs:             c.redirectUris,
-		AppType:                  domain.OIDCApplicationType(c.applicationType.Int16),
-		AuthMethodType:           domain.OIDCAuthMethodType(c.authMethodType.Int16),
-		PostLogoutRedirectURIs:   c.postLogoutRedirectUris,
-		IsDevMode:                c.devMode.Bool,
-		AccessTokenType:          domain.OIDCTokenType(c.accessTokenType.Int16),
-		AssertAccessTokenRole:    c.accessTokenRoleAssertion.Bool,
-		AssertIDTokenRole:        c.iDTokenRoleAssertion.Bool,
-		AssertIDTokenUserinfo:    c.iDTokenUserinfoAssertion.Bool,
-		ClockSkew:                time.Duration(c.clockSkew.Int64),
-		AdditionalOrigins:        c.additionalOrigins,
-		ResponseTypes:            c.responseTypes,
-		GrantTypes:               c.grantTypes,
-		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
+		Version:                               domain.OIDCVersion(c.version.Int32),
+		ClientID:                              c.clientID.String,
+		RedirectURIs:                          c.redirectUris,
+		AppType:                               domain.OIDCApplicationType(c.applicationType.Int16),
+		AuthMethodType:                        domain.OIDCAuthMethodType(c.authMethodType.Int16),
+		PostLogoutRedirectURIs:                c.postLogoutRedirectUris,
+		IsDevMode:                             c.devMode.Bool,
+		AccessTokenType:                       domain.OIDCTokenType(c.accessTokenType.Int16),
+		AssertAccessTokenRole:                 c.accessTokenRoleAssertion.Bool,
+		AssertIDTokenRole:                     c.iDTokenRoleAssertion.Bool,
+		AssertIDTokenUserinfo:                 c.iDTokenUserinfoAssertion.Bool,
+		ClockSkew:                             time.Duration(c.clockSkew.Int64),
+		AdditionalOrigins:                     c.additionalOrigins,
+		ResponseTypes:                         c.responseTypes,
+		GrantTypes:                            c.grantTypes,
+		SkipNativeAppSuccessPage:              c.skipNativeAppSuccessPage.Bool,
+		BackChannelLogoutURI:                  c.backChannelLogoutURI.String,
+		FrontChannelLogoutURI:                 c.frontChannelLogoutURI.String,
+		RequirePushedAuthRequest:              c.requirePushedAuthRequest.Bool,
+		RequireRequestObject:                  c.requireRequestObject.Bool,
+		RequireDPoP:                           c.requireDPoP.Bool,
+		TLSClientAuthSubjectDN:                c.tlsClientAuthSubjectDN.String,
+		BackChannelClientNotificationEndpoint: c.backChannelClientNotificationEndpoint.String,
 	}
 	compliance := domain.GetOIDCCompliance(app.OIDCConfig.Version, app.OIDCConfig.AppType, app.OIDCConfig.GrantTypes, app.OIDCConfig.ResponseTypes, app.OIDCConfig.AuthMethodType, app.OIDCConfig.RedirectURIs)
 	app.OIDCConfig.ComplianceProblems = compliance.Problems
@@ -889,9 +975,10 @@ func (c sqlSAMLConfig) set(app *App) {
 }
 
 type sqlAPIConfig struct {
-	appID      sql.NullString
-	clientID   sql.NullString
-	authMethod sql.NullInt16
+	appID                  sql.NullString
+	clientID               sql.NullString
+	authMethod             sql.NullInt16
+	tlsClientAuthSubjectDN sql.NullString
 }
 
 func (c sqlAPIConfig) set(app *App) {
@@ -899,7 +986,8 @@ func (c sqlAPIConfig) set(app *App) {
 		return
 	}
 	app.APIConfig = &APIApp{
-		ClientID:       c.clientID.String,
-		AuthMethodType: domain.APIAuthMethodType(c.authMethod.Int16),
+		ClientID:               c.clientID.String,
+		AuthMethodType:         domain.APIAuthMethodType(c.authMethod.Int16),
+		TLSClientAuthSubjectDN: c.tlsClientAuthSubjectDN.String,
 	}
 }
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+type AppFeatures struct {
+	Details       *domain.ObjectDetails
+	TokenExchange FeatureSource[bool]
+}
+
+// GetAppFeatures returns the features set on the application.
+// If cascade is set, unset features are inherited from the project, organization, instance and system level.
+func (q *Queries) GetAppFeatures(ctx context.Context, projectID, appID string, cascade bool) (_ *AppFeatures, err error) {
+	m := NewAppFeaturesReadModel(appID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, m); err != nil {
+		return nil, err
+	}
+	m.app.Details = readModelToObjectDetails(m.ReadModel)
+	if !cascade {
+		return m.app, nil
+	}
+	project, err := q.GetProjectFeatures(ctx, projectID, true)
+	if err != nil {
+		return nil, err
+	}
+	m.app.inherit(project)
+	if m.app.Details.ResourceOwner == "" {
+		m.app.Details.ResourceOwner = project.Details.ResourceOwner
+	}
+	return m.app, nil
+}
+
+func (f *AppFeatures) inherit(project *ProjectFeatures) {
+	inheritFeature(&f.TokenExchange, project.TokenExchange)
+}
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+)
+
+type AppFeaturesReadModel struct {
+	*eventstore.ReadModel
+	app *AppFeatures
+}
+
+func NewAppFeaturesReadModel(appID string) *AppFeaturesReadModel {
+	return &AppFeaturesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID: appID,
+		},
+		app: new(AppFeatures),
+	}
+}
+
+func (m *AppFeaturesReadModel) Reduce() (err error) {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *feature_v2.ResetEvent:
+			m.reduceReset()
+		case *feature_v2.SetEvent[bool]:
+			err = m.reduceBoolFeature(e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return m.ReadModel.Reduce()
+}
+
+func (m *AppFeaturesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(feature_v2.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			feature_v2.AppResetEventType,
+			feature_v2.AppTokenExchangeEventType,
+		).
+		Builder()
+}
+
+func (m *AppFeaturesReadModel) reduceReset() {
+	m.app = new(AppFeatures)
+}
+
+func (m *AppFeaturesReadModel) reduceBoolFeature(event *feature_v2.SetEvent[bool]) error {
+	level, key, err := event.FeatureInfo()
+	if err != nil {
+		return err
+	}
+	if key != feature.KeyTokenExchange {
+		return nil
+	}
+	m.app.TokenExchange = FeatureSource[bool]{
+		Level: level,
+		Value: event.Value,
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+func TestQueries_GetAppFeatures(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		cascade    bool
+		want       *AppFeatures
+		wantErr    error
+	}{
+		{
+			name: "set on app, not cascaded",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, feature_v2.NewAggregate("app1", "org1"),
+						feature_v2.AppTokenExchangeEventType, true,
+					)),
+				),
+			),
+			want: &AppFeatures{
+				Details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				TokenExchange: FeatureSource[bool]{
+					Level: feature.LevelApp,
+					Value: true,
+				},
+			},
+		},
+		{
+			name: "inherited from organization",
+			eventstore: expectEventstore(
+				// app
+				expectFilter(),
+				// project
+				expectFilter(
+					eventFromEventPusher(project.NewProjectAddedEvent(
+						ctx, &project.NewAggregate("project1", "org1").Aggregate,
+						"project", false, false, false, domain.PrivateLabelingSettingUnspecified,
+					)),
+				),
+				// organization
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, feature_v2.NewAggregate("org1", "org1"),
+						feature_v2.OrgTokenExchangeEventType, true,
+					)),
+				),
+				// system
+				expectFilter(),
+				// instance
+				expectFilter(),
+			),
+			cascade: true,
+			want: &AppFeatures{
+				Details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				TokenExchange: FeatureSource[bool]{
+					Level: feature.LevelOrg,
+					Value: true,
+				},
+			},
+		},
+		{
+			name: "set on project overrides organization",
+			eventstore: expectEventstore(
+				// app
+				expectFilter(),
+				// project
+				expectFilter(
+					eventFromEventPusher(project.NewProjectAddedEvent(
+						ctx, &project.NewAggregate("project1", "org1").Aggregate,
+						"project", false, false, false, domain.PrivateLabelingSettingUnspecified,
+					)),
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, feature_v2.NewAggregate("project1", "org1"),
+						feature_v2.ProjectTokenExchangeEventType, false,
+					)),
+				),
+				// organization
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, feature_v2.NewAggregate("org1", "org1"),
+						feature_v2.OrgTokenExchangeEventType, true,
+					)),
+				),
+				// system
+				expectFilter(),
+				// instance
+				expectFilter(),
+			),
+			cascade: true,
+			want: &AppFeatures{
+				Details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				TokenExchange: FeatureSource[bool]{
+					Level: feature.LevelProject,
+					Value: false,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Queries{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := q.GetAppFeatures(ctx, "project1", "app1", tt.cascade)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -15,98 +15,114 @@ import (
 )
 
 var (
-	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps6.id,` +
-		` projections.apps6.name,` +
-		` projections.apps6.project_id,` +
-		` projections.apps6.creation_date,` +
-		` projections.apps6.change_date,` +
-		` projections.apps6.resource_owner,` +
-		` projections.apps6.state,` +
-		` projections.apps6.sequence,` +
+	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps11.id,` +
+		` projections.apps11.name,` +
+		` projections.apps11.project_id,` +
+		` projections.apps11.creation_date,` +
+		` projections.apps11.change_date,` +
+		` projections.apps11.resource_owner,` +
+		` projections.apps11.state,` +
+		` projections.apps11.sequence,` +
 		// api config
-		` projections.apps6_api_configs.app_id,` +
-		` projections.apps6_api_configs.client_id,` +
-		` projections.apps6_api_configs.auth_method,` +
+		` projections.apps11_api_configs.app_id,` +
+		` projections.apps11_api_configs.client_id,` +
+		` projections.apps11_api_configs.auth_method,` +
+		` projections.apps11_api_configs.tls_client_auth_subject_dn,` +
 		// oidc config
-		` projections.apps6_oidc_configs.app_id,` +
-		` projections.apps6_oidc_configs.version,` +
-		` projections.apps6_oidc_configs.client_id,` +
-		` projections.apps6_oidc_configs.redirect_uris,` +
-		` projections.apps6_oidc_configs.response_types,` +
-		` projections.apps6_oidc_configs.grant_types,` +
-		` projections.apps6_oidc_configs.application_type,` +
-		` projections.apps6_oidc_configs.auth_method_type,` +
-		` projections.apps6_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps6_oidc_configs.is_dev_mode,` +
-		` projections.apps6_oidc_configs.access_token_type,` +
-		` projections.apps6_oidc_configs.access_token_role_assertion,` +
-		` projections.apps6_oidc_configs.id_token_role_assertion,` +
-		` projections.apps6_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps6_oidc_configs.clock_skew,` +
-		` projections.apps6_oidc_configs.additional_origins,` +
-		` projections.apps6_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.app_id,` +
+		` projections.apps11_oidc_configs.version,` +
+		` projections.apps11_oidc_configs.client_id,` +
+		` projections.apps11_oidc_configs.redirect_uris,` +
+		` projections.apps11_oidc_configs.response_types,` +
+		` projections.apps11_oidc_configs.grant_types,` +
+		` projections.apps11_oidc_configs.application_type,` +
+		` projections.apps11_oidc_configs.auth_method_type,` +
+		` projections.apps11_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps11_oidc_configs.is_dev_mode,` +
+		` projections.apps11_oidc_configs.access_token_type,` +
+		` projections.apps11_oidc_configs.access_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps11_oidc_configs.clock_skew,` +
+		` projections.apps11_oidc_configs.additional_origins,` +
+		` projections.apps11_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps11_oidc_configs.front_channel_logout_uri,` +
+		` projections.apps11_oidc_configs.require_pushed_auth_request,` +
+		` projections.apps11_oidc_configs.require_request_object,` +
+		` projections.apps11_oidc_configs.require_dpop,` +
+		` projections.apps11_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps11_oidc_configs.back_channel_client_notification_endpoint,` +
 		//saml config
-		` projections.apps6_saml_configs.app_id,` +
-		` projections.apps6_saml_configs.entity_id,` +
-		` projections.apps6_saml_configs.metadata,` +
-		` projections.apps6_saml_configs.metadata_url` +
-		` FROM projections.apps6` +
-		` LEFT JOIN projections.apps6_api_configs ON projections.apps6.id = projections.apps6_api_configs.app_id AND projections.apps6.instance_id = projections.apps6_api_configs.instance_id` +
-		` LEFT JOIN projections.apps6_oidc_configs ON projections.apps6.id = projections.apps6_oidc_configs.app_id AND projections.apps6.instance_id = projections.apps6_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps6_saml_configs ON projections.apps6.id = projections.apps6_saml_configs.app_id AND projections.apps6.instance_id = projections.apps6_saml_configs.instance_id` +
+		` projections.apps11_saml_configs.app_id,` +
+		` projections.apps11_saml_configs.entity_id,` +
+		` projections.apps11_saml_configs.metadata,` +
+		` projections.apps11_saml_configs.metadata_url` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps6.id,` +
-		` projections.apps6.name,` +
-		` projections.apps6.project_id,` +
-		` projections.apps6.creation_date,` +
-		` projections.apps6.change_date,` +
-		` projections.apps6.resource_owner,` +
-		` projections.apps6.state,` +
-		` projections.apps6.sequence,` +
+	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps11.id,` +
+		` projections.apps11.name,` +
+		` projections.apps11.project_id,` +
+		` projections.apps11.creation_date,` +
+		` projections.apps11.change_date,` +
+		` projections.apps11.resource_owner,` +
+		` projections.apps11.state,` +
+		` projections.apps11.sequence,` +
 		// api config
-		` projections.apps6_api_configs.app_id,` +
-		` projections.apps6_api_configs.client_id,` +
-		` projections.apps6_api_configs.auth_method,` +
+		` projections.apps11_api_configs.app_id,` +
+		` projections.apps11_api_configs.client_id,` +
+		` projections.apps11_api_configs.auth_method,` +
+		` projections.apps11_api_configs.tls_client_auth_subject_dn,` +
 		// oidc config
-		` projections.apps6_oidc_configs.app_id,` +
-		` projections.apps6_oidc_configs.version,` +
-		` projections.apps6_oidc_configs.client_id,` +
-		` projections.apps6_oidc_configs.redirect_uris,` +
-		` projections.apps6_oidc_configs.response_types,` +
-		` projections.apps6_oidc_configs.grant_types,` +
-		` projections.apps6_oidc_configs.application_type,` +
-		` projections.apps6_oidc_configs.auth_method_type,` +
-		` projections.apps6_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps6_oidc_configs.is_dev_mode,` +
-		` projections.apps6_oidc_configs.access_token_type,` +
-		` projections.apps6_oidc_configs.access_token_role_assertion,` +
-		` projections.apps6_oidc_configs.id_token_role_assertion,` +
-		` projections.apps6_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps6_oidc_configs.clock_skew,` +
-		` projections.apps6_oidc_configs.additional_origins,` +
-		` projections.apps6_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.app_id,` +
+		` projections.apps11_oidc_configs.version,` +
+		` projections.apps11_oidc_configs.client_id,` +
+		` projections.apps11_oidc_configs.redirect_uris,` +
+		` projections.apps11_oidc_configs.response_types,` +
+		` projections.apps11_oidc_configs.grant_types,` +
+		` projections.apps11_oidc_configs.application_type,` +
+		` projections.apps11_oidc_configs.auth_method_type,` +
+		` projections.apps11_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps11_oidc_configs.is_dev_mode,` +
+		` projections.apps11_oidc_configs.access_token_type,` +
+		` projections.apps11_oidc_configs.access_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_role_assertion,` +
+		` projections.apps11_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps11_oidc_configs.clock_skew,` +
+		` projections.apps11_oidc_configs.additional_origins,` +
+		` projections.apps11_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps11_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps11_oidc_configs.front_channel_logout_uri,` +
+		` projections.apps11_oidc_configs.require_pushed_auth_request,` +
+		` projections.apps11_oidc_configs.require_request_object,` +
+		` projections.apps11_oidc_configs.require_dpop,` +
+		` projections.apps11_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps11_oidc_configs.back_channel_client_notification_endpoint,` +
 		//saml config
-		` projections.apps6_saml_configs.app_id,` +
-		` projections.apps6_saml_configs.entity_id,` +
-		` projections.apps6_saml_configs.metadata,` +
-		` projections.apps6_saml_configs.metadata_url,` +
+		` projections.apps11_saml_configs.app_id,` +
+		` projections.apps11_saml_configs.entity_id,` +
+		` projections.apps11_saml_configs.metadata,` +
+		` projections.apps11_saml_configs.metadata_url,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.apps6` +
-		` LEFT JOIN projections.apps6_api_configs ON projections.apps6.id = projections.apps6_api_configs.app_id AND projections.apps6.instance_id = projections.apps6_api_configs.instance_id` +
-		` LEFT JOIN projections.apps6_oidc_configs ON projections.apps6.id = projections.apps6_oidc_configs.app_id AND projections.apps6.instance_id = projections.apps6_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps6_saml_configs ON projections.apps6.id = projections.apps6_saml_configs.app_id AND projections.apps6.instance_id = projections.apps6_saml_configs.instance_id` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps6_api_configs.client_id,` +
-		` projections.apps6_oidc_configs.client_id` +
-		` FROM projections.apps6` +
-		` LEFT JOIN projections.apps6_api_configs ON projections.apps6.id = projections.apps6_api_configs.app_id AND projections.apps6.instance_id = projections.apps6_api_configs.instance_id` +
-		` LEFT JOIN projections.apps6_oidc_configs ON projections.apps6.id = projections.apps6_oidc_configs.app_id AND projections.apps6.instance_id = projections.apps6_oidc_configs.instance_id` +
+	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps11_api_configs.client_id,` +
+		` projections.apps11_oidc_configs.client_id` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps6.project_id` +
-		` FROM projections.apps6` +
-		` LEFT JOIN projections.apps6_api_configs ON projections.apps6.id = projections.apps6_api_configs.app_id AND projections.apps6.instance_id = projections.apps6_api_configs.instance_id` +
-		` LEFT JOIN projections.apps6_oidc_configs ON projections.apps6.id = projections.apps6_oidc_configs.app_id AND projections.apps6.instance_id = projections.apps6_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps6_saml_configs ON projections.apps6.id = projections.apps6_saml_configs.app_id AND projections.apps6.instance_id = projections.apps6_saml_configs.instance_id` +
+	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps11.project_id` +
+		` FROM projections.apps11` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 	expectedProjectByAppQuery = regexp.QuoteMeta(`SELECT projections.projects4.id,` +
 		` projections.projects4.creation_date,` +
@@ -120,10 +136,10 @@ var (
 		` projections.projects4.has_project_check,` +
 		` projections.projects4.private_labeling_setting` +
 		` FROM projections.projects4` +
-		` JOIN projections.apps6 ON projections.projects4.id = projections.apps6.project_id AND projections.projects4.instance_id = projections.apps6.instance_id` +
-		` LEFT JOIN projections.apps6_api_configs ON projections.apps6.id = projections.apps6_api_configs.app_id AND projections.apps6.instance_id = projections.apps6_api_configs.instance_id` +
-		` LEFT JOIN projections.apps6_oidc_configs ON projections.apps6.id = projections.apps6_oidc_configs.app_id AND projections.apps6.instance_id = projections.apps6_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps6_saml_configs ON projections.apps6.id = projections.apps6_saml_configs.app_id AND projections.apps6.instance_id = projections.apps6_saml_configs.instance_id` +
+		` JOIN projections.apps11 ON projections.projects4.id = projections.apps11.project_id AND projections.projects4.instance_id = projections.apps11.instance_id` +
+		` LEFT JOIN projections.apps11_api_configs ON projections.apps11.id = projections.apps11_api_configs.app_id AND projections.apps11.instance_id = projections.apps11_api_configs.instance_id` +
+		` LEFT JOIN projections.apps11_oidc_configs ON projections.apps11.id = projections.apps11_oidc_configs.app_id AND projections.apps11.instance_id = projections.apps11_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps11_saml_configs ON projections.apps11.id = projections.apps11_saml_configs.app_id AND projections.apps11.instance_id = projections.apps11_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	appCols = database.TextArray[string]{
@@ -139,6 +155,7 @@ var (
 		"app_id",
 		"client_id",
 		"auth_method",
+		"tls_client_auth_subject_dn",
 		// oidc config
 		"app_id",
 		"version",
@@ -157,6 +174,13 @@ var (
 		"clock_skew",
 		"additional_origins",
 		"skip_native_app_success_page",
+		"back_channel_logout_uri",
+		"front_channel_logout_uri",
+		"require_pushed_auth_request",
+		"require_request_object",
+		"require_dpop",
+		"tls_client_auth_subject_dn",
+		"back_channel_client_notification_endpoint",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -210,6 +234,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -228,6 +253,13 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -276,6 +308,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
 							// oidc config
 							nil,
 							nil,
@@ -294,6 +327,13 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -345,6 +385,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -363,6 +404,13 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -416,6 +464,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -434,6 +483,13 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -502,6 +558,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -520,6 +577,13 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -588,6 +652,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -606,6 +671,13 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -674,6 +746,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -692,6 +765,13 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -760,6 +840,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -778,6 +859,13 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -846,6 +934,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -864,6 +953,13 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							true,
+							"https://redirect.to/backchannel",
+							"https://redirect.to/frontchannel",
+							true,
+							true,
+							true,
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -906,6 +1002,11 @@ func Test_AppsPrepare(t *testing.T) {
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
 							SkipNativeAppSuccessPage: true,
+							BackChannelLogoutURI:     "https://redirect.to/backchannel",
+							FrontChannelLogoutURI:    "https://redirect.to/frontchannel",
+							RequirePushedAuthRequest: true,
+							RequireRequestObject:     true,
+							RequireDPoP:              true,
 						},
 					},
 				},
@@ -932,6 +1033,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"oidc-app-id",
 							domain.OIDCVersionV1,
@@ -950,6 +1052,13 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -969,6 +1078,7 @@ func Test_AppsPrepare(t *testing.T) {
 							"api-app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
 							// oidc config
 							nil,
 							nil,
@@ -987,6 +1097,13 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1006,6 +1123,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1024,6 +1142,13 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1177,6 +1302,7 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc config
 						nil,
 						nil,
@@ -1195,6 +1321,13 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1235,6 +1368,7 @@ func Test_AppPrepare(t *testing.T) {
 							"app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
 							// oidc config
 							nil,
 							nil,
@@ -1253,6 +1387,13 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1298,6 +1439,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1316,6 +1458,13 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1376,6 +1525,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1394,6 +1544,13 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1440,6 +1597,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1458,6 +1616,13 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1519,6 +1684,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1537,6 +1703,13 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1598,6 +1771,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1616,6 +1790,13 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1677,6 +1858,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1695,6 +1877,13 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
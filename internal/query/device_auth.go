@@ -9,6 +9,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
@@ -65,6 +66,12 @@ type DeviceAuth struct {
 	Subject         string
 	UserAuthMethods []domain.UserAuthMethodType
 	AuthTime        time.Time
+
+	// backchannel authentication request (CIBA) only
+	UserID            string
+	LoginHint         string
+	BindingMessage    string
+	NotificationToken *crypto.CryptoValue
 }
 
 // DeviceAuthByDeviceCode gets the current state of a Device Authorization directly from the eventstore.
@@ -30,6 +30,10 @@ func (m *DeviceAuthReadModel) Reduce() error {
 			m.Expires = e.Expires
 			m.Scopes = e.Scopes
 			m.State = e.State
+			m.UserID = e.UserID
+			m.LoginHint = e.LoginHint
+			m.BindingMessage = e.BindingMessage
+			m.NotificationToken = e.NotificationToken
 		case *deviceauth.ApprovedEvent:
 			m.State = domain.DeviceAuthStateApproved
 			m.Subject = e.Subject
@@ -1,11 +1,11 @@
 with config as (
-		select app_id, client_id, client_secret
-		from projections.apps6_api_configs
+		select app_id, client_id, client_secret, auth_method as api_auth_method, null::smallint as oidc_auth_method, tls_client_auth_subject_dn
+		from projections.apps11_api_configs
 		where instance_id = $1
 			and client_id = $2
 	union
-		select app_id, client_id, client_secret
-		from projections.apps6_oidc_configs
+		select app_id, client_id, client_secret, null::smallint as api_auth_method, auth_method_type as oidc_auth_method, tls_client_auth_subject_dn
+		from projections.apps11_oidc_configs
 		where instance_id = $1
 			and client_id = $2
 ),
@@ -18,6 +18,6 @@ keys as (
 		and expiration > current_timestamp
 	group by identifier
 )
-select config.client_id, config.client_secret, apps.project_id, keys.public_keys from config
-join projections.apps6 apps on apps.id = config.app_id
+select config.client_id, config.client_secret, apps.project_id, keys.public_keys, config.api_auth_method, config.oidc_auth_method, config.tls_client_auth_subject_dn from config
+join projections.apps11 apps on apps.id = config.app_id
 left join keys on keys.client_id = config.client_id;
@@ -0,0 +1,270 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	executionDeliveryTable = table{
+		name:          projection.ExecutionDeliveryTable,
+		instanceIDCol: projection.ExecutionDeliveryInstanceIDCol,
+	}
+	ExecutionDeliveryColumnID = Column{
+		name:  projection.ExecutionDeliveryIDCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnCreationDate = Column{
+		name:  projection.ExecutionDeliveryCreationDateCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnChangeDate = Column{
+		name:  projection.ExecutionDeliveryChangeDateCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnResourceOwner = Column{
+		name:  projection.ExecutionDeliveryResourceOwnerCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnInstanceID = Column{
+		name:  projection.ExecutionDeliveryInstanceIDCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnSequence = Column{
+		name:  projection.ExecutionDeliverySequenceCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnTargetID = Column{
+		name:  projection.ExecutionDeliveryTargetIDCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnExecutionID = Column{
+		name:  projection.ExecutionDeliveryExecutionIDCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnEventType = Column{
+		name:  projection.ExecutionDeliveryEventTypeCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnAggregateType = Column{
+		name:  projection.ExecutionDeliveryAggregateTypeCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnAggregateID = Column{
+		name:  projection.ExecutionDeliveryAggregateIDCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnBody = Column{
+		name:  projection.ExecutionDeliveryBodyCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnState = Column{
+		name:  projection.ExecutionDeliveryStateCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnAttempts = Column{
+		name:  projection.ExecutionDeliveryAttemptsCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnNextAttempt = Column{
+		name:  projection.ExecutionDeliveryNextAttemptCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnLastError = Column{
+		name:  projection.ExecutionDeliveryLastErrorCol,
+		table: executionDeliveryTable,
+	}
+	ExecutionDeliveryColumnLastStatusCode = Column{
+		name:  projection.ExecutionDeliveryLastStatusCodeCol,
+		table: executionDeliveryTable,
+	}
+)
+
+type ExecutionDeliveries struct {
+	SearchResponse
+	ExecutionDeliveries []*ExecutionDelivery
+}
+
+func (d *ExecutionDeliveries) SetState(s *State) {
+	d.State = s
+}
+
+type ExecutionDelivery struct {
+	ID string
+	domain.ObjectDetails
+
+	CreationDate   time.Time
+	InstanceID     string
+	TargetID       string
+	ExecutionID    string
+	EventType      string
+	AggregateType  string
+	AggregateID    string
+	Body           []byte
+	State          domain.ExecutionDeliveryState
+	Attempts       uint16
+	NextAttempt    time.Time
+	LastError      string
+	LastStatusCode int
+}
+
+type ExecutionDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *ExecutionDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchExecutionDeliveries(ctx context.Context, queries *ExecutionDeliverySearchQueries) (deliveries *ExecutionDeliveries, err error) {
+	eq := sq.Eq{
+		ExecutionDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareExecutionDeliveriesQuery(ctx, q.client)
+	return genericRowsQueryWithState[*ExecutionDeliveries](ctx, q.client, executionDeliveryTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+// SearchDueExecutionDeliveries returns the pending deliveries of all instances,
+// which are due to be (re)tried at the passed time.
+func (q *Queries) SearchDueExecutionDeliveries(ctx context.Context, due time.Time, limit uint64) (deliveries *ExecutionDeliveries, err error) {
+	query, scan := prepareExecutionDeliveriesQuery(ctx, q.client)
+	return genericRowsQuery[*ExecutionDeliveries](ctx, q.client,
+		query.Where(sq.And{
+			sq.Eq{ExecutionDeliveryColumnState.identifier(): domain.ExecutionDeliveryStatePending},
+			sq.LtOrEq{ExecutionDeliveryColumnNextAttempt.identifier(): due},
+		}).
+			OrderBy(ExecutionDeliveryColumnNextAttempt.identifier()).
+			Limit(limit),
+		scan,
+	)
+}
+
+func (q *Queries) GetExecutionDeliveryByID(ctx context.Context, id string) (delivery *ExecutionDelivery, err error) {
+	eq := sq.Eq{
+		ExecutionDeliveryColumnID.identifier():         id,
+		ExecutionDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareExecutionDeliveryQuery(ctx, q.client)
+	return genericRowQuery[*ExecutionDelivery](ctx, q.client, query.Where(eq), scan)
+}
+
+func NewExecutionDeliveryTargetIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ExecutionDeliveryColumnTargetID, value, TextEquals)
+}
+
+func NewExecutionDeliveryStateSearchQuery(value domain.ExecutionDeliveryState) (SearchQuery, error) {
+	return NewNumberQuery(ExecutionDeliveryColumnState, value, NumberEquals)
+}
+
+func NewExecutionDeliveryEventTypeSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ExecutionDeliveryColumnEventType, value, method)
+}
+
+func executionDeliveryColumns() []string {
+	return []string{
+		ExecutionDeliveryColumnID.identifier(),
+		ExecutionDeliveryColumnCreationDate.identifier(),
+		ExecutionDeliveryColumnChangeDate.identifier(),
+		ExecutionDeliveryColumnResourceOwner.identifier(),
+		ExecutionDeliveryColumnInstanceID.identifier(),
+		ExecutionDeliveryColumnSequence.identifier(),
+		ExecutionDeliveryColumnTargetID.identifier(),
+		ExecutionDeliveryColumnExecutionID.identifier(),
+		ExecutionDeliveryColumnEventType.identifier(),
+		ExecutionDeliveryColumnAggregateType.identifier(),
+		ExecutionDeliveryColumnAggregateID.identifier(),
+		ExecutionDeliveryColumnBody.identifier(),
+		ExecutionDeliveryColumnState.identifier(),
+		ExecutionDeliveryColumnAttempts.identifier(),
+		ExecutionDeliveryColumnNextAttempt.identifier(),
+		ExecutionDeliveryColumnLastError.identifier(),
+		ExecutionDeliveryColumnLastStatusCode.identifier(),
+	}
+}
+
+func scanExecutionDelivery(scan func(dest ...any) error, additional ...any) (*ExecutionDelivery, error) {
+	delivery := new(ExecutionDelivery)
+	var nextAttempt sql.NullTime
+	err := scan(append([]any{
+		&delivery.ID,
+		&delivery.CreationDate,
+		&delivery.EventDate,
+		&delivery.ResourceOwner,
+		&delivery.InstanceID,
+		&delivery.Sequence,
+		&delivery.TargetID,
+		&delivery.ExecutionID,
+		&delivery.EventType,
+		&delivery.AggregateType,
+		&delivery.AggregateID,
+		&delivery.Body,
+		&delivery.State,
+		&delivery.Attempts,
+		&nextAttempt,
+		&delivery.LastError,
+		&delivery.LastStatusCode,
+	}, additional...)...)
+	if err != nil {
+		return nil, err
+	}
+	delivery.NextAttempt = nextAttempt.Time
+	return delivery, nil
+}
+
+func prepareExecutionDeliveriesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*ExecutionDeliveries, error)) {
+	return sq.Select(append(executionDeliveryColumns(), countColumn.identifier())...).
+			From(executionDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ExecutionDeliveries, error) {
+			deliveries := make([]*ExecutionDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				delivery, err := scanExecutionDelivery(rows.Scan, &count)
+				if err != nil {
+					return nil, err
+				}
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Edl8rc", "Errors.Query.CloseRows")
+			}
+
+			return &ExecutionDeliveries{
+				ExecutionDeliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareExecutionDeliveryQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(row *sql.Row) (*ExecutionDelivery, error)) {
+	return sq.Select(executionDeliveryColumns()...).
+			From(executionDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ExecutionDelivery, error) {
+			delivery, err := scanExecutionDelivery(row.Scan)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Edl9nf", "Errors.Execution.Delivery.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Edl0in", "Errors.Internal")
+			}
+			return delivery, nil
+		}
+}
@@ -0,0 +1,283 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareExecutionDeliveriesStmt = `SELECT projections.execution_deliveries.id,` +
+		` projections.execution_deliveries.creation_date,` +
+		` projections.execution_deliveries.change_date,` +
+		` projections.execution_deliveries.resource_owner,` +
+		` projections.execution_deliveries.instance_id,` +
+		` projections.execution_deliveries.sequence,` +
+		` projections.execution_deliveries.target_id,` +
+		` projections.execution_deliveries.execution_id,` +
+		` projections.execution_deliveries.event_type,` +
+		` projections.execution_deliveries.aggregate_type,` +
+		` projections.execution_deliveries.aggregate_id,` +
+		` projections.execution_deliveries.body,` +
+		` projections.execution_deliveries.state,` +
+		` projections.execution_deliveries.attempts,` +
+		` projections.execution_deliveries.next_attempt,` +
+		` projections.execution_deliveries.last_error,` +
+		` projections.execution_deliveries.last_status_code,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.execution_deliveries`
+	prepareExecutionDeliveriesCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"instance_id",
+		"sequence",
+		"target_id",
+		"execution_id",
+		"event_type",
+		"aggregate_type",
+		"aggregate_id",
+		"body",
+		"state",
+		"attempts",
+		"next_attempt",
+		"last_error",
+		"last_status_code",
+		"count",
+	}
+
+	prepareExecutionDeliveryStmt = `SELECT projections.execution_deliveries.id,` +
+		` projections.execution_deliveries.creation_date,` +
+		` projections.execution_deliveries.change_date,` +
+		` projections.execution_deliveries.resource_owner,` +
+		` projections.execution_deliveries.instance_id,` +
+		` projections.execution_deliveries.sequence,` +
+		` projections.execution_deliveries.target_id,` +
+		` projections.execution_deliveries.execution_id,` +
+		` projections.execution_deliveries.event_type,` +
+		` projections.execution_deliveries.aggregate_type,` +
+		` projections.execution_deliveries.aggregate_id,` +
+		` projections.execution_deliveries.body,` +
+		` projections.execution_deliveries.state,` +
+		` projections.execution_deliveries.attempts,` +
+		` projections.execution_deliveries.next_attempt,` +
+		` projections.execution_deliveries.last_error,` +
+		` projections.execution_deliveries.last_status_code` +
+		` FROM projections.execution_deliveries`
+	prepareExecutionDeliveryCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"instance_id",
+		"sequence",
+		"target_id",
+		"execution_id",
+		"event_type",
+		"aggregate_type",
+		"aggregate_id",
+		"body",
+		"state",
+		"attempts",
+		"next_attempt",
+		"last_error",
+		"last_status_code",
+	}
+)
+
+func Test_ExecutionDeliveryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareExecutionDeliveriesQuery no result",
+			prepare: prepareExecutionDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareExecutionDeliveriesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &ExecutionDeliveries{ExecutionDeliveries: []*ExecutionDelivery{}},
+		},
+		{
+			name:    "prepareExecutionDeliveriesQuery one result",
+			prepare: prepareExecutionDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareExecutionDeliveriesStmt),
+					prepareExecutionDeliveriesCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"instance",
+							"instance",
+							uint64(20211109),
+							"target",
+							"event",
+							"user.human.added",
+							"user",
+							"user",
+							[]byte(`{"aggregateID":"user"}`),
+							domain.ExecutionDeliveryStateDeadLetter,
+							uint16(5),
+							nil,
+							"connection refused",
+							0,
+						},
+					},
+				),
+			},
+			object: &ExecutionDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				ExecutionDeliveries: []*ExecutionDelivery{
+					{
+						ID: "id",
+						ObjectDetails: domain.ObjectDetails{
+							EventDate:     testNow,
+							ResourceOwner: "instance",
+							Sequence:      20211109,
+						},
+						CreationDate:  testNow,
+						InstanceID:    "instance",
+						TargetID:      "target",
+						ExecutionID:   "event",
+						EventType:     "user.human.added",
+						AggregateType: "user",
+						AggregateID:   "user",
+						Body:          []byte(`{"aggregateID":"user"}`),
+						State:         domain.ExecutionDeliveryStateDeadLetter,
+						Attempts:      5,
+						LastError:     "connection refused",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareExecutionDeliveriesQuery sql err",
+			prepare: prepareExecutionDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareExecutionDeliveriesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ExecutionDeliveries)(nil),
+		},
+		{
+			name:    "prepareExecutionDeliveryQuery no result",
+			prepare: prepareExecutionDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareExecutionDeliveryStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ExecutionDelivery)(nil),
+		},
+		{
+			name:    "prepareExecutionDeliveryQuery found",
+			prepare: prepareExecutionDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareExecutionDeliveryStmt),
+					prepareExecutionDeliveryCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"instance",
+						"instance",
+						uint64(20211109),
+						"target",
+						"event",
+						"user.human.added",
+						"user",
+						"user",
+						[]byte(`{"aggregateID":"user"}`),
+						domain.ExecutionDeliveryStatePending,
+						uint16(1),
+						testNow,
+						"internal server error",
+						500,
+					},
+				),
+			},
+			object: &ExecutionDelivery{
+				ID: "id",
+				ObjectDetails: domain.ObjectDetails{
+					EventDate:     testNow,
+					ResourceOwner: "instance",
+					Sequence:      20211109,
+				},
+				CreationDate:   testNow,
+				InstanceID:     "instance",
+				TargetID:       "target",
+				ExecutionID:    "event",
+				EventType:      "user.human.added",
+				AggregateType:  "user",
+				AggregateID:    "user",
+				Body:           []byte(`{"aggregateID":"user"}`),
+				State:          domain.ExecutionDeliveryStatePending,
+				Attempts:       1,
+				NextAttempt:    testNow,
+				LastError:      "internal server error",
+				LastStatusCode: 500,
+			},
+		},
+		{
+			name:    "prepareExecutionDeliveryQuery sql err",
+			prepare: prepareExecutionDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareExecutionDeliveryStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ExecutionDelivery)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/feature"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -429,6 +430,7 @@ type authzInstance struct {
 	enableImpersonation bool
 	block               *bool
 	auditLogRetention   *time.Duration
+	rateLimits          *ratelimit.Rules
 	features            feature.Features
 }
 
@@ -488,6 +490,10 @@ func (i *authzInstance) AuditLogRetention() *time.Duration {
 	return i.auditLogRetention
 }
 
+func (i *authzInstance) RateLimits() *ratelimit.Rules {
+	return i.rateLimits
+}
+
 func (i *authzInstance) Features() feature.Features {
 	return i.features
 }
@@ -504,6 +510,10 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 			enableImpersonation   sql.NullBool
 			auditLogRetention     database.NullDuration
 			block                 sql.NullBool
+			rateLimitInstance     []byte
+			rateLimitOrg          []byte
+			rateLimitClient       []byte
+			rateLimitIP           []byte
 			features              []byte
 		)
 		err := row.Scan(
@@ -518,6 +528,10 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 			&enableImpersonation,
 			&auditLogRetention,
 			&block,
+			&rateLimitInstance,
+			&rateLimitOrg,
+			&rateLimitClient,
+			&rateLimitIP,
 			&features,
 		)
 		if errors.Is(err, sql.ErrNoRows) {
@@ -533,6 +547,10 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 		if block.Valid {
 			instance.block = &block.Bool
 		}
+		instance.rateLimits, err = scanRateLimits(rateLimitInstance, rateLimitOrg, rateLimitClient, rateLimitIP)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "QUERY-Chai4", "Errors.Internal")
+		}
 		instance.csp.enableIframeEmbedding = enableIframeEmbedding.Bool
 		instance.enableImpersonation = enableImpersonation.Bool
 		if len(features) == 0 {
@@ -544,3 +562,27 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 		return nil
 	}
 }
+
+func scanRateLimits(instance, org, client, ip []byte) (*ratelimit.Rules, error) {
+	rules := new(ratelimit.Rules)
+	for _, r := range []struct {
+		value []byte
+		rule  **ratelimit.Rule
+	}{
+		{instance, &rules.Instance},
+		{org, &rules.Org},
+		{client, &rules.Client},
+		{ip, &rules.IP},
+	} {
+		if len(r.value) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(r.value, r.rule); err != nil {
+			return nil, err
+		}
+	}
+	if rules.IsZero() {
+		return nil, nil
+	}
+	return rules, nil
+}
@@ -27,6 +27,10 @@ select
 	s.enable_impersonation,
     l.audit_log_retention,
     l.block,
+    l.rate_limit_instance,
+    l.rate_limit_org,
+    l.rate_limit_client,
+    l.rate_limit_ip,
 	f.features
 from domain d
 join projections.instances i on i.id = d.instance_id
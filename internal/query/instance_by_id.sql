@@ -20,6 +20,10 @@ select
 	s.enable_impersonation,
     l.audit_log_retention,
     l.block,
+    l.rate_limit_instance,
+    l.rate_limit_org,
+    l.rate_limit_client,
+    l.rate_limit_ip,
 	f.features
 from projections.instances i
 left join projections.security_policies2 s on i.id = s.instance_id
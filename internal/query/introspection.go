@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -35,6 +36,24 @@ type IntrospectionClient struct {
 	ClientSecret *crypto.CryptoValue
 	ProjectID    string
 	PublicKeys   database.Map[[]byte]
+	// APIAuthMethodType is only set for API and OIDCAuthMethodType only for OIDC applications
+	APIAuthMethodType      *domain.APIAuthMethodType
+	OIDCAuthMethodType     *domain.OIDCAuthMethodType
+	TLSClientAuthSubjectDN string
+}
+
+// TLSClientAuth returns if the client authenticates with a certificate (RFC 8705)
+// and if the certificate is self-signed
+func (c *IntrospectionClient) TLSClientAuth() (tlsClientAuth, selfSigned bool) {
+	switch {
+	case c.APIAuthMethodType != nil:
+		return *c.APIAuthMethodType == domain.APIAuthMethodTypeTLSClientAuth || *c.APIAuthMethodType == domain.APIAuthMethodTypeSelfSignedTLSClientAuth,
+			*c.APIAuthMethodType == domain.APIAuthMethodTypeSelfSignedTLSClientAuth
+	case c.OIDCAuthMethodType != nil:
+		return c.OIDCAuthMethodType.IsTLSClientAuth(),
+			*c.OIDCAuthMethodType == domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
+	}
+	return false, false
 }
 
 //go:embed embed/introspection_client_by_id.sql
@@ -50,7 +69,7 @@ func (q *Queries) GetIntrospectionClientByID(ctx context.Context, clientID strin
 	)
 
 	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
-		return row.Scan(&client.ClientID, &client.ClientSecret, &client.ProjectID, &client.PublicKeys)
+		return row.Scan(&client.ClientID, &client.ClientSecret, &client.ProjectID, &client.PublicKeys, &client.APIAuthMethodType, &client.OIDCAuthMethodType, &client.TLSClientAuthSubjectDN)
 	},
 		introspectionClientByIDQuery,
 		instanceID, clientID, getKeys,
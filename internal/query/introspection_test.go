@@ -8,12 +8,14 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 func TestQueries_GetIntrospectionClientByID(t *testing.T) {
@@ -61,14 +63,15 @@ func TestQueries_GetIntrospectionClientByID(t *testing.T) {
 				getKeys:  false,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"client_id", "client_secret", "project_id", "public_keys"},
-				[]driver.Value{"clientID", encSecret, "projectID", nil},
+				[]string{"client_id", "client_secret", "project_id", "public_keys", "api_auth_method", "oidc_auth_method", "tls_client_auth_subject_dn"},
+				[]driver.Value{"clientID", encSecret, "projectID", nil, domain.APIAuthMethodTypeBasic, nil, ""},
 				"instanceID", "clientID", false),
 			want: &IntrospectionClient{
-				ClientID:     "clientID",
-				ClientSecret: secret,
-				ProjectID:    "projectID",
-				PublicKeys:   nil,
+				ClientID:          "clientID",
+				ClientSecret:      secret,
+				ProjectID:         "projectID",
+				PublicKeys:        nil,
+				APIAuthMethodType: gu.Ptr(domain.APIAuthMethodTypeBasic),
 			},
 		},
 		{
@@ -78,14 +81,32 @@ func TestQueries_GetIntrospectionClientByID(t *testing.T) {
 				getKeys:  true,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"client_id", "client_secret", "project_id", "public_keys"},
-				[]driver.Value{"clientID", nil, "projectID", encPubkeys},
+				[]string{"client_id", "client_secret", "project_id", "public_keys", "api_auth_method", "oidc_auth_method", "tls_client_auth_subject_dn"},
+				[]driver.Value{"clientID", nil, "projectID", encPubkeys, nil, domain.OIDCAuthMethodTypePrivateKeyJWT, ""},
 				"instanceID", "clientID", true),
 			want: &IntrospectionClient{
-				ClientID:     "clientID",
-				ClientSecret: nil,
-				ProjectID:    "projectID",
-				PublicKeys:   pubkeys,
+				ClientID:           "clientID",
+				ClientSecret:       nil,
+				ProjectID:          "projectID",
+				PublicKeys:         pubkeys,
+				OIDCAuthMethodType: gu.Ptr(domain.OIDCAuthMethodTypePrivateKeyJWT),
+			},
+		},
+		{
+			name: "success, tls client auth",
+			args: args{
+				clientID: "clientID",
+				getKeys:  true,
+			},
+			mock: mockQuery(expQuery,
+				[]string{"client_id", "client_secret", "project_id", "public_keys", "api_auth_method", "oidc_auth_method", "tls_client_auth_subject_dn"},
+				[]driver.Value{"clientID", nil, "projectID", nil, domain.APIAuthMethodTypeTLSClientAuth, nil, "CN=client"},
+				"instanceID", "clientID", true),
+			want: &IntrospectionClient{
+				ClientID:               "clientID",
+				ProjectID:              "projectID",
+				APIAuthMethodType:      gu.Ptr(domain.APIAuthMethodTypeTLSClientAuth),
+				TLSClientAuthSubjectDN: "CN=client",
 			},
 		},
 	}
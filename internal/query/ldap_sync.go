@@ -0,0 +1,403 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	ldapSyncTable = table{
+		name:          projection.LDAPSyncTable,
+		instanceIDCol: projection.LDAPSyncInstanceIDCol,
+	}
+	LDAPSyncColumnIDPID = Column{
+		name:  projection.LDAPSyncIDPIDCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnInstanceID = Column{
+		name:  projection.LDAPSyncInstanceIDCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnResourceOwner = Column{
+		name:  projection.LDAPSyncResourceOwnerCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnCreationDate = Column{
+		name:  projection.LDAPSyncCreationDateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnChangeDate = Column{
+		name:  projection.LDAPSyncChangeDateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnSequence = Column{
+		name:  projection.LDAPSyncSequenceCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnOrgID = Column{
+		name:  projection.LDAPSyncOrgIDCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnInterval = Column{
+		name:  projection.LDAPSyncIntervalCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnGroupsAttribute = Column{
+		name:  projection.LDAPSyncGroupsAttributeCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnDeactivateMissing = Column{
+		name:  projection.LDAPSyncDeactivateMissingCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnGroupRoleMappings = Column{
+		name:  projection.LDAPSyncGroupRoleMappingsCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastRunID = Column{
+		name:  projection.LDAPSyncLastRunIDCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastRunState = Column{
+		name:  projection.LDAPSyncLastRunStateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastRunStarted = Column{
+		name:  projection.LDAPSyncLastRunStartedCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastRunFinished = Column{
+		name:  projection.LDAPSyncLastRunFinishedCol,
+		table: ldapSyncTable,
+	}
+)
+
+var (
+	ldapSyncRunTable = table{
+		name:          projection.LDAPSyncRunTable,
+		instanceIDCol: projection.LDAPSyncRunInstanceIDCol,
+	}
+	LDAPSyncRunColumnID = Column{
+		name:  projection.LDAPSyncRunIDCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnIDPID = Column{
+		name:  projection.LDAPSyncRunIDPIDCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnInstanceID = Column{
+		name:  projection.LDAPSyncRunInstanceIDCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnState = Column{
+		name:  projection.LDAPSyncRunStateCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnStarted = Column{
+		name:  projection.LDAPSyncRunStartedCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnFinished = Column{
+		name:  projection.LDAPSyncRunFinishedCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnUsersCreated = Column{
+		name:  projection.LDAPSyncRunUsersCreatedCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnUsersUpdated = Column{
+		name:  projection.LDAPSyncRunUsersUpdatedCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnUsersDeactivated = Column{
+		name:  projection.LDAPSyncRunUsersDeactivatedCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnUsersReactivated = Column{
+		name:  projection.LDAPSyncRunUsersReactivatedCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnGrantsChanged = Column{
+		name:  projection.LDAPSyncRunGrantsChangedCol,
+		table: ldapSyncRunTable,
+	}
+	LDAPSyncRunColumnErrors = Column{
+		name:  projection.LDAPSyncRunErrorsCol,
+		table: ldapSyncRunTable,
+	}
+)
+
+type LDAPSyncs struct {
+	SearchResponse
+	LDAPSyncs []*LDAPSync
+}
+
+type LDAPSync struct {
+	IDPID string
+	domain.ObjectDetails
+
+	CreationDate      time.Time
+	InstanceID        string
+	OrgID             string
+	Interval          time.Duration
+	GroupsAttribute   string
+	DeactivateMissing bool
+	GroupRoleMappings []*ldapsync.GroupRoleMapping
+	LastRunID         string
+	LastRunState      domain.LDAPSyncRunState
+	LastRunStarted    time.Time
+	LastRunFinished   time.Time
+}
+
+type LDAPSyncRuns struct {
+	SearchResponse
+	LDAPSyncRuns []*LDAPSyncRun
+}
+
+func (r *LDAPSyncRuns) SetState(s *State) {
+	r.State = s
+}
+
+type LDAPSyncRun struct {
+	ID               string
+	IDPID            string
+	State            domain.LDAPSyncRunState
+	Started          time.Time
+	Finished         time.Time
+	UsersCreated     uint32
+	UsersUpdated     uint32
+	UsersDeactivated uint32
+	UsersReactivated uint32
+	GrantsChanged    uint32
+	Errors           database.TextArray[string]
+}
+
+type LDAPSyncRunSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *LDAPSyncRunSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) GetInstanceLDAPSync(ctx context.Context, idpID string) (sync *LDAPSync, err error) {
+	eq := sq.Eq{
+		LDAPSyncColumnIDPID.identifier():      idpID,
+		LDAPSyncColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareLDAPSyncQuery(ctx, q.client)
+	return genericRowQuery[*LDAPSync](ctx, q.client, query.Where(eq), scan)
+}
+
+// SearchDueLDAPSyncs returns the synchronizations of all instances, which are due to be run at the passed time.
+// A synchronization is due if it never ran, if its interval passed since the last run finished
+// or if the last run is considered stale because it wasn't finished after staleAfter.
+func (q *Queries) SearchDueLDAPSyncs(ctx context.Context, now time.Time, staleAfter time.Duration, limit uint64) (syncs *LDAPSyncs, err error) {
+	query, scan := prepareLDAPSyncsQuery(ctx, q.client)
+	return genericRowsQuery[*LDAPSyncs](ctx, q.client,
+		query.Where(sq.Or{
+			sq.Eq{LDAPSyncColumnLastRunStarted.identifier(): nil},
+			sq.And{
+				sq.NotEq{LDAPSyncColumnLastRunState.identifier(): domain.LDAPSyncRunStateRunning},
+				sq.Expr(LDAPSyncColumnLastRunFinished.identifier()+" + "+LDAPSyncColumnInterval.identifier()+" <= ?", now),
+			},
+			sq.And{
+				sq.Eq{LDAPSyncColumnLastRunState.identifier(): domain.LDAPSyncRunStateRunning},
+				sq.LtOrEq{LDAPSyncColumnLastRunStarted.identifier(): now.Add(-staleAfter)},
+			},
+		}).
+			OrderBy(LDAPSyncColumnLastRunStarted.identifier()+" NULLS FIRST").
+			Limit(limit),
+		scan,
+	)
+}
+
+func (q *Queries) SearchLDAPSyncRuns(ctx context.Context, idpID string, queries *LDAPSyncRunSearchQueries) (runs *LDAPSyncRuns, err error) {
+	eq := sq.Eq{
+		LDAPSyncRunColumnIDPID.identifier():      idpID,
+		LDAPSyncRunColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareLDAPSyncRunsQuery(ctx, q.client)
+	return genericRowsQueryWithState[*LDAPSyncRuns](ctx, q.client, ldapSyncRunTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewLDAPSyncRunStateSearchQuery(value domain.LDAPSyncRunState) (SearchQuery, error) {
+	return NewNumberQuery(LDAPSyncRunColumnState, value, NumberEquals)
+}
+
+func ldapSyncColumns() []string {
+	return []string{
+		LDAPSyncColumnIDPID.identifier(),
+		LDAPSyncColumnCreationDate.identifier(),
+		LDAPSyncColumnChangeDate.identifier(),
+		LDAPSyncColumnResourceOwner.identifier(),
+		LDAPSyncColumnInstanceID.identifier(),
+		LDAPSyncColumnSequence.identifier(),
+		LDAPSyncColumnOrgID.identifier(),
+		LDAPSyncColumnInterval.identifier(),
+		LDAPSyncColumnGroupsAttribute.identifier(),
+		LDAPSyncColumnDeactivateMissing.identifier(),
+		LDAPSyncColumnGroupRoleMappings.identifier(),
+		LDAPSyncColumnLastRunID.identifier(),
+		LDAPSyncColumnLastRunState.identifier(),
+		LDAPSyncColumnLastRunStarted.identifier(),
+		LDAPSyncColumnLastRunFinished.identifier(),
+	}
+}
+
+func scanLDAPSync(scan func(dest ...any) error, additional ...any) (*LDAPSync, error) {
+	sync := new(LDAPSync)
+	var (
+		interval        database.Duration
+		mappings        []byte
+		lastRunStarted  sql.NullTime
+		lastRunFinished sql.NullTime
+	)
+	err := scan(append([]any{
+		&sync.IDPID,
+		&sync.CreationDate,
+		&sync.EventDate,
+		&sync.ResourceOwner,
+		&sync.InstanceID,
+		&sync.Sequence,
+		&sync.OrgID,
+		&interval,
+		&sync.GroupsAttribute,
+		&sync.DeactivateMissing,
+		&mappings,
+		&sync.LastRunID,
+		&sync.LastRunState,
+		&lastRunStarted,
+		&lastRunFinished,
+	}, additional...)...)
+	if err != nil {
+		return nil, err
+	}
+	if len(mappings) > 0 {
+		if err := json.Unmarshal(mappings, &sync.GroupRoleMappings); err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-ieD2u", "Errors.Internal")
+		}
+	}
+	sync.Interval = time.Duration(interval)
+	sync.LastRunStarted = lastRunStarted.Time
+	sync.LastRunFinished = lastRunFinished.Time
+	return sync, nil
+}
+
+func prepareLDAPSyncsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*LDAPSyncs, error)) {
+	return sq.Select(append(ldapSyncColumns(), countColumn.identifier())...).
+			From(ldapSyncTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*LDAPSyncs, error) {
+			syncs := make([]*LDAPSync, 0)
+			var count uint64
+			for rows.Next() {
+				sync, err := scanLDAPSync(rows.Scan, &count)
+				if err != nil {
+					return nil, err
+				}
+				syncs = append(syncs, sync)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-ahX0o", "Errors.Query.CloseRows")
+			}
+
+			return &LDAPSyncs{
+				LDAPSyncs: syncs,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareLDAPSyncQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(row *sql.Row) (*LDAPSync, error)) {
+	return sq.Select(ldapSyncColumns()...).
+			From(ldapSyncTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*LDAPSync, error) {
+			sync, err := scanLDAPSync(row.Scan)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Phoo6", "Errors.LDAPSync.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-eeN4i", "Errors.Internal")
+			}
+			return sync, nil
+		}
+}
+
+func prepareLDAPSyncRunsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*LDAPSyncRuns, error)) {
+	return sq.Select(
+			LDAPSyncRunColumnID.identifier(),
+			LDAPSyncRunColumnIDPID.identifier(),
+			LDAPSyncRunColumnState.identifier(),
+			LDAPSyncRunColumnStarted.identifier(),
+			LDAPSyncRunColumnFinished.identifier(),
+			LDAPSyncRunColumnUsersCreated.identifier(),
+			LDAPSyncRunColumnUsersUpdated.identifier(),
+			LDAPSyncRunColumnUsersDeactivated.identifier(),
+			LDAPSyncRunColumnUsersReactivated.identifier(),
+			LDAPSyncRunColumnGrantsChanged.identifier(),
+			LDAPSyncRunColumnErrors.identifier(),
+			countColumn.identifier(),
+		).
+			From(ldapSyncRunTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*LDAPSyncRuns, error) {
+			runs := make([]*LDAPSyncRun, 0)
+			var count uint64
+			for rows.Next() {
+				run := new(LDAPSyncRun)
+				var finished sql.NullTime
+				err := rows.Scan(
+					&run.ID,
+					&run.IDPID,
+					&run.State,
+					&run.Started,
+					&finished,
+					&run.UsersCreated,
+					&run.UsersUpdated,
+					&run.UsersDeactivated,
+					&run.UsersReactivated,
+					&run.GrantsChanged,
+					&run.Errors,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				run.Finished = finished.Time
+				runs = append(runs, run)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Vae7h", "Errors.Query.CloseRows")
+			}
+
+			return &LDAPSyncRuns{
+				LDAPSyncRuns: runs,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,237 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareLDAPSyncStmt = `SELECT projections.ldap_syncs.idp_id,` +
+		` projections.ldap_syncs.creation_date,` +
+		` projections.ldap_syncs.change_date,` +
+		` projections.ldap_syncs.resource_owner,` +
+		` projections.ldap_syncs.instance_id,` +
+		` projections.ldap_syncs.sequence,` +
+		` projections.ldap_syncs.org_id,` +
+		` projections.ldap_syncs.sync_interval,` +
+		` projections.ldap_syncs.groups_attribute,` +
+		` projections.ldap_syncs.deactivate_missing,` +
+		` projections.ldap_syncs.group_role_mappings,` +
+		` projections.ldap_syncs.last_run_id,` +
+		` projections.ldap_syncs.last_run_state,` +
+		` projections.ldap_syncs.last_run_started,` +
+		` projections.ldap_syncs.last_run_finished` +
+		` FROM projections.ldap_syncs`
+	prepareLDAPSyncCols = []string{
+		"idp_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"instance_id",
+		"sequence",
+		"org_id",
+		"sync_interval",
+		"groups_attribute",
+		"deactivate_missing",
+		"group_role_mappings",
+		"last_run_id",
+		"last_run_state",
+		"last_run_started",
+		"last_run_finished",
+	}
+
+	prepareLDAPSyncRunsStmt = `SELECT projections.ldap_syncs_runs.id,` +
+		` projections.ldap_syncs_runs.idp_id,` +
+		` projections.ldap_syncs_runs.state,` +
+		` projections.ldap_syncs_runs.started,` +
+		` projections.ldap_syncs_runs.finished,` +
+		` projections.ldap_syncs_runs.users_created,` +
+		` projections.ldap_syncs_runs.users_updated,` +
+		` projections.ldap_syncs_runs.users_deactivated,` +
+		` projections.ldap_syncs_runs.users_reactivated,` +
+		` projections.ldap_syncs_runs.grants_changed,` +
+		` projections.ldap_syncs_runs.errors,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.ldap_syncs_runs`
+	prepareLDAPSyncRunsCols = []string{
+		"id",
+		"idp_id",
+		"state",
+		"started",
+		"finished",
+		"users_created",
+		"users_updated",
+		"users_deactivated",
+		"users_reactivated",
+		"grants_changed",
+		"errors",
+		"count",
+	}
+)
+
+func Test_LDAPSyncPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareLDAPSyncQuery no result",
+			prepare: prepareLDAPSyncQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareLDAPSyncStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*LDAPSync)(nil),
+		},
+		{
+			name:    "prepareLDAPSyncQuery found",
+			prepare: prepareLDAPSyncQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareLDAPSyncStmt),
+					prepareLDAPSyncCols,
+					[]driver.Value{
+						"idp",
+						testNow,
+						testNow,
+						"instance",
+						"instance",
+						uint64(20211109),
+						"org",
+						time.Hour,
+						"memberOf",
+						true,
+						[]byte(`[{"group":"cn=admins","projectId":"project","roleKeys":["admin"]}]`),
+						"run",
+						domain.LDAPSyncRunStateSucceeded,
+						testNow,
+						testNow,
+					},
+				),
+			},
+			object: &LDAPSync{
+				IDPID: "idp",
+				ObjectDetails: domain.ObjectDetails{
+					EventDate:     testNow,
+					ResourceOwner: "instance",
+					Sequence:      20211109,
+				},
+				CreationDate:      testNow,
+				InstanceID:        "instance",
+				OrgID:             "org",
+				Interval:          time.Hour,
+				GroupsAttribute:   "memberOf",
+				DeactivateMissing: true,
+				GroupRoleMappings: []*ldapsync.GroupRoleMapping{
+					{Group: "cn=admins", ProjectID: "project", RoleKeys: []string{"admin"}},
+				},
+				LastRunID:       "run",
+				LastRunState:    domain.LDAPSyncRunStateSucceeded,
+				LastRunStarted:  testNow,
+				LastRunFinished: testNow,
+			},
+		},
+		{
+			name:    "prepareLDAPSyncQuery sql err",
+			prepare: prepareLDAPSyncQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareLDAPSyncStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*LDAPSync)(nil),
+		},
+		{
+			name:    "prepareLDAPSyncRunsQuery no result",
+			prepare: prepareLDAPSyncRunsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareLDAPSyncRunsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &LDAPSyncRuns{LDAPSyncRuns: []*LDAPSyncRun{}},
+		},
+		{
+			name:    "prepareLDAPSyncRunsQuery one result",
+			prepare: prepareLDAPSyncRunsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareLDAPSyncRunsStmt),
+					prepareLDAPSyncRunsCols,
+					[][]driver.Value{
+						{
+							"run",
+							"idp",
+							domain.LDAPSyncRunStateSucceeded,
+							testNow,
+							testNow,
+							uint32(2),
+							uint32(1),
+							uint32(0),
+							uint32(0),
+							uint32(3),
+							database.TextArray[string]{"user: Errors.User.Email.Empty"},
+						},
+					},
+				),
+			},
+			object: &LDAPSyncRuns{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				LDAPSyncRuns: []*LDAPSyncRun{
+					{
+						ID:            "run",
+						IDPID:         "idp",
+						State:         domain.LDAPSyncRunStateSucceeded,
+						Started:       testNow,
+						Finished:      testNow,
+						UsersCreated:  2,
+						UsersUpdated:  1,
+						GrantsChanged: 3,
+						Errors:        database.TextArray[string]{"user: Errors.User.Email.Empty"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -16,28 +16,35 @@ import (
 )
 
 type OIDCClient struct {
-	InstanceID               string                     `json:"instance_id,omitempty"`
-	AppID                    string                     `json:"app_id,omitempty"`
-	State                    domain.AppState            `json:"state,omitempty"`
-	ClientID                 string                     `json:"client_id,omitempty"`
-	ClientSecret             *crypto.CryptoValue        `json:"client_secret,omitempty"`
-	RedirectURIs             []string                   `json:"redirect_uris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"response_types,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grant_types,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"application_type,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"auth_method_type,omitempty"`
-	PostLogoutRedirectURIs   []string                   `json:"post_logout_redirect_uris,omitempty"`
-	IsDevMode                bool                       `json:"is_dev_mode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"access_token_type,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"access_token_role_assertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"id_token_role_assertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"id_token_userinfo_assertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clock_skew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additional_origins,omitempty"`
-	PublicKeys               map[string][]byte          `json:"public_keys,omitempty"`
-	ProjectID                string                     `json:"project_id,omitempty"`
-	ProjectRoleKeys          []string                   `json:"project_role_keys,omitempty"`
-	Settings                 *OIDCSettings              `json:"settings,omitempty"`
+	InstanceID                            string                     `json:"instance_id,omitempty"`
+	AppID                                 string                     `json:"app_id,omitempty"`
+	State                                 domain.AppState            `json:"state,omitempty"`
+	ClientID                              string                     `json:"client_id,omitempty"`
+	ClientSecret                          *crypto.CryptoValue        `json:"client_secret,omitempty"`
+	RedirectURIs                          []string                   `json:"redirect_uris,omitempty"`
+	ResponseTypes                         []domain.OIDCResponseType  `json:"response_types,omitempty"`
+	GrantTypes                            []domain.OIDCGrantType     `json:"grant_types,omitempty"`
+	ApplicationType                       domain.OIDCApplicationType `json:"application_type,omitempty"`
+	AuthMethodType                        domain.OIDCAuthMethodType  `json:"auth_method_type,omitempty"`
+	PostLogoutRedirectURIs                []string                   `json:"post_logout_redirect_uris,omitempty"`
+	IsDevMode                             bool                       `json:"is_dev_mode,omitempty"`
+	AccessTokenType                       domain.OIDCTokenType       `json:"access_token_type,omitempty"`
+	AccessTokenRoleAssertion              bool                       `json:"access_token_role_assertion,omitempty"`
+	IDTokenRoleAssertion                  bool                       `json:"id_token_role_assertion,omitempty"`
+	IDTokenUserinfoAssertion              bool                       `json:"id_token_userinfo_assertion,omitempty"`
+	ClockSkew                             time.Duration              `json:"clock_skew,omitempty"`
+	AdditionalOrigins                     []string                   `json:"additional_origins,omitempty"`
+	BackChannelLogoutURI                  string                     `json:"back_channel_logout_uri,omitempty"`
+	FrontChannelLogoutURI                 string                     `json:"front_channel_logout_uri,omitempty"`
+	RequirePushedAuthRequest              bool                       `json:"require_pushed_auth_request,omitempty"`
+	RequireRequestObject                  bool                       `json:"require_request_object,omitempty"`
+	RequireDPoP                           bool                       `json:"require_dpop,omitempty"`
+	TLSClientAuthSubjectDN                string                     `json:"tls_client_auth_subject_dn,omitempty"`
+	BackChannelClientNotificationEndpoint string                     `json:"back_channel_client_notification_endpoint,omitempty"`
+	PublicKeys                            map[string][]byte          `json:"public_keys,omitempty"`
+	ProjectID                             string                     `json:"project_id,omitempty"`
+	ProjectRoleKeys                       []string                   `json:"project_role_keys,omitempty"`
+	Settings                              *OIDCSettings              `json:"settings,omitempty"`
 }
 
 //go:embed embed/oidc_client_by_id.sql
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/feature"
+)
+
+type OrgFeatures struct {
+	Details       *domain.ObjectDetails
+	UserSchema    FeatureSource[bool]
+	TokenExchange FeatureSource[bool]
+}
+
+// GetOrgFeatures returns the features set on the organization.
+// If cascade is set, unset features are inherited from the instance and system level.
+func (q *Queries) GetOrgFeatures(ctx context.Context, orgID string, cascade bool) (_ *OrgFeatures, err error) {
+	m := NewOrgFeaturesReadModel(orgID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, m); err != nil {
+		return nil, err
+	}
+	m.org.Details = readModelToObjectDetails(m.ReadModel)
+	if !cascade {
+		return m.org, nil
+	}
+	instance, err := q.GetInstanceFeatures(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	m.org.inherit(instance)
+	return m.org, nil
+}
+
+func (f *OrgFeatures) inherit(instance *InstanceFeatures) {
+	inheritFeature(&f.UserSchema, instance.UserSchema)
+	inheritFeature(&f.TokenExchange, instance.TokenExchange)
+}
+
+// inheritFeature sets the feature to the value of the parent level,
+// if it is not set on the current level.
+func inheritFeature[T any](dst *FeatureSource[T], parent FeatureSource[T]) {
+	if dst.Level == feature.LevelUnspecified {
+		*dst = parent
+	}
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+)
+
+type OrgFeaturesReadModel struct {
+	*eventstore.ReadModel
+	org *OrgFeatures
+}
+
+func NewOrgFeaturesReadModel(orgID string) *OrgFeaturesReadModel {
+	return &OrgFeaturesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		org: new(OrgFeatures),
+	}
+}
+
+func (m *OrgFeaturesReadModel) Reduce() (err error) {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *feature_v2.ResetEvent:
+			m.reduceReset()
+		case *feature_v2.SetEvent[bool]:
+			err = m.reduceBoolFeature(e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return m.ReadModel.Reduce()
+}
+
+func (m *OrgFeaturesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(feature_v2.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			feature_v2.OrgResetEventType,
+			feature_v2.OrgUserSchemaEventType,
+			feature_v2.OrgTokenExchangeEventType,
+		).
+		Builder().ResourceOwner(m.ResourceOwner)
+}
+
+func (m *OrgFeaturesReadModel) reduceReset() {
+	m.org = new(OrgFeatures)
+}
+
+func (m *OrgFeaturesReadModel) reduceBoolFeature(event *feature_v2.SetEvent[bool]) error {
+	level, key, err := event.FeatureInfo()
+	if err != nil {
+		return err
+	}
+	var dst *FeatureSource[bool]
+
+	switch key {
+	case feature.KeyUserSchema:
+		dst = &m.org.UserSchema
+	case feature.KeyTokenExchange:
+		dst = &m.org.TokenExchange
+	default:
+		return nil
+	}
+
+	*dst = FeatureSource[bool]{
+		Level: level,
+		Value: event.Value,
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package query
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+)
+
+func TestQueries_GetOrgFeatures(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := feature_v2.NewAggregate("org1", "org1")
+	instanceAggregate := feature_v2.NewAggregate("instance1", "instance1")
+
+	type args struct {
+		cascade bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       *OrgFeatures
+		wantErr    error
+	}{
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilterError(io.ErrClosedPipe),
+			),
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "instance filter error cascaded",
+			args: args{true},
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectFilterError(io.ErrClosedPipe),
+			),
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "no features set, not cascaded",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			want: &OrgFeatures{
+				Details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "features set, not cascaded",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgUserSchemaEventType, true,
+					)),
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, false,
+					)),
+				),
+			),
+			want: &OrgFeatures{
+				Details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				UserSchema: FeatureSource[bool]{
+					Level: feature.LevelOrg,
+					Value: true,
+				},
+				TokenExchange: FeatureSource[bool]{
+					Level: feature.LevelOrg,
+					Value: false,
+				},
+			},
+		},
+		{
+			name: "features set, reset, set some feature, cascaded",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgUserSchemaEventType, true,
+					)),
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, true,
+					)),
+					eventFromEventPusher(feature_v2.NewResetEvent(
+						ctx, aggregate,
+						feature_v2.OrgResetEventType,
+					)),
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, aggregate,
+						feature_v2.OrgTokenExchangeEventType, false,
+					)),
+				),
+				expectFilter(eventFromEventPusher(feature_v2.NewSetEvent[bool](
+					context.Background(), feature_v2.NewAggregate("SYSTEM", "SYSTEM"),
+					feature_v2.SystemTokenExchangeEventType, true,
+				))),
+				expectFilter(
+					eventFromEventPusher(feature_v2.NewSetEvent[bool](
+						ctx, instanceAggregate,
+						feature_v2.InstanceUserSchemaEventType, true,
+					)),
+				),
+			),
+			args: args{true},
+			want: &OrgFeatures{
+				Details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				UserSchema: FeatureSource[bool]{
+					Level: feature.LevelInstance,
+					Value: true,
+				},
+				TokenExchange: FeatureSource[bool]{
+					Level: feature.LevelOrg,
+					Value: false,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Queries{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := q.GetOrgFeatures(ctx, "org1", tt.args.cascade)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ProjectFeatures struct {
+	Details       *domain.ObjectDetails
+	TokenExchange FeatureSource[bool]
+}
+
+// GetProjectFeatures returns the features set on the project.
+// If cascade is set, unset features are inherited from the organization, instance and system level.
+func (q *Queries) GetProjectFeatures(ctx context.Context, projectID string, cascade bool) (_ *ProjectFeatures, err error) {
+	m := NewProjectFeaturesReadModel(projectID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, m); err != nil {
+		return nil, err
+	}
+	if m.ResourceOwner == "" {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ree4i", "Errors.Project.NotFound")
+	}
+	m.project.Details = readModelToObjectDetails(m.ReadModel)
+	if !cascade {
+		return m.project, nil
+	}
+	org, err := q.GetOrgFeatures(ctx, m.ResourceOwner, true)
+	if err != nil {
+		return nil, err
+	}
+	m.project.inherit(org)
+	return m.project, nil
+}
+
+func (f *ProjectFeatures) inherit(org *OrgFeatures) {
+	inheritFeature(&f.TokenExchange, org.TokenExchange)
+}
@@ -0,0 +1,77 @@
+package query
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectFeaturesReadModel struct {
+	*eventstore.ReadModel
+	project *ProjectFeatures
+}
+
+func NewProjectFeaturesReadModel(projectID string) *ProjectFeaturesReadModel {
+	return &ProjectFeaturesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID: projectID,
+		},
+		project: new(ProjectFeatures),
+	}
+}
+
+func (m *ProjectFeaturesReadModel) Reduce() (err error) {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			// the project is only queried to determine its organization
+			m.ResourceOwner = e.Aggregate().ResourceOwner
+		case *feature_v2.ResetEvent:
+			m.reduceReset()
+		case *feature_v2.SetEvent[bool]:
+			err = m.reduceBoolFeature(e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return m.ReadModel.Reduce()
+}
+
+func (m *ProjectFeaturesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(project.ProjectAddedType).
+		Builder().
+		AddQuery().
+		AggregateTypes(feature_v2.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			feature_v2.ProjectResetEventType,
+			feature_v2.ProjectTokenExchangeEventType,
+		).
+		Builder()
+}
+
+func (m *ProjectFeaturesReadModel) reduceReset() {
+	m.project = new(ProjectFeatures)
+}
+
+func (m *ProjectFeaturesReadModel) reduceBoolFeature(event *feature_v2.SetEvent[bool]) error {
+	level, key, err := event.FeatureInfo()
+	if err != nil {
+		return err
+	}
+	if key != feature.KeyTokenExchange {
+		return nil
+	}
+	m.project.TokenExchange = FeatureSource[bool]{
+		Level: level,
+		Value: event.Value,
+	}
+	return nil
+}
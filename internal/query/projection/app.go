@@ -15,7 +15,7 @@ import (
 )
 
 const (
-	AppProjectionTable = "projections.apps6"
+	AppProjectionTable = "projections.apps11"
 	AppAPITable        = AppProjectionTable + "_" + appAPITableSuffix
 	AppOIDCTable       = AppProjectionTable + "_" + appOIDCTableSuffix
 	AppSAMLTable       = AppProjectionTable + "_" + appSAMLTableSuffix
@@ -30,33 +30,41 @@ const (
 	AppColumnState         = "state"
 	AppColumnSequence      = "sequence"
 
-	appAPITableSuffix              = "api_configs"
-	AppAPIConfigColumnAppID        = "app_id"
-	AppAPIConfigColumnInstanceID   = "instance_id"
-	AppAPIConfigColumnClientID     = "client_id"
-	AppAPIConfigColumnClientSecret = "client_secret"
-	AppAPIConfigColumnAuthMethod   = "auth_method"
-
-	appOIDCTableSuffix                          = "oidc_configs"
-	AppOIDCConfigColumnAppID                    = "app_id"
-	AppOIDCConfigColumnInstanceID               = "instance_id"
-	AppOIDCConfigColumnVersion                  = "version"
-	AppOIDCConfigColumnClientID                 = "client_id"
-	AppOIDCConfigColumnClientSecret             = "client_secret"
-	AppOIDCConfigColumnRedirectUris             = "redirect_uris"
-	AppOIDCConfigColumnResponseTypes            = "response_types"
-	AppOIDCConfigColumnGrantTypes               = "grant_types"
-	AppOIDCConfigColumnApplicationType          = "application_type"
-	AppOIDCConfigColumnAuthMethodType           = "auth_method_type"
-	AppOIDCConfigColumnPostLogoutRedirectUris   = "post_logout_redirect_uris"
-	AppOIDCConfigColumnDevMode                  = "is_dev_mode"
-	AppOIDCConfigColumnAccessTokenType          = "access_token_type"
-	AppOIDCConfigColumnAccessTokenRoleAssertion = "access_token_role_assertion"
-	AppOIDCConfigColumnIDTokenRoleAssertion     = "id_token_role_assertion"
-	AppOIDCConfigColumnIDTokenUserinfoAssertion = "id_token_userinfo_assertion"
-	AppOIDCConfigColumnClockSkew                = "clock_skew"
-	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
-	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
+	appAPITableSuffix                        = "api_configs"
+	AppAPIConfigColumnAppID                  = "app_id"
+	AppAPIConfigColumnInstanceID             = "instance_id"
+	AppAPIConfigColumnClientID               = "client_id"
+	AppAPIConfigColumnClientSecret           = "client_secret"
+	AppAPIConfigColumnAuthMethod             = "auth_method"
+	AppAPIConfigColumnTLSClientAuthSubjectDN = "tls_client_auth_subject_dn"
+
+	appOIDCTableSuffix                                       = "oidc_configs"
+	AppOIDCConfigColumnAppID                                 = "app_id"
+	AppOIDCConfigColumnInstanceID                            = "instance_id"
+	AppOIDCConfigColumnVersion                               = "version"
+	AppOIDCConfigColumnClientID                              = "client_id"
+	AppOIDCConfigColumnClientSecret                          = "client_secret"
+	AppOIDCConfigColumnRedirectUris                          = "redirect_uris"
+	AppOIDCConfigColumnResponseTypes                         = "response_types"
+	AppOIDCConfigColumnGrantTypes                            = "grant_types"
+	AppOIDCConfigColumnApplicationType                       = "application_type"
+	AppOIDCConfigColumnAuthMethodType                        = "auth_method_type"
+	AppOIDCConfigColumnPostLogoutRedirectUris                = "post_logout_redirect_uris"
+	AppOIDCConfigColumnDevMode                               = "is_dev_mode"
+	AppOIDCConfigColumnAccessTokenType                       = "access_token_type"
+	AppOIDCConfigColumnAccessTokenRoleAssertion              = "access_token_role_assertion"
+	AppOIDCConfigColumnIDTokenRoleAssertion                  = "id_token_role_assertion"
+	AppOIDCConfigColumnIDTokenUserinfoAssertion              = "id_token_userinfo_assertion"
+	AppOIDCConfigColumnClockSkew                             = "clock_skew"
+	AppOIDCConfigColumnAdditionalOrigins                     = "additional_origins"
+	AppOIDCConfigColumnSkipNativeAppSuccessPage              = "skip_native_app_success_page"
+	AppOIDCConfigColumnBackChannelLogoutURI                  = "back_channel_logout_uri"
+	AppOIDCConfigColumnFrontChannelLogoutURI                 = "front_channel_logout_uri"
+	AppOIDCConfigColumnRequirePushedAuthRequest              = "require_pushed_auth_request"
+	AppOIDCConfigColumnRequireRequestObject                  = "require_request_object"
+	AppOIDCConfigColumnRequireDPoP                           = "require_dpop"
+	AppOIDCConfigColumnTLSClientAuthSubjectDN                = "tls_client_auth_subject_dn"
+	AppOIDCConfigColumnBackChannelClientNotificationEndpoint = "back_channel_client_notification_endpoint"
 
 	appSAMLTableSuffix             = "saml_configs"
 	AppSAMLConfigColumnAppID       = "app_id"
@@ -98,6 +106,7 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppAPIConfigColumnClientID, handler.ColumnTypeText),
 			handler.NewColumn(AppAPIConfigColumnClientSecret, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(AppAPIConfigColumnAuthMethod, handler.ColumnTypeEnum),
+			handler.NewColumn(AppAPIConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Default("")),
 		},
 			handler.NewPrimaryKey(AppAPIConfigColumnInstanceID, AppAPIConfigColumnAppID),
 			appAPITableSuffix,
@@ -124,6 +133,13 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnClockSkew, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(AppOIDCConfigColumnAdditionalOrigins, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnSkipNativeAppSuccessPage, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnFrontChannelLogoutURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnRequirePushedAuthRequest, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRequireRequestObject, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRequireDPoP, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelClientNotificationEndpoint, handler.ColumnTypeText, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -352,6 +368,7 @@ func (p *appProjection) reduceAPIConfigAdded(event eventstore.Event) (*handler.S
 				handler.NewCol(AppAPIConfigColumnClientID, e.ClientID),
 				handler.NewCol(AppAPIConfigColumnClientSecret, e.ClientSecret),
 				handler.NewCol(AppAPIConfigColumnAuthMethod, e.AuthMethodType),
+				handler.NewCol(AppAPIConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
 			},
 			handler.WithTableSuffix(appAPITableSuffix),
 		),
@@ -373,13 +390,16 @@ func (p *appProjection) reduceAPIConfigChanged(event eventstore.Event) (*handler
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-vnZKi", "reduce.wrong.event.type %s", project.APIConfigChangedType)
 	}
-	cols := make([]handler.Column, 0, 2)
+	cols := make([]handler.Column, 0, 3)
 	if e.ClientSecret != nil {
 		cols = append(cols, handler.NewCol(AppAPIConfigColumnClientSecret, e.ClientSecret))
 	}
 	if e.AuthMethodType != nil {
 		cols = append(cols, handler.NewCol(AppAPIConfigColumnAuthMethod, *e.AuthMethodType))
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppAPIConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
 	}
@@ -464,6 +484,13 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnClockSkew, e.ClockSkew),
 				handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.TextArray[string](e.AdditionalOrigins)),
 				handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, e.SkipNativeAppSuccessPage),
+				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
+				handler.NewCol(AppOIDCConfigColumnFrontChannelLogoutURI, e.FrontChannelLogoutURI),
+				handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequest, e.RequirePushedAuthRequest),
+				handler.NewCol(AppOIDCConfigColumnRequireRequestObject, e.RequireRequestObject),
+				handler.NewCol(AppOIDCConfigColumnRequireDPoP, e.RequireDPoP),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
+				handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationEndpoint, e.BackChannelClientNotificationEndpoint),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -486,7 +513,7 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-GNHU1", "reduce.wrong.event.type %s", project.OIDCConfigChangedType)
 	}
 
-	cols := make([]handler.Column, 0, 15)
+	cols := make([]handler.Column, 0, 21)
 	if e.Version != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnVersion, *e.Version))
 	}
@@ -532,6 +559,27 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.SkipNativeAppSuccessPage != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, *e.SkipNativeAppSuccessPage))
 	}
+	if e.BackChannelLogoutURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, *e.BackChannelLogoutURI))
+	}
+	if e.FrontChannelLogoutURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnFrontChannelLogoutURI, *e.FrontChannelLogoutURI))
+	}
+	if e.RequirePushedAuthRequest != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequest, *e.RequirePushedAuthRequest))
+	}
+	if e.RequireRequestObject != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequireRequestObject, *e.RequireRequestObject))
+	}
+	if e.RequireDPoP != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequireDPoP, *e.RequireDPoP))
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
+	if e.BackChannelClientNotificationEndpoint != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationEndpoint, *e.BackChannelClientNotificationEndpoint))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
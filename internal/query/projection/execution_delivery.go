@@ -0,0 +1,227 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/executiondelivery"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/target"
+)
+
+const (
+	ExecutionDeliveryTable             = "projections.execution_deliveries"
+	ExecutionDeliveryIDCol             = "id"
+	ExecutionDeliveryCreationDateCol   = "creation_date"
+	ExecutionDeliveryChangeDateCol     = "change_date"
+	ExecutionDeliveryResourceOwnerCol  = "resource_owner"
+	ExecutionDeliveryInstanceIDCol     = "instance_id"
+	ExecutionDeliverySequenceCol       = "sequence"
+	ExecutionDeliveryTargetIDCol       = "target_id"
+	ExecutionDeliveryExecutionIDCol    = "execution_id"
+	ExecutionDeliveryEventTypeCol      = "event_type"
+	ExecutionDeliveryAggregateTypeCol  = "aggregate_type"
+	ExecutionDeliveryAggregateIDCol    = "aggregate_id"
+	ExecutionDeliveryBodyCol           = "body"
+	ExecutionDeliveryStateCol          = "state"
+	ExecutionDeliveryAttemptsCol       = "attempts"
+	ExecutionDeliveryNextAttemptCol    = "next_attempt"
+	ExecutionDeliveryLastErrorCol      = "last_error"
+	ExecutionDeliveryLastStatusCodeCol = "last_status_code"
+)
+
+type executionDeliveryProjection struct{}
+
+func newExecutionDeliveryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(executionDeliveryProjection))
+}
+
+func (*executionDeliveryProjection) Name() string {
+	return ExecutionDeliveryTable
+}
+
+func (*executionDeliveryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ExecutionDeliveryIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliveryCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ExecutionDeliveryChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ExecutionDeliveryResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliveryInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliverySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ExecutionDeliveryTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliveryExecutionIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliveryEventTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliveryAggregateTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliveryAggregateIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionDeliveryBodyCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(ExecutionDeliveryStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(ExecutionDeliveryAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(ExecutionDeliveryNextAttemptCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(ExecutionDeliveryLastErrorCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(ExecutionDeliveryLastStatusCodeCol, handler.ColumnTypeInt64, handler.Default(0)),
+		},
+			handler.NewPrimaryKey(ExecutionDeliveryInstanceIDCol, ExecutionDeliveryIDCol),
+			handler.WithIndex(handler.NewIndex("due", []string{ExecutionDeliveryStateCol, ExecutionDeliveryNextAttemptCol})),
+			handler.WithIndex(handler.NewIndex("target_id", []string{ExecutionDeliveryTargetIDCol})),
+		),
+	)
+}
+
+func (p *executionDeliveryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: executiondelivery.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  executiondelivery.QueuedEventType,
+					Reduce: p.reduceQueued,
+				},
+				{
+					Event:  executiondelivery.SucceededEventType,
+					Reduce: p.reduceSucceeded,
+				},
+				{
+					Event:  executiondelivery.FailedEventType,
+					Reduce: p.reduceFailed,
+				},
+				{
+					Event:  executiondelivery.DeadLetteredEventType,
+					Reduce: p.reduceDeadLettered,
+				},
+				{
+					Event:  executiondelivery.ReplayedEventType,
+					Reduce: p.reduceReplayed,
+				},
+			},
+		},
+		{
+			Aggregate: target.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  target.RemovedEventType,
+					Reduce: p.reduceTargetRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ExecutionDeliveryInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *executionDeliveryProjection) reduceQueued(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*executiondelivery.QueuedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ExecutionDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(ExecutionDeliveryResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(ExecutionDeliveryIDCol, e.Aggregate().ID),
+			handler.NewCol(ExecutionDeliveryCreationDateCol, e.CreatedAt()),
+			handler.NewCol(ExecutionDeliveryChangeDateCol, e.CreatedAt()),
+			handler.NewCol(ExecutionDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(ExecutionDeliveryTargetIDCol, e.TargetID),
+			handler.NewCol(ExecutionDeliveryExecutionIDCol, e.ExecutionID),
+			handler.NewCol(ExecutionDeliveryEventTypeCol, e.EventType),
+			handler.NewCol(ExecutionDeliveryAggregateTypeCol, e.AggregateType),
+			handler.NewCol(ExecutionDeliveryAggregateIDCol, e.AggregateID),
+			handler.NewCol(ExecutionDeliveryBodyCol, e.Body),
+			handler.NewCol(ExecutionDeliveryStateCol, domain.ExecutionDeliveryStatePending),
+			handler.NewCol(ExecutionDeliveryNextAttemptCol, e.CreatedAt()),
+		},
+	), nil
+}
+
+func (p *executionDeliveryProjection) reduceSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*executiondelivery.SucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(ExecutionDeliveryStateCol, domain.ExecutionDeliveryStateSucceeded),
+		handler.NewCol(ExecutionDeliveryAttemptsCol, e.Attempt),
+		handler.NewCol(ExecutionDeliveryNextAttemptCol, nil),
+		handler.NewCol(ExecutionDeliveryLastErrorCol, ""),
+		handler.NewCol(ExecutionDeliveryLastStatusCodeCol, e.StatusCode),
+	), nil
+}
+
+func (p *executionDeliveryProjection) reduceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*executiondelivery.FailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(ExecutionDeliveryAttemptsCol, e.Attempt),
+		handler.NewCol(ExecutionDeliveryNextAttemptCol, e.NextAttempt),
+		handler.NewCol(ExecutionDeliveryLastErrorCol, e.Error),
+		handler.NewCol(ExecutionDeliveryLastStatusCodeCol, e.StatusCode),
+	), nil
+}
+
+func (p *executionDeliveryProjection) reduceDeadLettered(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*executiondelivery.DeadLetteredEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(ExecutionDeliveryStateCol, domain.ExecutionDeliveryStateDeadLetter),
+		handler.NewCol(ExecutionDeliveryAttemptsCol, e.Attempt),
+		handler.NewCol(ExecutionDeliveryNextAttemptCol, nil),
+		handler.NewCol(ExecutionDeliveryLastErrorCol, e.Error),
+		handler.NewCol(ExecutionDeliveryLastStatusCodeCol, e.StatusCode),
+	), nil
+}
+
+func (p *executionDeliveryProjection) reduceReplayed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*executiondelivery.ReplayedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(ExecutionDeliveryStateCol, domain.ExecutionDeliveryStatePending),
+		handler.NewCol(ExecutionDeliveryAttemptsCol, 0),
+		handler.NewCol(ExecutionDeliveryNextAttemptCol, e.CreatedAt()),
+	), nil
+}
+
+func (p *executionDeliveryProjection) updateStatement(e eventstore.Event, values ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		e,
+		append([]handler.Column{
+			handler.NewCol(ExecutionDeliveryChangeDateCol, e.CreatedAt()),
+			handler.NewCol(ExecutionDeliverySequenceCol, e.Sequence()),
+		}, values...),
+		[]handler.Condition{
+			handler.NewCond(ExecutionDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ExecutionDeliveryIDCol, e.Aggregate().ID),
+		},
+	)
+}
+
+func (p *executionDeliveryProjection) reduceTargetRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*target.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ExecutionDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ExecutionDeliveryTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,280 @@
+package projection
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/executiondelivery"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestExecutionDeliveryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceQueued",
+			args: args{
+				event: getEvent(
+					testEvent(
+						executiondelivery.QueuedEventType,
+						executiondelivery.AggregateType,
+						[]byte(`{"targetId": "target", "executionId": "event", "eventType": "user.human.added", "aggregateType": "user", "aggregateId": "user", "body": {"aggregateID": "user"}}`),
+					),
+					eventstore.GenericEventMapper[executiondelivery.QueuedEvent],
+				),
+			},
+			reduce: (&executionDeliveryProjection{}).reduceQueued,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("execution_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.execution_deliveries (instance_id, resource_owner, id, creation_date, change_date, sequence, target_id, execution_id, event_type, aggregate_type, aggregate_id, body, state, next_attempt) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"target",
+								"event",
+								"user.human.added",
+								"user",
+								"user",
+								json.RawMessage(`{"aggregateID": "user"}`),
+								domain.ExecutionDeliveryStatePending,
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						executiondelivery.SucceededEventType,
+						executiondelivery.AggregateType,
+						[]byte(`{"attempt": 1, "statusCode": 200}`),
+					),
+					eventstore.GenericEventMapper[executiondelivery.SucceededEvent],
+				),
+			},
+			reduce: (&executionDeliveryProjection{}).reduceSucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("execution_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.execution_deliveries SET (change_date, sequence, state, attempts, next_attempt, last_error, last_status_code) = ($1, $2, $3, $4, $5, $6, $7) WHERE (instance_id = $8) AND (id = $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.ExecutionDeliveryStateSucceeded,
+								uint16(1),
+								nil,
+								"",
+								200,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						executiondelivery.FailedEventType,
+						executiondelivery.AggregateType,
+						[]byte(`{"attempt": 2, "statusCode": 500, "error": "internal server error", "nextAttempt": "2024-01-01T00:00:00Z"}`),
+					),
+					eventstore.GenericEventMapper[executiondelivery.FailedEvent],
+				),
+			},
+			reduce: (&executionDeliveryProjection{}).reduceFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("execution_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.execution_deliveries SET (change_date, sequence, attempts, next_attempt, last_error, last_status_code) = ($1, $2, $3, $4, $5, $6) WHERE (instance_id = $7) AND (id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								uint16(2),
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								"internal server error",
+								500,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeadLettered",
+			args: args{
+				event: getEvent(
+					testEvent(
+						executiondelivery.DeadLetteredEventType,
+						executiondelivery.AggregateType,
+						[]byte(`{"attempt": 5, "error": "connection refused"}`),
+					),
+					eventstore.GenericEventMapper[executiondelivery.DeadLetteredEvent],
+				),
+			},
+			reduce: (&executionDeliveryProjection{}).reduceDeadLettered,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("execution_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.execution_deliveries SET (change_date, sequence, state, attempts, next_attempt, last_error, last_status_code) = ($1, $2, $3, $4, $5, $6, $7) WHERE (instance_id = $8) AND (id = $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.ExecutionDeliveryStateDeadLetter,
+								uint16(5),
+								nil,
+								"connection refused",
+								0,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceReplayed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						executiondelivery.ReplayedEventType,
+						executiondelivery.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[executiondelivery.ReplayedEvent],
+				),
+			},
+			reduce: (&executionDeliveryProjection{}).reduceReplayed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("execution_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.execution_deliveries SET (change_date, sequence, state, attempts, next_attempt) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.ExecutionDeliveryStatePending,
+								0,
+								anyArg{},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.RemovedEventType,
+						target.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[target.RemovedEvent],
+				),
+			},
+			reduce: (&executionDeliveryProjection{}).reduceTargetRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.execution_deliveries WHERE (instance_id = $1) AND (target_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(ExecutionDeliveryInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.execution_deliveries WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ExecutionDeliveryTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	LDAPSyncTable    = "projections.ldap_syncs"
+	LDAPSyncRunTable = LDAPSyncTable + "_" + ldapSyncRunTableSuffix
+
+	LDAPSyncIDPIDCol             = "idp_id"
+	LDAPSyncInstanceIDCol        = "instance_id"
+	LDAPSyncResourceOwnerCol     = "resource_owner"
+	LDAPSyncCreationDateCol      = "creation_date"
+	LDAPSyncChangeDateCol        = "change_date"
+	LDAPSyncSequenceCol          = "sequence"
+	LDAPSyncOrgIDCol             = "org_id"
+	LDAPSyncIntervalCol          = "sync_interval"
+	LDAPSyncGroupsAttributeCol   = "groups_attribute"
+	LDAPSyncDeactivateMissingCol = "deactivate_missing"
+	LDAPSyncGroupRoleMappingsCol = "group_role_mappings"
+	LDAPSyncLastRunIDCol         = "last_run_id"
+	LDAPSyncLastRunStateCol      = "last_run_state"
+	LDAPSyncLastRunStartedCol    = "last_run_started"
+	LDAPSyncLastRunFinishedCol   = "last_run_finished"
+
+	ldapSyncRunTableSuffix         = "runs"
+	LDAPSyncRunIDCol               = "id"
+	LDAPSyncRunIDPIDCol            = "idp_id"
+	LDAPSyncRunInstanceIDCol       = "instance_id"
+	LDAPSyncRunStateCol            = "state"
+	LDAPSyncRunStartedCol          = "started"
+	LDAPSyncRunFinishedCol         = "finished"
+	LDAPSyncRunUsersCreatedCol     = "users_created"
+	LDAPSyncRunUsersUpdatedCol     = "users_updated"
+	LDAPSyncRunUsersDeactivatedCol = "users_deactivated"
+	LDAPSyncRunUsersReactivatedCol = "users_reactivated"
+	LDAPSyncRunGrantsChangedCol    = "grants_changed"
+	LDAPSyncRunErrorsCol           = "errors"
+)
+
+type ldapSyncProjection struct{}
+
+func newLDAPSyncProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(ldapSyncProjection))
+}
+
+func (*ldapSyncProjection) Name() string {
+	return LDAPSyncTable
+}
+
+func (*ldapSyncProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(LDAPSyncIDPIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LDAPSyncChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LDAPSyncSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(LDAPSyncOrgIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncIntervalCol, handler.ColumnTypeInterval),
+			handler.NewColumn(LDAPSyncGroupsAttributeCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(LDAPSyncDeactivateMissingCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(LDAPSyncGroupRoleMappingsCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LDAPSyncLastRunIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(LDAPSyncLastRunStateCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(LDAPSyncLastRunStartedCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(LDAPSyncLastRunFinishedCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(LDAPSyncInstanceIDCol, LDAPSyncIDPIDCol),
+			handler.WithIndex(handler.NewIndex("org_id", []string{LDAPSyncOrgIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(LDAPSyncRunIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncRunIDPIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncRunInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncRunStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(LDAPSyncRunStartedCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LDAPSyncRunFinishedCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(LDAPSyncRunUsersCreatedCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LDAPSyncRunUsersUpdatedCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LDAPSyncRunUsersDeactivatedCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LDAPSyncRunUsersReactivatedCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LDAPSyncRunGrantsChangedCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LDAPSyncRunErrorsCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(LDAPSyncRunInstanceIDCol, LDAPSyncRunIDPIDCol, LDAPSyncRunIDCol),
+			ldapSyncRunTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+	)
+}
+
+func (p *ldapSyncProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: ldapsync.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  ldapsync.SetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  ldapsync.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  ldapsync.RunStartedEventType,
+					Reduce: p.reduceRunStarted,
+				},
+				{
+					Event:  ldapsync.RunSucceededEventType,
+					Reduce: p.reduceRunSucceeded,
+				},
+				{
+					Event:  ldapsync.RunFailedEventType,
+					Reduce: p.reduceRunFailed,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.IDPRemovedEventType,
+					Reduce: p.reduceIDPRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(LDAPSyncInstanceIDCol),
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *ldapSyncProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.SetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns := []handler.Column{
+		handler.NewCol(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCol(LDAPSyncIDPIDCol, e.Aggregate().ID),
+		handler.NewCol(LDAPSyncResourceOwnerCol, e.Aggregate().ResourceOwner),
+		handler.NewCol(LDAPSyncCreationDateCol, handler.OnlySetValueOnInsert(LDAPSyncTable, e.CreatedAt())),
+		handler.NewCol(LDAPSyncChangeDateCol, e.CreatedAt()),
+		handler.NewCol(LDAPSyncSequenceCol, e.Sequence()),
+		handler.NewCol(LDAPSyncOrgIDCol, e.OrgID),
+		handler.NewCol(LDAPSyncIntervalCol, e.Interval),
+		handler.NewCol(LDAPSyncGroupsAttributeCol, e.GroupsAttribute),
+		handler.NewCol(LDAPSyncDeactivateMissingCol, e.DeactivateMissing),
+		handler.NewJSONCol(LDAPSyncGroupRoleMappingsCol, e.GroupRoleMappings),
+	}
+	return handler.NewUpsertStatement(e, columns[0:2], columns), nil
+}
+
+func (p *ldapSyncProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(LDAPSyncIDPIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *ldapSyncProjection) reduceRunStarted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RunStartedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(LDAPSyncRunInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(LDAPSyncRunIDPIDCol, e.Aggregate().ID),
+				handler.NewCol(LDAPSyncRunIDCol, e.RunID),
+				handler.NewCol(LDAPSyncRunStateCol, domain.LDAPSyncRunStateRunning),
+				handler.NewCol(LDAPSyncRunStartedCol, e.CreatedAt()),
+			},
+			handler.WithTableSuffix(ldapSyncRunTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(LDAPSyncChangeDateCol, e.CreatedAt()),
+				handler.NewCol(LDAPSyncSequenceCol, e.Sequence()),
+				handler.NewCol(LDAPSyncLastRunIDCol, e.RunID),
+				handler.NewCol(LDAPSyncLastRunStateCol, domain.LDAPSyncRunStateRunning),
+				handler.NewCol(LDAPSyncLastRunStartedCol, e.CreatedAt()),
+				handler.NewCol(LDAPSyncLastRunFinishedCol, nil),
+			},
+			ldapSyncConditions(e),
+		),
+	), nil
+}
+
+func (p *ldapSyncProjection) reduceRunSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RunSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.runFinishedStatement(e, e.RunID, domain.LDAPSyncRunStateSucceeded,
+		handler.NewCol(LDAPSyncRunUsersCreatedCol, e.UsersCreated),
+		handler.NewCol(LDAPSyncRunUsersUpdatedCol, e.UsersUpdated),
+		handler.NewCol(LDAPSyncRunUsersDeactivatedCol, e.UsersDeactivated),
+		handler.NewCol(LDAPSyncRunUsersReactivatedCol, e.UsersReactivated),
+		handler.NewCol(LDAPSyncRunGrantsChangedCol, e.GrantsChanged),
+		handler.NewCol(LDAPSyncRunErrorsCol, database.TextArray[string](e.Errors)),
+	), nil
+}
+
+func (p *ldapSyncProjection) reduceRunFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RunFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.runFinishedStatement(e, e.RunID, domain.LDAPSyncRunStateFailed,
+		handler.NewCol(LDAPSyncRunErrorsCol, database.TextArray[string]{e.Error}),
+	), nil
+}
+
+func (p *ldapSyncProjection) runFinishedStatement(e eventstore.Event, runID string, state domain.LDAPSyncRunState, values ...handler.Column) *handler.Statement {
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			append([]handler.Column{
+				handler.NewCol(LDAPSyncRunStateCol, state),
+				handler.NewCol(LDAPSyncRunFinishedCol, e.CreatedAt()),
+			}, values...),
+			[]handler.Condition{
+				handler.NewCond(LDAPSyncRunInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(LDAPSyncRunIDPIDCol, e.Aggregate().ID),
+				handler.NewCond(LDAPSyncRunIDCol, runID),
+			},
+			handler.WithTableSuffix(ldapSyncRunTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(LDAPSyncChangeDateCol, e.CreatedAt()),
+				handler.NewCol(LDAPSyncSequenceCol, e.Sequence()),
+				handler.NewCol(LDAPSyncLastRunStateCol, state),
+				handler.NewCol(LDAPSyncLastRunFinishedCol, e.CreatedAt()),
+			},
+			ldapSyncConditions(e),
+		),
+	)
+}
+
+func ldapSyncConditions(e eventstore.Event) []handler.Condition {
+	return []handler.Condition{
+		handler.NewCond(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCond(LDAPSyncIDPIDCol, e.Aggregate().ID),
+	}
+}
+
+func (p *ldapSyncProjection) reduceIDPRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.IDPRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(LDAPSyncIDPIDCol, e.ID),
+		},
+	), nil
+}
+
+func (p *ldapSyncProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(LDAPSyncOrgIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
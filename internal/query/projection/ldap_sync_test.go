@@ -0,0 +1,235 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestLDAPSyncProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RemovedEventType,
+						ldapsync.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.RemovedEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("ldap_sync"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.ldap_syncs WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRunStarted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RunStartedEventType,
+						ldapsync.AggregateType,
+						[]byte(`{"runId": "run1"}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.RunStartedEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRunStarted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("ldap_sync"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.ldap_syncs_runs (instance_id, idp_id, id, state, started) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"run1",
+								domain.LDAPSyncRunStateRunning,
+								anyArg{},
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs SET (change_date, sequence, last_run_id, last_run_state, last_run_started, last_run_finished) = ($1, $2, $3, $4, $5, $6) WHERE (instance_id = $7) AND (idp_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"run1",
+								domain.LDAPSyncRunStateRunning,
+								anyArg{},
+								nil,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRunSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RunSucceededEventType,
+						ldapsync.AggregateType,
+						[]byte(`{"runId": "run1", "usersCreated": 2, "usersDeactivated": 1, "errors": ["user3: email missing"]}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.RunSucceededEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRunSucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("ldap_sync"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs_runs SET (state, finished, users_created, users_updated, users_deactivated, users_reactivated, grants_changed, errors) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (instance_id = $9) AND (idp_id = $10) AND (id = $11)",
+							expectedArgs: []interface{}{
+								domain.LDAPSyncRunStateSucceeded,
+								anyArg{},
+								uint32(2),
+								uint32(0),
+								uint32(1),
+								uint32(0),
+								uint32(0),
+								database.TextArray[string]{"user3: email missing"},
+								"instance-id",
+								"agg-id",
+								"run1",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs SET (change_date, sequence, last_run_state, last_run_finished) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (idp_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.LDAPSyncRunStateSucceeded,
+								anyArg{},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRunFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RunFailedEventType,
+						ldapsync.AggregateType,
+						[]byte(`{"runId": "run1", "error": "connection refused"}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.RunFailedEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRunFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("ldap_sync"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs_runs SET (state, finished, errors) = ($1, $2, $3) WHERE (instance_id = $4) AND (idp_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								domain.LDAPSyncRunStateFailed,
+								anyArg{},
+								database.TextArray[string]{"connection refused"},
+								"instance-id",
+								"agg-id",
+								"run1",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs SET (change_date, sequence, last_run_state, last_run_finished) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (idp_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.LDAPSyncRunStateFailed,
+								anyArg{},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceIDPRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.IDPRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"id": "idp-id"}`),
+					),
+					instance.IDPRemovedEventMapper,
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceIDPRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.ldap_syncs WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"idp-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, LDAPSyncTable, tt.want)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/limits"
 )
@@ -22,6 +23,11 @@ const (
 
 	LimitsColumnAuditLogRetention = "audit_log_retention"
 	LimitsColumnBlock             = "block"
+
+	LimitsColumnRateLimitInstance = "rate_limit_instance"
+	LimitsColumnRateLimitOrg      = "rate_limit_org"
+	LimitsColumnRateLimitClient   = "rate_limit_client"
+	LimitsColumnRateLimitIP       = "rate_limit_ip"
 )
 
 type limitsProjection struct{}
@@ -45,6 +51,10 @@ func (*limitsProjection) Init() *old_handler.Check {
 			handler.NewColumn(LimitsColumnSequence, handler.ColumnTypeInt64),
 			handler.NewColumn(LimitsColumnAuditLogRetention, handler.ColumnTypeInterval, handler.Nullable()),
 			handler.NewColumn(LimitsColumnBlock, handler.ColumnTypeBool, handler.Nullable()),
+			handler.NewColumn(LimitsColumnRateLimitInstance, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LimitsColumnRateLimitOrg, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LimitsColumnRateLimitClient, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LimitsColumnRateLimitIP, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(LimitsColumnInstanceID, LimitsColumnResourceOwner),
 		),
@@ -101,9 +111,22 @@ func (p *limitsProjection) reduceLimitsSet(event eventstore.Event) (*handler.Sta
 	if e.Block != nil {
 		updateCols = append(updateCols, handler.NewCol(LimitsColumnBlock, *e.Block))
 	}
+	if e.RateLimits != nil {
+		updateCols = appendRateLimitCol(updateCols, LimitsColumnRateLimitInstance, e.RateLimits.Instance)
+		updateCols = appendRateLimitCol(updateCols, LimitsColumnRateLimitOrg, e.RateLimits.Org)
+		updateCols = appendRateLimitCol(updateCols, LimitsColumnRateLimitClient, e.RateLimits.Client)
+		updateCols = appendRateLimitCol(updateCols, LimitsColumnRateLimitIP, e.RateLimits.IP)
+	}
 	return handler.NewUpsertStatement(e, conflictCols, updateCols), nil
 }
 
+func appendRateLimitCol(cols []handler.Column, name string, rule *ratelimit.Rule) []handler.Column {
+	if rule == nil {
+		return cols
+	}
+	return append(cols, handler.NewJSONCol(name, rule))
+}
+
 func (p *limitsProjection) reduceLimitsReset(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*limits.ResetEvent](event)
 	if err != nil {
@@ -154,6 +154,43 @@ func TestLimitsProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceLimitsSet rate limits",
+			args: args{
+				event: getEvent(testEvent(
+					limits.SetEventType,
+					limits.AggregateType,
+					[]byte(`{
+							"rateLimits": {
+								"org": {"requestsPerSecond": 10, "burst": 20},
+								"ip": {"requestsPerSecond": 0, "burst": 0}
+							}
+					}`),
+				), limits.SetEventMapper),
+			},
+			reduce: (&limitsProjection{}).reduceLimitsSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("limits"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.limits (instance_id, resource_owner, creation_date, change_date, sequence, aggregate_id, rate_limit_org, rate_limit_ip) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, resource_owner) DO UPDATE SET (creation_date, change_date, sequence, aggregate_id, rate_limit_org, rate_limit_ip) = (projections.limits.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.aggregate_id, EXCLUDED.rate_limit_org, EXCLUDED.rate_limit_ip)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								[]byte(`{"requestsPerSecond":10,"burst":20}`),
+								[]byte(`{"requestsPerSecond":0,"burst":0}`),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceLimitsReset",
 			args: args{
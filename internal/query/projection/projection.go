@@ -74,9 +74,14 @@ var (
 	RestrictionsProjection              *handler.Handler
 	SystemFeatureProjection             *handler.Handler
 	InstanceFeatureProjection           *handler.Handler
+	ResourceFeatureProjection           *handler.Handler
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
 	UserSchemaProjection                *handler.Handler
+	SCIMTargetProjection                *handler.Handler
+	ExecutionDeliveryProjection         *handler.Handler
+	LDAPSyncProjection                  *handler.Handler
+	SAMLSessionProjection               *handler.Handler
 )
 
 type projection interface {
@@ -155,9 +160,14 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	RestrictionsProjection = newRestrictionsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["restrictions"]))
 	SystemFeatureProjection = newSystemFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["system_features"]))
 	InstanceFeatureProjection = newInstanceFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_features"]))
+	ResourceFeatureProjection = newResourceFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["resource_features"]))
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
+	SCIMTargetProjection = newSCIMTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["scim_targets"]))
+	ExecutionDeliveryProjection = newExecutionDeliveryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["execution_deliveries"]))
+	LDAPSyncProjection = newLDAPSyncProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["ldap_syncs"]))
+	SAMLSessionProjection = newSAMLSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["saml_sessions"]))
 	newProjectionsList()
 	return nil
 }
@@ -269,8 +279,13 @@ func newProjectionsList() {
 		RestrictionsProjection,
 		SystemFeatureProjection,
 		InstanceFeatureProjection,
+		ResourceFeatureProjection,
 		ExecutionProjection,
 		TargetProjection,
 		UserSchemaProjection,
+		SCIMTargetProjection,
+		ExecutionDeliveryProjection,
+		LDAPSyncProjection,
+		SAMLSessionProjection,
 	}
 }
@@ -0,0 +1,203 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// ResourceFeatureTable contains the features set on organization, project and application level
+	ResourceFeatureTable = "projections.resource_features"
+
+	ResourceFeatureInstanceIDCol    = "instance_id"
+	ResourceFeatureLevelCol         = "level"
+	ResourceFeatureResourceIDCol    = "resource_id"
+	ResourceFeatureResourceOwnerCol = "resource_owner"
+	ResourceFeatureKeyCol           = "key"
+	ResourceFeatureCreationDateCol  = "creation_date"
+	ResourceFeatureChangeDateCol    = "change_date"
+	ResourceFeatureSequenceCol      = "sequence"
+	ResourceFeatureValueCol         = "value"
+)
+
+type resourceFeatureProjection struct{}
+
+func newResourceFeatureProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(resourceFeatureProjection))
+}
+
+func (*resourceFeatureProjection) Name() string {
+	return ResourceFeatureTable
+}
+
+func (*resourceFeatureProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(handler.NewTable(
+		[]*handler.InitColumn{
+			handler.NewColumn(ResourceFeatureInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ResourceFeatureLevelCol, handler.ColumnTypeText),
+			handler.NewColumn(ResourceFeatureResourceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ResourceFeatureResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ResourceFeatureKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(ResourceFeatureCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ResourceFeatureChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ResourceFeatureSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ResourceFeatureValueCol, handler.ColumnTypeJSONB),
+		},
+		handler.NewPrimaryKey(ResourceFeatureInstanceIDCol, ResourceFeatureLevelCol, ResourceFeatureResourceIDCol, ResourceFeatureKeyCol),
+		handler.WithIndex(handler.NewIndex("resource_owner", []string{ResourceFeatureResourceOwnerCol})),
+	))
+}
+
+func (*resourceFeatureProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: feature_v2.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  feature_v2.OrgResetEventType,
+					Reduce: reduceResourceResetFeatures(feature.LevelOrg),
+				},
+				{
+					Event:  feature_v2.OrgUserSchemaEventType,
+					Reduce: reduceResourceSetFeature[bool],
+				},
+				{
+					Event:  feature_v2.OrgTokenExchangeEventType,
+					Reduce: reduceResourceSetFeature[bool],
+				},
+				{
+					Event:  feature_v2.ProjectResetEventType,
+					Reduce: reduceResourceResetFeatures(feature.LevelProject),
+				},
+				{
+					Event:  feature_v2.ProjectTokenExchangeEventType,
+					Reduce: reduceResourceSetFeature[bool],
+				},
+				{
+					Event:  feature_v2.AppResetEventType,
+					Reduce: reduceResourceResetFeatures(feature.LevelApp),
+				},
+				{
+					Event:  feature_v2.AppTokenExchangeEventType,
+					Reduce: reduceResourceSetFeature[bool],
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: reduceResourceFeaturesOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: reduceResourceFeaturesProjectRemoved,
+				},
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: reduceResourceFeaturesAppRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ResourceFeatureInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func reduceResourceSetFeature[T any](event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*feature_v2.SetEvent[T])
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Eex9i", "reduce.wrong.event.type %T", event)
+	}
+	level, _, err := e.FeatureInfo()
+	if err != nil {
+		return nil, err
+	}
+	f, err := e.FeatureJSON()
+	if err != nil {
+		return nil, err
+	}
+	columns := []handler.Column{
+		handler.NewCol(ResourceFeatureInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCol(ResourceFeatureLevelCol, level.String()),
+		handler.NewCol(ResourceFeatureResourceIDCol, e.Aggregate().ID),
+		handler.NewCol(ResourceFeatureKeyCol, f.Key.String()),
+		handler.NewCol(ResourceFeatureResourceOwnerCol, e.Aggregate().ResourceOwner),
+		handler.NewCol(ResourceFeatureCreationDateCol, handler.OnlySetValueOnInsert(ResourceFeatureTable, e.CreationDate())),
+		handler.NewCol(ResourceFeatureChangeDateCol, e.CreationDate()),
+		handler.NewCol(ResourceFeatureSequenceCol, e.Sequence()),
+		handler.NewCol(ResourceFeatureValueCol, f.Value),
+	}
+	return handler.NewUpsertStatement(e, columns[0:4], columns), nil
+}
+
+func reduceResourceResetFeatures(level feature.Level) handler.Reduce {
+	return func(event eventstore.Event) (*handler.Statement, error) {
+		e, ok := event.(*feature_v2.ResetEvent)
+		if !ok {
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-ahR0o", "reduce.wrong.event.type %T", event)
+		}
+		return handler.NewDeleteStatement(e, []handler.Condition{
+			handler.NewCond(ResourceFeatureInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ResourceFeatureLevelCol, level.String()),
+			handler.NewCond(ResourceFeatureResourceIDCol, e.Aggregate().ID),
+		}), nil
+	}
+}
+
+func reduceResourceFeaturesOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Chee3", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(e, []handler.Condition{
+		handler.NewCond(ResourceFeatureInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCond(ResourceFeatureResourceOwnerCol, e.Aggregate().ID),
+	}), nil
+}
+
+func reduceResourceFeaturesProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ProjectRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ahj3e", "reduce.wrong.event.type %s", project.ProjectRemovedType)
+	}
+	return handler.NewDeleteStatement(e, []handler.Condition{
+		handler.NewCond(ResourceFeatureInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCond(ResourceFeatureLevelCol, feature.LevelProject.String()),
+		handler.NewCond(ResourceFeatureResourceIDCol, e.Aggregate().ID),
+	}), nil
+}
+
+func reduceResourceFeaturesAppRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ApplicationRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Iez7a", "reduce.wrong.event.type %s", project.ApplicationRemovedType)
+	}
+	return handler.NewDeleteStatement(e, []handler.Condition{
+		handler.NewCond(ResourceFeatureInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCond(ResourceFeatureLevelCol, feature.LevelApp.String()),
+		handler.NewCond(ResourceFeatureResourceIDCol, e.AppID),
+	}), nil
+}
@@ -0,0 +1,184 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/feature/feature_v2"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestResourceFeaturesProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceResourceSetFeature",
+			args: args{
+				event: getEvent(
+					testEvent(
+						feature_v2.OrgTokenExchangeEventType,
+						feature_v2.AggregateType,
+						[]byte(`{"value": true}`),
+					), eventstore.GenericEventMapper[feature_v2.SetEvent[bool]]),
+			},
+			reduce: reduceResourceSetFeature[bool],
+			want: wantReduce{
+				aggregateType: feature_v2.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.resource_features (instance_id, level, resource_id, key, resource_owner, creation_date, change_date, sequence, value) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, level, resource_id, key) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, value) = (EXCLUDED.resource_owner, projections.resource_features.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.value)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"org",
+								"agg-id",
+								"token_exchange",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								[]byte("true"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResourceResetFeatures",
+			args: args{
+				event: getEvent(
+					testEvent(
+						feature_v2.AppResetEventType,
+						feature_v2.AggregateType,
+						[]byte{},
+					), eventstore.GenericEventMapper[feature_v2.ResetEvent]),
+			},
+			reduce: reduceResourceResetFeatures(feature.LevelApp),
+			want: wantReduce{
+				aggregateType: feature_v2.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.resource_features WHERE (instance_id = $1) AND (level = $2) AND (resource_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResourceFeaturesOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: reduceResourceFeaturesOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.resource_features WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResourceFeaturesProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						[]byte(`{}`),
+					), project.ProjectRemovedEventMapper),
+			},
+			reduce: reduceResourceFeaturesProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.resource_features WHERE (instance_id = $1) AND (level = $2) AND (resource_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"project",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResourceFeaturesAppRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationRemovedType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id"}`),
+					), project.ApplicationRemovedEventMapper),
+			},
+			reduce: reduceResourceFeaturesAppRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.resource_features WHERE (instance_id = $1) AND (level = $2) AND (resource_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app",
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ResourceFeatureTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	SAMLSessionTable = "projections.saml_sessions"
+
+	SAMLSessionIDCol                = "id"
+	SAMLSessionInstanceIDCol        = "instance_id"
+	SAMLSessionResourceOwnerCol     = "resource_owner"
+	SAMLSessionCreationDateCol      = "creation_date"
+	SAMLSessionChangeDateCol        = "change_date"
+	SAMLSessionSequenceCol          = "sequence"
+	SAMLSessionUserIDCol            = "user_id"
+	SAMLSessionUserResourceOwnerCol = "user_resource_owner"
+	SAMLSessionUserAgentIDCol       = "user_agent_id"
+	SAMLSessionAppIDCol             = "app_id"
+	SAMLSessionEntityIDCol          = "entity_id"
+	SAMLSessionNameIDCol            = "name_id"
+)
+
+// samlSessionProjection only contains the active sessions,
+// terminated sessions are removed
+type samlSessionProjection struct{}
+
+func newSAMLSessionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(samlSessionProjection))
+}
+
+func (*samlSessionProjection) Name() string {
+	return SAMLSessionTable
+}
+
+func (*samlSessionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SAMLSessionIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SAMLSessionChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SAMLSessionSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SAMLSessionUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionUserResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionUserAgentIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionAppIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionEntityIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLSessionNameIDCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(SAMLSessionInstanceIDCol, SAMLSessionIDCol),
+			handler.WithIndex(handler.NewIndex("user_agent_id", []string{SAMLSessionUserAgentIDCol})),
+			handler.WithIndex(handler.NewIndex("name_id", []string{SAMLSessionEntityIDCol, SAMLSessionNameIDCol})),
+		),
+	)
+}
+
+func (p *samlSessionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: samlsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  samlsession.AddedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  samlsession.TerminatedType,
+					Reduce: p.reduceTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(SAMLSessionInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *samlSessionProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlsession.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SAMLSessionIDCol, e.Aggregate().ID),
+			handler.NewCol(SAMLSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(SAMLSessionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(SAMLSessionCreationDateCol, e.CreationDate()),
+			handler.NewCol(SAMLSessionChangeDateCol, e.CreationDate()),
+			handler.NewCol(SAMLSessionSequenceCol, e.Sequence()),
+			handler.NewCol(SAMLSessionUserIDCol, e.UserID),
+			handler.NewCol(SAMLSessionUserResourceOwnerCol, e.UserResourceOwner),
+			handler.NewCol(SAMLSessionUserAgentIDCol, e.UserAgentID),
+			handler.NewCol(SAMLSessionAppIDCol, e.AppID),
+			handler.NewCol(SAMLSessionEntityIDCol, e.EntityID),
+			handler.NewCol(SAMLSessionNameIDCol, e.NameID),
+		},
+	), nil
+}
+
+func (p *samlSessionProjection) reduceTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlsession.TerminatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SAMLSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SAMLSessionIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *samlSessionProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SAMLSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SAMLSessionUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *samlSessionProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SAMLSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SAMLSessionUserResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,193 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSAMLSessionProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlsession.AddedType,
+						samlsession.AggregateType,
+						[]byte(`{"userID": "user-id", "userResourceOwner": "org-id", "userAgentID": "agent-id", "appID": "app-id", "entityID": "https://sp.example.com/metadata", "nameID": "user@example.com"}`),
+					),
+					eventstore.GenericEventMapper[samlsession.AddedEvent],
+				),
+			},
+			reduce: (&samlSessionProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("saml_session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.saml_sessions (id, instance_id, resource_owner, creation_date, change_date, sequence, user_id, user_resource_owner, user_agent_id, app_id, entity_id, name_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"user-id",
+								"org-id",
+								"agent-id",
+								"app-id",
+								"https://sp.example.com/metadata",
+								"user@example.com",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTerminated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlsession.TerminatedType,
+						samlsession.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[samlsession.TerminatedEvent],
+				),
+			},
+			reduce: (&samlSessionProjection{}).reduceTerminated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("saml_session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_sessions WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						[]byte(`{}`),
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&samlSessionProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_sessions WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOrgRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						[]byte(`{}`),
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&samlSessionProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_sessions WHERE (instance_id = $1) AND (user_resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(SAMLSessionInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_sessions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, SAMLSessionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/scimtarget"
+)
+
+const (
+	SCIMTargetTable             = "projections.scim_targets"
+	SCIMProvisioningTable       = SCIMTargetTable + "_" + scimProvisioningTableSuffix
+	scimProvisioningTableSuffix = "users"
+
+	SCIMTargetIDCol            = "id"
+	SCIMTargetCreationDateCol  = "creation_date"
+	SCIMTargetChangeDateCol    = "change_date"
+	SCIMTargetResourceOwnerCol = "resource_owner"
+	SCIMTargetInstanceIDCol    = "instance_id"
+	SCIMTargetSequenceCol      = "sequence"
+	SCIMTargetProjectIDCol     = "project_id"
+	SCIMTargetAppIDCol         = "app_id"
+	SCIMTargetNameCol          = "name"
+	SCIMTargetEndpointCol      = "endpoint"
+	SCIMTargetTokenCol         = "token"
+	SCIMTargetTimeoutCol       = "timeout"
+
+	SCIMProvisioningTargetIDCol     = "target_id"
+	SCIMProvisioningInstanceIDCol   = "instance_id"
+	SCIMProvisioningUserIDCol       = "user_id"
+	SCIMProvisioningUserGrantIDCol  = "user_grant_id"
+	SCIMProvisioningRemoteIDCol     = "remote_id"
+	SCIMProvisioningStateCol        = "state"
+	SCIMProvisioningFailureCountCol = "failure_count"
+	SCIMProvisioningLastErrorCol    = "last_error"
+	SCIMProvisioningChangeDateCol   = "change_date"
+	SCIMProvisioningSequenceCol     = "sequence"
+)
+
+type scimTargetProjection struct{}
+
+func newSCIMTargetProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(scimTargetProjection))
+}
+
+func (*scimTargetProjection) Name() string {
+	return SCIMTargetTable
+}
+
+func (*scimTargetProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SCIMTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMTargetCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMTargetChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMTargetResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMTargetInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMTargetSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SCIMTargetProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMTargetAppIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(SCIMTargetNameCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMTargetEndpointCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMTargetTokenCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SCIMTargetTimeoutCol, handler.ColumnTypeInt64, handler.Default(0)),
+		},
+			handler.NewPrimaryKey(SCIMTargetInstanceIDCol, SCIMTargetIDCol),
+			handler.WithIndex(handler.NewIndex("project_id", []string{SCIMTargetProjectIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SCIMProvisioningTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningUserGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(SCIMProvisioningRemoteIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(SCIMProvisioningStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(SCIMProvisioningFailureCountCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(SCIMProvisioningLastErrorCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(SCIMProvisioningChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMProvisioningSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(SCIMProvisioningInstanceIDCol, SCIMProvisioningTargetIDCol, SCIMProvisioningUserIDCol),
+			scimProvisioningTableSuffix,
+			handler.WithIndex(handler.NewIndex("user_id", []string{SCIMProvisioningUserIDCol})),
+			handler.WithIndex(handler.NewIndex("user_grant_id", []string{SCIMProvisioningUserGrantIDCol})),
+		),
+	)
+}
+
+func (p *scimTargetProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: scimtarget.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  scimtarget.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  scimtarget.ChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  scimtarget.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  scimtarget.UserProvisionedEventType,
+					Reduce: p.reduceUserProvisioned,
+				},
+				{
+					Event:  scimtarget.UserDeprovisionedEventType,
+					Reduce: p.reduceUserDeprovisioned,
+				},
+				{
+					Event:  scimtarget.UserProvisioningFailedEventType,
+					Reduce: p.reduceUserProvisioningFailed,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *scimTargetProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimtarget.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(SCIMTargetResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(SCIMTargetIDCol, e.Aggregate().ID),
+			handler.NewCol(SCIMTargetCreationDateCol, e.CreationDate()),
+			handler.NewCol(SCIMTargetChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMTargetSequenceCol, e.Sequence()),
+			handler.NewCol(SCIMTargetProjectIDCol, e.ProjectID),
+			handler.NewCol(SCIMTargetAppIDCol, e.AppID),
+			handler.NewCol(SCIMTargetNameCol, e.Name),
+			handler.NewCol(SCIMTargetEndpointCol, e.Endpoint),
+			handler.NewCol(SCIMTargetTokenCol, e.Token),
+			handler.NewCol(SCIMTargetTimeoutCol, e.Timeout),
+		},
+	), nil
+}
+
+func (p *scimTargetProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimtarget.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(SCIMTargetChangeDateCol, e.CreationDate()),
+		handler.NewCol(SCIMTargetSequenceCol, e.Sequence()),
+	}
+	if e.AppID != nil {
+		values = append(values, handler.NewCol(SCIMTargetAppIDCol, *e.AppID))
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(SCIMTargetNameCol, *e.Name))
+	}
+	if e.Endpoint != nil {
+		values = append(values, handler.NewCol(SCIMTargetEndpointCol, *e.Endpoint))
+	}
+	if e.Token != nil {
+		values = append(values, handler.NewCol(SCIMTargetTokenCol, e.Token))
+	}
+	if e.Timeout != nil {
+		values = append(values, handler.NewCol(SCIMTargetTimeoutCol, *e.Timeout))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(SCIMTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *scimTargetProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimtarget.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisioningTargetIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(scimProvisioningTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMTargetInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SCIMTargetIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *scimTargetProjection) reduceUserProvisioned(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimtarget.UserProvisionedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCol(SCIMProvisioningTargetIDCol, e.Aggregate().ID),
+		handler.NewCol(SCIMProvisioningUserIDCol, e.UserID),
+		handler.NewCol(SCIMProvisioningRemoteIDCol, e.RemoteID),
+		handler.NewCol(SCIMProvisioningStateCol, domain.SCIMProvisioningStateProvisioned),
+		handler.NewCol(SCIMProvisioningFailureCountCol, 0),
+		handler.NewCol(SCIMProvisioningLastErrorCol, ""),
+		handler.NewCol(SCIMProvisioningChangeDateCol, e.CreationDate()),
+		handler.NewCol(SCIMProvisioningSequenceCol, e.Sequence()),
+	}
+	// updates triggered by user changes don't know the grant, so the grant of the initial provisioning is kept
+	if e.UserGrantID != "" {
+		values = append(values, handler.NewCol(SCIMProvisioningUserGrantIDCol, e.UserGrantID))
+	}
+	return handler.NewUpsertStatement(e, values[0:3], values, handler.WithTableSuffix(scimProvisioningTableSuffix)), nil
+}
+
+func (p *scimTargetProjection) reduceUserDeprovisioned(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimtarget.UserDeprovisionedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMProvisioningStateCol, domain.SCIMProvisioningStateDeprovisioned),
+			handler.NewCol(SCIMProvisioningFailureCountCol, 0),
+			handler.NewCol(SCIMProvisioningLastErrorCol, ""),
+			handler.NewCol(SCIMProvisioningChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMProvisioningSequenceCol, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SCIMProvisioningTargetIDCol, e.Aggregate().ID),
+			handler.NewCond(SCIMProvisioningUserIDCol, e.UserID),
+		},
+		handler.WithTableSuffix(scimProvisioningTableSuffix),
+	), nil
+}
+
+// reduceUserProvisioningFailed keeps the state of an already provisioned user,
+// so that further changes are still pushed to the target
+func (p *scimTargetProjection) reduceUserProvisioningFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimtarget.UserProvisioningFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCol(SCIMProvisioningTargetIDCol, e.Aggregate().ID),
+		handler.NewCol(SCIMProvisioningUserIDCol, e.UserID),
+		handler.NewCol(SCIMProvisioningStateCol, handler.OnlySetValueOnInsert(SCIMProvisioningTable, domain.SCIMProvisioningStateFailed)),
+		handler.NewCol(SCIMProvisioningLastErrorCol, e.Error),
+		handler.NewCol(SCIMProvisioningChangeDateCol, e.CreationDate()),
+		handler.NewCol(SCIMProvisioningSequenceCol, e.Sequence()),
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpsertStatement(values[0:3], values, handler.WithTableSuffix(scimProvisioningTableSuffix)),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewIncrementCol(SCIMProvisioningFailureCountCol, 1),
+			},
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisioningTargetIDCol, e.Aggregate().ID),
+				handler.NewCond(SCIMProvisioningUserIDCol, e.UserID),
+			},
+			handler.WithTableSuffix(scimProvisioningTableSuffix),
+		),
+	), nil
+}
+
+func (p *scimTargetProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisioningInstanceIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(scimProvisioningTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMTargetInstanceIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
@@ -0,0 +1,296 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/scimtarget"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSCIMTargetProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimtarget.AddedEventType,
+						scimtarget.AggregateType,
+						[]byte(`{"projectId": "project-id", "appId": "app-id", "name": "name", "endpoint": "https://example.com/scim/v2", "timeout": 3000000000}`),
+					),
+					eventstore.GenericEventMapper[scimtarget.AddedEvent],
+				),
+			},
+			reduce: (&scimTargetProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_targets (instance_id, resource_owner, id, creation_date, change_date, sequence, project_id, app_id, name, endpoint, token, timeout) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"project-id",
+								"app-id",
+								"name",
+								"https://example.com/scim/v2",
+								anyArg{},
+								3 * time.Second,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimtarget.ChangedEventType,
+						scimtarget.AggregateType,
+						[]byte(`{"name": "name2", "endpoint": "https://example.com/scim"}`),
+					),
+					eventstore.GenericEventMapper[scimtarget.ChangedEvent],
+				),
+			},
+			reduce: (&scimTargetProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_targets SET (change_date, sequence, name, endpoint) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"name2",
+								"https://example.com/scim",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimtarget.RemovedEventType,
+						scimtarget.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[scimtarget.RemovedEvent],
+				),
+			},
+			reduce: (&scimTargetProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_targets_users WHERE (instance_id = $1) AND (target_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.scim_targets WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserProvisioned",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimtarget.UserProvisionedEventType,
+						scimtarget.AggregateType,
+						[]byte(`{"userId": "user-id", "userGrantId": "grant-id", "remoteId": "remote-id"}`),
+					),
+					eventstore.GenericEventMapper[scimtarget.UserProvisionedEvent],
+				),
+			},
+			reduce: (&scimTargetProjection{}).reduceUserProvisioned,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_targets_users (instance_id, target_id, user_id, remote_id, state, failure_count, last_error, change_date, sequence, user_grant_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, target_id, user_id) DO UPDATE SET (remote_id, state, failure_count, last_error, change_date, sequence, user_grant_id) = (EXCLUDED.remote_id, EXCLUDED.state, EXCLUDED.failure_count, EXCLUDED.last_error, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.user_grant_id)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								"remote-id",
+								domain.SCIMProvisioningStateProvisioned,
+								0,
+								"",
+								anyArg{},
+								uint64(15),
+								"grant-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserDeprovisioned",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimtarget.UserDeprovisionedEventType,
+						scimtarget.AggregateType,
+						[]byte(`{"userId": "user-id"}`),
+					),
+					eventstore.GenericEventMapper[scimtarget.UserDeprovisionedEvent],
+				),
+			},
+			reduce: (&scimTargetProjection{}).reduceUserDeprovisioned,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.scim_targets_users SET (state, failure_count, last_error, change_date, sequence) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (target_id = $7) AND (user_id = $8)",
+							expectedArgs: []interface{}{
+								domain.SCIMProvisioningStateDeprovisioned,
+								0,
+								"",
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+								"user-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserProvisioningFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimtarget.UserProvisioningFailedEventType,
+						scimtarget.AggregateType,
+						[]byte(`{"userId": "user-id", "error": "unavailable"}`),
+					),
+					eventstore.GenericEventMapper[scimtarget.UserProvisioningFailedEvent],
+				),
+			},
+			reduce: (&scimTargetProjection{}).reduceUserProvisioningFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("scim_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_targets_users (instance_id, target_id, user_id, state, last_error, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, target_id, user_id) DO UPDATE SET (state, last_error, change_date, sequence) = (projections.scim_targets_users.state, EXCLUDED.last_error, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								domain.SCIMProvisioningStateFailed,
+								"unavailable",
+								anyArg{},
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.scim_targets_users SET failure_count = failure_count + $1 WHERE (instance_id = $2) AND (target_id = $3) AND (user_id = $4)",
+							expectedArgs: []interface{}{
+								1,
+								"instance-id",
+								"agg-id",
+								"user-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: (&scimTargetProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_targets_users WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.scim_targets WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, SCIMTargetTable, tt.want)
+		})
+	}
+}
@@ -12,8 +12,11 @@ import (
 )
 
 const (
-	SMSConfigProjectionTable = "projections.sms_configs2"
+	SMSConfigProjectionTable = "projections.sms_configs3"
 	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
+	SMSVonageTable           = SMSConfigProjectionTable + "_" + smsVonageTableSuffix
+	SMSMessageBirdTable      = SMSConfigProjectionTable + "_" + smsMessageBirdTableSuffix
+	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
 
 	SMSColumnID            = "id"
 	SMSColumnAggregateID   = "aggregate_id"
@@ -30,6 +33,29 @@ const (
 	SMSTwilioConfigColumnSID          = "sid"
 	SMSTwilioConfigColumnSenderNumber = "sender_number"
 	SMSTwilioConfigColumnToken        = "token"
+
+	smsVonageTableSuffix              = "vonage"
+	SMSVonageConfigColumnSMSID        = "sms_id"
+	SMSVonageColumnInstanceID         = "instance_id"
+	SMSVonageConfigColumnAPIKey       = "api_key"
+	SMSVonageConfigColumnAPISecret    = "api_secret"
+	SMSVonageConfigColumnSenderNumber = "sender_number"
+
+	smsMessageBirdTableSuffix            = "messagebird"
+	SMSMessageBirdConfigColumnSMSID      = "sms_id"
+	SMSMessageBirdColumnInstanceID       = "instance_id"
+	SMSMessageBirdConfigColumnAccessKey  = "access_key"
+	SMSMessageBirdConfigColumnOriginator = "originator"
+
+	smsHTTPTableSuffix               = "http"
+	SMSHTTPConfigColumnSMSID         = "sms_id"
+	SMSHTTPColumnInstanceID          = "instance_id"
+	SMSHTTPConfigColumnEndpoint      = "endpoint"
+	SMSHTTPConfigColumnMethod        = "method"
+	SMSHTTPConfigColumnContentType   = "content_type"
+	SMSHTTPConfigColumnBodyTemplate  = "body_template"
+	SMSHTTPConfigColumnSenderNumber  = "sender_number"
+	SMSHTTPConfigColumnAuthorization = "authorization_header"
 )
 
 type smsConfigProjection struct{}
@@ -67,6 +93,41 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			smsTwilioTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSVonageConfigColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageConfigColumnAPIKey, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageConfigColumnAPISecret, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSVonageConfigColumnSenderNumber, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSVonageColumnInstanceID, SMSVonageConfigColumnSMSID),
+			smsVonageTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSMessageBirdConfigColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSMessageBirdColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSMessageBirdConfigColumnAccessKey, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSMessageBirdConfigColumnOriginator, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSMessageBirdColumnInstanceID, SMSMessageBirdConfigColumnSMSID),
+			smsMessageBirdTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSHTTPConfigColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnEndpoint, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnMethod, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnContentType, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnBodyTemplate, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnSenderNumber, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnAuthorization, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SMSHTTPColumnInstanceID, SMSHTTPConfigColumnSMSID),
+			smsHTTPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -87,6 +148,42 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigTwilioTokenChangedEventType,
 					Reduce: p.reduceSMSConfigTwilioTokenChanged,
 				},
+				{
+					Event:  instance.SMSConfigVonageAddedEventType,
+					Reduce: p.reduceSMSConfigVonageAdded,
+				},
+				{
+					Event:  instance.SMSConfigVonageChangedEventType,
+					Reduce: p.reduceSMSConfigVonageChanged,
+				},
+				{
+					Event:  instance.SMSConfigVonageAPISecretChangedEventType,
+					Reduce: p.reduceSMSConfigVonageAPISecretChanged,
+				},
+				{
+					Event:  instance.SMSConfigMessageBirdAddedEventType,
+					Reduce: p.reduceSMSConfigMessageBirdAdded,
+				},
+				{
+					Event:  instance.SMSConfigMessageBirdChangedEventType,
+					Reduce: p.reduceSMSConfigMessageBirdChanged,
+				},
+				{
+					Event:  instance.SMSConfigMessageBirdAccessKeyChangedEventType,
+					Reduce: p.reduceSMSConfigMessageBirdAccessKeyChanged,
+				},
+				{
+					Event:  instance.SMSConfigHTTPAddedEventType,
+					Reduce: p.reduceSMSConfigHTTPAdded,
+				},
+				{
+					Event:  instance.SMSConfigHTTPChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPChanged,
+				},
+				{
+					Event:  instance.SMSConfigHTTPAuthorizationChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPAuthorizationChanged,
+				},
 				{
 					Event:  instance.SMSConfigActivatedEventType,
 					Reduce: p.reduceSMSConfigActivated,
@@ -210,6 +307,317 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore
 	), nil
 }
 
+func (p *smsConfigProjection) reduceSMSConfigVonageAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigVonageAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Vo1sa", "reduce.wrong.event.type %s", instance.SMSConfigVonageAddedEventType)
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVonageConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSVonageConfigColumnAPIKey, e.APIKey),
+				handler.NewCol(SMSVonageConfigColumnAPISecret, e.APISecret),
+				handler.NewCol(SMSVonageConfigColumnSenderNumber, e.SenderNumber),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigVonageChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Vo2sb", "reduce.wrong.event.type %s", instance.SMSConfigVonageChangedEventType)
+	}
+	columns := make([]handler.Column, 0)
+	if e.APIKey != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnAPIKey, *e.APIKey))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSVonageConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageAPISecretChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigVonageAPISecretChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Vo3sc", "reduce.wrong.event.type %s", instance.SMSConfigVonageAPISecretChangedEventType)
+	}
+	columns := make([]handler.Column, 0)
+	if e.APISecret != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnAPISecret, e.APISecret))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSVonageConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigMessageBirdAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Mb1sa", "reduce.wrong.event.type %s", instance.SMSConfigMessageBirdAddedEventType)
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSMessageBirdConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSMessageBirdColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSMessageBirdConfigColumnAccessKey, e.AccessKey),
+				handler.NewCol(SMSMessageBirdConfigColumnOriginator, e.Originator),
+			},
+			handler.WithTableSuffix(smsMessageBirdTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigMessageBirdChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Mb2sb", "reduce.wrong.event.type %s", instance.SMSConfigMessageBirdChangedEventType)
+	}
+	columns := make([]handler.Column, 0)
+	if e.Originator != nil {
+		columns = append(columns, handler.NewCol(SMSMessageBirdConfigColumnOriginator, *e.Originator))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSMessageBirdConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSMessageBirdColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsMessageBirdTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdAccessKeyChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigMessageBirdAccessKeyChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Mb3sc", "reduce.wrong.event.type %s", instance.SMSConfigMessageBirdAccessKeyChangedEventType)
+	}
+	columns := make([]handler.Column, 0)
+	if e.AccessKey != nil {
+		columns = append(columns, handler.NewCol(SMSMessageBirdConfigColumnAccessKey, e.AccessKey))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSMessageBirdConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSMessageBirdColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsMessageBirdTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ht1sa", "reduce.wrong.event.type %s", instance.SMSConfigHTTPAddedEventType)
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSHTTPConfigColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMSHTTPConfigColumnMethod, e.Method),
+				handler.NewCol(SMSHTTPConfigColumnContentType, e.ContentType),
+				handler.NewCol(SMSHTTPConfigColumnBodyTemplate, e.BodyTemplate),
+				handler.NewCol(SMSHTTPConfigColumnSenderNumber, e.SenderNumber),
+				handler.NewCol(SMSHTTPConfigColumnAuthorization, e.Authorization),
+			},
+			handler.WithTableSuffix(smsHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ht2sb", "reduce.wrong.event.type %s", instance.SMSConfigHTTPChangedEventType)
+	}
+	columns := make([]handler.Column, 0)
+	if e.Endpoint != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnEndpoint, *e.Endpoint))
+	}
+	if e.Method != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnMethod, *e.Method))
+	}
+	if e.ContentType != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnContentType, *e.ContentType))
+	}
+	if e.BodyTemplate != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnBodyTemplate, *e.BodyTemplate))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPAuthorizationChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPAuthorizationChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ht3sc", "reduce.wrong.event.type %s", instance.SMSConfigHTTPAuthorizationChangedEventType)
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnAuthorization, e.Authorization),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
 func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*instance.SMSConfigActivatedEvent)
 	if !ok {
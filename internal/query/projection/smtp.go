@@ -3,29 +3,51 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
-	SMTPConfigProjectionTable = "projections.smtp_configs1"
+	SMTPConfigProjectionTable = "projections.smtp_configs3"
+	SMTPConfigSMTPTable       = SMTPConfigProjectionTable + "_" + smtpConfigSMTPTableSuffix
+	SMTPConfigHTTPTable       = SMTPConfigProjectionTable + "_" + smtpConfigHTTPTableSuffix
 
+	SMTPConfigColumnID             = "id"
 	SMTPConfigColumnAggregateID    = "aggregate_id"
 	SMTPConfigColumnCreationDate   = "creation_date"
 	SMTPConfigColumnChangeDate     = "change_date"
 	SMTPConfigColumnSequence       = "sequence"
 	SMTPConfigColumnResourceOwner  = "resource_owner"
 	SMTPConfigColumnInstanceID     = "instance_id"
-	SMTPConfigColumnTLS            = "tls"
+	SMTPConfigColumnOrgID          = "org_id"
+	SMTPConfigColumnDescription    = "description"
+	SMTPConfigColumnPriority       = "priority"
+	SMTPConfigColumnState          = "state"
 	SMTPConfigColumnSenderAddress  = "sender_address"
 	SMTPConfigColumnSenderName     = "sender_name"
 	SMTPConfigColumnReplyToAddress = "reply_to_address"
-	SMTPConfigColumnSMTPHost       = "host"
-	SMTPConfigColumnSMTPUser       = "username"
-	SMTPConfigColumnSMTPPassword   = "password"
+
+	smtpConfigSMTPTableSuffix      = "smtp"
+	SMTPConfigSMTPColumnID         = "id"
+	SMTPConfigSMTPColumnInstanceID = "instance_id"
+	SMTPConfigSMTPColumnTLS        = "tls"
+	SMTPConfigSMTPColumnHost       = "host"
+	SMTPConfigSMTPColumnUser       = "username"
+	SMTPConfigSMTPColumnPassword   = "password"
+
+	smtpConfigHTTPTableSuffix        = "http"
+	SMTPConfigHTTPColumnID           = "id"
+	SMTPConfigHTTPColumnInstanceID   = "instance_id"
+	SMTPConfigHTTPColumnEndpoint     = "endpoint"
+	SMTPConfigHTTPColumnMethod       = "method"
+	SMTPConfigHTTPColumnContentType  = "content_type"
+	SMTPConfigHTTPColumnBodyTemplate = "body_template"
+	SMTPConfigHTTPColumnHeaders      = "headers"
 )
 
 type smtpConfigProjection struct{}
@@ -39,23 +61,50 @@ func (*smtpConfigProjection) Name() string {
 }
 
 func (*smtpConfigProjection) Init() *old_handler.Check {
-	return handler.NewTableCheck(
+	return handler.NewMultiTableCheck(
 		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SMTPConfigColumnID, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigColumnAggregateID, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigColumnCreationDate, handler.ColumnTypeTimestamp),
 			handler.NewColumn(SMTPConfigColumnChangeDate, handler.ColumnTypeTimestamp),
 			handler.NewColumn(SMTPConfigColumnSequence, handler.ColumnTypeInt64),
 			handler.NewColumn(SMTPConfigColumnResourceOwner, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigColumnInstanceID, handler.ColumnTypeText),
-			handler.NewColumn(SMTPConfigColumnTLS, handler.ColumnTypeBool),
+			handler.NewColumn(SMTPConfigColumnOrgID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(SMTPConfigColumnDescription, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigColumnPriority, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(SMTPConfigColumnState, handler.ColumnTypeEnum),
 			handler.NewColumn(SMTPConfigColumnSenderAddress, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigColumnSenderName, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigColumnReplyToAddress, handler.ColumnTypeText),
-			handler.NewColumn(SMTPConfigColumnSMTPHost, handler.ColumnTypeText),
-			handler.NewColumn(SMTPConfigColumnSMTPUser, handler.ColumnTypeText),
-			handler.NewColumn(SMTPConfigColumnSMTPPassword, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
-			handler.NewPrimaryKey(SMTPConfigColumnInstanceID, SMTPConfigColumnAggregateID),
+			handler.NewPrimaryKey(SMTPConfigColumnInstanceID, SMTPConfigColumnID),
+			handler.WithIndex(handler.NewIndex("org_id", []string{SMTPConfigColumnOrgID})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMTPConfigSMTPColumnID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigSMTPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigSMTPColumnTLS, handler.ColumnTypeBool),
+			handler.NewColumn(SMTPConfigSMTPColumnHost, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigSMTPColumnUser, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigSMTPColumnPassword, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SMTPConfigSMTPColumnInstanceID, SMTPConfigSMTPColumnID),
+			smtpConfigSMTPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMTPConfigHTTPColumnID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnEndpoint, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnMethod, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnContentType, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnBodyTemplate, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnHeaders, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SMTPConfigHTTPColumnInstanceID, SMTPConfigHTTPColumnID),
+			smtpConfigHTTPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
 	)
 }
@@ -77,6 +126,26 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMTPConfigPasswordChangedEventType,
 					Reduce: p.reduceSMTPConfigPasswordChanged,
 				},
+				{
+					Event:  instance.SMTPConfigHTTPAddedEventType,
+					Reduce: p.reduceSMTPConfigHTTPAdded,
+				},
+				{
+					Event:  instance.SMTPConfigHTTPChangedEventType,
+					Reduce: p.reduceSMTPConfigHTTPChanged,
+				},
+				{
+					Event:  instance.SMTPConfigHTTPHeadersChangedEventType,
+					Reduce: p.reduceSMTPConfigHTTPHeadersChanged,
+				},
+				{
+					Event:  instance.SMTPConfigActivatedEventType,
+					Reduce: p.reduceSMTPConfigActivated,
+				},
+				{
+					Event:  instance.SMTPConfigDeactivatedEventType,
+					Reduce: p.reduceSMTPConfigDeactivated,
+				},
 				{
 					Event:  instance.SMTPConfigRemovedEventType,
 					Reduce: p.reduceSMTPConfigRemoved,
@@ -87,6 +156,15 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
 	}
 }
 
@@ -95,23 +173,43 @@ func (p *smtpConfigProjection) reduceSMTPConfigAdded(event eventstore.Event) (*h
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-sk99F", "reduce.wrong.event.type %s", instance.SMTPConfigAddedEventType)
 	}
-	return handler.NewCreateStatement(
+	// the former single SMTP configuration of an instance was active as soon as it was added
+	state := domain.SMTPConfigStateInactive
+	if e.ID == "" {
+		state = domain.SMTPConfigStateActive
+	}
+	id := instance.SMTPConfigID(e.ID, e.Aggregate())
+	return handler.NewMultiStatement(
 		e,
-		[]handler.Column{
-			handler.NewCol(SMTPConfigColumnAggregateID, e.Aggregate().ID),
-			handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
-			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
-			handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
-			handler.NewCol(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
-			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
-			handler.NewCol(SMTPConfigColumnTLS, e.TLS),
-			handler.NewCol(SMTPConfigColumnSenderAddress, e.SenderAddress),
-			handler.NewCol(SMTPConfigColumnSenderName, e.SenderName),
-			handler.NewCol(SMTPConfigColumnReplyToAddress, e.ReplyToAddress),
-			handler.NewCol(SMTPConfigColumnSMTPHost, e.Host),
-			handler.NewCol(SMTPConfigColumnSMTPUser, e.User),
-			handler.NewCol(SMTPConfigColumnSMTPPassword, e.Password),
-		},
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnID, id),
+				handler.NewCol(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+				handler.NewCol(SMTPConfigColumnOrgID, e.OrgID),
+				handler.NewCol(SMTPConfigColumnDescription, e.Description),
+				handler.NewCol(SMTPConfigColumnPriority, e.Priority),
+				handler.NewCol(SMTPConfigColumnState, state),
+				handler.NewCol(SMTPConfigColumnSenderAddress, e.SenderAddress),
+				handler.NewCol(SMTPConfigColumnSenderName, e.SenderName),
+				handler.NewCol(SMTPConfigColumnReplyToAddress, e.ReplyToAddress),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigSMTPColumnID, id),
+				handler.NewCol(SMTPConfigSMTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigSMTPColumnTLS, e.TLS),
+				handler.NewCol(SMTPConfigSMTPColumnHost, e.Host),
+				handler.NewCol(SMTPConfigSMTPColumnUser, e.User),
+				handler.NewCol(SMTPConfigSMTPColumnPassword, e.Password),
+			},
+			handler.WithTableSuffix(smtpConfigSMTPTableSuffix),
+		),
 	), nil
 }
 
@@ -120,12 +218,17 @@ func (p *smtpConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-wl0wd", "reduce.wrong.event.type %s", instance.SMTPConfigChangedEventType)
 	}
+	id := instance.SMTPConfigID(e.ID, e.Aggregate())
 
-	columns := make([]handler.Column, 0, 8)
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := make([]handler.Column, 0, 7)
 	columns = append(columns, handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
 		handler.NewCol(SMTPConfigColumnSequence, e.Sequence()))
-	if e.TLS != nil {
-		columns = append(columns, handler.NewCol(SMTPConfigColumnTLS, *e.TLS))
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnDescription, *e.Description))
+	}
+	if e.Priority != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnPriority, *e.Priority))
 	}
 	if e.FromAddress != nil {
 		columns = append(columns, handler.NewCol(SMTPConfigColumnSenderAddress, *e.FromAddress))
@@ -136,37 +239,231 @@ func (p *smtpConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (
 	if e.ReplyToAddress != nil {
 		columns = append(columns, handler.NewCol(SMTPConfigColumnReplyToAddress, *e.ReplyToAddress))
 	}
+	ops = append(ops, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, id),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	smtpColumns := make([]handler.Column, 0, 3)
+	if e.TLS != nil {
+		smtpColumns = append(smtpColumns, handler.NewCol(SMTPConfigSMTPColumnTLS, *e.TLS))
+	}
 	if e.Host != nil {
-		columns = append(columns, handler.NewCol(SMTPConfigColumnSMTPHost, *e.Host))
+		smtpColumns = append(smtpColumns, handler.NewCol(SMTPConfigSMTPColumnHost, *e.Host))
 	}
 	if e.User != nil {
-		columns = append(columns, handler.NewCol(SMTPConfigColumnSMTPUser, *e.User))
+		smtpColumns = append(smtpColumns, handler.NewCol(SMTPConfigSMTPColumnUser, *e.User))
 	}
-	return handler.NewUpdateStatement(
+	if len(smtpColumns) > 0 {
+		ops = append(ops, handler.AddUpdateStatement(
+			smtpColumns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigSMTPColumnID, id),
+				handler.NewCond(SMTPConfigSMTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigSMTPTableSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, ops...), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMTPConfigPasswordChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fk02f", "reduce.wrong.event.type %s", instance.SMTPConfigChangedEventType)
+	}
+	id := instance.SMTPConfigID(e.ID, e.Aggregate())
+
+	return handler.NewMultiStatement(
 		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigSMTPColumnPassword, e.Password),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigSMTPColumnID, id),
+				handler.NewCond(SMTPConfigSMTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigSMTPTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigColumnID, id),
+				handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigHTTPAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnID, e.ID),
+				handler.NewCol(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+				handler.NewCol(SMTPConfigColumnOrgID, e.OrgID),
+				handler.NewCol(SMTPConfigColumnDescription, e.Description),
+				handler.NewCol(SMTPConfigColumnPriority, e.Priority),
+				handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateInactive),
+				handler.NewCol(SMTPConfigColumnSenderAddress, e.SenderAddress),
+				handler.NewCol(SMTPConfigColumnSenderName, e.SenderName),
+				handler.NewCol(SMTPConfigColumnReplyToAddress, e.ReplyToAddress),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigHTTPColumnID, e.ID),
+				handler.NewCol(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigHTTPColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMTPConfigHTTPColumnMethod, e.Method),
+				handler.NewCol(SMTPConfigHTTPColumnContentType, e.ContentType),
+				handler.NewCol(SMTPConfigHTTPColumnBodyTemplate, e.BodyTemplate),
+				handler.NewCol(SMTPConfigHTTPColumnHeaders, e.Headers),
+			},
+			handler.WithTableSuffix(smtpConfigHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigHTTPChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := make([]handler.Column, 0, 7)
+	columns = append(columns, handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMTPConfigColumnSequence, e.Sequence()))
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnDescription, *e.Description))
+	}
+	if e.Priority != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnPriority, *e.Priority))
+	}
+	if e.SenderAddress != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSenderAddress, *e.SenderAddress))
+	}
+	if e.SenderName != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSenderName, *e.SenderName))
+	}
+	if e.ReplyToAddress != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnReplyToAddress, *e.ReplyToAddress))
+	}
+	ops = append(ops, handler.AddUpdateStatement(
 		columns,
 		[]handler.Condition{
-			handler.NewCond(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+			handler.NewCond(SMTPConfigColumnID, e.ID),
 			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
 		},
+	))
+
+	httpColumns := make([]handler.Column, 0, 4)
+	if e.Endpoint != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnEndpoint, *e.Endpoint))
+	}
+	if e.Method != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnMethod, *e.Method))
+	}
+	if e.ContentType != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnContentType, *e.ContentType))
+	}
+	if e.BodyTemplate != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnBodyTemplate, *e.BodyTemplate))
+	}
+	if len(httpColumns) > 0 {
+		ops = append(ops, handler.AddUpdateStatement(
+			httpColumns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigHTTPColumnID, e.ID),
+				handler.NewCond(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigHTTPTableSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, ops...), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigHTTPHeadersChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigHTTPHeadersChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigHTTPColumnHeaders, e.Headers),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigHTTPColumnID, e.ID),
+				handler.NewCond(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigHTTPTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigColumnID, e.ID),
+				handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
 	), nil
 }
 
-func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMTPConfigPasswordChangedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fk02f", "reduce.wrong.event.type %s", instance.SMTPConfigChangedEventType)
+func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigActivatedEvent](event)
+	if err != nil {
+		return nil, err
 	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateActive),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, e.ID),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
 
+func (p *smtpConfigProjection) reduceSMTPConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigDeactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
 	return handler.NewUpdateStatement(
 		e,
 		[]handler.Column{
 			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
 			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
-			handler.NewCol(SMTPConfigColumnSMTPPassword, e.Password),
+			handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateInactive),
 		},
 		[]handler.Condition{
-			handler.NewCond(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+			handler.NewCond(SMTPConfigColumnID, e.ID),
 			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
 		},
 	), nil
@@ -180,7 +477,21 @@ func (p *smtpConfigProjection) reduceSMTPConfigRemoved(event eventstore.Event) (
 	return handler.NewDeleteStatement(
 		e,
 		[]handler.Condition{
-			handler.NewCond(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+			handler.NewCond(SMTPConfigColumnID, instance.SMTPConfigID(e.ID, e.Aggregate())),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnOrgID, e.Aggregate().ID),
 			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
 		},
 	), nil
@@ -3,9 +3,11 @@ package projection
 import (
 	"testing"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -27,6 +29,9 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 						instance.SMTPConfigChangedEventType,
 						instance.AggregateType,
 						[]byte(`{
+						"id": "config-id",
+						"description": "test",
+						"priority": 1,
 						"tls": true,
 						"senderAddress": "sender",
 						"senderName": "name",
@@ -44,18 +49,89 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.smtp_configs1 SET (change_date, sequence, tls, sender_address, sender_name, reply_to_address, host, username) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (aggregate_id = $9) AND (instance_id = $10)",
+							expectedStmt: "UPDATE projections.smtp_configs3 SET (change_date, sequence, description, priority, sender_address, sender_name, reply_to_address) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
-								true,
+								"test",
+								int32(1),
 								"sender",
 								"name",
 								"reply-to",
+								"config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3_smtp SET (tls, host, username) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								true,
 								"host",
 								"user",
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigAdded (former instance config)",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"tls": true,
+						"senderAddress": "sender",
+						"senderName": "name",
+						"replyToAddress": "reply-to",
+						"host": "host",
+						"user": "user",
+						"password": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id"
+						}
+					}`),
+					), instance.SMTPConfigAddedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.smtp_configs3 (id, aggregate_id, creation_date, change_date, resource_owner, instance_id, sequence, org_id, description, priority, state, sender_address, sender_name, reply_to_address) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"agg-id",
 								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
 								"instance-id",
+								uint64(15),
+								"",
+								"",
+								int32(0),
+								domain.SMTPConfigStateActive,
+								"sender",
+								"name",
+								"reply-to",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.smtp_configs3_smtp (id, instance_id, tls, host, username, password) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								true,
+								"host",
+								"user",
+								anyArg{},
 							},
 						},
 					},
@@ -70,6 +146,10 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 						instance.SMTPConfigAddedEventType,
 						instance.AggregateType,
 						[]byte(`{
+						"id": "config-id",
+						"orgId": "org-id",
+						"description": "test",
+						"priority": 1,
 						"tls": true,
 						"senderAddress": "sender",
 						"senderName": "name",
@@ -91,18 +171,30 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.smtp_configs1 (aggregate_id, creation_date, change_date, resource_owner, instance_id, sequence, tls, sender_address, sender_name, reply_to_address, host, username, password) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedStmt: "INSERT INTO projections.smtp_configs3 (id, aggregate_id, creation_date, change_date, resource_owner, instance_id, sequence, org_id, description, priority, state, sender_address, sender_name, reply_to_address) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
 							expectedArgs: []interface{}{
+								"config-id",
 								"agg-id",
 								anyArg{},
 								anyArg{},
 								"ro-id",
 								"instance-id",
 								uint64(15),
-								true,
+								"org-id",
+								"test",
+								int32(1),
+								domain.SMTPConfigStateInactive,
 								"sender",
 								"name",
 								"reply-to",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.smtp_configs3_smtp (id, instance_id, tls, host, username, password) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"config-id",
+								"instance-id",
+								true,
 								"host",
 								"user",
 								anyArg{},
@@ -112,6 +204,259 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceSMTPConfigHTTPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigHTTPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "config-id",
+						"orgId": "org-id",
+						"description": "test",
+						"priority": 1,
+						"senderAddress": "sender",
+						"senderName": "name",
+						"replyToAddress": "reply-to",
+						"endpoint": "https://api.example.com/send",
+						"method": "POST",
+						"contentType": "application/json",
+						"bodyTemplate": "{{json .Content}}",
+						"headers": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id"
+						}
+					}`),
+					), instance.SMTPConfigHTTPAddedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigHTTPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.smtp_configs3 (id, aggregate_id, creation_date, change_date, resource_owner, instance_id, sequence, org_id, description, priority, state, sender_address, sender_name, reply_to_address) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"config-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								uint64(15),
+								"org-id",
+								"test",
+								int32(1),
+								domain.SMTPConfigStateInactive,
+								"sender",
+								"name",
+								"reply-to",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.smtp_configs3_http (id, instance_id, endpoint, method, content_type, body_template, headers) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"config-id",
+								"instance-id",
+								"https://api.example.com/send",
+								"POST",
+								"application/json",
+								"{{json .Content}}",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigHTTPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigHTTPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "config-id",
+						"priority": 2,
+						"endpoint": "https://api.example.com/v2/send",
+						"bodyTemplate": "{{json .Subject}}"
+					}`),
+					), instance.SMTPConfigHTTPChangedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigHTTPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3 SET (change_date, sequence, priority) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								int32(2),
+								"config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3_http SET (endpoint, body_template) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"https://api.example.com/v2/send",
+								"{{json .Subject}}",
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigHTTPChanged, only common fields",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigHTTPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "config-id",
+						"senderName": "name"
+					}`),
+					), instance.SMTPConfigHTTPChangedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigHTTPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3 SET (change_date, sequence, sender_name) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"name",
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigHTTPHeadersChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigHTTPHeadersChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "config-id",
+						"headers": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id"
+						}
+					}`),
+					), instance.SMTPConfigHTTPHeadersChangedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigHTTPHeadersChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3_http SET headers = $1 WHERE (id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigActivated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigActivatedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "config-id"
+					}`),
+					), instance.SMTPConfigActivatedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigActivated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3 SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.SMTPConfigStateActive,
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigDeactivated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigDeactivatedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "config-id"
+					}`),
+					), instance.SMTPConfigDeactivatedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigDeactivated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3 SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.SMTPConfigStateInactive,
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceSMTPConfigPasswordChanged",
 			args: args{
@@ -135,11 +480,18 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.smtp_configs1 SET (change_date, sequence, password) = ($1, $2, $3) WHERE (aggregate_id = $4) AND (instance_id = $5)",
+							expectedStmt: "UPDATE projections.smtp_configs3_smtp SET password = $1 WHERE (id = $2) AND (instance_id = $3)",
 							expectedArgs: []interface{}{
 								anyArg{},
-								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.smtp_configs3 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
 								anyArg{},
+								uint64(15),
 								"agg-id",
 								"instance-id",
 							},
@@ -154,7 +506,9 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				event: getEvent(testEvent(
 					instance.SMTPConfigRemovedEventType,
 					instance.AggregateType,
-					[]byte(`{}`),
+					[]byte(`{
+						"id": "config-id"
+					}`),
 				), instance.SMTPConfigRemovedEventMapper),
 			},
 			reduce: (&smtpConfigProjection{}).reduceSMTPConfigRemoved,
@@ -164,9 +518,9 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.smtp_configs1 WHERE (aggregate_id = $1) AND (instance_id = $2)",
+							expectedStmt: "DELETE FROM projections.smtp_configs3 WHERE (id = $1) AND (instance_id = $2)",
 							expectedArgs: []interface{}{
-								"agg-id",
+								"config-id",
 								"instance-id",
 							},
 						},
@@ -191,9 +545,36 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.smtp_configs1 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.smtp_configs3 WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&smtpConfigProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.smtp_configs3 WHERE (org_id = $1) AND (instance_id = $2)",
 							expectedArgs: []interface{}{
 								"agg-id",
+								"instance-id",
 							},
 						},
 					},
@@ -11,7 +11,7 @@ import (
 )
 
 const (
-	TargetTable               = "projections.targets"
+	TargetTable               = "projections.targets1"
 	TargetIDCol               = "id"
 	TargetCreationDateCol     = "creation_date"
 	TargetChangeDateCol       = "change_date"
@@ -24,6 +24,7 @@ const (
 	TargetTimeoutCol          = "timeout"
 	TargetAsyncCol            = "async"
 	TargetInterruptOnErrorCol = "interrupt_on_error"
+	TargetSigningKeyCol       = "signing_key"
 )
 
 type targetProjection struct{}
@@ -51,6 +52,7 @@ func (*targetProjection) Init() *old_handler.Check {
 			handler.NewColumn(TargetTimeoutCol, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(TargetAsyncCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(TargetInterruptOnErrorCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(TargetSigningKeyCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(TargetInstanceIDCol, TargetIDCol),
 		),
@@ -108,6 +110,7 @@ func (p *targetProjection) reduceTargetAdded(event eventstore.Event) (*handler.S
 			handler.NewCol(TargetTimeoutCol, e.Timeout),
 			handler.NewCol(TargetAsyncCol, e.Async),
 			handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
+			handler.NewCol(TargetSigningKeyCol, e.SigningKey),
 		},
 	), nil
 }
@@ -140,6 +143,9 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	if e.InterruptOnError != nil {
 		values = append(values, handler.NewCol(TargetInterruptOnErrorCol, *e.InterruptOnError))
 	}
+	if e.SigningKey != nil {
+		values = append(values, handler.NewCol(TargetSigningKeyCol, e.SigningKey))
+	}
 	return handler.NewUpdateStatement(
 		e,
 		values,
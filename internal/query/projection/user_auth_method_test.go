@@ -483,6 +483,68 @@ func TestUserAuthMethodProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceRecoveryCodesAdded",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanRecoveryCodesAddedType,
+					user.AggregateType,
+					[]byte(`{"codeHashes": ["hash"]}`),
+				), eventstore.GenericEventMapper[user.HumanRecoveryCodesAddedEvent]),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceRecoveryCodesAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_auth_methods4 (token_id, creation_date, change_date, resource_owner, instance_id, user_id, sequence, state, method_type, name) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, user_id, method_type, token_id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, state, name) = (projections.user_auth_methods4.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.state, EXCLUDED.name)",
+							expectedArgs: []interface{}{
+								"",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								domain.MFAStateReady,
+								domain.UserAuthMethodTypeRecoveryCode,
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoveRecoveryCodes",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanRecoveryCodesRemovedType,
+					user.AggregateType,
+					nil,
+				), eventstore.GenericEventMapper[user.HumanRecoveryCodesRemovedEvent]),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceRemoveAuthMethod,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_auth_methods4 WHERE (user_id = $1) AND (method_type = $2) AND (resource_owner = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								domain.UserAuthMethodTypeRecoveryCode,
+								"ro-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:   "org reduceOwnerRemoved",
 			reduce: (&userAuthMethodProjection{}).reduceOwnerRemoved,
@@ -0,0 +1,162 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	samlSessionTable = table{
+		name:          projection.SAMLSessionTable,
+		instanceIDCol: projection.SAMLSessionInstanceIDCol,
+	}
+	SAMLSessionColumnID = Column{
+		name:  projection.SAMLSessionIDCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnInstanceID = Column{
+		name:  projection.SAMLSessionInstanceIDCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnCreationDate = Column{
+		name:  projection.SAMLSessionCreationDateCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnUserID = Column{
+		name:  projection.SAMLSessionUserIDCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnUserResourceOwner = Column{
+		name:  projection.SAMLSessionUserResourceOwnerCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnUserAgentID = Column{
+		name:  projection.SAMLSessionUserAgentIDCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnAppID = Column{
+		name:  projection.SAMLSessionAppIDCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnEntityID = Column{
+		name:  projection.SAMLSessionEntityIDCol,
+		table: samlSessionTable,
+	}
+	SAMLSessionColumnNameID = Column{
+		name:  projection.SAMLSessionNameIDCol,
+		table: samlSessionTable,
+	}
+)
+
+type SAMLSessions struct {
+	SearchResponse
+	SAMLSessions []*SAMLSession
+}
+
+// SAMLSession is an active participation of a SAML service provider in a user agent session
+type SAMLSession struct {
+	ID                string
+	CreationDate      time.Time
+	UserID            string
+	UserResourceOwner string
+	UserAgentID       string
+	AppID             string
+	EntityID          string
+	NameID            string
+}
+
+// ActiveSAMLSessionsByUserAgent returns the sessions of all service providers
+// a SAML response was issued to for the user agents and which were not logged out yet.
+func (q *Queries) ActiveSAMLSessionsByUserAgent(ctx context.Context, userAgentIDs ...string) (sessions *SAMLSessions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(userAgentIDs) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Aeph7", "Errors.SAMLSession.Invalid")
+	}
+	return q.searchActiveSAMLSessions(ctx, sq.Eq{
+		SAMLSessionColumnUserAgentID.identifier(): userAgentIDs,
+	})
+}
+
+// ActiveSAMLSessionsByNameID returns the sessions of the service provider for the subject it knows the user by.
+func (q *Queries) ActiveSAMLSessionsByNameID(ctx context.Context, entityID, nameID string) (sessions *SAMLSessions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if entityID == "" || nameID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-ahY5o", "Errors.SAMLSession.Invalid")
+	}
+	return q.searchActiveSAMLSessions(ctx, sq.Eq{
+		SAMLSessionColumnEntityID.identifier(): entityID,
+		SAMLSessionColumnNameID.identifier():   nameID,
+	})
+}
+
+func (q *Queries) searchActiveSAMLSessions(ctx context.Context, eq sq.Eq) (*SAMLSessions, error) {
+	_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerSAMLSessionProjection")
+	ctx, err := projection.SAMLSessionProjection.Trigger(ctx, handler.WithAwaitRunning())
+	logging.OnError(err).Debug("trigger failed")
+	traceSpan.EndWithError(err)
+
+	eq[SAMLSessionColumnInstanceID.identifier()] = authz.GetInstance(ctx).InstanceID()
+	query, scan := prepareSAMLSessionsQuery(ctx, q.client)
+	return genericRowsQuery[*SAMLSessions](ctx, q.client, query.Where(eq).OrderBy(SAMLSessionColumnCreationDate.identifier()), scan)
+}
+
+func prepareSAMLSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*SAMLSessions, error)) {
+	return sq.Select(
+			SAMLSessionColumnID.identifier(),
+			SAMLSessionColumnCreationDate.identifier(),
+			SAMLSessionColumnUserID.identifier(),
+			SAMLSessionColumnUserResourceOwner.identifier(),
+			SAMLSessionColumnUserAgentID.identifier(),
+			SAMLSessionColumnAppID.identifier(),
+			SAMLSessionColumnEntityID.identifier(),
+			SAMLSessionColumnNameID.identifier(),
+			countColumn.identifier(),
+		).
+			From(samlSessionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SAMLSessions, error) {
+			sessions := make([]*SAMLSession, 0)
+			var count uint64
+			for rows.Next() {
+				session := new(SAMLSession)
+				err := rows.Scan(
+					&session.ID,
+					&session.CreationDate,
+					&session.UserID,
+					&session.UserResourceOwner,
+					&session.UserAgentID,
+					&session.AppID,
+					&session.EntityID,
+					&session.NameID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				sessions = append(sessions, session)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-ooT3a", "Errors.Query.CloseRows")
+			}
+			return &SAMLSessions{
+				SAMLSessions: sessions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
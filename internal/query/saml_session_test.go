@@ -0,0 +1,141 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareSAMLSessionsStmt = `SELECT projections.saml_sessions.id,` +
+		` projections.saml_sessions.creation_date,` +
+		` projections.saml_sessions.user_id,` +
+		` projections.saml_sessions.user_resource_owner,` +
+		` projections.saml_sessions.user_agent_id,` +
+		` projections.saml_sessions.app_id,` +
+		` projections.saml_sessions.entity_id,` +
+		` projections.saml_sessions.name_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.saml_sessions`
+	prepareSAMLSessionsCols = []string{
+		"id",
+		"creation_date",
+		"user_id",
+		"user_resource_owner",
+		"user_agent_id",
+		"app_id",
+		"entity_id",
+		"name_id",
+		"count",
+	}
+)
+
+func Test_SAMLSessionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareSAMLSessionsQuery no result",
+			prepare: prepareSAMLSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSAMLSessionsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &SAMLSessions{SAMLSessions: []*SAMLSession{}},
+		},
+		{
+			name:    "prepareSAMLSessionsQuery multiple results",
+			prepare: prepareSAMLSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSAMLSessionsStmt),
+					prepareSAMLSessionsCols,
+					[][]driver.Value{
+						{
+							"session1",
+							testNow,
+							"user1",
+							"org1",
+							"agent1",
+							"app1",
+							"https://sp1.example.com/metadata",
+							"user@example.com",
+						},
+						{
+							"session2",
+							testNow,
+							"user1",
+							"org1",
+							"agent1",
+							"app2",
+							"https://sp2.example.com/metadata",
+							"user@example.com",
+						},
+					},
+				),
+			},
+			object: &SAMLSessions{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				SAMLSessions: []*SAMLSession{
+					{
+						ID:                "session1",
+						CreationDate:      testNow,
+						UserID:            "user1",
+						UserResourceOwner: "org1",
+						UserAgentID:       "agent1",
+						AppID:             "app1",
+						EntityID:          "https://sp1.example.com/metadata",
+						NameID:            "user@example.com",
+					},
+					{
+						ID:                "session2",
+						CreationDate:      testNow,
+						UserID:            "user1",
+						UserResourceOwner: "org1",
+						UserAgentID:       "agent1",
+						AppID:             "app2",
+						EntityID:          "https://sp2.example.com/metadata",
+						NameID:            "user@example.com",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSAMLSessionsQuery sql err",
+			prepare: prepareSAMLSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareSAMLSessionsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SAMLSessions)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	scimTargetTable = table{
+		name:          projection.SCIMTargetTable,
+		instanceIDCol: projection.SCIMTargetInstanceIDCol,
+	}
+	SCIMTargetColumnID = Column{
+		name:  projection.SCIMTargetIDCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnCreationDate = Column{
+		name:  projection.SCIMTargetCreationDateCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnChangeDate = Column{
+		name:  projection.SCIMTargetChangeDateCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnResourceOwner = Column{
+		name:  projection.SCIMTargetResourceOwnerCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnInstanceID = Column{
+		name:  projection.SCIMTargetInstanceIDCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnSequence = Column{
+		name:  projection.SCIMTargetSequenceCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnProjectID = Column{
+		name:  projection.SCIMTargetProjectIDCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnAppID = Column{
+		name:  projection.SCIMTargetAppIDCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnName = Column{
+		name:  projection.SCIMTargetNameCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnEndpoint = Column{
+		name:  projection.SCIMTargetEndpointCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnToken = Column{
+		name:  projection.SCIMTargetTokenCol,
+		table: scimTargetTable,
+	}
+	SCIMTargetColumnTimeout = Column{
+		name:  projection.SCIMTargetTimeoutCol,
+		table: scimTargetTable,
+	}
+)
+
+var (
+	scimProvisioningTable = table{
+		name:          projection.SCIMProvisioningTable,
+		instanceIDCol: projection.SCIMProvisioningInstanceIDCol,
+	}
+	SCIMProvisioningColumnTargetID = Column{
+		name:  projection.SCIMProvisioningTargetIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnInstanceID = Column{
+		name:  projection.SCIMProvisioningInstanceIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnUserID = Column{
+		name:  projection.SCIMProvisioningUserIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnUserGrantID = Column{
+		name:  projection.SCIMProvisioningUserGrantIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnRemoteID = Column{
+		name:  projection.SCIMProvisioningRemoteIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnState = Column{
+		name:  projection.SCIMProvisioningStateCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnFailureCount = Column{
+		name:  projection.SCIMProvisioningFailureCountCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnLastError = Column{
+		name:  projection.SCIMProvisioningLastErrorCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnChangeDate = Column{
+		name:  projection.SCIMProvisioningChangeDateCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnSequence = Column{
+		name:  projection.SCIMProvisioningSequenceCol,
+		table: scimProvisioningTable,
+	}
+)
+
+type SCIMTargets struct {
+	SearchResponse
+	SCIMTargets []*SCIMTarget
+}
+
+func (t *SCIMTargets) SetState(s *State) {
+	t.State = s
+}
+
+type SCIMTarget struct {
+	ID string
+	domain.ObjectDetails
+	CreationDate time.Time
+
+	ProjectID string
+	AppID     string
+	Name      string
+	Endpoint  string
+	Token     *crypto.CryptoValue
+	Timeout   time.Duration
+}
+
+type SCIMTargetSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *SCIMTargetSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchSCIMTargets(ctx context.Context, shouldTriggerBulk bool, queries *SCIMTargetSearchQueries) (targets *SCIMTargets, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = triggerSCIMTargetProjection(ctx)
+	}
+	eq := sq.Eq{
+		SCIMTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMTargetsQuery(ctx, q.client)
+	return genericRowsQueryWithState[*SCIMTargets](ctx, q.client, scimTargetTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func (q *Queries) GetSCIMTargetByID(ctx context.Context, id, resourceOwner string) (target *SCIMTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		SCIMTargetColumnID.identifier():            id,
+		SCIMTargetColumnResourceOwner.identifier(): resourceOwner,
+		SCIMTargetColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMTargetQuery(ctx, q.client)
+	return genericRowQuery[*SCIMTarget](ctx, q.client, query.Where(eq), scan)
+}
+
+func NewSCIMTargetResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMTargetColumnResourceOwner, value, TextEquals)
+}
+
+func NewSCIMTargetProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMTargetColumnProjectID, value, TextEquals)
+}
+
+func NewSCIMTargetAppIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMTargetColumnAppID, value, TextEquals)
+}
+
+func NewSCIMTargetNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMTargetColumnName, value, method)
+}
+
+func NewSCIMTargetInIDsSearchQuery(values []string) (SearchQuery, error) {
+	return NewInTextQuery(SCIMTargetColumnID, values)
+}
+
+func triggerSCIMTargetProjection(ctx context.Context) context.Context {
+	_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerSCIMTargetProjection")
+	ctx, err := projection.SCIMTargetProjection.Trigger(ctx, handler.WithAwaitRunning())
+	logging.OnError(err).Debug("trigger failed")
+	traceSpan.EndWithError(err)
+	return ctx
+}
+
+func prepareSCIMTargetsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*SCIMTargets, error)) {
+	return sq.Select(
+			SCIMTargetColumnID.identifier(),
+			SCIMTargetColumnCreationDate.identifier(),
+			SCIMTargetColumnChangeDate.identifier(),
+			SCIMTargetColumnResourceOwner.identifier(),
+			SCIMTargetColumnSequence.identifier(),
+			SCIMTargetColumnProjectID.identifier(),
+			SCIMTargetColumnAppID.identifier(),
+			SCIMTargetColumnName.identifier(),
+			SCIMTargetColumnEndpoint.identifier(),
+			SCIMTargetColumnToken.identifier(),
+			SCIMTargetColumnTimeout.identifier(),
+			countColumn.identifier(),
+		).From(scimTargetTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SCIMTargets, error) {
+			targets := make([]*SCIMTarget, 0)
+			var count uint64
+			for rows.Next() {
+				target := new(SCIMTarget)
+				err := rows.Scan(
+					&target.ID,
+					&target.CreationDate,
+					&target.EventDate,
+					&target.ResourceOwner,
+					&target.Sequence,
+					&target.ProjectID,
+					&target.AppID,
+					&target.Name,
+					&target.Endpoint,
+					&target.Token,
+					&target.Timeout,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				targets = append(targets, target)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sct7c", "Errors.Query.CloseRows")
+			}
+
+			return &SCIMTargets{
+				SCIMTargets: targets,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareSCIMTargetQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(row *sql.Row) (*SCIMTarget, error)) {
+	return sq.Select(
+			SCIMTargetColumnID.identifier(),
+			SCIMTargetColumnCreationDate.identifier(),
+			SCIMTargetColumnChangeDate.identifier(),
+			SCIMTargetColumnResourceOwner.identifier(),
+			SCIMTargetColumnSequence.identifier(),
+			SCIMTargetColumnProjectID.identifier(),
+			SCIMTargetColumnAppID.identifier(),
+			SCIMTargetColumnName.identifier(),
+			SCIMTargetColumnEndpoint.identifier(),
+			SCIMTargetColumnToken.identifier(),
+			SCIMTargetColumnTimeout.identifier(),
+		).From(scimTargetTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*SCIMTarget, error) {
+			target := new(SCIMTarget)
+			err := row.Scan(
+				&target.ID,
+				&target.CreationDate,
+				&target.EventDate,
+				&target.ResourceOwner,
+				&target.Sequence,
+				&target.ProjectID,
+				&target.AppID,
+				&target.Name,
+				&target.Endpoint,
+				&target.Token,
+				&target.Timeout,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Sct8n", "Errors.SCIMTarget.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sct8i", "Errors.Internal")
+			}
+			return target, nil
+		}
+}
+
+type SCIMProvisioningStates struct {
+	SearchResponse
+	States []*SCIMProvisioningState
+}
+
+func (s *SCIMProvisioningStates) SetState(state *State) {
+	s.State = state
+}
+
+// SCIMProvisioningState is the state of a user at a SCIM target
+type SCIMProvisioningState struct {
+	TargetID     string
+	UserID       string
+	UserGrantID  string
+	RemoteID     string
+	State        domain.SCIMProvisioningState
+	FailureCount uint64
+	LastError    string
+	ChangeDate   time.Time
+	Sequence     uint64
+}
+
+type SCIMProvisioningStateSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *SCIMProvisioningStateSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchSCIMProvisioningStates(ctx context.Context, shouldTriggerBulk bool, queries *SCIMProvisioningStateSearchQueries) (states *SCIMProvisioningStates, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		ctx = triggerSCIMTargetProjection(ctx)
+	}
+	eq := sq.Eq{
+		SCIMProvisioningColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMProvisioningStatesQuery(ctx, q.client)
+	return genericRowsQueryWithState[*SCIMProvisioningStates](ctx, q.client, scimTargetTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewSCIMProvisioningTargetIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMProvisioningColumnTargetID, value, TextEquals)
+}
+
+func NewSCIMProvisioningUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMProvisioningColumnUserID, value, TextEquals)
+}
+
+func NewSCIMProvisioningUserGrantIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(SCIMProvisioningColumnUserGrantID, value, TextEquals)
+}
+
+func NewSCIMProvisioningStateSearchQuery(value domain.SCIMProvisioningState) (SearchQuery, error) {
+	return NewNumberQuery(SCIMProvisioningColumnState, value, NumberEquals)
+}
+
+func prepareSCIMProvisioningStatesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*SCIMProvisioningStates, error)) {
+	return sq.Select(
+			SCIMProvisioningColumnTargetID.identifier(),
+			SCIMProvisioningColumnUserID.identifier(),
+			SCIMProvisioningColumnUserGrantID.identifier(),
+			SCIMProvisioningColumnRemoteID.identifier(),
+			SCIMProvisioningColumnState.identifier(),
+			SCIMProvisioningColumnFailureCount.identifier(),
+			SCIMProvisioningColumnLastError.identifier(),
+			SCIMProvisioningColumnChangeDate.identifier(),
+			SCIMProvisioningColumnSequence.identifier(),
+			countColumn.identifier(),
+		).From(scimProvisioningTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SCIMProvisioningStates, error) {
+			states := make([]*SCIMProvisioningState, 0)
+			var count uint64
+			for rows.Next() {
+				state := new(SCIMProvisioningState)
+				err := rows.Scan(
+					&state.TargetID,
+					&state.UserID,
+					&state.UserGrantID,
+					&state.RemoteID,
+					&state.State,
+					&state.FailureCount,
+					&state.LastError,
+					&state.ChangeDate,
+					&state.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				states = append(states, state)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sct9c", "Errors.Query.CloseRows")
+			}
+
+			return &SCIMProvisioningStates{
+				States: states,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// SessionLogoutClient is a client which received an id_token
+// with the session id (`sid` claim) of a user.
+type SessionLogoutClient struct {
+	UserID                string
+	ClientID              string
+	Issuer                string
+	BackChannelLogoutSent bool
+}
+
+type SessionLogoutReadModel struct {
+	eventstore.WriteModel
+
+	Clients []*SessionLogoutClient
+}
+
+func newSessionLogoutReadModel(sessionID, instanceID string) *SessionLogoutReadModel {
+	return &SessionLogoutReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   sessionID,
+			ResourceOwner: instanceID,
+		},
+	}
+}
+
+func (rm *SessionLogoutReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *sessionlogout.RegisteredEvent:
+			client := rm.client(e.UserID, e.ClientID)
+			client.Issuer = e.Issuer
+			client.BackChannelLogoutSent = false
+		case *sessionlogout.BackChannelLogoutSentEvent:
+			rm.client(e.UserID, e.ClientID).BackChannelLogoutSent = true
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *SessionLogoutReadModel) client(userID, clientID string) *SessionLogoutClient {
+	for _, client := range rm.Clients {
+		if client.UserID == userID && client.ClientID == clientID {
+			return client
+		}
+	}
+	client := &SessionLogoutClient{UserID: userID, ClientID: clientID}
+	rm.Clients = append(rm.Clients, client)
+	return client
+}
+
+func (rm *SessionLogoutReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(sessionlogout.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			sessionlogout.RegisteredType,
+			sessionlogout.BackChannelLogoutSentType,
+		).
+		Builder()
+}
+
+// SessionLogoutClients returns the clients which have to be notified on the logout of the session.
+func (q *Queries) SessionLogoutClients(ctx context.Context, sessionID string) (_ []*SessionLogoutClient, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newSessionLogoutReadModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return model.Clients, nil
+}
@@ -31,7 +31,10 @@ type SMSConfig struct {
 	State         domain.SMSConfigState
 	Sequence      uint64
 
-	TwilioConfig *Twilio
+	TwilioConfig      *Twilio
+	VonageConfig      *Vonage
+	MessageBirdConfig *MessageBird
+	HTTPConfig        *SMSHTTP
 }
 
 type Twilio struct {
@@ -40,6 +43,26 @@ type Twilio struct {
 	SenderNumber string
 }
 
+type Vonage struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type MessageBird struct {
+	AccessKey  *crypto.CryptoValue
+	Originator string
+}
+
+type SMSHTTP struct {
+	Endpoint      string
+	Method        string
+	ContentType   string
+	BodyTemplate  string
+	SenderNumber  string
+	Authorization *crypto.CryptoValue
+}
+
 type SMSConfigsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -115,6 +138,83 @@ var (
 	}
 )
 
+var (
+	smsVonageConfigsTable = table{
+		name:          projection.SMSVonageTable,
+		instanceIDCol: projection.SMSVonageColumnInstanceID,
+	}
+	SMSVonageConfigColumnSMSID = Column{
+		name:  projection.SMSVonageConfigColumnSMSID,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnAPIKey = Column{
+		name:  projection.SMSVonageConfigColumnAPIKey,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnAPISecret = Column{
+		name:  projection.SMSVonageConfigColumnAPISecret,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnSenderNumber = Column{
+		name:  projection.SMSVonageConfigColumnSenderNumber,
+		table: smsVonageConfigsTable,
+	}
+)
+
+var (
+	smsMessageBirdConfigsTable = table{
+		name:          projection.SMSMessageBirdTable,
+		instanceIDCol: projection.SMSMessageBirdColumnInstanceID,
+	}
+	SMSMessageBirdConfigColumnSMSID = Column{
+		name:  projection.SMSMessageBirdConfigColumnSMSID,
+		table: smsMessageBirdConfigsTable,
+	}
+	SMSMessageBirdConfigColumnAccessKey = Column{
+		name:  projection.SMSMessageBirdConfigColumnAccessKey,
+		table: smsMessageBirdConfigsTable,
+	}
+	SMSMessageBirdConfigColumnOriginator = Column{
+		name:  projection.SMSMessageBirdConfigColumnOriginator,
+		table: smsMessageBirdConfigsTable,
+	}
+)
+
+var (
+	smsHTTPConfigsTable = table{
+		name:          projection.SMSHTTPTable,
+		instanceIDCol: projection.SMSHTTPColumnInstanceID,
+	}
+	SMSHTTPConfigColumnSMSID = Column{
+		name:  projection.SMSHTTPConfigColumnSMSID,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnEndpoint = Column{
+		name:  projection.SMSHTTPConfigColumnEndpoint,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnMethod = Column{
+		name:  projection.SMSHTTPConfigColumnMethod,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnContentType = Column{
+		name:  projection.SMSHTTPConfigColumnContentType,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnBodyTemplate = Column{
+		name:  projection.SMSHTTPConfigColumnBodyTemplate,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnSenderNumber = Column{
+		name:  projection.SMSHTTPConfigColumnSenderNumber,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnAuthorization = Column{
+		name:  projection.SMSHTTPConfigColumnAuthorization,
+		table: smsHTTPConfigsTable,
+	}
+)
+
 func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -203,13 +303,36 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSVonageConfigColumnSMSID.identifier(),
+			SMSVonageConfigColumnAPIKey.identifier(),
+			SMSVonageConfigColumnAPISecret.identifier(),
+			SMSVonageConfigColumnSenderNumber.identifier(),
+
+			SMSMessageBirdConfigColumnSMSID.identifier(),
+			SMSMessageBirdConfigColumnAccessKey.identifier(),
+			SMSMessageBirdConfigColumnOriginator.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnContentType.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+			SMSHTTPConfigColumnAuthorization.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSVonageConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSMessageBirdConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
-				twilioConfig = sqlTwilioConfig{}
+				twilioConfig      = sqlTwilioConfig{}
+				vonageConfig      = sqlVonageConfig{}
+				messageBirdConfig = sqlMessageBirdConfig{}
+				httpConfig        = sqlSMSHTTPConfig{}
 			)
 
 			err := row.Scan(
@@ -225,6 +348,23 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&twilioConfig.sid,
 				&twilioConfig.token,
 				&twilioConfig.senderNumber,
+
+				&vonageConfig.smsID,
+				&vonageConfig.apiKey,
+				&vonageConfig.apiSecret,
+				&vonageConfig.senderNumber,
+
+				&messageBirdConfig.smsID,
+				&messageBirdConfig.accessKey,
+				&messageBirdConfig.originator,
+
+				&httpConfig.smsID,
+				&httpConfig.endpoint,
+				&httpConfig.method,
+				&httpConfig.contentType,
+				&httpConfig.bodyTemplate,
+				&httpConfig.senderNumber,
+				&httpConfig.authorization,
 			)
 
 			if err != nil {
@@ -235,6 +375,9 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			}
 
 			twilioConfig.set(config)
+			vonageConfig.set(config)
+			messageBirdConfig.set(config)
+			httpConfig.set(config)
 
 			return config, nil
 		}
@@ -254,16 +397,39 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSVonageConfigColumnSMSID.identifier(),
+			SMSVonageConfigColumnAPIKey.identifier(),
+			SMSVonageConfigColumnAPISecret.identifier(),
+			SMSVonageConfigColumnSenderNumber.identifier(),
+
+			SMSMessageBirdConfigColumnSMSID.identifier(),
+			SMSMessageBirdConfigColumnAccessKey.identifier(),
+			SMSMessageBirdConfigColumnOriginator.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnContentType.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+			SMSHTTPConfigColumnAuthorization.identifier(),
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSVonageConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSMessageBirdConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
 			for row.Next() {
 				config := new(SMSConfig)
 				var (
-					twilioConfig = sqlTwilioConfig{}
+					twilioConfig      = sqlTwilioConfig{}
+					vonageConfig      = sqlVonageConfig{}
+					messageBirdConfig = sqlMessageBirdConfig{}
+					httpConfig        = sqlSMSHTTPConfig{}
 				)
 
 				err := row.Scan(
@@ -279,6 +445,23 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&twilioConfig.sid,
 					&twilioConfig.token,
 					&twilioConfig.senderNumber,
+
+					&vonageConfig.smsID,
+					&vonageConfig.apiKey,
+					&vonageConfig.apiSecret,
+					&vonageConfig.senderNumber,
+
+					&messageBirdConfig.smsID,
+					&messageBirdConfig.accessKey,
+					&messageBirdConfig.originator,
+
+					&httpConfig.smsID,
+					&httpConfig.endpoint,
+					&httpConfig.method,
+					&httpConfig.contentType,
+					&httpConfig.bodyTemplate,
+					&httpConfig.senderNumber,
+					&httpConfig.authorization,
 					&configs.Count,
 				)
 
@@ -287,6 +470,9 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				}
 
 				twilioConfig.set(config)
+				vonageConfig.set(config)
+				messageBirdConfig.set(config)
+				httpConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -312,3 +498,61 @@ func (c sqlTwilioConfig) set(smsConfig *SMSConfig) {
 		SenderNumber: c.senderNumber.String,
 	}
 }
+
+type sqlVonageConfig struct {
+	smsID        sql.NullString
+	apiKey       sql.NullString
+	apiSecret    *crypto.CryptoValue
+	senderNumber sql.NullString
+}
+
+func (c sqlVonageConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.VonageConfig = &Vonage{
+		APIKey:       c.apiKey.String,
+		APISecret:    c.apiSecret,
+		SenderNumber: c.senderNumber.String,
+	}
+}
+
+type sqlMessageBirdConfig struct {
+	smsID      sql.NullString
+	accessKey  *crypto.CryptoValue
+	originator sql.NullString
+}
+
+func (c sqlMessageBirdConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.MessageBirdConfig = &MessageBird{
+		AccessKey:  c.accessKey,
+		Originator: c.originator.String,
+	}
+}
+
+type sqlSMSHTTPConfig struct {
+	smsID         sql.NullString
+	endpoint      sql.NullString
+	method        sql.NullString
+	contentType   sql.NullString
+	bodyTemplate  sql.NullString
+	senderNumber  sql.NullString
+	authorization *crypto.CryptoValue
+}
+
+func (c sqlSMSHTTPConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.HTTPConfig = &SMSHTTP{
+		Endpoint:      c.endpoint.String,
+		Method:        c.method.String,
+		ContentType:   c.contentType.String,
+		BodyTemplate:  c.bodyTemplate.String,
+		SenderNumber:  c.senderNumber.String,
+		Authorization: c.authorization,
+	}
+}
@@ -14,38 +14,84 @@ import (
 )
 
 var (
-	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs2.id,` +
-		` projections.sms_configs2.aggregate_id,` +
-		` projections.sms_configs2.creation_date,` +
-		` projections.sms_configs2.change_date,` +
-		` projections.sms_configs2.resource_owner,` +
-		` projections.sms_configs2.state,` +
-		` projections.sms_configs2.sequence,` +
+	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
+		` projections.sms_configs3.aggregate_id,` +
+		` projections.sms_configs3.creation_date,` +
+		` projections.sms_configs3.change_date,` +
+		` projections.sms_configs3.resource_owner,` +
+		` projections.sms_configs3.state,` +
+		` projections.sms_configs3.sequence,` +
 
 		// twilio config
-		` projections.sms_configs2_twilio.sms_id,` +
-		` projections.sms_configs2_twilio.sid,` +
-		` projections.sms_configs2_twilio.token,` +
-		` projections.sms_configs2_twilio.sender_number` +
-		` FROM projections.sms_configs2` +
-		` LEFT JOIN projections.sms_configs2_twilio ON projections.sms_configs2.id = projections.sms_configs2_twilio.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_twilio.instance_id` +
+		` projections.sms_configs3_twilio.sms_id,` +
+		` projections.sms_configs3_twilio.sid,` +
+		` projections.sms_configs3_twilio.token,` +
+		` projections.sms_configs3_twilio.sender_number,` +
+
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+
+		// messagebird config
+		` projections.sms_configs3_messagebird.sms_id,` +
+		` projections.sms_configs3_messagebird.access_key,` +
+		` projections.sms_configs3_messagebird.originator,` +
+
+		// http config
+		` projections.sms_configs3_http.sms_id,` +
+		` projections.sms_configs3_http.endpoint,` +
+		` projections.sms_configs3_http.method,` +
+		` projections.sms_configs3_http.content_type,` +
+		` projections.sms_configs3_http.body_template,` +
+		` projections.sms_configs3_http.sender_number,` +
+		` projections.sms_configs3_http.authorization_header` +
+		` FROM projections.sms_configs3` +
+		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_messagebird ON projections.sms_configs3.id = projections.sms_configs3_messagebird.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_messagebird.instance_id` +
+		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs2.id,` +
-		` projections.sms_configs2.aggregate_id,` +
-		` projections.sms_configs2.creation_date,` +
-		` projections.sms_configs2.change_date,` +
-		` projections.sms_configs2.resource_owner,` +
-		` projections.sms_configs2.state,` +
-		` projections.sms_configs2.sequence,` +
+	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
+		` projections.sms_configs3.aggregate_id,` +
+		` projections.sms_configs3.creation_date,` +
+		` projections.sms_configs3.change_date,` +
+		` projections.sms_configs3.resource_owner,` +
+		` projections.sms_configs3.state,` +
+		` projections.sms_configs3.sequence,` +
 
 		// twilio config
-		` projections.sms_configs2_twilio.sms_id,` +
-		` projections.sms_configs2_twilio.sid,` +
-		` projections.sms_configs2_twilio.token,` +
-		` projections.sms_configs2_twilio.sender_number,` +
+		` projections.sms_configs3_twilio.sms_id,` +
+		` projections.sms_configs3_twilio.sid,` +
+		` projections.sms_configs3_twilio.token,` +
+		` projections.sms_configs3_twilio.sender_number,` +
+
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+
+		// messagebird config
+		` projections.sms_configs3_messagebird.sms_id,` +
+		` projections.sms_configs3_messagebird.access_key,` +
+		` projections.sms_configs3_messagebird.originator,` +
+
+		// http config
+		` projections.sms_configs3_http.sms_id,` +
+		` projections.sms_configs3_http.endpoint,` +
+		` projections.sms_configs3_http.method,` +
+		` projections.sms_configs3_http.content_type,` +
+		` projections.sms_configs3_http.body_template,` +
+		` projections.sms_configs3_http.sender_number,` +
+		` projections.sms_configs3_http.authorization_header,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.sms_configs2` +
-		` LEFT JOIN projections.sms_configs2_twilio ON projections.sms_configs2.id = projections.sms_configs2_twilio.sms_id AND projections.sms_configs2.instance_id = projections.sms_configs2_twilio.instance_id` +
+		` FROM projections.sms_configs3` +
+		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_messagebird ON projections.sms_configs3.id = projections.sms_configs3_messagebird.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_messagebird.instance_id` +
+		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	smsConfigCols = []string{
@@ -61,6 +107,23 @@ var (
 		"sid",
 		"token",
 		"sender-number",
+		// vonage config
+		"sms_id",
+		"api_key",
+		"api_secret",
+		"sender_number",
+		// messagebird config
+		"sms_id",
+		"access_key",
+		"originator",
+		// http config
+		"sms_id",
+		"endpoint",
+		"method",
+		"content_type",
+		"body_template",
+		"sender_number",
+		"authorization_header",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -109,6 +172,23 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -156,6 +236,23 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -170,6 +267,23 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid2",
 							&crypto.CryptoValue{},
 							"sender-number2",
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -284,6 +398,23 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						"sid",
 						&crypto.CryptoValue{},
 						"sender-number",
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						// messagebird config
+						nil,
+						nil,
+						nil,
+						// http config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -302,6 +433,64 @@ func Test_SMSConfigPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSConfigQuery http config found",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedSMSConfigQuery,
+					smsConfigCols,
+					[]driver.Value{
+						"sms-id",
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.SMSConfigStateInactive,
+						uint64(20211109),
+						// twilio config
+						nil,
+						nil,
+						nil,
+						nil,
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						// messagebird config
+						nil,
+						nil,
+						nil,
+						// http config
+						"sms-id",
+						"https://sms.example.com",
+						"POST",
+						"application/json",
+						"{{json .Content}}",
+						"sender-number",
+						&crypto.CryptoValue{},
+					},
+				),
+			},
+			object: &SMSConfig{
+				ID:            "sms-id",
+				AggregateID:   "agg-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.SMSConfigStateInactive,
+				Sequence:      20211109,
+				HTTPConfig: &SMSHTTP{
+					Endpoint:      "https://sms.example.com",
+					Method:        "POST",
+					ContentType:   "application/json",
+					BodyTemplate:  "{{json .Content}}",
+					SenderNumber:  "sender-number",
+					Authorization: &crypto.CryptoValue{},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigQuery sql err",
 			prepare: prepareSMSConfigQuery,
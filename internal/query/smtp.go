@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -21,6 +22,10 @@ var (
 		name:          projection.SMTPConfigProjectionTable,
 		instanceIDCol: projection.SMTPConfigColumnInstanceID,
 	}
+	SMTPConfigColumnID = Column{
+		name:  projection.SMTPConfigColumnID,
+		table: smtpConfigsTable,
+	}
 	SMTPConfigColumnAggregateID = Column{
 		name:  projection.SMTPConfigColumnAggregateID,
 		table: smtpConfigsTable,
@@ -41,12 +46,24 @@ var (
 		name:  projection.SMTPConfigColumnInstanceID,
 		table: smtpConfigsTable,
 	}
-	SMTPConfigColumnSequence = Column{
-		name:  projection.SMTPConfigColumnSequence,
+	SMTPConfigColumnOrgID = Column{
+		name:  projection.SMTPConfigColumnOrgID,
+		table: smtpConfigsTable,
+	}
+	SMTPConfigColumnDescription = Column{
+		name:  projection.SMTPConfigColumnDescription,
+		table: smtpConfigsTable,
+	}
+	SMTPConfigColumnPriority = Column{
+		name:  projection.SMTPConfigColumnPriority,
 		table: smtpConfigsTable,
 	}
-	SMTPConfigColumnTLS = Column{
-		name:  projection.SMTPConfigColumnTLS,
+	SMTPConfigColumnState = Column{
+		name:  projection.SMTPConfigColumnState,
+		table: smtpConfigsTable,
+	}
+	SMTPConfigColumnSequence = Column{
+		name:  projection.SMTPConfigColumnSequence,
 		table: smtpConfigsTable,
 	}
 	SMTPConfigColumnSenderAddress = Column{
@@ -61,17 +78,63 @@ var (
 		name:  projection.SMTPConfigColumnReplyToAddress,
 		table: smtpConfigsTable,
 	}
-	SMTPConfigColumnSMTPHost = Column{
-		name:  projection.SMTPConfigColumnSMTPHost,
-		table: smtpConfigsTable,
+)
+
+var (
+	smtpConfigsSMTPTable = table{
+		name:          projection.SMTPConfigSMTPTable,
+		instanceIDCol: projection.SMTPConfigSMTPColumnInstanceID,
 	}
-	SMTPConfigColumnSMTPUser = Column{
-		name:  projection.SMTPConfigColumnSMTPUser,
-		table: smtpConfigsTable,
+	SMTPConfigSMTPColumnID = Column{
+		name:  projection.SMTPConfigSMTPColumnID,
+		table: smtpConfigsSMTPTable,
 	}
-	SMTPConfigColumnSMTPPassword = Column{
-		name:  projection.SMTPConfigColumnSMTPPassword,
-		table: smtpConfigsTable,
+	SMTPConfigSMTPColumnTLS = Column{
+		name:  projection.SMTPConfigSMTPColumnTLS,
+		table: smtpConfigsSMTPTable,
+	}
+	SMTPConfigSMTPColumnHost = Column{
+		name:  projection.SMTPConfigSMTPColumnHost,
+		table: smtpConfigsSMTPTable,
+	}
+	SMTPConfigSMTPColumnUser = Column{
+		name:  projection.SMTPConfigSMTPColumnUser,
+		table: smtpConfigsSMTPTable,
+	}
+	SMTPConfigSMTPColumnPassword = Column{
+		name:  projection.SMTPConfigSMTPColumnPassword,
+		table: smtpConfigsSMTPTable,
+	}
+)
+
+var (
+	smtpConfigsHTTPTable = table{
+		name:          projection.SMTPConfigHTTPTable,
+		instanceIDCol: projection.SMTPConfigHTTPColumnInstanceID,
+	}
+	SMTPConfigHTTPColumnID = Column{
+		name:  projection.SMTPConfigHTTPColumnID,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnEndpoint = Column{
+		name:  projection.SMTPConfigHTTPColumnEndpoint,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnMethod = Column{
+		name:  projection.SMTPConfigHTTPColumnMethod,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnContentType = Column{
+		name:  projection.SMTPConfigHTTPColumnContentType,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnBodyTemplate = Column{
+		name:  projection.SMTPConfigHTTPColumnBodyTemplate,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnHeaders = Column{
+		name:  projection.SMTPConfigHTTPColumnHeaders,
+		table: smtpConfigsHTTPTable,
 	}
 )
 
@@ -80,30 +143,63 @@ type SMTPConfigs struct {
 	SMTPConfigs []*SMTPConfig
 }
 
+type SMTPConfigsSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *SMTPConfigsSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
 type SMTPConfig struct {
+	ID            string
 	AggregateID   string
 	CreationDate  time.Time
 	ChangeDate    time.Time
 	ResourceOwner string
 	Sequence      uint64
+	OrgID         string
+	Description   string
+	Priority      int32
+	State         domain.SMTPConfigState
 
-	TLS            bool
 	SenderAddress  string
 	SenderName     string
 	ReplyToAddress string
-	Host           string
-	User           string
-	Password       *crypto.CryptoValue
+
+	SMTPConfig *SMTP
+	HTTPConfig *EmailHTTP
+}
+
+type SMTP struct {
+	TLS      bool
+	Host     string
+	User     string
+	Password *crypto.CryptoValue
 }
 
-func (q *Queries) SMTPConfigByAggregateID(ctx context.Context, aggregateID string) (config *SMTPConfig, err error) {
+// EmailHTTP is the configuration of a provider sending emails using an HTTP API
+type EmailHTTP struct {
+	Endpoint     string
+	Method       string
+	ContentType  string
+	BodyTemplate string
+	Headers      *crypto.CryptoValue
+}
+
+func (q *Queries) SMTPConfigByID(ctx context.Context, id string) (config *SMTPConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	stmt, scan := prepareSMTPConfigQuery(ctx, q.client)
 	query, args, err := stmt.Where(sq.Eq{
-		SMTPConfigColumnAggregateID.identifier(): aggregateID,
-		SMTPConfigColumnInstanceID.identifier():  authz.GetInstance(ctx).InstanceID(),
+		SMTPConfigColumnID.identifier():         id,
+		SMTPConfigColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 	}).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-3m9sl", "Errors.Query.SQLStatment")
@@ -116,39 +212,141 @@ func (q *Queries) SMTPConfigByAggregateID(ctx context.Context, aggregateID strin
 	return config, err
 }
 
-func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*SMTPConfig, error)) {
-	password := new(crypto.CryptoValue)
+func (q *Queries) SearchSMTPConfigs(ctx context.Context, queries *SMTPConfigsSearchQueries) (configs *SMTPConfigs, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareSMTPConfigsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			SMTPConfigColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-4Rt9m", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		configs, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Wh2ks", "Errors.Internal")
+	}
+	configs.State, err = q.latestState(ctx, smtpConfigsTable)
+	return configs, err
+}
 
+// ActiveSMTPConfigs returns the active SMTP configurations used to send notifications to users of the organization
+// in the order they have to be tried:
+// the configurations of the organization take precedence over the ones of the instance,
+// each of them ordered by their priority.
+func (q *Queries) ActiveSMTPConfigs(ctx context.Context, orgID string) (configs []*SMTPConfig, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareSMTPConfigsQuery(ctx, q.client)
+	stmt, args, err := query.
+		Where(sq.Eq{
+			SMTPConfigColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+			SMTPConfigColumnState.identifier():      domain.SMTPConfigStateActive,
+			SMTPConfigColumnOrgID.identifier():      []string{orgID, ""},
+		}).
+		// configurations of the instance have an empty org_id and are therefore sorted last
+		OrderBy(
+			SMTPConfigColumnOrgID.identifier()+" DESC",
+			SMTPConfigColumnPriority.identifier(),
+			SMTPConfigColumnCreationDate.identifier(),
+		).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lp3sd", "Errors.Query.SQLStatment")
+	}
+
+	var result *SMTPConfigs
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		result, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	return result.SMTPConfigs, nil
+}
+
+func NewSMTPConfigStateSearchQuery(state domain.SMTPConfigState) (SearchQuery, error) {
+	return NewNumberQuery(SMTPConfigColumnState, state, NumberEquals)
+}
+
+func NewSMTPConfigOrgIDSearchQuery(orgID string) (SearchQuery, error) {
+	return NewTextQuery(SMTPConfigColumnOrgID, orgID, TextEquals)
+}
+
+func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*SMTPConfig, error)) {
 	return sq.Select(
+			SMTPConfigColumnID.identifier(),
 			SMTPConfigColumnAggregateID.identifier(),
 			SMTPConfigColumnCreationDate.identifier(),
 			SMTPConfigColumnChangeDate.identifier(),
 			SMTPConfigColumnResourceOwner.identifier(),
 			SMTPConfigColumnSequence.identifier(),
-			SMTPConfigColumnTLS.identifier(),
+			SMTPConfigColumnOrgID.identifier(),
+			SMTPConfigColumnDescription.identifier(),
+			SMTPConfigColumnPriority.identifier(),
+			SMTPConfigColumnState.identifier(),
 			SMTPConfigColumnSenderAddress.identifier(),
 			SMTPConfigColumnSenderName.identifier(),
 			SMTPConfigColumnReplyToAddress.identifier(),
-			SMTPConfigColumnSMTPHost.identifier(),
-			SMTPConfigColumnSMTPUser.identifier(),
-			SMTPConfigColumnSMTPPassword.identifier()).
-			From(smtpConfigsTable.identifier() + db.Timetravel(call.Took(ctx))).
+
+			SMTPConfigSMTPColumnID.identifier(),
+			SMTPConfigSMTPColumnTLS.identifier(),
+			SMTPConfigSMTPColumnHost.identifier(),
+			SMTPConfigSMTPColumnUser.identifier(),
+			SMTPConfigSMTPColumnPassword.identifier(),
+
+			SMTPConfigHTTPColumnID.identifier(),
+			SMTPConfigHTTPColumnEndpoint.identifier(),
+			SMTPConfigHTTPColumnMethod.identifier(),
+			SMTPConfigHTTPColumnContentType.identifier(),
+			SMTPConfigHTTPColumnBodyTemplate.identifier(),
+			SMTPConfigHTTPColumnHeaders.identifier()).
+			From(smtpConfigsTable.identifier()).
+			LeftJoin(join(SMTPConfigSMTPColumnID, SMTPConfigColumnID)).
+			LeftJoin(join(SMTPConfigHTTPColumnID, SMTPConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*SMTPConfig, error) {
 			config := new(SMTPConfig)
+
+			var (
+				smtpConfig = sqlSMTPConfigSMTP{}
+				httpConfig = sqlSMTPConfigHTTP{}
+			)
+
 			err := row.Scan(
+				&config.ID,
 				&config.AggregateID,
 				&config.CreationDate,
 				&config.ChangeDate,
 				&config.ResourceOwner,
 				&config.Sequence,
-				&config.TLS,
+				&config.OrgID,
+				&config.Description,
+				&config.Priority,
+				&config.State,
 				&config.SenderAddress,
 				&config.SenderName,
 				&config.ReplyToAddress,
-				&config.Host,
-				&config.User,
-				&password,
+
+				&smtpConfig.id,
+				&smtpConfig.tls,
+				&smtpConfig.host,
+				&smtpConfig.user,
+				&smtpConfig.password,
+
+				&httpConfig.id,
+				&httpConfig.endpoint,
+				&httpConfig.method,
+				&httpConfig.contentType,
+				&httpConfig.bodyTemplate,
+				&httpConfig.headers,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -156,7 +354,137 @@ func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				}
 				return nil, zerrors.ThrowInternal(err, "QUERY-9k87F", "Errors.Internal")
 			}
-			config.Password = password
+			smtpConfig.set(config)
+			httpConfig.set(config)
 			return config, nil
 		}
 }
+
+func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*SMTPConfigs, error)) {
+	return sq.Select(
+			SMTPConfigColumnID.identifier(),
+			SMTPConfigColumnAggregateID.identifier(),
+			SMTPConfigColumnCreationDate.identifier(),
+			SMTPConfigColumnChangeDate.identifier(),
+			SMTPConfigColumnResourceOwner.identifier(),
+			SMTPConfigColumnSequence.identifier(),
+			SMTPConfigColumnOrgID.identifier(),
+			SMTPConfigColumnDescription.identifier(),
+			SMTPConfigColumnPriority.identifier(),
+			SMTPConfigColumnState.identifier(),
+			SMTPConfigColumnSenderAddress.identifier(),
+			SMTPConfigColumnSenderName.identifier(),
+			SMTPConfigColumnReplyToAddress.identifier(),
+
+			SMTPConfigSMTPColumnID.identifier(),
+			SMTPConfigSMTPColumnTLS.identifier(),
+			SMTPConfigSMTPColumnHost.identifier(),
+			SMTPConfigSMTPColumnUser.identifier(),
+			SMTPConfigSMTPColumnPassword.identifier(),
+
+			SMTPConfigHTTPColumnID.identifier(),
+			SMTPConfigHTTPColumnEndpoint.identifier(),
+			SMTPConfigHTTPColumnMethod.identifier(),
+			SMTPConfigHTTPColumnContentType.identifier(),
+			SMTPConfigHTTPColumnBodyTemplate.identifier(),
+			SMTPConfigHTTPColumnHeaders.identifier(),
+			countColumn.identifier()).
+			From(smtpConfigsTable.identifier()).
+			LeftJoin(join(SMTPConfigSMTPColumnID, SMTPConfigColumnID)).
+			LeftJoin(join(SMTPConfigHTTPColumnID, SMTPConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SMTPConfigs, error) {
+			configs := &SMTPConfigs{SMTPConfigs: []*SMTPConfig{}}
+			for rows.Next() {
+				config := new(SMTPConfig)
+
+				var (
+					smtpConfig = sqlSMTPConfigSMTP{}
+					httpConfig = sqlSMTPConfigHTTP{}
+				)
+
+				err := rows.Scan(
+					&config.ID,
+					&config.AggregateID,
+					&config.CreationDate,
+					&config.ChangeDate,
+					&config.ResourceOwner,
+					&config.Sequence,
+					&config.OrgID,
+					&config.Description,
+					&config.Priority,
+					&config.State,
+					&config.SenderAddress,
+					&config.SenderName,
+					&config.ReplyToAddress,
+
+					&smtpConfig.id,
+					&smtpConfig.tls,
+					&smtpConfig.host,
+					&smtpConfig.user,
+					&smtpConfig.password,
+
+					&httpConfig.id,
+					&httpConfig.endpoint,
+					&httpConfig.method,
+					&httpConfig.contentType,
+					&httpConfig.bodyTemplate,
+					&httpConfig.headers,
+
+					&configs.Count,
+				)
+				if err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-9jR4s", "Errors.Internal")
+				}
+				smtpConfig.set(config)
+				httpConfig.set(config)
+				configs.SMTPConfigs = append(configs.SMTPConfigs, config)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Vh2la", "Errors.Query.CloseRows")
+			}
+			return configs, nil
+		}
+}
+
+type sqlSMTPConfigSMTP struct {
+	id       sql.NullString
+	tls      sql.NullBool
+	host     sql.NullString
+	user     sql.NullString
+	password *crypto.CryptoValue
+}
+
+func (c sqlSMTPConfigSMTP) set(smtpConfig *SMTPConfig) {
+	if !c.id.Valid {
+		return
+	}
+	smtpConfig.SMTPConfig = &SMTP{
+		TLS:      c.tls.Bool,
+		Host:     c.host.String,
+		User:     c.user.String,
+		Password: c.password,
+	}
+}
+
+type sqlSMTPConfigHTTP struct {
+	id           sql.NullString
+	endpoint     sql.NullString
+	method       sql.NullString
+	contentType  sql.NullString
+	bodyTemplate sql.NullString
+	headers      *crypto.CryptoValue
+}
+
+func (c sqlSMTPConfigHTTP) set(smtpConfig *SMTPConfig) {
+	if !c.id.Valid {
+		return
+	}
+	smtpConfig.HTTPConfig = &EmailHTTP{
+		Endpoint:     c.endpoint.String,
+		Method:       c.method.String,
+		ContentType:  c.contentType.String,
+		BodyTemplate: c.bodyTemplate.String,
+		Headers:      c.headers,
+	}
+}
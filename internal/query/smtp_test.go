@@ -9,38 +9,95 @@ import (
 	"testing"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var (
-	prepareSMTPConfigStmt = `SELECT projections.smtp_configs1.aggregate_id,` +
-		` projections.smtp_configs1.creation_date,` +
-		` projections.smtp_configs1.change_date,` +
-		` projections.smtp_configs1.resource_owner,` +
-		` projections.smtp_configs1.sequence,` +
-		` projections.smtp_configs1.tls,` +
-		` projections.smtp_configs1.sender_address,` +
-		` projections.smtp_configs1.sender_name,` +
-		` projections.smtp_configs1.reply_to_address,` +
-		` projections.smtp_configs1.host,` +
-		` projections.smtp_configs1.username,` +
-		` projections.smtp_configs1.password` +
-		` FROM projections.smtp_configs1` +
+	prepareSMTPConfigStmt = `SELECT projections.smtp_configs3.id,` +
+		` projections.smtp_configs3.aggregate_id,` +
+		` projections.smtp_configs3.creation_date,` +
+		` projections.smtp_configs3.change_date,` +
+		` projections.smtp_configs3.resource_owner,` +
+		` projections.smtp_configs3.sequence,` +
+		` projections.smtp_configs3.org_id,` +
+		` projections.smtp_configs3.description,` +
+		` projections.smtp_configs3.priority,` +
+		` projections.smtp_configs3.state,` +
+		` projections.smtp_configs3.sender_address,` +
+		` projections.smtp_configs3.sender_name,` +
+		` projections.smtp_configs3.reply_to_address,` +
+		` projections.smtp_configs3_smtp.id,` +
+		` projections.smtp_configs3_smtp.tls,` +
+		` projections.smtp_configs3_smtp.host,` +
+		` projections.smtp_configs3_smtp.username,` +
+		` projections.smtp_configs3_smtp.password,` +
+		` projections.smtp_configs3_http.id,` +
+		` projections.smtp_configs3_http.endpoint,` +
+		` projections.smtp_configs3_http.method,` +
+		` projections.smtp_configs3_http.content_type,` +
+		` projections.smtp_configs3_http.body_template,` +
+		` projections.smtp_configs3_http.headers` +
+		` FROM projections.smtp_configs3` +
+		` LEFT JOIN projections.smtp_configs3_smtp ON projections.smtp_configs3.id = projections.smtp_configs3_smtp.id AND projections.smtp_configs3.instance_id = projections.smtp_configs3_smtp.instance_id` +
+		` LEFT JOIN projections.smtp_configs3_http ON projections.smtp_configs3.id = projections.smtp_configs3_http.id AND projections.smtp_configs3.instance_id = projections.smtp_configs3_http.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareSMTPConfigCols = []string{
+		"id",
 		"aggregate_id",
 		"creation_date",
 		"change_date",
 		"resource_owner",
 		"sequence",
-		"tls",
+		"org_id",
+		"description",
+		"priority",
+		"state",
 		"sender_address",
 		"sender_name",
 		"reply_to_address",
-		"smtp_host",
-		"smtp_user",
-		"smtp_password",
+		"smtp_id",
+		"tls",
+		"host",
+		"username",
+		"password",
+		"http_id",
+		"endpoint",
+		"method",
+		"content_type",
+		"body_template",
+		"headers",
 	}
+	prepareSMTPConfigsStmt = `SELECT projections.smtp_configs3.id,` +
+		` projections.smtp_configs3.aggregate_id,` +
+		` projections.smtp_configs3.creation_date,` +
+		` projections.smtp_configs3.change_date,` +
+		` projections.smtp_configs3.resource_owner,` +
+		` projections.smtp_configs3.sequence,` +
+		` projections.smtp_configs3.org_id,` +
+		` projections.smtp_configs3.description,` +
+		` projections.smtp_configs3.priority,` +
+		` projections.smtp_configs3.state,` +
+		` projections.smtp_configs3.sender_address,` +
+		` projections.smtp_configs3.sender_name,` +
+		` projections.smtp_configs3.reply_to_address,` +
+		` projections.smtp_configs3_smtp.id,` +
+		` projections.smtp_configs3_smtp.tls,` +
+		` projections.smtp_configs3_smtp.host,` +
+		` projections.smtp_configs3_smtp.username,` +
+		` projections.smtp_configs3_smtp.password,` +
+		` projections.smtp_configs3_http.id,` +
+		` projections.smtp_configs3_http.endpoint,` +
+		` projections.smtp_configs3_http.method,` +
+		` projections.smtp_configs3_http.content_type,` +
+		` projections.smtp_configs3_http.body_template,` +
+		` projections.smtp_configs3_http.headers,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.smtp_configs3` +
+		` LEFT JOIN projections.smtp_configs3_smtp ON projections.smtp_configs3.id = projections.smtp_configs3_smtp.id AND projections.smtp_configs3.instance_id = projections.smtp_configs3_smtp.instance_id` +
+		` LEFT JOIN projections.smtp_configs3_http ON projections.smtp_configs3.id = projections.smtp_configs3_http.id AND projections.smtp_configs3.instance_id = projections.smtp_configs3_http.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareSMTPConfigsCols = append(prepareSMTPConfigCols, "count")
 )
 
 func Test_SMTPConfigsPrepares(t *testing.T) {
@@ -80,34 +137,109 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 					regexp.QuoteMeta(prepareSMTPConfigStmt),
 					prepareSMTPConfigCols,
 					[]driver.Value{
+						"config-id",
 						"agg-id",
 						testNow,
 						testNow,
 						"ro",
 						uint64(20211108),
-						true,
+						"org-id",
+						"description",
+						int32(1),
+						domain.SMTPConfigStateActive,
 						"sender",
 						"name",
 						"reply-to",
+						"config-id",
+						true,
 						"host",
 						"user",
 						&crypto.CryptoValue{},
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &SMTPConfig{
+				ID:             "config-id",
+				AggregateID:    "agg-id",
+				CreationDate:   testNow,
+				ChangeDate:     testNow,
+				ResourceOwner:  "ro",
+				Sequence:       20211108,
+				OrgID:          "org-id",
+				Description:    "description",
+				Priority:       1,
+				State:          domain.SMTPConfigStateActive,
+				SenderAddress:  "sender",
+				SenderName:     "name",
+				ReplyToAddress: "reply-to",
+				SMTPConfig: &SMTP{
+					TLS:      true,
+					Host:     "host",
+					User:     "user",
+					Password: &crypto.CryptoValue{},
+				},
+			},
+		},
+		{
+			name:    "prepareSMTPConfigQuery http found",
+			prepare: prepareSMTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareSMTPConfigStmt),
+					prepareSMTPConfigCols,
+					[]driver.Value{
+						"config-id",
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211108),
+						"",
+						"description",
+						int32(0),
+						domain.SMTPConfigStateActive,
+						"sender",
+						"name",
+						"reply-to",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						"config-id",
+						"https://api.example.com/mail",
+						"POST",
+						"application/json",
+						`{"subject":{{json .Subject}}}`,
+						&crypto.CryptoValue{},
 					},
 				),
 			},
 			object: &SMTPConfig{
+				ID:             "config-id",
 				AggregateID:    "agg-id",
 				CreationDate:   testNow,
 				ChangeDate:     testNow,
 				ResourceOwner:  "ro",
 				Sequence:       20211108,
-				TLS:            true,
+				Description:    "description",
+				State:          domain.SMTPConfigStateActive,
 				SenderAddress:  "sender",
 				SenderName:     "name",
 				ReplyToAddress: "reply-to",
-				Host:           "host",
-				User:           "user",
-				Password:       &crypto.CryptoValue{},
+				HTTPConfig: &EmailHTTP{
+					Endpoint:     "https://api.example.com/mail",
+					Method:       "POST",
+					ContentType:  "application/json",
+					BodyTemplate: `{"subject":{{json .Subject}}}`,
+					Headers:      &crypto.CryptoValue{},
+				},
 			},
 		},
 		{
@@ -127,6 +259,145 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 			},
 			object: (*SMTPConfig)(nil),
 		},
+		{
+			name:    "prepareSMTPConfigsQuery no result",
+			prepare: prepareSMTPConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSMTPConfigsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &SMTPConfigs{SMTPConfigs: []*SMTPConfig{}},
+		},
+		{
+			name:    "prepareSMTPConfigsQuery multiple result",
+			prepare: prepareSMTPConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareSMTPConfigsStmt),
+					prepareSMTPConfigsCols,
+					[][]driver.Value{
+						{
+							"config-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211108),
+							"org-id",
+							"description",
+							int32(0),
+							domain.SMTPConfigStateActive,
+							"sender",
+							"name",
+							"reply-to",
+							"config-id",
+							true,
+							"host",
+							"user",
+							&crypto.CryptoValue{},
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+						},
+						{
+							"config-id2",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211108),
+							"",
+							"fallback",
+							int32(1),
+							domain.SMTPConfigStateInactive,
+							"sender2",
+							"name2",
+							"",
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							"config-id2",
+							"https://api.example.com/mail",
+							"POST",
+							"application/json",
+							"body",
+							nil,
+						},
+					},
+				),
+			},
+			object: &SMTPConfigs{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				SMTPConfigs: []*SMTPConfig{
+					{
+						ID:             "config-id",
+						AggregateID:    "agg-id",
+						CreationDate:   testNow,
+						ChangeDate:     testNow,
+						ResourceOwner:  "ro",
+						Sequence:       20211108,
+						OrgID:          "org-id",
+						Description:    "description",
+						State:          domain.SMTPConfigStateActive,
+						SenderAddress:  "sender",
+						SenderName:     "name",
+						ReplyToAddress: "reply-to",
+						SMTPConfig: &SMTP{
+							TLS:      true,
+							Host:     "host",
+							User:     "user",
+							Password: &crypto.CryptoValue{},
+						},
+					},
+					{
+						ID:            "config-id2",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211108,
+						Description:   "fallback",
+						Priority:      1,
+						State:         domain.SMTPConfigStateInactive,
+						SenderAddress: "sender2",
+						SenderName:    "name2",
+						HTTPConfig: &EmailHTTP{
+							Endpoint:     "https://api.example.com/mail",
+							Method:       "POST",
+							ContentType:  "application/json",
+							BodyTemplate: "body",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSMTPConfigsQuery sql err",
+			prepare: prepareSMTPConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareSMTPConfigsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SMTPConfigs)(nil),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -67,6 +68,10 @@ var (
 		name:  projection.TargetInterruptOnErrorCol,
 		table: targetTable,
 	}
+	TargetColumnSigningKey = Column{
+		name:  projection.TargetSigningKeyCol,
+		table: targetTable,
+	}
 )
 
 type Targets struct {
@@ -88,6 +93,7 @@ type Target struct {
 	Timeout          time.Duration
 	Async            bool
 	InterruptOnError bool
+	SigningKey       *crypto.CryptoValue
 }
 
 type TargetSearchQueries struct {
@@ -140,6 +146,7 @@ func prepareTargetsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			TargetColumnURL.identifier(),
 			TargetColumnAsync.identifier(),
 			TargetColumnInterruptOnError.identifier(),
+			TargetColumnSigningKey.identifier(),
 			countColumn.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -159,6 +166,7 @@ func prepareTargetsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 					&target.URL,
 					&target.Async,
 					&target.InterruptOnError,
+					&target.SigningKey,
 					&count,
 				)
 				if err != nil {
@@ -192,6 +200,7 @@ func prepareTargetQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuild
 			TargetColumnURL.identifier(),
 			TargetColumnAsync.identifier(),
 			TargetColumnInterruptOnError.identifier(),
+			TargetColumnSigningKey.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Target, error) {
@@ -207,6 +216,7 @@ func prepareTargetQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuild
 				&target.URL,
 				&target.Async,
 				&target.InterruptOnError,
+				&target.SigningKey,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
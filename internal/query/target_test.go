@@ -9,23 +9,25 @@ import (
 	"testing"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var (
-	prepareTargetsStmt = `SELECT projections.targets.id,` +
-		` projections.targets.change_date,` +
-		` projections.targets.resource_owner,` +
-		` projections.targets.sequence,` +
-		` projections.targets.name,` +
-		` projections.targets.target_type,` +
-		` projections.targets.timeout,` +
-		` projections.targets.url,` +
-		` projections.targets.async,` +
-		` projections.targets.interrupt_on_error,` +
+	prepareTargetsStmt = `SELECT projections.targets1.id,` +
+		` projections.targets1.change_date,` +
+		` projections.targets1.resource_owner,` +
+		` projections.targets1.sequence,` +
+		` projections.targets1.name,` +
+		` projections.targets1.target_type,` +
+		` projections.targets1.timeout,` +
+		` projections.targets1.url,` +
+		` projections.targets1.async,` +
+		` projections.targets1.interrupt_on_error,` +
+		` projections.targets1.signing_key,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.targets`
+		` FROM projections.targets1`
 	prepareTargetsCols = []string{
 		"id",
 		"change_date",
@@ -37,20 +39,22 @@ var (
 		"url",
 		"async",
 		"interrupt_on_error",
+		"signing_key",
 		"count",
 	}
 
-	prepareTargetStmt = `SELECT projections.targets.id,` +
-		` projections.targets.change_date,` +
-		` projections.targets.resource_owner,` +
-		` projections.targets.sequence,` +
-		` projections.targets.name,` +
-		` projections.targets.target_type,` +
-		` projections.targets.timeout,` +
-		` projections.targets.url,` +
-		` projections.targets.async,` +
-		` projections.targets.interrupt_on_error` +
-		` FROM projections.targets`
+	prepareTargetStmt = `SELECT projections.targets1.id,` +
+		` projections.targets1.change_date,` +
+		` projections.targets1.resource_owner,` +
+		` projections.targets1.sequence,` +
+		` projections.targets1.name,` +
+		` projections.targets1.target_type,` +
+		` projections.targets1.timeout,` +
+		` projections.targets1.url,` +
+		` projections.targets1.async,` +
+		` projections.targets1.interrupt_on_error,` +
+		` projections.targets1.signing_key` +
+		` FROM projections.targets1`
 	prepareTargetCols = []string{
 		"id",
 		"change_date",
@@ -62,6 +66,7 @@ var (
 		"url",
 		"async",
 		"interrupt_on_error",
+		"signing_key",
 	}
 )
 
@@ -107,6 +112,7 @@ func Test_TargetPrepares(t *testing.T) {
 							"https://example.com",
 							true,
 							true,
+							nil,
 						},
 					},
 				),
@@ -152,6 +158,7 @@ func Test_TargetPrepares(t *testing.T) {
 							"https://example.com",
 							true,
 							false,
+							nil,
 						},
 						{
 							"id-2",
@@ -164,6 +171,7 @@ func Test_TargetPrepares(t *testing.T) {
 							"https://example.com",
 							false,
 							true,
+							nil,
 						},
 					},
 				),
@@ -257,6 +265,7 @@ func Test_TargetPrepares(t *testing.T) {
 						"https://example.com",
 						true,
 						false,
+						[]byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"MTIzNDU2Nzg="}`),
 					},
 				),
 			},
@@ -273,6 +282,12 @@ func Test_TargetPrepares(t *testing.T) {
 				URL:              "https://example.com",
 				Async:            true,
 				InterruptOnError: false,
+				SigningKey: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("12345678"),
+				},
 			},
 		},
 		{
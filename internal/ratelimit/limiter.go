@@ -0,0 +1,145 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	Enabled bool
+	// IdleTimeout is the duration after which unused buckets are removed from memory
+	IdleTimeout time.Duration
+	// Defaults apply to all instances which don't override them using the system API
+	Defaults Rules
+}
+
+// Keys identify the buckets a request is counted for.
+// Empty keys are not limited.
+type Keys struct {
+	InstanceID string
+	OrgID      string
+	ClientID   string
+	IP         string
+}
+
+// Limiter keeps the token buckets of all keys in memory.
+// The buckets are not shared between multiple ZITADEL processes,
+// so the effective limits are multiplied by the number of running replicas.
+type Limiter struct {
+	config *Config
+	now    func() time.Time
+
+	mu          sync.Mutex
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+}
+
+func NewLimiter(config *Config) *Limiter {
+	return &Limiter{
+		config:      config,
+		now:         time.Now,
+		buckets:     make(map[string]*bucket),
+		lastCleanup: time.Now(),
+	}
+}
+
+func (l *Limiter) Enabled() bool {
+	return l != nil && l.config != nil && l.config.Enabled
+}
+
+// Allow takes a token from all buckets the request is counted for.
+// The instance rules override the configured defaults.
+// If any of the buckets is empty, no token is taken and
+// the duration after which the request can be retried is returned.
+func (l *Limiter) Allow(instanceRules *Rules, keys Keys) (allowed bool, retryAfter time.Duration) {
+	if !l.Enabled() {
+		return true, 0
+	}
+	rules := l.config.Defaults.Override(instanceRules)
+	checks := make([]check, 0, 4)
+	checks = appendCheck(checks, rules.Instance, "instance", keys.InstanceID, "")
+	checks = appendCheck(checks, rules.Org, "org", keys.InstanceID, keys.OrgID)
+	checks = appendCheck(checks, rules.Client, "client", keys.InstanceID, keys.ClientID)
+	checks = appendCheck(checks, rules.IP, "ip", keys.InstanceID, keys.IP)
+	if len(checks) == 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.cleanup(now)
+
+	taken := make([]*bucket, len(checks))
+	for i, c := range checks {
+		b, ok := l.buckets[c.key]
+		if !ok {
+			b = &bucket{tokens: float64(c.rule.Burst), last: now}
+			l.buckets[c.key] = b
+		}
+		b.refill(now, c.rule)
+		if wait := b.retryAfter(c.rule); b.tokens < 1 && wait > retryAfter {
+			retryAfter = wait
+		}
+		taken[i] = b
+	}
+	if retryAfter > 0 {
+		return false, retryAfter
+	}
+	for _, b := range taken {
+		b.tokens--
+	}
+	return true, 0
+}
+
+// cleanup removes all buckets which weren't used during the idle timeout.
+// It only runs once per idle timeout.
+func (l *Limiter) cleanup(now time.Time) {
+	if l.config.IdleTimeout <= 0 || now.Sub(l.lastCleanup) < l.config.IdleTimeout {
+		return
+	}
+	l.lastCleanup = now
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= l.config.IdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+type check struct {
+	key  string
+	rule *Rule
+}
+
+func appendCheck(checks []check, rule *Rule, level, instanceID, id string) []check {
+	if !rule.Enabled() || instanceID == "" {
+		return checks
+	}
+	if level != "instance" && id == "" {
+		return checks
+	}
+	return append(checks, check{
+		key:  level + ":" + instanceID + ":" + id,
+		rule: rule,
+	})
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) refill(now time.Time, rule *Rule) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens += elapsed * rule.RequestsPerSecond
+		b.last = now
+	}
+	// the rule might have changed since the bucket was created
+	b.tokens = math.Min(b.tokens, float64(rule.Burst))
+}
+
+func (b *bucket) retryAfter(rule *Rule) time.Duration {
+	return time.Duration(math.Ceil((1 - b.tokens) / rule.RequestsPerSecond * float64(time.Second)))
+}
@@ -0,0 +1,149 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type request struct {
+	after          time.Duration
+	keys           Keys
+	wantAllowed    bool
+	wantRetryAfter time.Duration
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        *Config
+		instanceRules *Rules
+		requests      []request
+	}{
+		{
+			name: "disabled",
+			config: &Config{
+				Enabled:  false,
+				Defaults: Rules{Instance: &Rule{RequestsPerSecond: 1, Burst: 1}},
+			},
+			requests: []request{
+				{keys: Keys{InstanceID: "instance1"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1"}, wantAllowed: true},
+			},
+		},
+		{
+			name: "instance burst exhausted",
+			config: &Config{
+				Enabled:  true,
+				Defaults: Rules{Instance: &Rule{RequestsPerSecond: 2, Burst: 2}},
+			},
+			requests: []request{
+				{keys: Keys{InstanceID: "instance1"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1"}, wantAllowed: false, wantRetryAfter: 500 * time.Millisecond},
+				{keys: Keys{InstanceID: "instance2"}, wantAllowed: true},
+				{after: 500 * time.Millisecond, keys: Keys{InstanceID: "instance1"}, wantAllowed: true},
+			},
+		},
+		{
+			name: "ip limited, other ip allowed",
+			config: &Config{
+				Enabled:  true,
+				Defaults: Rules{IP: &Rule{RequestsPerSecond: 1, Burst: 1}},
+			},
+			requests: []request{
+				{keys: Keys{InstanceID: "instance1", IP: "1.1.1.1"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1", IP: "1.1.1.1"}, wantAllowed: false, wantRetryAfter: time.Second},
+				{keys: Keys{InstanceID: "instance1", IP: "2.2.2.2"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1"}, wantAllowed: true},
+			},
+		},
+		{
+			name: "rejected request takes no tokens",
+			config: &Config{
+				Enabled: true,
+				Defaults: Rules{
+					Org:    &Rule{RequestsPerSecond: 1, Burst: 2},
+					Client: &Rule{RequestsPerSecond: 1, Burst: 1},
+				},
+			},
+			requests: []request{
+				{keys: Keys{InstanceID: "instance1", OrgID: "org1", ClientID: "client1"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1", OrgID: "org1", ClientID: "client1"}, wantAllowed: false, wantRetryAfter: time.Second},
+				{keys: Keys{InstanceID: "instance1", OrgID: "org1", ClientID: "client2"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1", OrgID: "org1", ClientID: "client3"}, wantAllowed: false, wantRetryAfter: time.Second},
+			},
+		},
+		{
+			name: "instance rules override defaults",
+			config: &Config{
+				Enabled:  true,
+				Defaults: Rules{Instance: &Rule{RequestsPerSecond: 1, Burst: 1}},
+			},
+			instanceRules: &Rules{
+				Instance: &Rule{},
+				Org:      &Rule{RequestsPerSecond: 1, Burst: 1},
+			},
+			requests: []request{
+				{keys: Keys{InstanceID: "instance1", OrgID: "org1"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1", OrgID: "org2"}, wantAllowed: true},
+				{keys: Keys{InstanceID: "instance1", OrgID: "org1"}, wantAllowed: false, wantRetryAfter: time.Second},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(1000, 0)
+			l := NewLimiter(tt.config)
+			l.now = func() time.Time { return now }
+			for i, r := range tt.requests {
+				now = now.Add(r.after)
+				allowed, retryAfter := l.Allow(tt.instanceRules, r.keys)
+				assert.Equal(t, r.wantAllowed, allowed, "request %d", i)
+				assert.Equal(t, r.wantRetryAfter, retryAfter, "request %d", i)
+			}
+		})
+	}
+}
+
+func TestLimiter_cleanup(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := NewLimiter(&Config{
+		Enabled:     true,
+		IdleTimeout: time.Minute,
+		Defaults:    Rules{IP: &Rule{RequestsPerSecond: 1, Burst: 1}},
+	})
+	l.now = func() time.Time { return now }
+	l.lastCleanup = now
+
+	l.Allow(nil, Keys{InstanceID: "instance1", IP: "1.1.1.1"})
+	now = now.Add(30 * time.Second)
+	l.Allow(nil, Keys{InstanceID: "instance1", IP: "2.2.2.2"})
+	assert.Len(t, l.buckets, 2)
+
+	now = now.Add(40 * time.Second)
+	l.Allow(nil, Keys{InstanceID: "instance1", IP: "2.2.2.2"})
+	assert.Len(t, l.buckets, 1)
+	assert.Contains(t, l.buckets, "ip:instance1:2.2.2.2")
+}
+
+func TestRules_Valid(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules *Rules
+		want  bool
+	}{
+		{"nil", nil, true},
+		{"zero rule", &Rules{Instance: &Rule{}}, true},
+		{"valid rule", &Rules{Org: &Rule{RequestsPerSecond: 0.5, Burst: 10}}, true},
+		{"burst missing", &Rules{Client: &Rule{RequestsPerSecond: 1}}, false},
+		{"rate missing", &Rules{IP: &Rule{Burst: 1}}, false},
+		{"negative rate", &Rules{IP: &Rule{RequestsPerSecond: -1, Burst: 1}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rules.Valid())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+// Rule configures a token bucket.
+// The bucket holds up to Burst tokens and is refilled with RequestsPerSecond tokens per second.
+// Every request takes one token, if the bucket is empty the request is rejected.
+type Rule struct {
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	Burst             uint32  `json:"burst"`
+}
+
+// Enabled returns false for nil and zero rules.
+// A zero rule can be used to disable a default rule.
+func (r *Rule) Enabled() bool {
+	return r != nil && r.RequestsPerSecond > 0 && r.Burst > 0
+}
+
+// Valid returns false if the rule only specifies one of RequestsPerSecond or Burst or a negative rate
+func (r *Rule) Valid() bool {
+	if r == nil {
+		return true
+	}
+	if r.RequestsPerSecond < 0 {
+		return false
+	}
+	return (r.RequestsPerSecond == 0) == (r.Burst == 0)
+}
+
+func (r *Rule) Equal(o *Rule) bool {
+	if r == nil || o == nil {
+		return r == o
+	}
+	return *r == *o
+}
+
+// Rules are the rate limits by the key the requests are counted for.
+// A nil rule means that the rule of the level above applies.
+type Rules struct {
+	// Instance limits the requests of the whole instance
+	Instance *Rule `json:"instance,omitempty"`
+	// Org limits the requests per organization of the caller
+	Org *Rule `json:"org,omitempty"`
+	// Client limits the requests per client ID of the token.
+	// Tokens without client (e.g. personal access tokens) are counted per user.
+	Client *Rule `json:"client,omitempty"`
+	// IP limits the requests per remote IP
+	IP *Rule `json:"ip,omitempty"`
+}
+
+func (r *Rules) IsZero() bool {
+	return r == nil || r.Instance == nil && r.Org == nil && r.Client == nil && r.IP == nil
+}
+
+func (r *Rules) Valid() bool {
+	if r == nil {
+		return true
+	}
+	return r.Instance.Valid() && r.Org.Valid() && r.Client.Valid() && r.IP.Valid()
+}
+
+// Override returns a copy of the rules where all rules set in o are replaced.
+func (r Rules) Override(o *Rules) Rules {
+	if o == nil {
+		return r
+	}
+	if o.Instance != nil {
+		r.Instance = o.Instance
+	}
+	if o.Org != nil {
+		r.Org = o.Org
+	}
+	if o.Client != nil {
+		r.Client = o.Client
+	}
+	if o.IP != nil {
+		r.IP = o.IP
+	}
+	return r
+}
@@ -2,6 +2,7 @@ package authrequest
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
@@ -17,6 +18,8 @@ const (
 	SessionLinkedType      = authRequestEventPrefix + "session.linked"
 	CodeExchangedType      = authRequestEventPrefix + "code.exchanged"
 	SucceededType          = authRequestEventPrefix + "succeeded"
+	PushedType             = authRequestEventPrefix + "pushed"
+	PushedUsedType         = authRequestEventPrefix + "pushed.used"
 )
 
 type AddedEvent struct {
@@ -36,6 +39,7 @@ type AddedEvent struct {
 	MaxAge        *time.Duration            `json:"max_age,omitempty"`
 	LoginHint     *string                   `json:"login_hint,omitempty"`
 	HintUserID    *string                   `json:"hint_user_id,omitempty"`
+	ACRValues     []string                  `json:"acr_values,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -62,6 +66,7 @@ func NewAddedEvent(ctx context.Context,
 	maxAge *time.Duration,
 	loginHint,
 	hintUserID *string,
+	acrValues []string,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -83,6 +88,7 @@ func NewAddedEvent(ctx context.Context,
 		MaxAge:        maxAge,
 		LoginHint:     loginHint,
 		HintUserID:    hintUserID,
+		ACRValues:     acrValues,
 	}
 }
 
@@ -283,3 +289,84 @@ func SucceededEventMapper(event eventstore.Event) (eventstore.Event, error) {
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// PushedEvent stores the parameters of an authorization request,
+// which the client pushed to the pushed authorization request endpoint (RFC 9126).
+// The client credentials are not part of the parameters.
+type PushedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string     `json:"client_id"`
+	Parameters url.Values `json:"parameters"`
+	Expiration time.Time  `json:"expiration"`
+}
+
+func (e *PushedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	parameters url.Values,
+	expiration time.Time,
+) *PushedEvent {
+	return &PushedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedType,
+		),
+		ClientID:   clientID,
+		Parameters: parameters,
+		Expiration: expiration,
+	}
+}
+
+func PushedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	pushed := &PushedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(pushed)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "AUTHR-ieG4o", "unable to unmarshal pushed auth request")
+	}
+
+	return pushed, nil
+}
+
+// PushedUsedEvent marks the request_uri of a pushed authorization request as used,
+// as it must only be used once.
+type PushedUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushedUsedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *PushedUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedUsedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushedUsedEvent {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedUsedType,
+		),
+	}
+}
+
+func PushedUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
 const (
-	eventTypePrefix   eventstore.EventType = "device.authorization."
-	AddedEventType                         = eventTypePrefix + "added"
-	ApprovedEventType                      = eventTypePrefix + "approved"
-	CanceledEventType                      = eventTypePrefix + "canceled"
+	eventTypePrefix         eventstore.EventType = "device.authorization."
+	AddedEventType                               = eventTypePrefix + "added"
+	ApprovedEventType                            = eventTypePrefix + "approved"
+	CanceledEventType                            = eventTypePrefix + "canceled"
+	UserNotifiedEventType                        = eventTypePrefix + "user.notified"
+	ClientNotifiedEventType                      = eventTypePrefix + "client.notified"
 )
 
 type AddedEvent struct {
@@ -24,6 +28,23 @@ type AddedEvent struct {
 	Expires    time.Time
 	Scopes     []string
 	State      domain.DeviceAuthState
+
+	// The following fields are only set for backchannel authentication requests (CIBA),
+	// where the DeviceCode is used as auth_req_id.
+	UserID            string              `json:"userID,omitempty"`
+	LoginHint         string              `json:"loginHint,omitempty"`
+	BindingMessage    string              `json:"bindingMessage,omitempty"`
+	NotificationToken *crypto.CryptoValue `json:"notificationToken,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *AddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+// IsCIBA returns if the event was created by a backchannel authentication request
+func (e *AddedEvent) IsCIBA() bool {
+	return e.UserID != ""
 }
 
 func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -48,10 +69,40 @@ func NewAddedEvent(
 	scopes []string,
 ) *AddedEvent {
 	return &AddedEvent{
-		eventstore.NewBaseEventForPush(
+		BaseEvent: eventstore.NewBaseEventForPush(
 			ctx, aggregate, AddedEventType,
 		),
-		clientID, deviceCode, userCode, expires, scopes, domain.DeviceAuthStateInitiated}
+		ClientID:   clientID,
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		Expires:    expires,
+		Scopes:     scopes,
+		State:      domain.DeviceAuthStateInitiated,
+	}
+}
+
+// NewCIBAAddedEvent creates the device authorization of a backchannel authentication request (CIBA).
+// The user has to approve the request with the userCode, which is sent to them.
+func NewCIBAAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	authReqID string,
+	userCode string,
+	expires time.Time,
+	scopes []string,
+	userID string,
+	loginHint string,
+	bindingMessage string,
+	notificationToken *crypto.CryptoValue,
+) *AddedEvent {
+	event := NewAddedEvent(ctx, aggregate, clientID, authReqID, userCode, expires, scopes)
+	event.UserID = userID
+	event.LoginHint = loginHint
+	event.BindingMessage = bindingMessage
+	event.NotificationToken = notificationToken
+	event.TriggeredAtOrigin = http.ComposedOrigin(ctx)
+	return event
 }
 
 type ApprovedEvent struct {
@@ -112,3 +163,45 @@ func (e *CanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 func NewCanceledEvent(ctx context.Context, aggregate *eventstore.Aggregate, reason domain.DeviceAuthCanceled) *CanceledEvent {
 	return &CanceledEvent{eventstore.NewBaseEventForPush(ctx, aggregate, CanceledEventType), reason}
 }
+
+// UserNotifiedEvent records that the user was asked to approve a backchannel authentication request
+type UserNotifiedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserNotifiedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *UserNotifiedEvent) Payload() any {
+	return nil
+}
+
+func (e *UserNotifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserNotifiedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserNotifiedEvent {
+	return &UserNotifiedEvent{eventstore.NewBaseEventForPush(ctx, aggregate, UserNotifiedEventType)}
+}
+
+// ClientNotifiedEvent records that the client was notified about the completed backchannel authentication request (CIBA ping mode)
+type ClientNotifiedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ClientNotifiedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ClientNotifiedEvent) Payload() any {
+	return nil
+}
+
+func (e *ClientNotifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewClientNotifiedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ClientNotifiedEvent {
+	return &ClientNotifiedEvent{eventstore.NewBaseEventForPush(ctx, aggregate, ClientNotifiedEventType)}
+}
@@ -6,4 +6,6 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, ApprovedEventType, eventstore.GenericEventMapper[ApprovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, CanceledEventType, eventstore.GenericEventMapper[CanceledEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserNotifiedEventType, eventstore.GenericEventMapper[UserNotifiedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ClientNotifiedEventType, eventstore.GenericEventMapper[ClientNotifiedEvent])
 }
@@ -0,0 +1,168 @@
+package executiondelivery
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix       eventstore.EventType = "execution_delivery."
+	QueuedEventType                            = eventTypePrefix + "queued"
+	SucceededEventType                         = eventTypePrefix + "succeeded"
+	FailedEventType                            = eventTypePrefix + "failed"
+	DeadLetteredEventType                      = eventTypePrefix + "dead_lettered"
+	ReplayedEventType                          = eventTypePrefix + "replayed"
+)
+
+// QueuedEvent is pushed for every target of an event execution, which has to be called for an event.
+// Body contains the payload which is sent to the target.
+type QueuedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TargetID      string          `json:"targetId"`
+	ExecutionID   string          `json:"executionId"`
+	EventType     string          `json:"eventType"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	Body          json.RawMessage `json:"body"`
+}
+
+func (e *QueuedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *QueuedEvent) Payload() any {
+	return e
+}
+
+func (e *QueuedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewQueuedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	targetID,
+	executionID,
+	eventType,
+	aggregateType,
+	aggregateID string,
+	body json.RawMessage,
+) *QueuedEvent {
+	return &QueuedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, QueuedEventType),
+		targetID, executionID, eventType, aggregateType, aggregateID, body,
+	}
+}
+
+// SucceededEvent is pushed after the target accepted the delivery.
+type SucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Attempt    uint16 `json:"attempt"`
+	StatusCode int    `json:"statusCode"`
+}
+
+func (e *SucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SucceededEvent) Payload() any {
+	return e
+}
+
+func (e *SucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSucceededEvent(ctx context.Context, aggregate *eventstore.Aggregate, attempt uint16, statusCode int) *SucceededEvent {
+	return &SucceededEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, SucceededEventType),
+		attempt, statusCode,
+	}
+}
+
+// FailedEvent is pushed after a failed attempt, the delivery is retried at NextAttempt.
+type FailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Attempt     uint16    `json:"attempt"`
+	StatusCode  int       `json:"statusCode,omitempty"`
+	Error       string    `json:"error"`
+	NextAttempt time.Time `json:"nextAttempt"`
+}
+
+func (e *FailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *FailedEvent) Payload() any {
+	return e
+}
+
+func (e *FailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, attempt uint16, statusCode int, err error, nextAttempt time.Time) *FailedEvent {
+	return &FailedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, FailedEventType),
+		attempt, statusCode, err.Error(), nextAttempt,
+	}
+}
+
+// DeadLetteredEvent is pushed after the last allowed attempt failed.
+// The delivery is not retried anymore, unless it is replayed.
+type DeadLetteredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Attempt    uint16 `json:"attempt"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	Error      string `json:"error"`
+}
+
+func (e *DeadLetteredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeadLetteredEvent) Payload() any {
+	return e
+}
+
+func (e *DeadLetteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeadLetteredEvent(ctx context.Context, aggregate *eventstore.Aggregate, attempt uint16, statusCode int, err error) *DeadLetteredEvent {
+	return &DeadLetteredEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, DeadLetteredEventType),
+		attempt, statusCode, err.Error(),
+	}
+}
+
+// ReplayedEvent is pushed if a dead lettered delivery is queued again.
+// The attempts are reset and the delivery is retried immediately.
+type ReplayedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ReplayedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ReplayedEvent) Payload() any {
+	return e
+}
+
+func (e *ReplayedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewReplayedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ReplayedEvent {
+	return &ReplayedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, ReplayedEventType),
+	}
+}
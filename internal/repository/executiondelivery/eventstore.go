@@ -0,0 +1,11 @@
+package executiondelivery
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, QueuedEventType, eventstore.GenericEventMapper[QueuedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SucceededEventType, eventstore.GenericEventMapper[SucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, FailedEventType, eventstore.GenericEventMapper[FailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeadLetteredEventType, eventstore.GenericEventMapper[DeadLetteredEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ReplayedEventType, eventstore.GenericEventMapper[ReplayedEvent])
+}
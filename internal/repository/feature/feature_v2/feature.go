@@ -24,6 +24,16 @@ var (
 	InstanceLegacyIntrospectionEventType             = setEventTypeFromFeature(feature.LevelInstance, feature.KeyLegacyIntrospection)
 	InstanceUserSchemaEventType                      = setEventTypeFromFeature(feature.LevelInstance, feature.KeyUserSchema)
 	InstanceTokenExchangeEventType                   = setEventTypeFromFeature(feature.LevelInstance, feature.KeyTokenExchange)
+
+	OrgResetEventType         = resetEventTypeFromFeature(feature.LevelOrg)
+	OrgUserSchemaEventType    = setEventTypeFromFeature(feature.LevelOrg, feature.KeyUserSchema)
+	OrgTokenExchangeEventType = setEventTypeFromFeature(feature.LevelOrg, feature.KeyTokenExchange)
+
+	ProjectResetEventType         = resetEventTypeFromFeature(feature.LevelProject)
+	ProjectTokenExchangeEventType = setEventTypeFromFeature(feature.LevelProject, feature.KeyTokenExchange)
+
+	AppResetEventType         = resetEventTypeFromFeature(feature.LevelApp)
+	AppTokenExchangeEventType = setEventTypeFromFeature(feature.LevelApp, feature.KeyTokenExchange)
 )
 
 const (
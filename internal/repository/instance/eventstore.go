@@ -16,9 +16,23 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigChangedEventType, SMTPConfigChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigPasswordChangedEventType, SMTPConfigPasswordChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigRemovedEventType, SMTPConfigRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigActivatedEventType, SMTPConfigActivatedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigDeactivatedEventType, SMTPConfigDeactivatedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPAddedEventType, SMTPConfigHTTPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPChangedEventType, SMTPConfigHTTPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPHeadersChangedEventType, SMTPConfigHTTPHeadersChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioAddedEventType, SMSConfigTwilioAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioChangedEventType, SMSConfigTwilioChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioTokenChangedEventType, SMSConfigTwilioTokenChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageAddedEventType, SMSConfigVonageAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageChangedEventType, SMSConfigVonageChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageAPISecretChangedEventType, SMSConfigVonageAPISecretChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdAddedEventType, SMSConfigMessageBirdAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdChangedEventType, SMSConfigMessageBirdChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdAccessKeyChangedEventType, SMSConfigMessageBirdAccessKeyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPAddedEventType, SMSConfigHTTPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPChangedEventType, SMSConfigHTTPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPAuthorizationChangedEventType, SMSConfigHTTPAuthorizationChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigActivatedEventType, SMSConfigActivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigDeactivatedEventType, SMSConfigDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigRemovedEventType, SMSConfigRemovedEventMapper)
@@ -0,0 +1,205 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	smsConfigHTTPPrefix                        = "http."
+	SMSConfigHTTPAddedEventType                = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType              = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigHTTPAuthorizationChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "authorization.changed"
+)
+
+type SMSConfigHTTPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID            string              `json:"id,omitempty"`
+	Endpoint      string              `json:"endpoint,omitempty"`
+	Method        string              `json:"method,omitempty"`
+	ContentType   string              `json:"contentType,omitempty"`
+	BodyTemplate  string              `json:"bodyTemplate,omitempty"`
+	SenderNumber  string              `json:"senderNumber,omitempty"`
+	Authorization *crypto.CryptoValue `json:"authorization,omitempty"`
+}
+
+func NewSMSConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	endpoint,
+	method,
+	contentType,
+	bodyTemplate,
+	senderNumber string,
+	authorization *crypto.CryptoValue,
+) *SMSConfigHTTPAddedEvent {
+	return &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPAddedEventType,
+		),
+		ID:            id,
+		Endpoint:      endpoint,
+		Method:        method,
+		ContentType:   contentType,
+		BodyTemplate:  bodyTemplate,
+		SenderNumber:  senderNumber,
+		Authorization: authorization,
+	}
+}
+
+func (e *SMSConfigHTTPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Ht1sa", "unable to unmarshal sms config http added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigHTTPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string  `json:"id,omitempty"`
+	Endpoint     *string `json:"endpoint,omitempty"`
+	Method       *string `json:"method,omitempty"`
+	ContentType  *string `json:"contentType,omitempty"`
+	BodyTemplate *string `json:"bodyTemplate,omitempty"`
+	SenderNumber *string `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigHTTPChanges,
+) (*SMSConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Ht2sb", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigHTTPChanges func(event *SMSConfigHTTPChangedEvent)
+
+func ChangeSMSConfigHTTPEndpoint(endpoint string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSMSConfigHTTPMethod(method string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Method = &method
+	}
+}
+
+func ChangeSMSConfigHTTPContentType(contentType string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.ContentType = &contentType
+	}
+}
+
+func ChangeSMSConfigHTTPBodyTemplate(bodyTemplate string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.BodyTemplate = &bodyTemplate
+	}
+}
+
+func ChangeSMSConfigHTTPSenderNumber(senderNumber string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigHTTPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Ht3sc", "unable to unmarshal sms config http changed")
+	}
+
+	return smsConfigChanged, nil
+}
+
+type SMSConfigHTTPAuthorizationChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID            string              `json:"id,omitempty"`
+	Authorization *crypto.CryptoValue `json:"authorization,omitempty"`
+}
+
+func NewSMSConfigHTTPAuthorizationChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	authorization *crypto.CryptoValue,
+) *SMSConfigHTTPAuthorizationChangedEvent {
+	return &SMSConfigHTTPAuthorizationChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPAuthorizationChangedEventType,
+		),
+		ID:            id,
+		Authorization: authorization,
+	}
+}
+
+func (e *SMSConfigHTTPAuthorizationChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPAuthorizationChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPAuthorizationChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	authorizationChanged := &SMSConfigHTTPAuthorizationChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(authorizationChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Ht4sd", "unable to unmarshal sms config http authorization changed")
+	}
+
+	return authorizationChanged, nil
+}
@@ -0,0 +1,175 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	smsConfigVonagePrefix                    = "vonage."
+	SMSConfigVonageAddedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "added"
+	SMSConfigVonageChangedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "changed"
+	SMSConfigVonageAPISecretChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "apisecret.changed"
+)
+
+type SMSConfigVonageAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id,omitempty"`
+	APIKey       string              `json:"apiKey,omitempty"`
+	APISecret    *crypto.CryptoValue `json:"apiSecret,omitempty"`
+	SenderNumber string              `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVonageAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	apiKey,
+	senderNumber string,
+	apiSecret *crypto.CryptoValue,
+) *SMSConfigVonageAddedEvent {
+	return &SMSConfigVonageAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageAddedEventType,
+		),
+		ID:           id,
+		APIKey:       apiKey,
+		APISecret:    apiSecret,
+		SenderNumber: senderNumber,
+	}
+}
+
+func (e *SMSConfigVonageAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigVonageAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigVonageAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Vo1sa", "unable to unmarshal sms config vonage added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigVonageChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string  `json:"id,omitempty"`
+	APIKey       *string `json:"apiKey,omitempty"`
+	SenderNumber *string `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVonageChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigVonageChanges,
+) (*SMSConfigVonageChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Vo2sb", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigVonageChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigVonageChanges func(event *SMSConfigVonageChangedEvent)
+
+func ChangeSMSConfigVonageAPIKey(apiKey string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.APIKey = &apiKey
+	}
+}
+
+func ChangeSMSConfigVonageSenderNumber(senderNumber string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigVonageChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigVonageChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigVonageChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smsConfigChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Vo3sc", "unable to unmarshal sms config vonage changed")
+	}
+
+	return smsConfigChanged, nil
+}
+
+type SMSConfigVonageAPISecretChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID        string              `json:"id,omitempty"`
+	APISecret *crypto.CryptoValue `json:"apiSecret,omitempty"`
+}
+
+func NewSMSConfigVonageAPISecretChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	apiSecret *crypto.CryptoValue,
+) *SMSConfigVonageAPISecretChangedEvent {
+	return &SMSConfigVonageAPISecretChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageAPISecretChangedEventType,
+		),
+		ID:        id,
+		APISecret: apiSecret,
+	}
+}
+
+func (e *SMSConfigVonageAPISecretChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageAPISecretChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMSConfigVonageAPISecretChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	apiSecretChanged := &SMSConfigVonageAPISecretChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(apiSecretChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Vo4sd", "unable to unmarshal sms config vonage api secret changed")
+	}
+
+	return apiSecretChanged, nil
+}
@@ -14,11 +14,27 @@ const (
 	SMTPConfigChangedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "changed"
 	SMTPConfigPasswordChangedEventType = instanceEventTypePrefix + smtpConfigPrefix + "password.changed"
 	SMTPConfigRemovedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "removed"
+	SMTPConfigActivatedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "activated"
+	SMTPConfigDeactivatedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "deactivated"
 )
 
+// SMTPConfigID returns the id of the SMTP configuration the event belongs to.
+// Events of the former single SMTP configuration of an instance don't contain an id,
+// they belong to the configuration identified by the instance id.
+func SMTPConfigID(id string, aggregate *eventstore.Aggregate) string {
+	if id != "" {
+		return id
+	}
+	return aggregate.ID
+}
+
 type SMTPConfigAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
+	ID             string              `json:"id,omitempty"`
+	OrgID          string              `json:"orgId,omitempty"`
+	Description    string              `json:"description,omitempty"`
+	Priority       int32               `json:"priority,omitempty"`
 	SenderAddress  string              `json:"senderAddress,omitempty"`
 	SenderName     string              `json:"senderName,omitempty"`
 	ReplyToAddress string              `json:"replyToAddress,omitempty"`
@@ -31,6 +47,10 @@ type SMTPConfigAddedEvent struct {
 func NewSMTPConfigAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
+	id,
+	orgID,
+	description string,
+	priority int32,
 	tls bool,
 	senderAddress,
 	senderName,
@@ -45,6 +65,10 @@ func NewSMTPConfigAddedEvent(
 			aggregate,
 			SMTPConfigAddedEventType,
 		),
+		ID:             id,
+		OrgID:          orgID,
+		Description:    description,
+		Priority:       priority,
 		TLS:            tls,
 		SenderAddress:  senderAddress,
 		SenderName:     senderName,
@@ -78,6 +102,9 @@ func SMTPConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type SMTPConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
+	ID             string  `json:"id,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Priority       *int32  `json:"priority,omitempty"`
 	FromAddress    *string `json:"senderAddress,omitempty"`
 	FromName       *string `json:"senderName,omitempty"`
 	ReplyToAddress *string `json:"replyToAddress,omitempty"`
@@ -97,6 +124,7 @@ func (e *SMTPConfigChangedEvent) UniqueConstraints() []*eventstore.UniqueConstra
 func NewSMTPConfigChangeEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
+	id string,
 	changes []SMTPConfigChanges,
 ) (*SMTPConfigChangedEvent, error) {
 	if len(changes) == 0 {
@@ -108,6 +136,7 @@ func NewSMTPConfigChangeEvent(
 			aggregate,
 			SMTPConfigChangedEventType,
 		),
+		ID: id,
 	}
 	for _, change := range changes {
 		change(changeEvent)
@@ -117,6 +146,18 @@ func NewSMTPConfigChangeEvent(
 
 type SMTPConfigChanges func(event *SMTPConfigChangedEvent)
 
+func ChangeSMTPConfigDescription(description string) func(event *SMTPConfigChangedEvent) {
+	return func(e *SMTPConfigChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMTPConfigPriority(priority int32) func(event *SMTPConfigChangedEvent) {
+	return func(e *SMTPConfigChangedEvent) {
+		e.Priority = &priority
+	}
+}
+
 func ChangeSMTPConfigTLS(tls bool) func(event *SMTPConfigChangedEvent) {
 	return func(e *SMTPConfigChangedEvent) {
 		e.TLS = &tls
@@ -169,12 +210,14 @@ func SMTPConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, err
 type SMTPConfigPasswordChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
+	ID       string              `json:"id,omitempty"`
 	Password *crypto.CryptoValue `json:"password,omitempty"`
 }
 
 func NewSMTPConfigPasswordChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
+	id string,
 	password *crypto.CryptoValue,
 ) *SMTPConfigPasswordChangedEvent {
 	return &SMTPConfigPasswordChangedEvent{
@@ -183,6 +226,7 @@ func NewSMTPConfigPasswordChangedEvent(
 			aggregate,
 			SMTPConfigPasswordChangedEventType,
 		),
+		ID:       id,
 		Password: password,
 	}
 }
@@ -209,11 +253,14 @@ func SMTPConfigPasswordChangedEventMapper(event eventstore.Event) (eventstore.Ev
 
 type SMTPConfigRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id,omitempty"`
 }
 
 func NewSMTPConfigRemovedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
+	id string,
 ) *SMTPConfigRemovedEvent {
 	return &SMTPConfigRemovedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -221,6 +268,7 @@ func NewSMTPConfigRemovedEvent(
 			aggregate,
 			SMTPConfigRemovedEventType,
 		),
+		ID: id,
 	}
 }
 
@@ -243,3 +291,85 @@ func SMTPConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, err
 
 	return smtpConfigRemoved, nil
 }
+
+type SMTPConfigActivatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id,omitempty"`
+}
+
+func NewSMTPConfigActivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigActivatedEvent {
+	return &SMTPConfigActivatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigActivatedEventType,
+		),
+		ID: id,
+	}
+}
+
+func (e *SMTPConfigActivatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigActivatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigActivatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigActivated := &SMTPConfigActivatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigActivated)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-KPr5t", "unable to unmarshal smtp config activated")
+	}
+
+	return smtpConfigActivated, nil
+}
+
+type SMTPConfigDeactivatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id,omitempty"`
+}
+
+func NewSMTPConfigDeactivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigDeactivatedEvent {
+	return &SMTPConfigDeactivatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigDeactivatedEventType,
+		),
+		ID: id,
+	}
+}
+
+func (e *SMTPConfigDeactivatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigDeactivatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigDeactivatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigDeactivated := &SMTPConfigDeactivatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigDeactivated)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Wf9xn", "unable to unmarshal smtp config deactivated")
+	}
+
+	return smtpConfigDeactivated, nil
+}
@@ -0,0 +1,251 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	smtpConfigHTTPPrefix                  = "http."
+	SMTPConfigHTTPAddedEventType          = instanceEventTypePrefix + smtpConfigPrefix + smtpConfigHTTPPrefix + "added"
+	SMTPConfigHTTPChangedEventType        = instanceEventTypePrefix + smtpConfigPrefix + smtpConfigHTTPPrefix + "changed"
+	SMTPConfigHTTPHeadersChangedEventType = instanceEventTypePrefix + smtpConfigPrefix + smtpConfigHTTPPrefix + "headers.changed"
+)
+
+// SMTPConfigHTTPAddedEvent adds an email provider, which sends the emails using an HTTP API instead of SMTP.
+// It shares the state, activation and removal of SMTP configurations.
+type SMTPConfigHTTPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID             string `json:"id,omitempty"`
+	OrgID          string `json:"orgId,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Priority       int32  `json:"priority,omitempty"`
+	SenderAddress  string `json:"senderAddress,omitempty"`
+	SenderName     string `json:"senderName,omitempty"`
+	ReplyToAddress string `json:"replyToAddress,omitempty"`
+	Endpoint       string `json:"endpoint,omitempty"`
+	Method         string `json:"method,omitempty"`
+	ContentType    string `json:"contentType,omitempty"`
+	BodyTemplate   string `json:"bodyTemplate,omitempty"`
+	// Headers are the JSON encoded header templates, encrypted as they usually contain the credentials of the provider
+	Headers *crypto.CryptoValue `json:"headers,omitempty"`
+}
+
+func NewSMTPConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	orgID,
+	description string,
+	priority int32,
+	senderAddress,
+	senderName,
+	replyToAddress,
+	endpoint,
+	method,
+	contentType,
+	bodyTemplate string,
+	headers *crypto.CryptoValue,
+) *SMTPConfigHTTPAddedEvent {
+	return &SMTPConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHTTPAddedEventType,
+		),
+		ID:             id,
+		OrgID:          orgID,
+		Description:    description,
+		Priority:       priority,
+		SenderAddress:  senderAddress,
+		SenderName:     senderName,
+		ReplyToAddress: replyToAddress,
+		Endpoint:       endpoint,
+		Method:         method,
+		ContentType:    contentType,
+		BodyTemplate:   bodyTemplate,
+		Headers:        headers,
+	}
+}
+
+func (e *SMTPConfigHTTPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHTTPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigHTTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigAdded := &SMTPConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Eh1ka", "unable to unmarshal smtp config http added")
+	}
+
+	return smtpConfigAdded, nil
+}
+
+type SMTPConfigHTTPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID             string  `json:"id,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Priority       *int32  `json:"priority,omitempty"`
+	SenderAddress  *string `json:"senderAddress,omitempty"`
+	SenderName     *string `json:"senderName,omitempty"`
+	ReplyToAddress *string `json:"replyToAddress,omitempty"`
+	Endpoint       *string `json:"endpoint,omitempty"`
+	Method         *string `json:"method,omitempty"`
+	ContentType    *string `json:"contentType,omitempty"`
+	BodyTemplate   *string `json:"bodyTemplate,omitempty"`
+}
+
+func NewSMTPConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMTPConfigHTTPChanges,
+) (*SMTPConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Eh2kb", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMTPConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHTTPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMTPConfigHTTPChanges func(event *SMTPConfigHTTPChangedEvent)
+
+func ChangeSMTPConfigHTTPDescription(description string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMTPConfigHTTPPriority(priority int32) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Priority = &priority
+	}
+}
+
+func ChangeSMTPConfigHTTPSenderAddress(senderAddress string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.SenderAddress = &senderAddress
+	}
+}
+
+func ChangeSMTPConfigHTTPSenderName(senderName string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.SenderName = &senderName
+	}
+}
+
+func ChangeSMTPConfigHTTPReplyToAddress(replyToAddress string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.ReplyToAddress = &replyToAddress
+	}
+}
+
+func ChangeSMTPConfigHTTPEndpoint(endpoint string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSMTPConfigHTTPMethod(method string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Method = &method
+	}
+}
+
+func ChangeSMTPConfigHTTPContentType(contentType string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.ContentType = &contentType
+	}
+}
+
+func ChangeSMTPConfigHTTPBodyTemplate(bodyTemplate string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.BodyTemplate = &bodyTemplate
+	}
+}
+
+func (e *SMTPConfigHTTPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigHTTPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigChanged := &SMTPConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Eh3kc", "unable to unmarshal smtp config http changed")
+	}
+
+	return smtpConfigChanged, nil
+}
+
+type SMTPConfigHTTPHeadersChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID      string              `json:"id,omitempty"`
+	Headers *crypto.CryptoValue `json:"headers,omitempty"`
+}
+
+func NewSMTPConfigHTTPHeadersChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	headers *crypto.CryptoValue,
+) *SMTPConfigHTTPHeadersChangedEvent {
+	return &SMTPConfigHTTPHeadersChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHTTPHeadersChangedEventType,
+		),
+		ID:      id,
+		Headers: headers,
+	}
+}
+
+func (e *SMTPConfigHTTPHeadersChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHTTPHeadersChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigHTTPHeadersChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	headersChanged := &SMTPConfigHTTPHeadersChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(headersChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Eh4kd", "unable to unmarshal smtp config http headers changed")
+	}
+
+	return headersChanged, nil
+}
@@ -0,0 +1,19 @@
+package ldapsync
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "ldap_sync"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the synchronization of the LDAP identity provider with the idpID
+func NewAggregate(idpID, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            idpID,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
@@ -0,0 +1,11 @@
+package ldapsync
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, SetEventType, eventstore.GenericEventMapper[SetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RemovedEventType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RunStartedEventType, eventstore.GenericEventMapper[RunStartedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RunSucceededEventType, eventstore.GenericEventMapper[RunSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RunFailedEventType, eventstore.GenericEventMapper[RunFailedEvent])
+}
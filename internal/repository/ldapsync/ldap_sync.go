@@ -0,0 +1,185 @@
+package ldapsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix       eventstore.EventType = "ldap_sync."
+	SetEventType                               = eventTypePrefix + "set"
+	RemovedEventType                           = eventTypePrefix + "removed"
+	RunStartedEventType                        = eventTypePrefix + "run.started"
+	RunSucceededEventType                      = eventTypePrefix + "run.succeeded"
+	RunFailedEventType                         = eventTypePrefix + "run.failed"
+)
+
+// GroupRoleMapping grants the members of the LDAP group the roles of the project
+type GroupRoleMapping struct {
+	Group     string   `json:"group"`
+	ProjectID string   `json:"projectId"`
+	RoleKeys  []string `json:"roleKeys"`
+}
+
+// SetEvent configures the synchronization of an LDAP identity provider.
+// It always contains the whole configuration.
+type SetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// OrgID is the organization the users are imported into
+	OrgID             string              `json:"orgId"`
+	Interval          time.Duration       `json:"interval"`
+	GroupsAttribute   string              `json:"groupsAttribute,omitempty"`
+	DeactivateMissing bool                `json:"deactivateMissing,omitempty"`
+	GroupRoleMappings []*GroupRoleMapping `json:"groupRoleMappings,omitempty"`
+}
+
+func (e *SetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SetEvent) Payload() any {
+	return e
+}
+
+func (e *SetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	orgID string,
+	interval time.Duration,
+	groupsAttribute string,
+	deactivateMissing bool,
+	groupRoleMappings []*GroupRoleMapping,
+) *SetEvent {
+	return &SetEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, SetEventType),
+		orgID, interval, groupsAttribute, deactivateMissing, groupRoleMappings,
+	}
+}
+
+// RemovedEvent stops the synchronization, the already imported users are kept.
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, RemovedEventType),
+	}
+}
+
+// RunStartedEvent is pushed by the worker before the directory is read.
+// As long as the run is not finished, no other worker starts a run.
+type RunStartedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RunID string `json:"runId"`
+}
+
+func (e *RunStartedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RunStartedEvent) Payload() any {
+	return e
+}
+
+func (e *RunStartedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRunStartedEvent(ctx context.Context, aggregate *eventstore.Aggregate, runID string) *RunStartedEvent {
+	return &RunStartedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, RunStartedEventType),
+		runID,
+	}
+}
+
+// RunSucceededEvent is pushed after the directory was read and all users were processed.
+// Errors contains the users which could not be synchronized.
+type RunSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RunID            string   `json:"runId"`
+	UsersCreated     uint32   `json:"usersCreated,omitempty"`
+	UsersUpdated     uint32   `json:"usersUpdated,omitempty"`
+	UsersDeactivated uint32   `json:"usersDeactivated,omitempty"`
+	UsersReactivated uint32   `json:"usersReactivated,omitempty"`
+	GrantsChanged    uint32   `json:"grantsChanged,omitempty"`
+	Errors           []string `json:"errors,omitempty"`
+}
+
+func (e *RunSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RunSucceededEvent) Payload() any {
+	return e
+}
+
+func (e *RunSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRunSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	runID string,
+	usersCreated,
+	usersUpdated,
+	usersDeactivated,
+	usersReactivated,
+	grantsChanged uint32,
+	errors []string,
+) *RunSucceededEvent {
+	return &RunSucceededEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, RunSucceededEventType),
+		runID, usersCreated, usersUpdated, usersDeactivated, usersReactivated, grantsChanged, errors,
+	}
+}
+
+// RunFailedEvent is pushed if the directory could not be read.
+// No user was changed by the run.
+type RunFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RunID string `json:"runId"`
+	Error string `json:"error"`
+}
+
+func (e *RunFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RunFailedEvent) Payload() any {
+	return e
+}
+
+func (e *RunFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRunFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, runID string, err error) *RunFailedEvent {
+	return &RunFailedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, RunFailedEventType),
+		runID, err.Error(),
+	}
+}
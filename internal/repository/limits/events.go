@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 const (
@@ -18,6 +19,8 @@ type SetEvent struct {
 	*eventstore.BaseEvent `json:"-"`
 	AuditLogRetention     *time.Duration `json:"auditLogRetention,omitempty"`
 	Block                 *bool          `json:"block,omitempty"`
+	// RateLimits only contains the changed rules
+	RateLimits *ratelimit.Rules `json:"rateLimits,omitempty"`
 }
 
 func (e *SetEvent) Payload() any {
@@ -59,6 +62,12 @@ func ChangeBlock(block *bool) LimitsChange {
 	}
 }
 
+func ChangeRateLimits(rateLimits *ratelimit.Rules) LimitsChange {
+	return func(e *SetEvent) {
+		e.RateLimits = rateLimits
+	}
+}
+
 var SetEventMapper = eventstore.GenericEventMapper[SetEvent]
 
 type ResetEvent struct {
@@ -71,11 +71,14 @@ func NewAddedEvent(ctx context.Context,
 type AccessTokenAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID       string             `json:"id,omitempty"`
-	Scope    []string           `json:"scope,omitempty"`
-	Lifetime time.Duration      `json:"lifetime,omitempty"`
-	Reason   domain.TokenReason `json:"reason,omitempty"`
-	Actor    *domain.TokenActor `json:"actor,omitempty"`
+	ID             string             `json:"id,omitempty"`
+	Scope          []string           `json:"scope,omitempty"`
+	Lifetime       time.Duration      `json:"lifetime,omitempty"`
+	Reason         domain.TokenReason `json:"reason,omitempty"`
+	Actor          *domain.TokenActor `json:"actor,omitempty"`
+	DPoPThumbprint string             `json:"dpopThumbprint,omitempty"`
+	// CertificateThumbprint binds the access token to the client certificate (RFC 8705)
+	CertificateThumbprint string `json:"certificateThumbprint,omitempty"`
 }
 
 func (e *AccessTokenAddedEvent) Payload() interface{} {
@@ -98,6 +101,8 @@ func NewAccessTokenAddedEvent(
 	lifetime time.Duration,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	dpopThumbprint string,
+	certificateThumbprint string,
 ) *AccessTokenAddedEvent {
 	return &AccessTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -105,11 +110,13 @@ func NewAccessTokenAddedEvent(
 			aggregate,
 			AccessTokenAddedType,
 		),
-		ID:       id,
-		Scope:    scope,
-		Lifetime: lifetime,
-		Reason:   reason,
-		Actor:    actor,
+		ID:                    id,
+		Scope:                 scope,
+		Lifetime:              lifetime,
+		Reason:                reason,
+		Actor:                 actor,
+		DPoPThumbprint:        dpopThumbprint,
+		CertificateThumbprint: certificateThumbprint,
 	}
 }
 
@@ -145,9 +152,10 @@ func NewAccessTokenRevokedEvent(
 type RefreshTokenAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID           string        `json:"id"`
-	Lifetime     time.Duration `json:"lifetime"`
-	IdleLifetime time.Duration `json:"idleLifetime"`
+	ID             string        `json:"id"`
+	Lifetime       time.Duration `json:"lifetime"`
+	IdleLifetime   time.Duration `json:"idleLifetime"`
+	DPoPThumbprint string        `json:"dpopThumbprint,omitempty"`
 }
 
 func (e *RefreshTokenAddedEvent) Payload() interface{} {
@@ -168,6 +176,7 @@ func NewRefreshTokenAddedEvent(
 	id string,
 	lifetime,
 	idleLifetime time.Duration,
+	dpopThumbprint string,
 ) *RefreshTokenAddedEvent {
 	return &RefreshTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -175,9 +184,10 @@ func NewRefreshTokenAddedEvent(
 			aggregate,
 			RefreshTokenAddedType,
 		),
-		ID:           id,
-		Lifetime:     lifetime,
-		IdleLifetime: idleLifetime,
+		ID:             id,
+		Lifetime:       lifetime,
+		IdleLifetime:   idleLifetime,
+		DPoPThumbprint: dpopThumbprint,
 	}
 }
 
@@ -20,10 +20,11 @@ const (
 type APIConfigAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID          string                   `json:"appId"`
-	ClientID       string                   `json:"clientId,omitempty"`
-	ClientSecret   *crypto.CryptoValue      `json:"clientSecret,omitempty"`
-	AuthMethodType domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	AppID                  string                   `json:"appId"`
+	ClientID               string                   `json:"clientId,omitempty"`
+	ClientSecret           *crypto.CryptoValue      `json:"clientSecret,omitempty"`
+	AuthMethodType         domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	TLSClientAuthSubjectDN string                   `json:"tlsClientAuthSubjectDN,omitempty"`
 }
 
 func (e *APIConfigAddedEvent) Payload() interface{} {
@@ -41,6 +42,7 @@ func NewAPIConfigAddedEvent(
 	clientID string,
 	clientSecret *crypto.CryptoValue,
 	authMethodType domain.APIAuthMethodType,
+	tlsClientAuthSubjectDN string,
 ) *APIConfigAddedEvent {
 	return &APIConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -48,10 +50,11 @@ func NewAPIConfigAddedEvent(
 			aggregate,
 			APIConfigAddedType,
 		),
-		AppID:          appID,
-		ClientID:       clientID,
-		ClientSecret:   clientSecret,
-		AuthMethodType: authMethodType,
+		AppID:                  appID,
+		ClientID:               clientID,
+		ClientSecret:           clientSecret,
+		AuthMethodType:         authMethodType,
+		TLSClientAuthSubjectDN: tlsClientAuthSubjectDN,
 	}
 }
 
@@ -70,6 +73,9 @@ func (e *APIConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.AuthMethodType != c.AuthMethodType {
 		return false
 	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
 
 	return true
 }
@@ -90,9 +96,10 @@ func APIConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error)
 type APIConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID          string                    `json:"appId"`
-	ClientSecret   *crypto.CryptoValue       `json:"clientSecret,omitempty"`
-	AuthMethodType *domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	AppID                  string                    `json:"appId"`
+	ClientSecret           *crypto.CryptoValue       `json:"clientSecret,omitempty"`
+	AuthMethodType         *domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	TLSClientAuthSubjectDN *string                   `json:"tlsClientAuthSubjectDN,omitempty"`
 }
 
 func (e *APIConfigChangedEvent) Payload() interface{} {
@@ -135,6 +142,12 @@ func ChangeAPIAuthMethodType(authMethodType domain.APIAuthMethodType) func(event
 	}
 }
 
+func ChangeAPITLSClientAuthSubjectDN(subjectDN string) func(event *APIConfigChangedEvent) {
+	return func(e *APIConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
 func APIConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &APIConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
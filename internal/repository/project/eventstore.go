@@ -44,4 +44,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyRemovedEventType, ApplicationKeyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigAddedType, SAMLConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, RegistrationTokenAddedType, RegistrationTokenAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, RegistrationTokenRemovedType, RegistrationTokenRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationRegistrationTokenAddedType, ApplicationRegistrationTokenAddedEventMapper)
 }
@@ -21,24 +21,31 @@ const (
 type OIDCConfigAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                     `json:"appId"`
-	ClientID                 string                     `json:"clientId,omitempty"`
-	ClientSecret             *crypto.CryptoValue        `json:"clientSecret,omitempty"`
-	RedirectUris             []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  bool                       `json:"devMode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	Version                               domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                                 string                     `json:"appId"`
+	ClientID                              string                     `json:"clientId,omitempty"`
+	ClientSecret                          *crypto.CryptoValue        `json:"clientSecret,omitempty"`
+	RedirectUris                          []string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                         []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                            []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                       domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                        domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris                []string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                               bool                       `json:"devMode,omitempty"`
+	AccessTokenType                       domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion              bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion                  bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion              bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                             time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                     []string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage              bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI                  string                     `json:"backChannelLogoutURI,omitempty"`
+	FrontChannelLogoutURI                 string                     `json:"frontChannelLogoutURI,omitempty"`
+	RequirePushedAuthRequest              bool                       `json:"requirePushedAuthRequest,omitempty"`
+	RequireRequestObject                  bool                       `json:"requireRequestObject,omitempty"`
+	RequireDPoP                           bool                       `json:"requireDPoP,omitempty"`
+	TLSClientAuthSubjectDN                string                     `json:"tlsClientAuthSubjectDN,omitempty"`
+	BackChannelClientNotificationEndpoint string                     `json:"backChannelClientNotificationEndpoint,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -70,6 +77,13 @@ func NewOIDCConfigAddedEvent(
 	clockSkew time.Duration,
 	additionalOrigins []string,
 	skipNativeAppSuccessPage bool,
+	backChannelLogoutURI string,
+	frontChannelLogoutURI string,
+	requirePushedAuthRequest bool,
+	requireRequestObject bool,
+	requireDPoP bool,
+	tlsClientAuthSubjectDN string,
+	backChannelClientNotificationEndpoint string,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -77,24 +91,31 @@ func NewOIDCConfigAddedEvent(
 			aggregate,
 			OIDCConfigAddedType,
 		),
-		Version:                  version,
-		AppID:                    appID,
-		ClientID:                 clientID,
-		ClientSecret:             clientSecret,
-		RedirectUris:             redirectUris,
-		ResponseTypes:            responseTypes,
-		GrantTypes:               grantTypes,
-		ApplicationType:          applicationType,
-		AuthMethodType:           authMethodType,
-		PostLogoutRedirectUris:   postLogoutRedirectUris,
-		DevMode:                  devMode,
-		AccessTokenType:          accessTokenType,
-		AccessTokenRoleAssertion: accessTokenRoleAssertion,
-		IDTokenRoleAssertion:     idTokenRoleAssertion,
-		IDTokenUserinfoAssertion: idTokenUserinfoAssertion,
-		ClockSkew:                clockSkew,
-		AdditionalOrigins:        additionalOrigins,
-		SkipNativeAppSuccessPage: skipNativeAppSuccessPage,
+		Version:                               version,
+		AppID:                                 appID,
+		ClientID:                              clientID,
+		ClientSecret:                          clientSecret,
+		RedirectUris:                          redirectUris,
+		ResponseTypes:                         responseTypes,
+		GrantTypes:                            grantTypes,
+		ApplicationType:                       applicationType,
+		AuthMethodType:                        authMethodType,
+		PostLogoutRedirectUris:                postLogoutRedirectUris,
+		DevMode:                               devMode,
+		AccessTokenType:                       accessTokenType,
+		AccessTokenRoleAssertion:              accessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  idTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              idTokenUserinfoAssertion,
+		ClockSkew:                             clockSkew,
+		AdditionalOrigins:                     additionalOrigins,
+		SkipNativeAppSuccessPage:              skipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  backChannelLogoutURI,
+		FrontChannelLogoutURI:                 frontChannelLogoutURI,
+		RequirePushedAuthRequest:              requirePushedAuthRequest,
+		RequireRequestObject:                  requireRequestObject,
+		RequireDPoP:                           requireDPoP,
+		TLSClientAuthSubjectDN:                tlsClientAuthSubjectDN,
+		BackChannelClientNotificationEndpoint: backChannelClientNotificationEndpoint,
 	}
 }
 
@@ -180,6 +201,27 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 			return false
 		}
 	}
+	if e.BackChannelLogoutURI != c.BackChannelLogoutURI {
+		return false
+	}
+	if e.FrontChannelLogoutURI != c.FrontChannelLogoutURI {
+		return false
+	}
+	if e.RequirePushedAuthRequest != c.RequirePushedAuthRequest {
+		return false
+	}
+	if e.RequireRequestObject != c.RequireRequestObject {
+		return false
+	}
+	if e.RequireDPoP != c.RequireDPoP {
+		return false
+	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
+	if e.BackChannelClientNotificationEndpoint != c.BackChannelClientNotificationEndpoint {
+		return false
+	}
 	return e.SkipNativeAppSuccessPage == c.SkipNativeAppSuccessPage
 }
 
@@ -199,22 +241,29 @@ func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type OIDCConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                      `json:"appId"`
-	RedirectUris             *[]string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          *domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   *[]string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  *bool                       `json:"devMode,omitempty"`
-	AccessTokenType          *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion *bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     *bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	Version                               *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                                 string                      `json:"appId"`
+	RedirectUris                          *[]string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                         *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                            *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                       *domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                        *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris                *[]string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                               *bool                       `json:"devMode,omitempty"`
+	AccessTokenType                       *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion              *bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion                  *bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion              *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                             *time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                     *[]string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage              *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI                  *string                     `json:"backChannelLogoutURI,omitempty"`
+	FrontChannelLogoutURI                 *string                     `json:"frontChannelLogoutURI,omitempty"`
+	RequirePushedAuthRequest              *bool                       `json:"requirePushedAuthRequest,omitempty"`
+	RequireRequestObject                  *bool                       `json:"requireRequestObject,omitempty"`
+	RequireDPoP                           *bool                       `json:"requireDPoP,omitempty"`
+	TLSClientAuthSubjectDN                *string                     `json:"tlsClientAuthSubjectDN,omitempty"`
+	BackChannelClientNotificationEndpoint *string                     `json:"backChannelClientNotificationEndpoint,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -341,6 +390,48 @@ func ChangeSkipNativeAppSuccessPage(skipNativeAppSuccessPage bool) func(event *O
 	}
 }
 
+func ChangeBackChannelLogoutURI(backChannelLogoutURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelLogoutURI = &backChannelLogoutURI
+	}
+}
+
+func ChangeFrontChannelLogoutURI(frontChannelLogoutURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.FrontChannelLogoutURI = &frontChannelLogoutURI
+	}
+}
+
+func ChangeRequirePushedAuthRequest(requirePushedAuthRequest bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequirePushedAuthRequest = &requirePushedAuthRequest
+	}
+}
+
+func ChangeRequireRequestObject(requireRequestObject bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequireRequestObject = &requireRequestObject
+	}
+}
+
+func ChangeRequireDPoP(requireDPoP bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequireDPoP = &requireDPoP
+	}
+}
+
+func ChangeTLSClientAuthSubjectDN(subjectDN string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
+func ChangeBackChannelClientNotificationEndpoint(endpoint string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelClientNotificationEndpoint = &endpoint
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -0,0 +1,153 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	registrationTokenEventTypePrefix = projectEventTypePrefix + "registration.token."
+	RegistrationTokenAddedType       = registrationTokenEventTypePrefix + "added"
+	RegistrationTokenRemovedType     = registrationTokenEventTypePrefix + "removed"
+
+	ApplicationRegistrationTokenAddedType = applicationEventTypePrefix + "oidc.registration.token.added"
+)
+
+// RegistrationTokenAddedEvent adds an initial access token,
+// which allows to register OIDC applications in the project (RFC 7591)
+type RegistrationTokenAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID    string    `json:"tokenId,omitempty"`
+	Expiration time.Time `json:"expiration,omitempty"`
+}
+
+func (e *RegistrationTokenAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RegistrationTokenAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRegistrationTokenAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+	expiration time.Time,
+) *RegistrationTokenAddedEvent {
+	return &RegistrationTokenAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RegistrationTokenAddedType,
+		),
+		TokenID:    tokenID,
+		Expiration: expiration,
+	}
+}
+
+func RegistrationTokenAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &RegistrationTokenAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-ooR4e", "unable to unmarshal registration token")
+	}
+
+	return e, nil
+}
+
+type RegistrationTokenRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID string `json:"tokenId,omitempty"`
+}
+
+func (e *RegistrationTokenRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RegistrationTokenRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRegistrationTokenRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+) *RegistrationTokenRemovedEvent {
+	return &RegistrationTokenRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RegistrationTokenRemovedType,
+		),
+		TokenID: tokenID,
+	}
+}
+
+func RegistrationTokenRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &RegistrationTokenRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Eet7a", "unable to unmarshal registration token")
+	}
+
+	return e, nil
+}
+
+// ApplicationRegistrationTokenAddedEvent adds the registration access token of a dynamically registered application (RFC 7592).
+// Only the latest token of the application is valid.
+type ApplicationRegistrationTokenAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID   string `json:"appId"`
+	TokenID string `json:"tokenId,omitempty"`
+}
+
+func (e *ApplicationRegistrationTokenAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationRegistrationTokenAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationRegistrationTokenAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	tokenID string,
+) *ApplicationRegistrationTokenAddedEvent {
+	return &ApplicationRegistrationTokenAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationRegistrationTokenAddedType,
+		),
+		AppID:   appID,
+		TokenID: tokenID,
+	}
+}
+
+func ApplicationRegistrationTokenAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ApplicationRegistrationTokenAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Uu5ae", "unable to unmarshal registration token")
+	}
+
+	return e, nil
+}
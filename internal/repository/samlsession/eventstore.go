@@ -0,0 +1,10 @@
+package samlsession
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, TerminatedType, eventstore.GenericEventMapper[TerminatedEvent])
+}
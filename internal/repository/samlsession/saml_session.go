@@ -0,0 +1,90 @@
+package samlsession
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	samlSessionEventPrefix = "saml_session."
+	AddedType              = samlSessionEventPrefix + "added"
+	TerminatedType         = samlSessionEventPrefix + "terminated"
+)
+
+// AddedEvent is pushed when a SAML response was issued to a service provider
+// on behalf of a user agent.
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID            string `json:"userID"`
+	UserResourceOwner string `json:"userResourceOwner"`
+	UserAgentID       string `json:"userAgentID"`
+	AppID             string `json:"appID"`
+	EntityID          string `json:"entityID"`
+	NameID            string `json:"nameID"`
+}
+
+func (e *AddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *AddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewAddedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	userResourceOwner,
+	userAgentID,
+	appID,
+	entityID,
+	nameID string,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedType,
+		),
+		UserID:            userID,
+		UserResourceOwner: userResourceOwner,
+		UserAgentID:       userAgentID,
+		AppID:             appID,
+		EntityID:          entityID,
+		NameID:            nameID,
+	}
+}
+
+// TerminatedEvent is pushed when the session was logged out,
+// either by the service provider itself or by the propagation of a logout.
+type TerminatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *TerminatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TerminatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TerminatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewTerminatedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *TerminatedEvent {
+	return &TerminatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TerminatedType,
+		),
+	}
+}
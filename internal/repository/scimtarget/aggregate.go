@@ -0,0 +1,18 @@
+package scimtarget
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "scim_target"
+	AggregateVersion = "v1"
+)
+
+func NewAggregate(id, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            id,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
@@ -0,0 +1,12 @@
+package scimtarget
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ChangedEventType, eventstore.GenericEventMapper[ChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RemovedEventType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserProvisionedEventType, eventstore.GenericEventMapper[UserProvisionedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserDeprovisionedEventType, eventstore.GenericEventMapper[UserDeprovisionedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserProvisioningFailedEventType, eventstore.GenericEventMapper[UserProvisioningFailedEvent])
+}
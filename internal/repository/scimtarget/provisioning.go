@@ -0,0 +1,96 @@
+package scimtarget
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	userEventTypePrefix             = eventTypePrefix + "user."
+	UserProvisionedEventType        = userEventTypePrefix + "provisioned"
+	UserDeprovisionedEventType      = userEventTypePrefix + "deprovisioned"
+	UserProvisioningFailedEventType = userEventTypePrefix + "provisioning.failed"
+)
+
+// UserProvisionedEvent is pushed after a user was successfully created or updated at the SCIM target.
+// RemoteID is the id the target assigned to the user.
+type UserProvisionedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID      string `json:"userId"`
+	UserGrantID string `json:"userGrantId,omitempty"`
+	RemoteID    string `json:"remoteId"`
+}
+
+func (e *UserProvisionedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserProvisionedEvent) Payload() any {
+	return e
+}
+
+func (e *UserProvisionedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserProvisionedEvent(ctx context.Context, aggregate *eventstore.Aggregate, userID, userGrantID, remoteID string) *UserProvisionedEvent {
+	return &UserProvisionedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, UserProvisionedEventType),
+		userID, userGrantID, remoteID,
+	}
+}
+
+// UserDeprovisionedEvent is pushed after a user was deleted at the SCIM target.
+type UserDeprovisionedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+}
+
+func (e *UserDeprovisionedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserDeprovisionedEvent) Payload() any {
+	return e
+}
+
+func (e *UserDeprovisionedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserDeprovisionedEvent(ctx context.Context, aggregate *eventstore.Aggregate, userID string) *UserDeprovisionedEvent {
+	return &UserDeprovisionedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, UserDeprovisionedEventType),
+		userID,
+	}
+}
+
+// UserProvisioningFailedEvent is pushed for every failed attempt to provision or deprovision a user.
+type UserProvisioningFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+	Error  string `json:"error"`
+}
+
+func (e *UserProvisioningFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserProvisioningFailedEvent) Payload() any {
+	return e
+}
+
+func (e *UserProvisioningFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserProvisioningFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, userID, err string) *UserProvisioningFailedEvent {
+	return &UserProvisioningFailedEvent{
+		*eventstore.NewBaseEventForPush(ctx, aggregate, UserProvisioningFailedEventType),
+		userID, err,
+	}
+}
@@ -0,0 +1,148 @@
+package scimtarget
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix  eventstore.EventType = "scim_target."
+	AddedEventType                        = eventTypePrefix + "added"
+	ChangedEventType                      = eventTypePrefix + "changed"
+	RemovedEventType                      = eventTypePrefix + "removed"
+)
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID string              `json:"projectId"`
+	AppID     string              `json:"appId,omitempty"`
+	Name      string              `json:"name"`
+	Endpoint  string              `json:"endpoint"`
+	Token     *crypto.CryptoValue `json:"token,omitempty"`
+	Timeout   time.Duration       `json:"timeout"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID,
+	appID,
+	name,
+	endpoint string,
+	token *crypto.CryptoValue,
+	timeout time.Duration,
+) *AddedEvent {
+	return &AddedEvent{
+		*eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		projectID, appID, name, endpoint, token, timeout}
+}
+
+type ChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AppID    *string             `json:"appId,omitempty"`
+	Name     *string             `json:"name,omitempty"`
+	Endpoint *string             `json:"endpoint,omitempty"`
+	Token    *crypto.CryptoValue `json:"token,omitempty"`
+	Timeout  *time.Duration      `json:"timeout,omitempty"`
+}
+
+func (e *ChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ChangedEvent) Payload() any {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []Changes,
+) *ChangedEvent {
+	changeEvent := &ChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ChangedEventType,
+		),
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent
+}
+
+type Changes func(event *ChangedEvent)
+
+func ChangeAppID(appID string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.AppID = &appID
+	}
+}
+
+func ChangeName(name string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeEndpoint(endpoint string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeToken(token *crypto.CryptoValue) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Token = token
+	}
+}
+
+func ChangeTimeout(timeout time.Duration) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Timeout = &timeout
+	}
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{*eventstore.NewBaseEventForPush(ctx, aggregate, RemovedEventType)}
+}
@@ -12,24 +12,25 @@ import (
 )
 
 const (
-	sessionEventPrefix     = "session."
-	AddedType              = sessionEventPrefix + "added"
-	UserCheckedType        = sessionEventPrefix + "user.checked"
-	PasswordCheckedType    = sessionEventPrefix + "password.checked"
-	IntentCheckedType      = sessionEventPrefix + "intent.checked"
-	WebAuthNChallengedType = sessionEventPrefix + "webAuthN.challenged"
-	WebAuthNCheckedType    = sessionEventPrefix + "webAuthN.checked"
-	TOTPCheckedType        = sessionEventPrefix + "totp.checked"
-	OTPSMSChallengedType   = sessionEventPrefix + "otp.sms.challenged"
-	OTPSMSSentType         = sessionEventPrefix + "otp.sms.sent"
-	OTPSMSCheckedType      = sessionEventPrefix + "otp.sms.checked"
-	OTPEmailChallengedType = sessionEventPrefix + "otp.email.challenged"
-	OTPEmailSentType       = sessionEventPrefix + "otp.email.sent"
-	OTPEmailCheckedType    = sessionEventPrefix + "otp.email.checked"
-	TokenSetType           = sessionEventPrefix + "token.set"
-	MetadataSetType        = sessionEventPrefix + "metadata.set"
-	LifetimeSetType        = sessionEventPrefix + "lifetime.set"
-	TerminateType          = sessionEventPrefix + "terminated"
+	sessionEventPrefix      = "session."
+	AddedType               = sessionEventPrefix + "added"
+	UserCheckedType         = sessionEventPrefix + "user.checked"
+	PasswordCheckedType     = sessionEventPrefix + "password.checked"
+	IntentCheckedType       = sessionEventPrefix + "intent.checked"
+	WebAuthNChallengedType  = sessionEventPrefix + "webAuthN.challenged"
+	WebAuthNCheckedType     = sessionEventPrefix + "webAuthN.checked"
+	TOTPCheckedType         = sessionEventPrefix + "totp.checked"
+	OTPSMSChallengedType    = sessionEventPrefix + "otp.sms.challenged"
+	OTPSMSSentType          = sessionEventPrefix + "otp.sms.sent"
+	OTPSMSCheckedType       = sessionEventPrefix + "otp.sms.checked"
+	OTPEmailChallengedType  = sessionEventPrefix + "otp.email.challenged"
+	OTPEmailSentType        = sessionEventPrefix + "otp.email.sent"
+	OTPEmailCheckedType     = sessionEventPrefix + "otp.email.checked"
+	RecoveryCodeCheckedType = sessionEventPrefix + "recoverycode.checked"
+	TokenSetType            = sessionEventPrefix + "token.set"
+	MetadataSetType         = sessionEventPrefix + "metadata.set"
+	LifetimeSetType         = sessionEventPrefix + "lifetime.set"
+	TerminateType           = sessionEventPrefix + "terminated"
 )
 
 type AddedEvent struct {
@@ -529,6 +530,39 @@ func NewOTPEmailCheckedEvent(
 	}
 }
 
+type RecoveryCodeCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *RecoveryCodeCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RecoveryCodeCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RecoveryCodeCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRecoveryCodeCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *RecoveryCodeCheckedEvent {
+	return &RecoveryCodeCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RecoveryCodeCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
 type TokenSetEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
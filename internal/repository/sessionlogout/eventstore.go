@@ -0,0 +1,10 @@
+package sessionlogout
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, RegisteredType, eventstore.GenericEventMapper[RegisteredEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, BackChannelLogoutSentType, eventstore.GenericEventMapper[BackChannelLogoutSentEvent])
+}
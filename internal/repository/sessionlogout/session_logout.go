@@ -0,0 +1,91 @@
+package sessionlogout
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	sessionLogoutEventPrefix  = "session_logout."
+	RegisteredType            = sessionLogoutEventPrefix + "registered"
+	BackChannelLogoutSentType = sessionLogoutEventPrefix + "back_channel.sent"
+)
+
+// RegisteredEvent is pushed when an id_token containing the `sid` claim
+// was issued to a client with a back- or front-channel logout uri.
+// The issuer of the id_token is kept, as the logout token must be issued by the same issuer.
+type RegisteredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID   string `json:"userID"`
+	ClientID string `json:"clientID"`
+	Issuer   string `json:"issuer"`
+}
+
+func (e *RegisteredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RegisteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RegisteredEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewRegisteredEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	clientID,
+	issuer string,
+) *RegisteredEvent {
+	return &RegisteredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RegisteredType,
+		),
+		UserID:   userID,
+		ClientID: clientID,
+		Issuer:   issuer,
+	}
+}
+
+// BackChannelLogoutSentEvent is pushed when the logout token
+// was successfully delivered to the back-channel logout uri of the client.
+type BackChannelLogoutSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID   string `json:"userID"`
+	ClientID string `json:"clientID"`
+}
+
+func (e *BackChannelLogoutSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *BackChannelLogoutSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *BackChannelLogoutSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewBackChannelLogoutSentEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	clientID string,
+) *BackChannelLogoutSentEvent {
+	return &BackChannelLogoutSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			BackChannelLogoutSentType,
+		),
+		UserID:   userID,
+		ClientID: clientID,
+	}
+}
@@ -50,6 +50,7 @@ type HumanView struct {
 	OTPState                 MFAState
 	OTPSMSAdded              bool
 	OTPEmailAdded            bool
+	RecoveryCodesAdded       bool
 	U2FTokens                []*WebAuthNView
 	PasswordlessTokens       []*WebAuthNView
 	MFAMaxSetUp              domain.MFALevel
@@ -205,6 +206,12 @@ func (u *UserView) MFATypesAllowed(level domain.MFALevel, policy *domain.LoginPo
 					if u.OTPEmailAdded {
 						types = append(types, domain.MFATypeOTPEmail)
 					}
+				case domain.SecondFactorTypeRecoveryCodes:
+					// recovery codes are prepended, so they will never be selected by default
+					// since the last provider of the list is preselected
+					if u.RecoveryCodesAdded {
+						types = append([]domain.MFAType{domain.MFATypeRecoveryCode}, types...)
+					}
 				}
 			}
 		}
@@ -159,6 +159,15 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		if v.UserAgentID == data.UserAgentID {
 			v.setSecondFactorVerification(event.CreatedAt(), domain.MFATypeOTPEmail)
 		}
+	case user.HumanRecoveryCodeCheckSucceededType:
+		data := new(es_model.OTPVerified)
+		err := data.SetData(event)
+		if err != nil {
+			return err
+		}
+		if v.UserAgentID == data.UserAgentID {
+			v.setSecondFactorVerification(event.CreatedAt(), domain.MFATypeRecoveryCode)
+		}
 	case user.UserV1MFAOTPCheckFailedType,
 		user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPCheckFailedType,
@@ -166,7 +175,9 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		user.HumanU2FTokenCheckFailedType,
 		user.HumanU2FTokenRemovedType,
 		user.HumanOTPSMSCheckFailedType,
-		user.HumanOTPEmailCheckFailedType:
+		user.HumanOTPEmailCheckFailedType,
+		user.HumanRecoveryCodeCheckFailedType,
+		user.HumanRecoveryCodesRemovedType:
 		v.SecondFactorVerification = time.Time{}
 	case user.HumanU2FTokenVerifiedType:
 		data := new(es_model.WebAuthNVerify)
@@ -227,6 +238,9 @@ func (v *UserSessionView) EventTypes() []eventstore.EventType {
 		user.HumanOTPSMSCheckFailedType,
 		user.HumanOTPEmailCheckSucceededType,
 		user.HumanOTPEmailCheckFailedType,
+		user.HumanRecoveryCodeCheckSucceededType,
+		user.HumanRecoveryCodeCheckFailedType,
+		user.HumanRecoveryCodesRemovedType,
 		user.HumanU2FTokenCheckFailedType,
 		user.HumanU2FTokenRemovedType,
 		user.HumanU2FTokenVerifiedType,